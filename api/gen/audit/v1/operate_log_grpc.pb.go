@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.0
+// - protoc             v6.33.5
+// source: audit/v1/operate_log.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OperateLogService_GetOperateLog_FullMethodName   = "/system.audit.v1.OperateLogService/GetOperateLog"
+	OperateLogService_ListOperateLogs_FullMethodName = "/system.audit.v1.OperateLogService/ListOperateLogs"
+)
+
+// OperateLogServiceClient is the client API for OperateLogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type OperateLogServiceClient interface {
+	// 获取操作日志详情
+	GetOperateLog(ctx context.Context, in *GetOperateLogRequest, opts ...grpc.CallOption) (*GetOperateLogReply, error)
+	// 获取操作日志列表
+	ListOperateLogs(ctx context.Context, in *ListOperateLogsRequest, opts ...grpc.CallOption) (*ListOperateLogsReply, error)
+}
+
+type operateLogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOperateLogServiceClient(cc grpc.ClientConnInterface) OperateLogServiceClient {
+	return &operateLogServiceClient{cc}
+}
+
+func (c *operateLogServiceClient) GetOperateLog(ctx context.Context, in *GetOperateLogRequest, opts ...grpc.CallOption) (*GetOperateLogReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOperateLogReply)
+	err := c.cc.Invoke(ctx, OperateLogService_GetOperateLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *operateLogServiceClient) ListOperateLogs(ctx context.Context, in *ListOperateLogsRequest, opts ...grpc.CallOption) (*ListOperateLogsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOperateLogsReply)
+	err := c.cc.Invoke(ctx, OperateLogService_ListOperateLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OperateLogServiceServer is the server API for OperateLogService service.
+// All implementations must embed UnimplementedOperateLogServiceServer
+// for forward compatibility.
+type OperateLogServiceServer interface {
+	// 获取操作日志详情
+	GetOperateLog(context.Context, *GetOperateLogRequest) (*GetOperateLogReply, error)
+	// 获取操作日志列表
+	ListOperateLogs(context.Context, *ListOperateLogsRequest) (*ListOperateLogsReply, error)
+	mustEmbedUnimplementedOperateLogServiceServer()
+}
+
+// UnimplementedOperateLogServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOperateLogServiceServer struct{}
+
+func (UnimplementedOperateLogServiceServer) GetOperateLog(context.Context, *GetOperateLogRequest) (*GetOperateLogReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetOperateLog not implemented")
+}
+func (UnimplementedOperateLogServiceServer) ListOperateLogs(context.Context, *ListOperateLogsRequest) (*ListOperateLogsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOperateLogs not implemented")
+}
+func (UnimplementedOperateLogServiceServer) mustEmbedUnimplementedOperateLogServiceServer() {}
+func (UnimplementedOperateLogServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeOperateLogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OperateLogServiceServer will
+// result in compilation errors.
+type UnsafeOperateLogServiceServer interface {
+	mustEmbedUnimplementedOperateLogServiceServer()
+}
+
+func RegisterOperateLogServiceServer(s grpc.ServiceRegistrar, srv OperateLogServiceServer) {
+	// If the following call panics, it indicates UnimplementedOperateLogServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OperateLogService_ServiceDesc, srv)
+}
+
+func _OperateLogService_GetOperateLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperateLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperateLogServiceServer).GetOperateLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperateLogService_GetOperateLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperateLogServiceServer).GetOperateLog(ctx, req.(*GetOperateLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OperateLogService_ListOperateLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperateLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OperateLogServiceServer).ListOperateLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OperateLogService_ListOperateLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OperateLogServiceServer).ListOperateLogs(ctx, req.(*ListOperateLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OperateLogService_ServiceDesc is the grpc.ServiceDesc for OperateLogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OperateLogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "system.audit.v1.OperateLogService",
+	HandlerType: (*OperateLogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOperateLog",
+			Handler:    _OperateLogService_GetOperateLog_Handler,
+		},
+		{
+			MethodName: "ListOperateLogs",
+			Handler:    _OperateLogService_ListOperateLogs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "audit/v1/operate_log.proto",
+}
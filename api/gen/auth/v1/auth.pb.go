@@ -11,7 +11,9 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	_ "quest-admin/api/gen/quest"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -29,6 +31,8 @@ type LoginRequest struct {
 	Username      *string                `protobuf:"bytes,1,opt,name=username,proto3,oneof" json:"username,omitempty"`
 	Password      *string                `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
 	Device        *string                `protobuf:"bytes,3,opt,name=device,proto3,oneof" json:"device,omitempty"`
+	CaptchaId     *string                `protobuf:"bytes,4,opt,name=captcha_id,json=captchaId,proto3,oneof" json:"captcha_id,omitempty"`
+	CaptchaCode   *string                `protobuf:"bytes,5,opt,name=captcha_code,json=captchaCode,proto3,oneof" json:"captcha_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -84,28 +88,1939 @@ func (x *LoginRequest) GetDevice() string {
 	return ""
 }
 
+func (x *LoginRequest) GetCaptchaId() string {
+	if x != nil && x.CaptchaId != nil {
+		return *x.CaptchaId
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetCaptchaCode() string {
+	if x != nil && x.CaptchaCode != nil {
+		return *x.CaptchaCode
+	}
+	return ""
+}
+
 type LoginReply struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Token                  string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken           string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresIn              int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	RefreshExpiresIn       int64                  `protobuf:"varint,4,opt,name=refresh_expires_in,json=refreshExpiresIn,proto3" json:"refresh_expires_in,omitempty"`
+	MfaRequired            bool                   `protobuf:"varint,5,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaTicket              string                 `protobuf:"bytes,6,opt,name=mfa_ticket,json=mfaTicket,proto3" json:"mfa_ticket,omitempty"`
+	MfaExpiresIn           int64                  `protobuf:"varint,7,opt,name=mfa_expires_in,json=mfaExpiresIn,proto3" json:"mfa_expires_in,omitempty"`
+	PasswordChangeRequired bool                   `protobuf:"varint,8,opt,name=password_change_required,json=passwordChangeRequired,proto3" json:"password_change_required,omitempty"`
+	IdToken                string                 `protobuf:"bytes,9,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	MfaMethods             []string               `protobuf:"bytes,10,rep,name=mfa_methods,json=mfaMethods,proto3" json:"mfa_methods,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *LoginReply) Reset() {
+	*x = LoginReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginReply) ProtoMessage() {}
+
+func (x *LoginReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginReply.ProtoReflect.Descriptor instead.
+func (*LoginReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LoginReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LoginReply) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *LoginReply) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *LoginReply) GetRefreshExpiresIn() int64 {
+	if x != nil {
+		return x.RefreshExpiresIn
+	}
+	return 0
+}
+
+func (x *LoginReply) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *LoginReply) GetMfaTicket() string {
+	if x != nil {
+		return x.MfaTicket
+	}
+	return ""
+}
+
+func (x *LoginReply) GetMfaExpiresIn() int64 {
+	if x != nil {
+		return x.MfaExpiresIn
+	}
+	return 0
+}
+
+func (x *LoginReply) GetPasswordChangeRequired() bool {
+	if x != nil {
+		return x.PasswordChangeRequired
+	}
+	return false
+}
+
+func (x *LoginReply) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+func (x *LoginReply) GetMfaMethods() []string {
+	if x != nil {
+		return x.MfaMethods
+	}
+	return nil
+}
+
+type SendLoginCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *string                `protobuf:"bytes,1,opt,name=channel,proto3,oneof" json:"channel,omitempty"`
+	Target        *string                `protobuf:"bytes,2,opt,name=target,proto3,oneof" json:"target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendLoginCodeRequest) Reset() {
+	*x = SendLoginCodeRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendLoginCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendLoginCodeRequest) ProtoMessage() {}
+
+func (x *SendLoginCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendLoginCodeRequest.ProtoReflect.Descriptor instead.
+func (*SendLoginCodeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SendLoginCodeRequest) GetChannel() string {
+	if x != nil && x.Channel != nil {
+		return *x.Channel
+	}
+	return ""
+}
+
+func (x *SendLoginCodeRequest) GetTarget() string {
+	if x != nil && x.Target != nil {
+		return *x.Target
+	}
+	return ""
+}
+
+type SendLoginCodeReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExpiresIn     int64                  `protobuf:"varint,1,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	RetryAfter    int64                  `protobuf:"varint,2,opt,name=retry_after,json=retryAfter,proto3" json:"retry_after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendLoginCodeReply) Reset() {
+	*x = SendLoginCodeReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendLoginCodeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendLoginCodeReply) ProtoMessage() {}
+
+func (x *SendLoginCodeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendLoginCodeReply.ProtoReflect.Descriptor instead.
+func (*SendLoginCodeReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SendLoginCodeReply) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *SendLoginCodeReply) GetRetryAfter() int64 {
+	if x != nil {
+		return x.RetryAfter
+	}
+	return 0
+}
+
+type LoginByCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       *string                `protobuf:"bytes,1,opt,name=channel,proto3,oneof" json:"channel,omitempty"`
+	Target        *string                `protobuf:"bytes,2,opt,name=target,proto3,oneof" json:"target,omitempty"`
+	Code          *string                `protobuf:"bytes,3,opt,name=code,proto3,oneof" json:"code,omitempty"`
+	Device        *string                `protobuf:"bytes,4,opt,name=device,proto3,oneof" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginByCodeRequest) Reset() {
+	*x = LoginByCodeRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginByCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginByCodeRequest) ProtoMessage() {}
+
+func (x *LoginByCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginByCodeRequest.ProtoReflect.Descriptor instead.
+func (*LoginByCodeRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LoginByCodeRequest) GetChannel() string {
+	if x != nil && x.Channel != nil {
+		return *x.Channel
+	}
+	return ""
+}
+
+func (x *LoginByCodeRequest) GetTarget() string {
+	if x != nil && x.Target != nil {
+		return *x.Target
+	}
+	return ""
+}
+
+func (x *LoginByCodeRequest) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *LoginByCodeRequest) GetDevice() string {
+	if x != nil && x.Device != nil {
+		return *x.Device
+	}
+	return ""
+}
+
+type VerifyMfaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MfaTicket     *string                `protobuf:"bytes,1,opt,name=mfa_ticket,json=mfaTicket,proto3,oneof" json:"mfa_ticket,omitempty"`
+	OtpCode       *string                `protobuf:"bytes,2,opt,name=otp_code,json=otpCode,proto3,oneof" json:"otp_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyMfaRequest) Reset() {
+	*x = VerifyMfaRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyMfaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyMfaRequest) ProtoMessage() {}
+
+func (x *VerifyMfaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyMfaRequest.ProtoReflect.Descriptor instead.
+func (*VerifyMfaRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VerifyMfaRequest) GetMfaTicket() string {
+	if x != nil && x.MfaTicket != nil {
+		return *x.MfaTicket
+	}
+	return ""
+}
+
+func (x *VerifyMfaRequest) GetOtpCode() string {
+	if x != nil && x.OtpCode != nil {
+		return *x.OtpCode
+	}
+	return ""
+}
+
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *string                `protobuf:"bytes,1,opt,name=account,proto3,oneof" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RequestPasswordResetRequest) GetAccount() string {
+	if x != nil && x.Account != nil {
+		return *x.Account
+	}
+	return ""
+}
+
+type ResetPasswordWithTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         *string                `protobuf:"bytes,1,opt,name=token,proto3,oneof" json:"token,omitempty"`
+	NewPassword   *string                `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3,oneof" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordWithTokenRequest) Reset() {
+	*x = ResetPasswordWithTokenRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordWithTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordWithTokenRequest) ProtoMessage() {}
+
+func (x *ResetPasswordWithTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordWithTokenRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordWithTokenRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ResetPasswordWithTokenRequest) GetToken() string {
+	if x != nil && x.Token != nil {
+		return *x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordWithTokenRequest) GetNewPassword() string {
+	if x != nil && x.NewPassword != nil {
+		return *x.NewPassword
+	}
+	return ""
+}
+
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  *string                `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3,oneof" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil && x.RefreshToken != nil {
+		return *x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenReply struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Token                  string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken           string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresIn              int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	RefreshExpiresIn       int64                  `protobuf:"varint,4,opt,name=refresh_expires_in,json=refreshExpiresIn,proto3" json:"refresh_expires_in,omitempty"`
+	PasswordChangeRequired bool                   `protobuf:"varint,5,opt,name=password_change_required,json=passwordChangeRequired,proto3" json:"password_change_required,omitempty"`
+	IdToken                string                 `protobuf:"bytes,6,opt,name=id_token,json=idToken,proto3" json:"id_token,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RefreshTokenReply) Reset() {
+	*x = RefreshTokenReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenReply) ProtoMessage() {}
+
+func (x *RefreshTokenReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenReply.ProtoReflect.Descriptor instead.
+func (*RefreshTokenReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RefreshTokenReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RefreshTokenReply) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshTokenReply) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *RefreshTokenReply) GetRefreshExpiresIn() int64 {
+	if x != nil {
+		return x.RefreshExpiresIn
+	}
+	return 0
+}
+
+func (x *RefreshTokenReply) GetPasswordChangeRequired() bool {
+	if x != nil {
+		return x.PasswordChangeRequired
+	}
+	return false
+}
+
+func (x *RefreshTokenReply) GetIdToken() string {
+	if x != nil {
+		return x.IdToken
+	}
+	return ""
+}
+
+type GetCaptchaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCaptchaRequest) Reset() {
+	*x = GetCaptchaRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCaptchaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCaptchaRequest) ProtoMessage() {}
+
+func (x *GetCaptchaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCaptchaRequest.ProtoReflect.Descriptor instead.
+func (*GetCaptchaRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{10}
+}
+
+type GetCaptchaReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CaptchaId     string                 `protobuf:"bytes,1,opt,name=captcha_id,json=captchaId,proto3" json:"captcha_id,omitempty"`
+	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCaptchaReply) Reset() {
+	*x = GetCaptchaReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCaptchaReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCaptchaReply) ProtoMessage() {}
+
+func (x *GetCaptchaReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCaptchaReply.ProtoReflect.Descriptor instead.
+func (*GetCaptchaReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCaptchaReply) GetCaptchaId() string {
+	if x != nil {
+		return x.CaptchaId
+	}
+	return ""
+}
+
+func (x *GetCaptchaReply) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *GetCaptchaReply) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+type GetPermissionInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPermissionInfoRequest) Reset() {
+	*x = GetPermissionInfoRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPermissionInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPermissionInfoRequest) ProtoMessage() {}
+
+func (x *GetPermissionInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPermissionInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetPermissionInfoRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{12}
+}
+
+type GetPermissionInfoReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserInfo              `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Roles         []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Menus         []*MenuInfo            `protobuf:"bytes,4,rep,name=menus,proto3" json:"menus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPermissionInfoReply) Reset() {
+	*x = GetPermissionInfoReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPermissionInfoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPermissionInfoReply) ProtoMessage() {}
+
+func (x *GetPermissionInfoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPermissionInfoReply.ProtoReflect.Descriptor instead.
+func (*GetPermissionInfoReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetPermissionInfoReply) GetUser() *UserInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *GetPermissionInfoReply) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *GetPermissionInfoReply) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *GetPermissionInfoReply) GetMenus() []*MenuInfo {
+	if x != nil {
+		return x.Menus
+	}
+	return nil
+}
+
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{14}
+}
+
+type KickoutUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickoutUserRequest) Reset() {
+	*x = KickoutUserRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickoutUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickoutUserRequest) ProtoMessage() {}
+
+func (x *KickoutUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickoutUserRequest.ProtoReflect.Descriptor instead.
+func (*KickoutUserRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *KickoutUserRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+type KickoutSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     *string                `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickoutSessionRequest) Reset() {
+	*x = KickoutSessionRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickoutSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickoutSessionRequest) ProtoMessage() {}
+
+func (x *KickoutSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickoutSessionRequest.ProtoReflect.Descriptor instead.
+func (*KickoutSessionRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *KickoutSessionRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+type GetMfaStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMfaStatusRequest) Reset() {
+	*x = GetMfaStatusRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMfaStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMfaStatusRequest) ProtoMessage() {}
+
+func (x *GetMfaStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMfaStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetMfaStatusRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{17}
+}
+
+type GetMfaStatusReply struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	TotpEnabled            bool                   `protobuf:"varint,1,opt,name=totp_enabled,json=totpEnabled,proto3" json:"totp_enabled,omitempty"`
+	RecoveryCodesRemaining int32                  `protobuf:"varint,2,opt,name=recovery_codes_remaining,json=recoveryCodesRemaining,proto3" json:"recovery_codes_remaining,omitempty"`
+	PasskeyCount           int32                  `protobuf:"varint,3,opt,name=passkey_count,json=passkeyCount,proto3" json:"passkey_count,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetMfaStatusReply) Reset() {
+	*x = GetMfaStatusReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMfaStatusReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMfaStatusReply) ProtoMessage() {}
+
+func (x *GetMfaStatusReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMfaStatusReply.ProtoReflect.Descriptor instead.
+func (*GetMfaStatusReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetMfaStatusReply) GetTotpEnabled() bool {
+	if x != nil {
+		return x.TotpEnabled
+	}
+	return false
+}
+
+func (x *GetMfaStatusReply) GetRecoveryCodesRemaining() int32 {
+	if x != nil {
+		return x.RecoveryCodesRemaining
+	}
+	return 0
+}
+
+func (x *GetMfaStatusReply) GetPasskeyCount() int32 {
+	if x != nil {
+		return x.PasskeyCount
+	}
+	return 0
+}
+
+type BeginTotpEnrollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginTotpEnrollRequest) Reset() {
+	*x = BeginTotpEnrollRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginTotpEnrollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTotpEnrollRequest) ProtoMessage() {}
+
+func (x *BeginTotpEnrollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTotpEnrollRequest.ProtoReflect.Descriptor instead.
+func (*BeginTotpEnrollRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{19}
+}
+
+type BeginTotpEnrollReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUri    string                 `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
+	QrCode        string                 `protobuf:"bytes,3,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginTotpEnrollReply) Reset() {
+	*x = BeginTotpEnrollReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginTotpEnrollReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginTotpEnrollReply) ProtoMessage() {}
+
+func (x *BeginTotpEnrollReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginTotpEnrollReply.ProtoReflect.Descriptor instead.
+func (*BeginTotpEnrollReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *BeginTotpEnrollReply) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *BeginTotpEnrollReply) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+func (x *BeginTotpEnrollReply) GetQrCode() string {
+	if x != nil {
+		return x.QrCode
+	}
+	return ""
+}
+
+type ConfirmTotpEnrollRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OtpCode       *string                `protobuf:"bytes,1,opt,name=otp_code,json=otpCode,proto3,oneof" json:"otp_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTotpEnrollRequest) Reset() {
+	*x = ConfirmTotpEnrollRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTotpEnrollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTotpEnrollRequest) ProtoMessage() {}
+
+func (x *ConfirmTotpEnrollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTotpEnrollRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTotpEnrollRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ConfirmTotpEnrollRequest) GetOtpCode() string {
+	if x != nil && x.OtpCode != nil {
+		return *x.OtpCode
+	}
+	return ""
+}
+
+type ConfirmTotpEnrollReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTotpEnrollReply) Reset() {
+	*x = ConfirmTotpEnrollReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTotpEnrollReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTotpEnrollReply) ProtoMessage() {}
+
+func (x *ConfirmTotpEnrollReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTotpEnrollReply.ProtoReflect.Descriptor instead.
+func (*ConfirmTotpEnrollReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ConfirmTotpEnrollReply) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type DisableTotpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OtpCode       *string                `protobuf:"bytes,1,opt,name=otp_code,json=otpCode,proto3,oneof" json:"otp_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisableTotpRequest) Reset() {
+	*x = DisableTotpRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisableTotpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisableTotpRequest) ProtoMessage() {}
+
+func (x *DisableTotpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisableTotpRequest.ProtoReflect.Descriptor instead.
+func (*DisableTotpRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DisableTotpRequest) GetOtpCode() string {
+	if x != nil && x.OtpCode != nil {
+		return *x.OtpCode
+	}
+	return ""
+}
+
+type CreateApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Permissions   []string               `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	ExpireAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyRequest) Reset() {
+	*x = CreateApiKeyRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyRequest) ProtoMessage() {}
+
+func (x *CreateApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateApiKeyRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiKeyRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *CreateApiKeyRequest) GetExpireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return nil
+}
+
+type CreateApiKeyReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *ApiKeyInfo            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Secret        string                 `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiKeyReply) Reset() {
+	*x = CreateApiKeyReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiKeyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiKeyReply) ProtoMessage() {}
+
+func (x *CreateApiKeyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiKeyReply.ProtoReflect.Descriptor instead.
+func (*CreateApiKeyReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateApiKeyReply) GetKey() *ApiKeyInfo {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *CreateApiKeyReply) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ListApiKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysRequest) Reset() {
+	*x = ListApiKeysRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysRequest) ProtoMessage() {}
+
+func (x *ListApiKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{26}
+}
+
+type ListApiKeysReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*ApiKeyInfo          `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiKeysReply) Reset() {
+	*x = ListApiKeysReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiKeysReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiKeysReply) ProtoMessage() {}
+
+func (x *ListApiKeysReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiKeysReply.ProtoReflect.Descriptor instead.
+func (*ListApiKeysReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ListApiKeysReply) GetKeys() []*ApiKeyInfo {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *ListApiKeysReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RevokeApiKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiKeyRequest) Reset() {
+	*x = RevokeApiKeyRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiKeyRequest) ProtoMessage() {}
+
+func (x *RevokeApiKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RevokeApiKeyRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+type ApiKeyInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix        string                 `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Permissions   []string               `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	ExpireAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	CreateAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiKeyInfo) Reset() {
+	*x = ApiKeyInfo{}
+	mi := &file_auth_v1_auth_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiKeyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyInfo) ProtoMessage() {}
+
+func (x *ApiKeyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyInfo.ProtoReflect.Descriptor instead.
+func (*ApiKeyInfo) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ApiKeyInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApiKeyInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiKeyInfo) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ApiKeyInfo) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *ApiKeyInfo) GetExpireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return nil
+}
+
+func (x *ApiKeyInfo) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *ApiKeyInfo) GetCreateAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateAt
+	}
+	return nil
+}
+
+type BeginPasskeyRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyRegistrationRequest) Reset() {
+	*x = BeginPasskeyRegistrationRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyRegistrationRequest) ProtoMessage() {}
+
+func (x *BeginPasskeyRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeyRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{30}
+}
+
+type FinishPasskeyRegistrationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     *string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Credential    *string                `protobuf:"bytes,3,opt,name=credential,proto3,oneof" json:"credential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishPasskeyRegistrationRequest) Reset() {
+	*x = FinishPasskeyRegistrationRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeyRegistrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeyRegistrationRequest) ProtoMessage() {}
+
+func (x *FinishPasskeyRegistrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPasskeyRegistrationRequest.ProtoReflect.Descriptor instead.
+func (*FinishPasskeyRegistrationRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *FinishPasskeyRegistrationRequest) GetCredential() string {
+	if x != nil && x.Credential != nil {
+		return *x.Credential
+	}
+	return ""
+}
+
+type BeginPasskeyLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MfaTicket     *string                `protobuf:"bytes,1,opt,name=mfa_ticket,json=mfaTicket,proto3,oneof" json:"mfa_ticket,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BeginPasskeyLoginRequest) Reset() {
+	*x = BeginPasskeyLoginRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BeginPasskeyLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BeginPasskeyLoginRequest) ProtoMessage() {}
+
+func (x *BeginPasskeyLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BeginPasskeyLoginRequest.ProtoReflect.Descriptor instead.
+func (*BeginPasskeyLoginRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BeginPasskeyLoginRequest) GetMfaTicket() string {
+	if x != nil && x.MfaTicket != nil {
+		return *x.MfaTicket
+	}
+	return ""
+}
+
+type PasskeyCeremonyReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Options       string                 `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasskeyCeremonyReply) Reset() {
+	*x = PasskeyCeremonyReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasskeyCeremonyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasskeyCeremonyReply) ProtoMessage() {}
+
+func (x *PasskeyCeremonyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasskeyCeremonyReply.ProtoReflect.Descriptor instead.
+func (*PasskeyCeremonyReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PasskeyCeremonyReply) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PasskeyCeremonyReply) GetOptions() string {
+	if x != nil {
+		return x.Options
+	}
+	return ""
+}
+
+func (x *PasskeyCeremonyReply) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+type FinishPasskeyLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     *string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	Credential    *string                `protobuf:"bytes,2,opt,name=credential,proto3,oneof" json:"credential,omitempty"`
+	Device        *string                `protobuf:"bytes,3,opt,name=device,proto3,oneof" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishPasskeyLoginRequest) Reset() {
+	*x = FinishPasskeyLoginRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishPasskeyLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishPasskeyLoginRequest) ProtoMessage() {}
+
+func (x *FinishPasskeyLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishPasskeyLoginRequest.ProtoReflect.Descriptor instead.
+func (*FinishPasskeyLoginRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FinishPasskeyLoginRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *FinishPasskeyLoginRequest) GetCredential() string {
+	if x != nil && x.Credential != nil {
+		return *x.Credential
+	}
+	return ""
+}
+
+func (x *FinishPasskeyLoginRequest) GetDevice() string {
+	if x != nil && x.Device != nil {
+		return *x.Device
+	}
+	return ""
+}
+
+type ListPasskeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPasskeysRequest) Reset() {
+	*x = ListPasskeysRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPasskeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPasskeysRequest) ProtoMessage() {}
+
+func (x *ListPasskeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPasskeysRequest.ProtoReflect.Descriptor instead.
+func (*ListPasskeysRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{35}
+}
+
+type ListPasskeysReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Passkeys      []*PasskeyInfo         `protobuf:"bytes,1,rep,name=passkeys,proto3" json:"passkeys,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPasskeysReply) Reset() {
+	*x = ListPasskeysReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPasskeysReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPasskeysReply) ProtoMessage() {}
+
+func (x *ListPasskeysReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPasskeysReply.ProtoReflect.Descriptor instead.
+func (*ListPasskeysReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListPasskeysReply) GetPasskeys() []*PasskeyInfo {
+	if x != nil {
+		return x.Passkeys
+	}
+	return nil
+}
+
+func (x *ListPasskeysReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RenamePasskeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Name          *string                `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LoginReply) Reset() {
-	*x = LoginReply{}
-	mi := &file_auth_v1_auth_proto_msgTypes[1]
+func (x *RenamePasskeyRequest) Reset() {
+	*x = RenamePasskeyRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LoginReply) String() string {
+func (x *RenamePasskeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LoginReply) ProtoMessage() {}
+func (*RenamePasskeyRequest) ProtoMessage() {}
 
-func (x *LoginReply) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[1]
+func (x *RenamePasskeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -116,39 +2031,47 @@ func (x *LoginReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LoginReply.ProtoReflect.Descriptor instead.
-func (*LoginReply) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use RenamePasskeyRequest.ProtoReflect.Descriptor instead.
+func (*RenamePasskeyRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *LoginReply) GetToken() string {
-	if x != nil {
-		return x.Token
+func (x *RenamePasskeyRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
 	}
 	return ""
 }
 
-type GetPermissionInfoRequest struct {
+func (x *RenamePasskeyRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+type DeletePasskeyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPermissionInfoRequest) Reset() {
-	*x = GetPermissionInfoRequest{}
-	mi := &file_auth_v1_auth_proto_msgTypes[2]
+func (x *DeletePasskeyRequest) Reset() {
+	*x = DeletePasskeyRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPermissionInfoRequest) String() string {
+func (x *DeletePasskeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPermissionInfoRequest) ProtoMessage() {}
+func (*DeletePasskeyRequest) ProtoMessage() {}
 
-func (x *GetPermissionInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[2]
+func (x *DeletePasskeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -159,36 +2082,45 @@ func (x *GetPermissionInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPermissionInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetPermissionInfoRequest) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use DeletePasskeyRequest.ProtoReflect.Descriptor instead.
+func (*DeletePasskeyRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{38}
 }
 
-type GetPermissionInfoReply struct {
+func (x *DeletePasskeyRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+type PasskeyInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *UserInfo              `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Roles         []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
-	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
-	Menus         []*MenuInfo            `protobuf:"bytes,4,rep,name=menus,proto3" json:"menus,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Transports    []string               `protobuf:"bytes,3,rep,name=transports,proto3" json:"transports,omitempty"`
+	BackedUp      bool                   `protobuf:"varint,4,opt,name=backed_up,json=backedUp,proto3" json:"backed_up,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	CreateAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPermissionInfoReply) Reset() {
-	*x = GetPermissionInfoReply{}
-	mi := &file_auth_v1_auth_proto_msgTypes[3]
+func (x *PasskeyInfo) Reset() {
+	*x = PasskeyInfo{}
+	mi := &file_auth_v1_auth_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPermissionInfoReply) String() string {
+func (x *PasskeyInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPermissionInfoReply) ProtoMessage() {}
+func (*PasskeyInfo) ProtoMessage() {}
 
-func (x *GetPermissionInfoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[3]
+func (x *PasskeyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -199,35 +2131,281 @@ func (x *GetPermissionInfoReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPermissionInfoReply.ProtoReflect.Descriptor instead.
-func (*GetPermissionInfoReply) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use PasskeyInfo.ProtoReflect.Descriptor instead.
+func (*PasskeyInfo) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetPermissionInfoReply) GetUser() *UserInfo {
+func (x *PasskeyInfo) GetId() string {
 	if x != nil {
-		return x.User
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PasskeyInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PasskeyInfo) GetTransports() []string {
+	if x != nil {
+		return x.Transports
 	}
 	return nil
 }
 
-func (x *GetPermissionInfoReply) GetRoles() []string {
+func (x *PasskeyInfo) GetBackedUp() bool {
 	if x != nil {
-		return x.Roles
+		return x.BackedUp
+	}
+	return false
+}
+
+func (x *PasskeyInfo) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
 	}
 	return nil
 }
 
-func (x *GetPermissionInfoReply) GetPermissions() []string {
+func (x *PasskeyInfo) GetCreateAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Permissions
+		return x.CreateAt
 	}
 	return nil
 }
 
-func (x *GetPermissionInfoReply) GetMenus() []*MenuInfo {
+type UnlockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	Ip            *string                `protobuf:"bytes,2,opt,name=ip,proto3,oneof" json:"ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockUserRequest) Reset() {
+	*x = UnlockUserRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockUserRequest) ProtoMessage() {}
+
+func (x *UnlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[40]
 	if x != nil {
-		return x.Menus
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UnlockUserRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *UnlockUserRequest) GetIp() string {
+	if x != nil && x.Ip != nil {
+		return *x.Ip
+	}
+	return ""
+}
+
+type ListOnlineSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        *string                `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnlineSessionsRequest) Reset() {
+	*x = ListOnlineSessionsRequest{}
+	mi := &file_auth_v1_auth_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnlineSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnlineSessionsRequest) ProtoMessage() {}
+
+func (x *ListOnlineSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnlineSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListOnlineSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListOnlineSessionsRequest) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+type ListOnlineSessionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sessions      []*SessionInfo         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOnlineSessionsReply) Reset() {
+	*x = ListOnlineSessionsReply{}
+	mi := &file_auth_v1_auth_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOnlineSessionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOnlineSessionsReply) ProtoMessage() {}
+
+func (x *ListOnlineSessionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOnlineSessionsReply.ProtoReflect.Descriptor instead.
+func (*ListOnlineSessionsReply) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListOnlineSessionsReply) GetSessions() []*SessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *ListOnlineSessionsReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SessionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,7,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Device        string                 `protobuf:"bytes,4,opt,name=device,proto3" json:"device,omitempty"`
+	LoginAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=login_at,json=loginAt,proto3" json:"login_at,omitempty"`
+	ActiveAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=active_at,json=activeAt,proto3" json:"active_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionInfo) Reset() {
+	*x = SessionInfo{}
+	mi := &file_auth_v1_auth_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionInfo) ProtoMessage() {}
+
+func (x *SessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_auth_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionInfo.ProtoReflect.Descriptor instead.
+func (*SessionInfo) Descriptor() ([]byte, []int) {
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *SessionInfo) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *SessionInfo) GetLoginAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LoginAt
+	}
+	return nil
+}
+
+func (x *SessionInfo) GetActiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveAt
 	}
 	return nil
 }
@@ -250,7 +2428,7 @@ type UserInfo struct {
 
 func (x *UserInfo) Reset() {
 	*x = UserInfo{}
-	mi := &file_auth_v1_auth_proto_msgTypes[4]
+	mi := &file_auth_v1_auth_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -262,7 +2440,7 @@ func (x *UserInfo) String() string {
 func (*UserInfo) ProtoMessage() {}
 
 func (x *UserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[4]
+	mi := &file_auth_v1_auth_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -275,7 +2453,7 @@ func (x *UserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
 func (*UserInfo) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{4}
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *UserInfo) GetId() string {
@@ -370,7 +2548,7 @@ type MenuInfo struct {
 
 func (x *MenuInfo) Reset() {
 	*x = MenuInfo{}
-	mi := &file_auth_v1_auth_proto_msgTypes[5]
+	mi := &file_auth_v1_auth_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -382,7 +2560,7 @@ func (x *MenuInfo) String() string {
 func (*MenuInfo) ProtoMessage() {}
 
 func (x *MenuInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_v1_auth_proto_msgTypes[5]
+	mi := &file_auth_v1_auth_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -395,7 +2573,7 @@ func (x *MenuInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MenuInfo.ProtoReflect.Descriptor instead.
 func (*MenuInfo) Descriptor() ([]byte, []int) {
-	return file_auth_v1_auth_proto_rawDescGZIP(), []int{5}
+	return file_auth_v1_auth_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *MenuInfo) GetId() string {
@@ -500,23 +2678,222 @@ var File_auth_v1_auth_proto protoreflect.FileDescriptor
 
 const file_auth_v1_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x12auth/v1/auth.proto\x12\x0esystem.auth.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1copenapi/v3/annotations.proto\"\x9e\x02\n" +
+	"\x12auth/v1/auth.proto\x12\x0esystem.auth.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\x1a\x10quest/auth.proto\"\xec\x03\n" +
 	"\fLoginRequest\x129\n" +
 	"\busername\x18\x01 \x01(\tB\x18\xbaG\x15:\a\x12\x05admin\x92\x02\t用户名H\x00R\busername\x88\x01\x01\x127\n" +
 	"\bpassword\x18\x02 \x01(\tB\x16\xbaG\x13:\b\x12\x06123456\x92\x02\x06密码H\x01R\bpassword\x88\x01\x01\x12/\n" +
-	"\x06device\x18\x03 \x01(\tB\x12\xbaG\x0f:\x04\x12\x02pc\x92\x02\x06设备H\x02R\x06device\x88\x01\x01:D\xbaGA:-\x12+{\"username\": \"admin\", \"password\": \"123456\"}\x92\x02\x0f登录请求体B\v\n" +
+	"\x06device\x18\x03 \x01(\tB\x12\xbaG\x0f:\x04\x12\x02pc\x92\x02\x06设备H\x02R\x06device\x88\x01\x01\x12P\n" +
+	"\n" +
+	"captcha_id\x18\x04 \x01(\tB,\xbaG)\x92\x02&验证码ID，开启验证码时必填H\x03R\tcaptchaId\x88\x01\x01\x12Z\n" +
+	"\fcaptcha_code\x18\x05 \x01(\tB2\xbaG/:\x06\x12\x041234\x92\x02$验证码，开启验证码时必填H\x04R\vcaptchaCode\x88\x01\x01:D\xbaGA:-\x12+{\"username\": \"admin\", \"password\": \"123456\"}\x92\x02\x0f登录请求体B\v\n" +
 	"\t_usernameB\v\n" +
 	"\t_passwordB\t\n" +
-	"\a_device\"x\n" +
+	"\a_deviceB\r\n" +
+	"\v_captcha_idB\x0f\n" +
+	"\r_captcha_code\"\xe2\a\n" +
 	"\n" +
 	"LoginReply\x12S\n" +
-	"\x05token\x18\x01 \x01(\tB=\xbaG::)\x12'eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\x92\x02\f访问令牌R\x05token:\x15\xbaG\x12\x92\x02\x0f登录响应体\"=\n" +
+	"\x05token\x18\x01 \x01(\tB=\xbaG::)\x12'eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9...\x92\x02\f访问令牌R\x05token\x127\n" +
+	"\rrefresh_token\x18\x02 \x01(\tB\x12\xbaG\x0f\x92\x02\f刷新令牌R\frefreshToken\x12N\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03B/\xbaG,:\x06\x12\x047200\x92\x02!访问令牌有效期，单位秒R\texpiresIn\x12_\n" +
+	"\x12refresh_expires_in\x18\x04 \x01(\x03B1\xbaG.:\b\x12\x06604800\x92\x02!刷新令牌有效期，单位秒R\x10refreshExpiresIn\x12k\n" +
+	"\fmfa_required\x18\x05 \x01(\bBH\xbaGE:\a\x12\x05false\x92\x029是否需要MFA二次验证，为 true 时不返回令牌R\vmfaRequired\x12C\n" +
+	"\n" +
+	"mfa_ticket\x18\x06 \x01(\tB$\xbaG!\x92\x02\x1eMFA票据，用于二次验证R\tmfaTicket\x12Q\n" +
+	"\x0emfa_expires_in\x18\a \x01(\x03B+\xbaG(:\x05\x12\x03300\x92\x02\x1eMFA票据有效期，单位秒R\fmfaExpiresIn\x12\xaf\x01\n" +
+	"\x18password_change_required\x18\b \x01(\bBu\xbaGr:\a\x12\x05false\x92\x02f是否需要先修改密码，为 true 时会话不具备任何权限，修改密码后需重新登录R\x16passwordChangeRequired\x12`\n" +
+	"\bid_token\x18\t \x01(\tBE\xbaGB\x92\x02?OpenID Connect ID Token，下游服务可通过JWKS离线验签R\aidToken\x12e\n" +
+	"\vmfa_methods\x18\n" +
+	" \x03(\tBD\xbaGA:\x11\x12\x0f[totp, passkey]\x92\x02+可用的二次验证方式: totp、passkeyR\n" +
+	"mfaMethods:\x15\xbaG\x12\x92\x02\x0f登录响应体\"\xed\x01\n" +
+	"\x14SendLoginCodeRequest\x12R\n" +
+	"\achannel\x18\x01 \x01(\tB3\xbaG0:\x05\x12\x03sms\x92\x02&发送渠道: sms-短信, email-邮件H\x00R\achannel\x88\x01\x01\x12D\n" +
+	"\x06target\x18\x02 \x01(\tB'\xbaG$:\r\x12\v13800138000\x92\x02\x12手机号或邮箱H\x01R\x06target\x88\x01\x01:$\xbaG!\x92\x02\x1e发送登录验证码请求体B\n" +
+	"\n" +
+	"\b_channelB\t\n" +
+	"\a_target\"\xe2\x01\n" +
+	"\x12SendLoginCodeReply\x12J\n" +
+	"\n" +
+	"expires_in\x18\x01 \x01(\x03B+\xbaG(:\x05\x12\x03300\x92\x02\x1e验证码有效期，单位秒R\texpiresIn\x12Z\n" +
+	"\vretry_after\x18\x02 \x01(\x03B9\xbaG6:\x04\x12\x0260\x92\x02-再次发送前需等待的时间，单位秒R\n" +
+	"retryAfter:$\xbaG!\x92\x02\x1e发送登录验证码响应体\"\xde\x02\n" +
+	"\x12LoginByCodeRequest\x12R\n" +
+	"\achannel\x18\x01 \x01(\tB3\xbaG0:\x05\x12\x03sms\x92\x02&发送渠道: sms-短信, email-邮件H\x00R\achannel\x88\x01\x01\x12D\n" +
+	"\x06target\x18\x02 \x01(\tB'\xbaG$:\r\x12\v13800138000\x92\x02\x12手机号或邮箱H\x01R\x06target\x88\x01\x01\x122\n" +
+	"\x04code\x18\x03 \x01(\tB\x19\xbaG\x16:\b\x12\x06123456\x92\x02\t验证码H\x02R\x04code\x88\x01\x01\x12/\n" +
+	"\x06device\x18\x04 \x01(\tB\x12\xbaG\x0f:\x04\x12\x02pc\x92\x02\x06设备H\x03R\x06device\x88\x01\x01:\x1e\xbaG\x1b\x92\x02\x18验证码登录请求体B\n" +
+	"\n" +
+	"\b_channelB\t\n" +
+	"\a_targetB\a\n" +
+	"\x05_codeB\t\n" +
+	"\a_device\"\xdd\x01\n" +
+	"\x10VerifyMfaRequest\x12B\n" +
+	"\n" +
+	"mfa_ticket\x18\x01 \x01(\tB\x1e\xbaG\x1b\x92\x02\x18登录返回的MFA票据H\x00R\tmfaTicket\x88\x01\x01\x12I\n" +
+	"\botp_code\x18\x02 \x01(\tB)\xbaG&:\b\x12\x06123456\x92\x02\x19TOTP验证码或恢复码H\x01R\aotpCode\x88\x01\x01:\x1e\xbaG\x1b\x92\x02\x18MFA二次验证请求体B\r\n" +
+	"\v_mfa_ticketB\v\n" +
+	"\t_otp_code\"\x8e\x01\n" +
+	"\x1bRequestPasswordResetRequest\x12@\n" +
+	"\aaccount\x18\x01 \x01(\tB!\xbaG\x1e:\a\x12\x05admin\x92\x02\x12用户名或邮箱H\x00R\aaccount\x88\x01\x01:!\xbaG\x1e\x92\x02\x1b申请找回密码请求体B\n" +
+	"\n" +
+	"\b_account\"\xed\x01\n" +
+	"\x1dResetPasswordWithTokenRequest\x12?\n" +
+	"\x05token\x18\x01 \x01(\tB$\xbaG!\x92\x02\x1e找回密码邮件中的令牌H\x00R\x05token\x88\x01\x01\x12G\n" +
+	"\fnew_password\x18\x02 \x01(\tB\x1f\xbaG\x1c:\x0e\x12\fWelcome@2024\x92\x02\t新密码H\x01R\vnewPassword\x88\x01\x01:'\xbaG$\x92\x02!使用令牌重置密码请求体B\b\n" +
+	"\x06_tokenB\x0f\n" +
+	"\r_new_password\"\x82\x01\n" +
+	"\x13RefreshTokenRequest\x12<\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\x12\xbaG\x0f\x92\x02\f刷新令牌H\x00R\frefreshToken\x88\x01\x01:\x1b\xbaG\x18\x92\x02\x15刷新令牌请求体B\x10\n" +
+	"\x0e_refresh_token\"\xe4\x03\n" +
+	"\x11RefreshTokenReply\x12(\n" +
+	"\x05token\x18\x01 \x01(\tB\x12\xbaG\x0f\x92\x02\f访问令牌R\x05token\x127\n" +
+	"\rrefresh_token\x18\x02 \x01(\tB\x12\xbaG\x0f\x92\x02\f刷新令牌R\frefreshToken\x12N\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03B/\xbaG,:\x06\x12\x047200\x92\x02!访问令牌有效期，单位秒R\texpiresIn\x12_\n" +
+	"\x12refresh_expires_in\x18\x04 \x01(\x03B1\xbaG.:\b\x12\x06604800\x92\x02!刷新令牌有效期，单位秒R\x10refreshExpiresIn\x12d\n" +
+	"\x18password_change_required\x18\x05 \x01(\bB*\xbaG':\a\x12\x05false\x92\x02\x1b是否需要先修改密码R\x16passwordChangeRequired\x128\n" +
+	"\bid_token\x18\x06 \x01(\tB\x1d\xbaG\x1a\x92\x02\x17OpenID Connect ID TokenR\aidToken:\x1b\xbaG\x18\x92\x02\x15刷新令牌响应体\"9\n" +
+	"\x11GetCaptchaRequest:$\xbaG!\x92\x02\x1e获取图形验证码请求体\"\xa1\x02\n" +
+	"\x0fGetCaptchaReply\x120\n" +
+	"\n" +
+	"captcha_id\x18\x01 \x01(\tB\x11\xbaG\x0e\x92\x02\v验证码IDR\tcaptchaId\x12s\n" +
+	"\x05image\x18\x02 \x01(\tB]\xbaGZ:&\x12$data:image/png;base64,iVBORw0KGgo...\x92\x02/验证码图片，base64 编码的 PNG data URIR\x05image\x12A\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03B\"\xbaG\x1f:\x05\x12\x03120\x92\x02\x15有效期，单位秒R\texpiresIn:$\xbaG!\x92\x02\x1e获取图形验证码响应体\"=\n" +
 	"\x18GetPermissionInfoRequest:!\xbaG\x1e\x92\x02\x1b获取权限信息请求体\"\xf3\x02\n" +
 	"\x16GetPermissionInfoReply\x12@\n" +
 	"\x04user\x18\x01 \x01(\v2\x18.system.auth.v1.UserInfoB\x12\xbaG\x0f\x92\x02\f用户信息R\x04user\x12C\n" +
 	"\x05roles\x18\x02 \x03(\tB-\xbaG*:\x13\x12\x11[\"admin\", \"user\"]\x92\x02\x12角色代码列表R\x05roles\x12h\n" +
 	"\vpermissions\x18\x03 \x03(\tBF\xbaGC:,\x12*[\"system:user:list\", \"system:user:create\"]\x92\x02\x12权限标识列表R\vpermissions\x12E\n" +
-	"\x05menus\x18\x04 \x03(\v2\x18.system.auth.v1.MenuInfoB\x15\xbaG\x12\x92\x02\x0f菜单树结构R\x05menus:!\xbaG\x1e\x92\x02\x1b获取权限信息响应体\"\x97\x05\n" +
+	"\x05menus\x18\x04 \x03(\v2\x18.system.auth.v1.MenuInfoB\x15\xbaG\x12\x92\x02\x0f菜单树结构R\x05menus:!\xbaG\x1e\x92\x02\x1b获取权限信息响应体\",\n" +
+	"\rLogoutRequest:\x1b\xbaG\x18\x92\x02\x15退出登录请求体\"x\n" +
+	"\x12KickoutUserRequest\x129\n" +
+	"\auser_id\x18\x01 \x01(\tB\x1b\xbaG\x18:\v\x12\t123456789\x92\x02\b用户IDH\x00R\x06userId\x88\x01\x01:\x1b\xbaG\x18\x92\x02\x15踢出用户请求体B\n" +
+	"\n" +
+	"\b_user_id\"\x81\x01\n" +
+	"\x15KickoutSessionRequest\x126\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tB\x12\xbaG\x0f\x92\x02\f会话编号H\x00R\tsessionId\x88\x01\x01:\x1b\xbaG\x18\x92\x02\x15踢出会话请求体B\r\n" +
+	"\v_session_idJ\x04\b\x01\x10\x02\"5\n" +
+	"\x13GetMfaStatusRequest:\x1e\xbaG\x1b\x92\x02\x18获取MFA状态请求体\"\xac\x02\n" +
+	"\x11GetMfaStatusReply\x12D\n" +
+	"\ftotp_enabled\x18\x01 \x01(\bB!\xbaG\x1e:\x06\x12\x04true\x92\x02\x13是否已启用TOTPR\vtotpEnabled\x12a\n" +
+	"\x18recovery_codes_remaining\x18\x02 \x01(\x05B'\xbaG$:\x04\x12\x0210\x92\x02\x1b剩余可用恢复码数量R\x16recoveryCodesRemaining\x12N\n" +
+	"\rpasskey_count\x18\x03 \x01(\x05B)\xbaG&:\x03\x12\x011\x92\x02\x1e已绑定的通行密钥数量R\fpasskeyCount:\x1e\xbaG\x1b\x92\x02\x18获取MFA状态响应体\"9\n" +
+	"\x16BeginTotpEnrollRequest:\x1f\xbaG\x1c\x92\x02\x19开始绑定TOTP请求体\"\xc2\x02\n" +
+	"\x14BeginTotpEnrollReply\x12R\n" +
+	"\x06secret\x18\x01 \x01(\tB:\xbaG7\x92\x024TOTP密钥（base32），无法扫码时手动输入R\x06secret\x12e\n" +
+	"\votpauth_uri\x18\x02 \x01(\tBD\xbaGA:/\x12-otpauth://totp/Quest%20Admin:admin?secret=...\x92\x02\rotpauth地址R\n" +
+	"otpauthUri\x12N\n" +
+	"\aqr_code\x18\x03 \x01(\tB5\xbaG2\x92\x02/二维码图片，base64 编码的 PNG data URIR\x06qrCode:\x1f\xbaG\x1c\x92\x02\x19开始绑定TOTP响应体\"\x95\x01\n" +
+	"\x18ConfirmTotpEnrollRequest\x12K\n" +
+	"\botp_code\x18\x01 \x01(\tB+\xbaG(:\b\x12\x06123456\x92\x02\x1b认证器生成的验证码H\x00R\aotpCode\x88\x01\x01:\x1f\xbaG\x1c\x92\x02\x19确认绑定TOTP请求体B\v\n" +
+	"\t_otp_code\"\x9e\x01\n" +
+	"\x16ConfirmTotpEnrollReply\x12c\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tB<\xbaG9\x92\x026恢复码，每个只能使用一次，请妥善保存R\rrecoveryCodes:\x1f\xbaG\x1c\x92\x02\x19确认绑定TOTP响应体\"\x87\x01\n" +
+	"\x12DisableTotpRequest\x12I\n" +
+	"\botp_code\x18\x01 \x01(\tB)\xbaG&:\b\x12\x06123456\x92\x02\x19TOTP验证码或恢复码H\x00R\aotpCode\x88\x01\x01:\x19\xbaG\x16\x92\x02\x13关闭TOTP请求体B\v\n" +
+	"\t_otp_code\"\xb8\x02\n" +
+	"\x13CreateApiKeyRequest\x122\n" +
+	"\x04name\x18\x01 \x01(\tB\x19\xbaG\x16:\v\x12\tci-deploy\x92\x02\x06名称H\x00R\x04name\x88\x01\x01\x12P\n" +
+	"\vpermissions\x18\x02 \x03(\tB.\xbaG+:\x14\x12\x12[system:user:list]\x92\x02\x12授权的权限码R\vpermissions\x12t\n" +
+	"\texpire_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB;\xbaG8\x92\x025过期时间，为空时90天后过期，最长一年R\bexpireAt:\x1c\xbaG\x19\x92\x02\x16创建API Key请求体B\a\n" +
+	"\x05_name\"\xe8\x01\n" +
+	"\x11CreateApiKeyReply\x12A\n" +
+	"\x03key\x18\x01 \x01(\v2\x1a.system.auth.v1.ApiKeyInfoB\x13\xbaG\x10\x92\x02\rAPI Key信息R\x03key\x12r\n" +
+	"\x06secret\x18\x02 \x01(\tBZ\xbaGW\x92\x02TAPI Key密钥，只返回一次，请求时以 Authorization: Bearer <secret> 携带R\x06secret:\x1c\xbaG\x19\x92\x02\x16创建API Key响应体\"8\n" +
+	"\x12ListApiKeysRequest:\"\xbaG\x1f\x92\x02\x1c查询API Key列表请求体\"\xaa\x01\n" +
+	"\x10ListApiKeysReply\x12C\n" +
+	"\x04keys\x18\x01 \x03(\v2\x1a.system.auth.v1.ApiKeyInfoB\x13\xbaG\x10\x92\x02\rAPI Key列表R\x04keys\x12-\n" +
+	"\x05total\x18\x02 \x01(\x03B\x17\xbaG\x14:\x03\x12\x011\x92\x02\f总记录数R\x05total:\"\xbaG\x1f\x92\x02\x1c查询API Key列表响应体\"u\n" +
+	"\x13RevokeApiKeyRequest\x129\n" +
+	"\x02id\x18\x01 \x01(\tB$\xbaG!:\x0f\x12\rAPIK123456789\x92\x02\rAPI Key编号H\x00R\x02id\x88\x01\x01:\x1c\xbaG\x19\x92\x02\x16吊销API Key请求体B\x05\n" +
+	"\x03_id\"\x97\x04\n" +
+	"\n" +
+	"ApiKeyInfo\x124\n" +
+	"\x02id\x18\x01 \x01(\tB$\xbaG!:\x0f\x12\rAPIK123456789\x92\x02\rAPI Key编号R\x02id\x12-\n" +
+	"\x04name\x18\x02 \x01(\tB\x19\xbaG\x16:\v\x12\tci-deploy\x92\x02\x06名称R\x04name\x12I\n" +
+	"\x06prefix\x18\x03 \x01(\tB1\xbaG.:\x0e\x12\fqak_Xy3k9aB2\x92\x02\x1b密钥前缀，用于识别R\x06prefix\x12:\n" +
+	"\vpermissions\x18\x04 \x03(\tB\x18\xbaG\x15\x92\x02\x12授权的权限码R\vpermissions\x12K\n" +
+	"\texpire_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f过期时间R\bexpireAt\x12n\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB0\xbaG-\x92\x02*最近使用时间，未使用过时为空R\n" +
+	"lastUsedAt\x12K\n" +
+	"\tcreate_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间R\bcreateAt:\x13\xbaG\x10\x92\x02\rAPI Key信息\"J\n" +
+	"\x1fBeginPasskeyRegistrationRequest:'\xbaG$\x92\x02!开始注册通行密钥请求体\"\x83\x03\n" +
+	" FinishPasskeyRegistrationRequest\x12J\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tB&\xbaG#\x92\x02 开始注册时返回的会话IDH\x00R\tsessionId\x88\x01\x01\x12W\n" +
+	"\x04name\x18\x02 \x01(\tB>\xbaG;:\x12\x12\x10MacBook Touch ID\x92\x02$名称，为空时使用默认名称H\x01R\x04name\x88\x01\x01\x12j\n" +
+	"\n" +
+	"credential\x18\x03 \x01(\tBE\xbaGB\x92\x02?navigator.credentials.create 返回的 PublicKeyCredential JSONH\x02R\n" +
+	"credential\x88\x01\x01:'\xbaG$\x92\x02!完成注册通行密钥请求体B\r\n" +
+	"\v_session_idB\a\n" +
+	"\x05_nameB\r\n" +
+	"\v_credential\"\xba\x01\n" +
+	"\x18BeginPasskeyLoginRequest\x12f\n" +
+	"\n" +
+	"mfa_ticket\x18\x01 \x01(\tBB\xbaG?\x92\x02<密码登录返回的MFA票据，作为二次验证时必填H\x00R\tmfaTicket\x88\x01\x01:'\xbaG$\x92\x02!开始通行密钥登录请求体B\r\n" +
+	"\v_mfa_ticket\"\x92\x02\n" +
+	"\x14PasskeyCeremonyReply\x12E\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tB&\xbaG#\x92\x02 会话ID，完成时原样提交R\tsessionId\x12P\n" +
+	"\aoptions\x18\x02 \x01(\tB6\xbaG3\x92\x020传给 navigator.credentials 的参数（JSON）R\aoptions\x12A\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03B\"\xbaG\x1f:\x05\x12\x03300\x92\x02\x15有效期，单位秒R\texpiresIn:\x1e\xbaG\x1b\x92\x02\x18通行密钥仪式参数\"\x86\x03\n" +
+	"\x19FinishPasskeyLoginRequest\x12J\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tB&\xbaG#\x92\x02 开始登录时返回的会话IDH\x00R\tsessionId\x88\x01\x01\x12g\n" +
+	"\n" +
+	"credential\x18\x02 \x01(\tBB\xbaG?\x92\x02<navigator.credentials.get 返回的 PublicKeyCredential JSONH\x01R\n" +
+	"credential\x88\x01\x01\x12b\n" +
+	"\x06device\x18\x03 \x01(\tBE\xbaGB:\x04\x12\x02pc\x92\x029设备，作为二次验证时沿用密码登录的设备H\x02R\x06device\x88\x01\x01:'\xbaG$\x92\x02!完成通行密钥登录请求体B\r\n" +
+	"\v_session_idB\r\n" +
+	"\v_credentialB\t\n" +
+	"\a_device\">\n" +
+	"\x13ListPasskeysRequest:'\xbaG$\x92\x02!查询通行密钥列表请求体\"\xbe\x01\n" +
+	"\x11ListPasskeysReply\x12Q\n" +
+	"\bpasskeys\x18\x01 \x03(\v2\x1b.system.auth.v1.PasskeyInfoB\x18\xbaG\x15\x92\x02\x12通行密钥列表R\bpasskeys\x12-\n" +
+	"\x05total\x18\x02 \x01(\x03B\x17\xbaG\x14:\x03\x12\x011\x92\x02\f总记录数R\x05total:'\xbaG$\x92\x02!查询通行密钥列表响应体\"\xc0\x01\n" +
+	"\x14RenamePasskeyRequest\x12>\n" +
+	"\x02id\x18\x01 \x01(\tB)\xbaG&:\x0f\x12\rPKEY123456789\x92\x02\x12通行密钥编号H\x00R\x02id\x88\x01\x01\x122\n" +
+	"\x04name\x18\x02 \x01(\tB\x19\xbaG\x16:\v\x12\tYubiKey 5\x92\x02\x06名称H\x01R\x04name\x88\x01\x01:$\xbaG!\x92\x02\x1e重命名通行密钥请求体B\x05\n" +
+	"\x03_idB\a\n" +
+	"\x05_name\"\x80\x01\n" +
+	"\x14DeletePasskeyRequest\x12>\n" +
+	"\x02id\x18\x01 \x01(\tB)\xbaG&:\x0f\x12\rPKEY123456789\x92\x02\x12通行密钥编号H\x00R\x02id\x88\x01\x01:!\xbaG\x1e\x92\x02\x1b删除通行密钥请求体B\x05\n" +
+	"\x03_id\"\xe1\x03\n" +
+	"\vPasskeyInfo\x129\n" +
+	"\x02id\x18\x01 \x01(\tB)\xbaG&:\x0f\x12\rPKEY123456789\x92\x02\x12通行密钥编号R\x02id\x124\n" +
+	"\x04name\x18\x02 \x01(\tB \xbaG\x1d:\x12\x12\x10MacBook Touch ID\x92\x02\x06名称R\x04name\x12H\n" +
+	"\n" +
+	"transports\x18\x03 \x03(\tB(\xbaG%:\x14\x12\x12[internal, hybrid]\x92\x02\f传输方式R\n" +
+	"transports\x12@\n" +
+	"\tbacked_up\x18\x04 \x01(\bB#\xbaG :\x06\x12\x04true\x92\x02\x15是否已同步备份R\bbackedUp\x12n\n" +
+	"\flast_used_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB0\xbaG-\x92\x02*最近使用时间，未使用过时为空R\n" +
+	"lastUsedAt\x12K\n" +
+	"\tcreate_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间R\bcreateAt:\x18\xbaG\x15\x92\x02\x12通行密钥信息\"\xc5\x01\n" +
+	"\x11UnlockUserRequest\x129\n" +
+	"\auser_id\x18\x01 \x01(\tB\x1b\xbaG\x18:\v\x12\t123456789\x92\x02\b用户IDH\x00R\x06userId\x88\x01\x01\x12?\n" +
+	"\x02ip\x18\x02 \x01(\tB*\xbaG':\v\x12\t127.0.0.1\x92\x02\x17同时解除锁定的IPH\x01R\x02ip\x88\x01\x01:!\xbaG\x1e\x92\x02\x1b解除登录锁定请求体B\n" +
+	"\n" +
+	"\b_user_idB\x05\n" +
+	"\x03_ip\"\x8b\x01\n" +
+	"\x19ListOnlineSessionsRequest\x12?\n" +
+	"\auser_id\x18\x01 \x01(\tB!\xbaG\x1e:\v\x12\t123456789\x92\x02\x0e用户ID筛选H\x00R\x06userId\x88\x01\x01:!\xbaG\x1e\x92\x02\x1b查询在线会话请求体B\n" +
+	"\n" +
+	"\b_user_id\"\xba\x01\n" +
+	"\x17ListOnlineSessionsReply\x12K\n" +
+	"\bsessions\x18\x01 \x03(\v2\x1b.system.auth.v1.SessionInfoB\x12\xbaG\x0f\x92\x02\f会话列表R\bsessions\x12/\n" +
+	"\x05total\x18\x02 \x01(\x03B\x19\xbaG\x16:\x05\x12\x03100\x92\x02\f总记录数R\x05total:!\xbaG\x1e\x92\x02\x1b查询在线会话响应体\"\xb4\x03\n" +
+	"\vSessionInfo\x12I\n" +
+	"\n" +
+	"session_id\x18\a \x01(\tB*\xbaG'\x92\x02$会话编号，由令牌摘要生成R\tsessionId\x124\n" +
+	"\auser_id\x18\x02 \x01(\tB\x1b\xbaG\x18:\v\x12\t123456789\x92\x02\b用户IDR\x06userId\x124\n" +
+	"\busername\x18\x03 \x01(\tB\x18\xbaG\x15:\a\x12\x05admin\x92\x02\t用户名R\busername\x120\n" +
+	"\x06device\x18\x04 \x01(\tB\x18\xbaG\x15:\x04\x12\x02pc\x92\x02\f登录设备R\x06device\x12I\n" +
+	"\blogin_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f登录时间R\aloginAt\x12Q\n" +
+	"\tactive_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12最后活跃时间R\bactiveAt:\x18\xbaG\x15\x92\x02\x12在线会话信息J\x04\b\x01\x10\x02\"\x97\x05\n" +
 	"\bUserInfo\x128\n" +
 	"\x02id\x18\x01 \x01(\tB(\xbaG%:\v\x12\t123456789\x92\x02\x15用户唯一标识符R\x02id\x124\n" +
 	"\busername\x18\x02 \x01(\tB\x18\xbaG\x15:\a\x12\x05admin\x92\x02\t用户名R\busername\x12;\n" +
@@ -548,10 +2925,43 @@ const file_auth_v1_auth_proto_rawDesc = "" +
 	"\n" +
 	"keep_alive\x18\r \x01(\bB\x1a\xbaG\x17:\x06\x12\x04true\x92\x02\f是否缓存R\tkeepAlive\x12A\n" +
 	"\valways_show\x18\x0e \x01(\bB \xbaG\x1d:\x06\x12\x04true\x92\x02\x12是否总是显示R\n" +
-	"alwaysShow:\x12\xbaG\x0f\x92\x02\f菜单信息2\xb6\x03\n" +
+	"alwaysShow:\x12\xbaG\x0f\x92\x02\f菜单信息2\xe45\n" +
 	"\vAuthService\x12\xb1\x01\n" +
-	"\x05Login\x12\x1c.system.auth.v1.LoginRequest\x1a\x1a.system.auth.v1.LoginReply\"n\xbaGI\x12\f用户登录\x1a9根据用户名和密码进行登录，返回访问令牌\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/qs/v1/auth/admin/login\x12\xf2\x01\n" +
-	"\x11GetPermissionInfo\x12(.system.auth.v1.GetPermissionInfoRequest\x1a&.system.auth.v1.GetPermissionInfoReply\"\x8a\x01\xbaG^\x12\x18获取用户权限信息\x1aB获取当前登录用户的详细信息、角色、权限和菜单\x82\xd3\xe4\x93\x02#\x12!/qs/v1/auth/admin/permission-infoBB\xbaG#:!\n" +
+	"\x05Login\x12\x1c.system.auth.v1.LoginRequest\x1a\x1a.system.auth.v1.LoginReply\"n\xbaGI\x12\f用户登录\x1a9根据用户名和密码进行登录，返回访问令牌\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/qs/v1/auth/admin/login\x12\x9f\x02\n" +
+	"\fRefreshToken\x12#.system.auth.v1.RefreshTokenRequest\x1a!.system.auth.v1.RefreshTokenReply\"\xc6\x01\xbaG\x98\x01\x12\f刷新令牌\x1a\x87\x01使用刷新令牌换取新的访问令牌和刷新令牌，旧刷新令牌随即失效，重复使用将吊销该登录的全部会话\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/qs/v1/auth/admin/refresh-token\x12\xa6\x02\n" +
+	"\rSendLoginCode\x12$.system.auth.v1.SendLoginCodeRequest\x1a\".system.auth.v1.SendLoginCodeReply\"\xca\x01\xbaG\x9a\x01\x12\x15发送登录验证码\x1a\x80\x01向手机号或邮箱发送登录验证码，按手机号、邮箱和IP限制发送频率；账号不存在时同样返回成功\x82\xd3\xe4\x93\x02&:\x01*\"!/qs/v1/auth/admin/login-code/send\x12\xab\x02\n" +
+	"\vLoginByCode\x12\".system.auth.v1.LoginByCodeRequest\x1a\x1a.system.auth.v1.LoginReply\"\xdb\x01\xbaG\xad\x01\x12\x0f验证码登录\x1a\x99\x01使用手机号或邮箱收到的验证码登录，手机号或邮箱需在租户内唯一对应一个用户；已启用MFA的用户需继续二次验证\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/qs/v1/auth/admin/login-by-code\x12\x86\x02\n" +
+	"\tVerifyMfa\x12 .system.auth.v1.VerifyMfaRequest\x1a\x1a.system.auth.v1.LoginReply\"\xba\x01\xbaG\x8f\x01\x12\x0fMFA二次验证\x1a|已启用TOTP的用户密码校验通过后，使用登录返回的MFA票据和验证码（或恢复码）换取访问令牌\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/qs/v1/auth/admin/verify-mfa\x12\x96\x02\n" +
+	"\x14RequestPasswordReset\x12+.system.auth.v1.RequestPasswordResetRequest\x1a\x16.google.protobuf.Empty\"\xb8\x01\xbaG\x88\x01\x12\x12申请找回密码\x1ar向用户名或邮箱对应账号绑定的邮箱发送重置密码链接，无论账号是否存在均返回成功\x82\xd3\xe4\x93\x02&:\x01*\"!/qs/v1/auth/admin/password/forgot\x12\xa5\x02\n" +
+	"\x16ResetPasswordWithToken\x12-.system.auth.v1.ResetPasswordWithTokenRequest\x1a\x16.google.protobuf.Empty\"\xc3\x01\xbaG\x94\x01\x12\x18使用令牌重置密码\x1ax使用找回密码邮件中的令牌设置新密码，令牌只能使用一次，重置后该用户的所有会话下线\x82\xd3\xe4\x93\x02%:\x01*\" /qs/v1/auth/admin/password/reset\x12\xe3\x01\n" +
+	"\n" +
+	"GetCaptcha\x12!.system.auth.v1.GetCaptchaRequest\x1a\x1f.system.auth.v1.GetCaptchaReply\"\x90\x01\xbaGl\x12\x15获取图形验证码\x1aS生成一次性图形验证码，登录时携带 captcha_id 和 captcha_code 提交\x82\xd3\xe4\x93\x02\x1b\x12\x19/qs/v1/auth/admin/captcha\x12\xf2\x01\n" +
+	"\x11GetPermissionInfo\x12(.system.auth.v1.GetPermissionInfoRequest\x1a&.system.auth.v1.GetPermissionInfoReply\"\x8a\x01\xbaG^\x12\x18获取用户权限信息\x1aB获取当前登录用户的详细信息、角色、权限和菜单\x82\xd3\xe4\x93\x02#\x12!/qs/v1/auth/admin/permission-info\x12\x9e\x01\n" +
+	"\x06Logout\x12\x1d.system.auth.v1.LogoutRequest\x1a\x16.google.protobuf.Empty\"]\xbaG7\x12\f退出登录\x1a'注销当前请求携带的访问令牌\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/qs/v1/auth/admin/logout\x12\xcd\x01\n" +
+	"\vKickoutUser\x12\".system.auth.v1.KickoutUserRequest\x1a\x16.google.protobuf.Empty\"\x81\x01\xbaG7\x12\f踢出用户\x1a'将指定用户的所有会话踢下线\xca\xf3\x18\x18\n" +
+	"\x16system:session:kickout\x82\xd3\xe4\x93\x02%:\x01*\" /qs/v1/auth/session/kickout-user\x12\xd7\x01\n" +
+	"\x0eKickoutSession\x12%.system.auth.v1.KickoutSessionRequest\x1a\x16.google.protobuf.Empty\"\x85\x01\xbaG@\x12\f踢出会话\x1a0将当前租户下指定编号的会话踢下线\xca\xf3\x18\x18\n" +
+	"\x16system:session:kickout\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/qs/v1/auth/session/kickout\x12\xfc\x01\n" +
+	"\n" +
+	"UnlockUser\x12!.system.auth.v1.UnlockUserRequest\x1a\x16.google.protobuf.Empty\"\xb2\x01\xbaGo\x12\x12解除登录锁定\x1aY提前解除因连续登录失败导致的用户锁定，可同时解除指定IP的锁定\xca\xf3\x18\x14\n" +
+	"\x12system:user:unlock\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/qs/v1/auth/admin/unlock-user\x12\xcf\x01\n" +
+	"\fGetMfaStatus\x12#.system.auth.v1.GetMfaStatusRequest\x1a!.system.auth.v1.GetMfaStatusReply\"w\xbaGV\x12\x0f获取MFA状态\x1aC查询当前登录用户的TOTP启用状态和剩余恢复码数量\x82\xd3\xe4\x93\x02\x18\x12\x16/qs/v1/auth/mfa/status\x12\x89\x02\n" +
+	"\x0fBeginTotpEnroll\x12&.system.auth.v1.BeginTotpEnrollRequest\x1a$.system.auth.v1.BeginTotpEnrollReply\"\xa7\x01\xbaG\x7f\x12\x10开始绑定TOTP\x1ak为当前登录用户生成TOTP密钥，返回otpauth地址和二维码，需调用确认接口后才生效\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/qs/v1/auth/mfa/totp/begin\x12\xf8\x01\n" +
+	"\x11ConfirmTotpEnroll\x12(.system.auth.v1.ConfirmTotpEnrollRequest\x1a&.system.auth.v1.ConfirmTotpEnrollReply\"\x90\x01\xbaGf\x12\x10确认绑定TOTP\x1aR提交认证器生成的验证码以启用TOTP，返回仅展示一次的恢复码\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/qs/v1/auth/mfa/totp/confirm\x12\xc0\x01\n" +
+	"\vDisableTotp\x12\".system.auth.v1.DisableTotpRequest\x1a\x16.google.protobuf.Empty\"u\xbaGK\x12\n" +
+	"关闭TOTP\x1a=提交验证码或恢复码后关闭当前登录用户的TOTP\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/qs/v1/auth/mfa/totp/disable\x12\x96\x02\n" +
+	"\fCreateApiKey\x12#.system.auth.v1.CreateApiKeyRequest\x1a!.system.auth.v1.CreateApiKeyReply\"\xbd\x01\xbaG\x94\x01\x12\r创建API Key\x1a\x82\x01为当前登录用户创建API Key，授权的权限码不能超出用户当前拥有的权限，密钥只在创建时返回一次\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/qs/v1/auth/api-key/create\x12\xc9\x01\n" +
+	"\vListApiKeys\x12\".system.auth.v1.ListApiKeysRequest\x1a .system.auth.v1.ListApiKeysReply\"t\xbaGQ\x12\x13获取API Key列表\x1a:获取当前登录用户的API Key列表，不包含密钥\x82\xd3\xe4\x93\x02\x1a\x12\x18/qs/v1/auth/api-key/list\x12\xb7\x01\n" +
+	"\fRevokeApiKey\x12#.system.auth.v1.RevokeApiKeyRequest\x1a\x16.google.protobuf.Empty\"j\xbaGB\x12\r吊销API Key\x1a1吊销当前登录用户的API Key，立即生效\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/qs/v1/auth/api-key/revoke\x12\xac\x02\n" +
+	"\x18BeginPasskeyRegistration\x12/.system.auth.v1.BeginPasskeyRegistrationRequest\x1a$.system.auth.v1.PasskeyCeremonyReply\"\xb8\x01\xbaG\x87\x01\x12\x18开始注册通行密钥\x1ak为当前登录用户生成通行密钥注册参数，前端将 options 传给 navigator.credentials.create\x82\xd3\xe4\x93\x02':\x01*\"\"/qs/v1/auth/passkey/register/begin\x12\xc3\x02\n" +
+	"\x19FinishPasskeyRegistration\x120.system.auth.v1.FinishPasskeyRegistrationRequest\x1a\x1b.system.auth.v1.PasskeyInfo\"\xd6\x01\xbaG\xa4\x01\x12\x18完成注册通行密钥\x1a\x87\x01提交浏览器返回的注册凭证，校验通过后绑定到当前登录用户；绑定通行密钥后密码登录需要二次验证\x82\xd3\xe4\x93\x02(:\x01*\"#/qs/v1/auth/passkey/register/finish\x12\xcc\x02\n" +
+	"\x11BeginPasskeyLogin\x12(.system.auth.v1.BeginPasskeyLoginRequest\x1a$.system.auth.v1.PasskeyCeremonyReply\"\xe6\x01\xbaG\xb8\x01\x12\x18开始通行密钥登录\x1a\x9b\x01生成通行密钥登录参数，前端将 options 传给 navigator.credentials.get；不带MFA票据时为免密登录，带MFA票据时作为二次验证\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/qs/v1/auth/admin/passkey/begin\x12\xea\x01\n" +
+	"\x12FinishPasskeyLogin\x12).system.auth.v1.FinishPasskeyLoginRequest\x1a\x1a.system.auth.v1.LoginReply\"\x8c\x01\xbaG^\x12\x18完成通行密钥登录\x1aB提交浏览器返回的断言，校验通过后返回访问令牌\x82\xd3\xe4\x93\x02%:\x01*\" /qs/v1/auth/admin/passkey/finish\x12\xca\x01\n" +
+	"\fListPasskeys\x12#.system.auth.v1.ListPasskeysRequest\x1a!.system.auth.v1.ListPasskeysReply\"r\xbaGO\x12\x18获取通行密钥列表\x1a3获取当前登录用户绑定的通行密钥列表\x82\xd3\xe4\x93\x02\x1a\x12\x18/qs/v1/auth/passkey/list\x12\xbd\x01\n" +
+	"\rRenamePasskey\x12$.system.auth.v1.RenamePasskeyRequest\x1a\x16.google.protobuf.Empty\"n\xbaGF\x12\x15重命名通行密钥\x1a-修改当前登录用户通行密钥的名称\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/qs/v1/auth/passkey/rename\x12\xc3\x01\n" +
+	"\rDeletePasskey\x12$.system.auth.v1.DeletePasskeyRequest\x1a\x16.google.protobuf.Empty\"t\xbaGL\x12\x12删除通行密钥\x1a6删除当前登录用户的通行密钥，立即生效\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/qs/v1/auth/passkey/delete\x12\xf9\x01\n" +
+	"\x12ListOnlineSessions\x12).system.auth.v1.ListOnlineSessionsRequest\x1a'.system.auth.v1.ListOnlineSessionsReply\"\x8e\x01\xbaGR\x12\x18获取在线会话列表\x1a6查询当前在线的后台会话，可按用户筛选\xca\xf3\x18\x15\n" +
+	"\x13system:session:list\x82\xd3\xe4\x93\x02\x1a\x12\x18/qs/v1/auth/session/listBB\xbaG#:!\n" +
 	"\vAuthService\x12\x12认证相关操作Z\x1aquest-admin/api/auth/v1;v1b\x06proto3"
 
 var (
@@ -566,29 +2976,134 @@ func file_auth_v1_auth_proto_rawDescGZIP() []byte {
 	return file_auth_v1_auth_proto_rawDescData
 }
 
-var file_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_auth_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_auth_v1_auth_proto_goTypes = []any{
-	(*LoginRequest)(nil),             // 0: system.auth.v1.LoginRequest
-	(*LoginReply)(nil),               // 1: system.auth.v1.LoginReply
-	(*GetPermissionInfoRequest)(nil), // 2: system.auth.v1.GetPermissionInfoRequest
-	(*GetPermissionInfoReply)(nil),   // 3: system.auth.v1.GetPermissionInfoReply
-	(*UserInfo)(nil),                 // 4: system.auth.v1.UserInfo
-	(*MenuInfo)(nil),                 // 5: system.auth.v1.MenuInfo
-	(*timestamppb.Timestamp)(nil),    // 6: google.protobuf.Timestamp
+	(*LoginRequest)(nil),                     // 0: system.auth.v1.LoginRequest
+	(*LoginReply)(nil),                       // 1: system.auth.v1.LoginReply
+	(*SendLoginCodeRequest)(nil),             // 2: system.auth.v1.SendLoginCodeRequest
+	(*SendLoginCodeReply)(nil),               // 3: system.auth.v1.SendLoginCodeReply
+	(*LoginByCodeRequest)(nil),               // 4: system.auth.v1.LoginByCodeRequest
+	(*VerifyMfaRequest)(nil),                 // 5: system.auth.v1.VerifyMfaRequest
+	(*RequestPasswordResetRequest)(nil),      // 6: system.auth.v1.RequestPasswordResetRequest
+	(*ResetPasswordWithTokenRequest)(nil),    // 7: system.auth.v1.ResetPasswordWithTokenRequest
+	(*RefreshTokenRequest)(nil),              // 8: system.auth.v1.RefreshTokenRequest
+	(*RefreshTokenReply)(nil),                // 9: system.auth.v1.RefreshTokenReply
+	(*GetCaptchaRequest)(nil),                // 10: system.auth.v1.GetCaptchaRequest
+	(*GetCaptchaReply)(nil),                  // 11: system.auth.v1.GetCaptchaReply
+	(*GetPermissionInfoRequest)(nil),         // 12: system.auth.v1.GetPermissionInfoRequest
+	(*GetPermissionInfoReply)(nil),           // 13: system.auth.v1.GetPermissionInfoReply
+	(*LogoutRequest)(nil),                    // 14: system.auth.v1.LogoutRequest
+	(*KickoutUserRequest)(nil),               // 15: system.auth.v1.KickoutUserRequest
+	(*KickoutSessionRequest)(nil),            // 16: system.auth.v1.KickoutSessionRequest
+	(*GetMfaStatusRequest)(nil),              // 17: system.auth.v1.GetMfaStatusRequest
+	(*GetMfaStatusReply)(nil),                // 18: system.auth.v1.GetMfaStatusReply
+	(*BeginTotpEnrollRequest)(nil),           // 19: system.auth.v1.BeginTotpEnrollRequest
+	(*BeginTotpEnrollReply)(nil),             // 20: system.auth.v1.BeginTotpEnrollReply
+	(*ConfirmTotpEnrollRequest)(nil),         // 21: system.auth.v1.ConfirmTotpEnrollRequest
+	(*ConfirmTotpEnrollReply)(nil),           // 22: system.auth.v1.ConfirmTotpEnrollReply
+	(*DisableTotpRequest)(nil),               // 23: system.auth.v1.DisableTotpRequest
+	(*CreateApiKeyRequest)(nil),              // 24: system.auth.v1.CreateApiKeyRequest
+	(*CreateApiKeyReply)(nil),                // 25: system.auth.v1.CreateApiKeyReply
+	(*ListApiKeysRequest)(nil),               // 26: system.auth.v1.ListApiKeysRequest
+	(*ListApiKeysReply)(nil),                 // 27: system.auth.v1.ListApiKeysReply
+	(*RevokeApiKeyRequest)(nil),              // 28: system.auth.v1.RevokeApiKeyRequest
+	(*ApiKeyInfo)(nil),                       // 29: system.auth.v1.ApiKeyInfo
+	(*BeginPasskeyRegistrationRequest)(nil),  // 30: system.auth.v1.BeginPasskeyRegistrationRequest
+	(*FinishPasskeyRegistrationRequest)(nil), // 31: system.auth.v1.FinishPasskeyRegistrationRequest
+	(*BeginPasskeyLoginRequest)(nil),         // 32: system.auth.v1.BeginPasskeyLoginRequest
+	(*PasskeyCeremonyReply)(nil),             // 33: system.auth.v1.PasskeyCeremonyReply
+	(*FinishPasskeyLoginRequest)(nil),        // 34: system.auth.v1.FinishPasskeyLoginRequest
+	(*ListPasskeysRequest)(nil),              // 35: system.auth.v1.ListPasskeysRequest
+	(*ListPasskeysReply)(nil),                // 36: system.auth.v1.ListPasskeysReply
+	(*RenamePasskeyRequest)(nil),             // 37: system.auth.v1.RenamePasskeyRequest
+	(*DeletePasskeyRequest)(nil),             // 38: system.auth.v1.DeletePasskeyRequest
+	(*PasskeyInfo)(nil),                      // 39: system.auth.v1.PasskeyInfo
+	(*UnlockUserRequest)(nil),                // 40: system.auth.v1.UnlockUserRequest
+	(*ListOnlineSessionsRequest)(nil),        // 41: system.auth.v1.ListOnlineSessionsRequest
+	(*ListOnlineSessionsReply)(nil),          // 42: system.auth.v1.ListOnlineSessionsReply
+	(*SessionInfo)(nil),                      // 43: system.auth.v1.SessionInfo
+	(*UserInfo)(nil),                         // 44: system.auth.v1.UserInfo
+	(*MenuInfo)(nil),                         // 45: system.auth.v1.MenuInfo
+	(*timestamppb.Timestamp)(nil),            // 46: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                    // 47: google.protobuf.Empty
 }
 var file_auth_v1_auth_proto_depIdxs = []int32{
-	4, // 0: system.auth.v1.GetPermissionInfoReply.user:type_name -> system.auth.v1.UserInfo
-	5, // 1: system.auth.v1.GetPermissionInfoReply.menus:type_name -> system.auth.v1.MenuInfo
-	6, // 2: system.auth.v1.UserInfo.create_at:type_name -> google.protobuf.Timestamp
-	0, // 3: system.auth.v1.AuthService.Login:input_type -> system.auth.v1.LoginRequest
-	2, // 4: system.auth.v1.AuthService.GetPermissionInfo:input_type -> system.auth.v1.GetPermissionInfoRequest
-	1, // 5: system.auth.v1.AuthService.Login:output_type -> system.auth.v1.LoginReply
-	3, // 6: system.auth.v1.AuthService.GetPermissionInfo:output_type -> system.auth.v1.GetPermissionInfoReply
-	5, // [5:7] is the sub-list for method output_type
-	3, // [3:5] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	44, // 0: system.auth.v1.GetPermissionInfoReply.user:type_name -> system.auth.v1.UserInfo
+	45, // 1: system.auth.v1.GetPermissionInfoReply.menus:type_name -> system.auth.v1.MenuInfo
+	46, // 2: system.auth.v1.CreateApiKeyRequest.expire_at:type_name -> google.protobuf.Timestamp
+	29, // 3: system.auth.v1.CreateApiKeyReply.key:type_name -> system.auth.v1.ApiKeyInfo
+	29, // 4: system.auth.v1.ListApiKeysReply.keys:type_name -> system.auth.v1.ApiKeyInfo
+	46, // 5: system.auth.v1.ApiKeyInfo.expire_at:type_name -> google.protobuf.Timestamp
+	46, // 6: system.auth.v1.ApiKeyInfo.last_used_at:type_name -> google.protobuf.Timestamp
+	46, // 7: system.auth.v1.ApiKeyInfo.create_at:type_name -> google.protobuf.Timestamp
+	39, // 8: system.auth.v1.ListPasskeysReply.passkeys:type_name -> system.auth.v1.PasskeyInfo
+	46, // 9: system.auth.v1.PasskeyInfo.last_used_at:type_name -> google.protobuf.Timestamp
+	46, // 10: system.auth.v1.PasskeyInfo.create_at:type_name -> google.protobuf.Timestamp
+	43, // 11: system.auth.v1.ListOnlineSessionsReply.sessions:type_name -> system.auth.v1.SessionInfo
+	46, // 12: system.auth.v1.SessionInfo.login_at:type_name -> google.protobuf.Timestamp
+	46, // 13: system.auth.v1.SessionInfo.active_at:type_name -> google.protobuf.Timestamp
+	46, // 14: system.auth.v1.UserInfo.create_at:type_name -> google.protobuf.Timestamp
+	0,  // 15: system.auth.v1.AuthService.Login:input_type -> system.auth.v1.LoginRequest
+	8,  // 16: system.auth.v1.AuthService.RefreshToken:input_type -> system.auth.v1.RefreshTokenRequest
+	2,  // 17: system.auth.v1.AuthService.SendLoginCode:input_type -> system.auth.v1.SendLoginCodeRequest
+	4,  // 18: system.auth.v1.AuthService.LoginByCode:input_type -> system.auth.v1.LoginByCodeRequest
+	5,  // 19: system.auth.v1.AuthService.VerifyMfa:input_type -> system.auth.v1.VerifyMfaRequest
+	6,  // 20: system.auth.v1.AuthService.RequestPasswordReset:input_type -> system.auth.v1.RequestPasswordResetRequest
+	7,  // 21: system.auth.v1.AuthService.ResetPasswordWithToken:input_type -> system.auth.v1.ResetPasswordWithTokenRequest
+	10, // 22: system.auth.v1.AuthService.GetCaptcha:input_type -> system.auth.v1.GetCaptchaRequest
+	12, // 23: system.auth.v1.AuthService.GetPermissionInfo:input_type -> system.auth.v1.GetPermissionInfoRequest
+	14, // 24: system.auth.v1.AuthService.Logout:input_type -> system.auth.v1.LogoutRequest
+	15, // 25: system.auth.v1.AuthService.KickoutUser:input_type -> system.auth.v1.KickoutUserRequest
+	16, // 26: system.auth.v1.AuthService.KickoutSession:input_type -> system.auth.v1.KickoutSessionRequest
+	40, // 27: system.auth.v1.AuthService.UnlockUser:input_type -> system.auth.v1.UnlockUserRequest
+	17, // 28: system.auth.v1.AuthService.GetMfaStatus:input_type -> system.auth.v1.GetMfaStatusRequest
+	19, // 29: system.auth.v1.AuthService.BeginTotpEnroll:input_type -> system.auth.v1.BeginTotpEnrollRequest
+	21, // 30: system.auth.v1.AuthService.ConfirmTotpEnroll:input_type -> system.auth.v1.ConfirmTotpEnrollRequest
+	23, // 31: system.auth.v1.AuthService.DisableTotp:input_type -> system.auth.v1.DisableTotpRequest
+	24, // 32: system.auth.v1.AuthService.CreateApiKey:input_type -> system.auth.v1.CreateApiKeyRequest
+	26, // 33: system.auth.v1.AuthService.ListApiKeys:input_type -> system.auth.v1.ListApiKeysRequest
+	28, // 34: system.auth.v1.AuthService.RevokeApiKey:input_type -> system.auth.v1.RevokeApiKeyRequest
+	30, // 35: system.auth.v1.AuthService.BeginPasskeyRegistration:input_type -> system.auth.v1.BeginPasskeyRegistrationRequest
+	31, // 36: system.auth.v1.AuthService.FinishPasskeyRegistration:input_type -> system.auth.v1.FinishPasskeyRegistrationRequest
+	32, // 37: system.auth.v1.AuthService.BeginPasskeyLogin:input_type -> system.auth.v1.BeginPasskeyLoginRequest
+	34, // 38: system.auth.v1.AuthService.FinishPasskeyLogin:input_type -> system.auth.v1.FinishPasskeyLoginRequest
+	35, // 39: system.auth.v1.AuthService.ListPasskeys:input_type -> system.auth.v1.ListPasskeysRequest
+	37, // 40: system.auth.v1.AuthService.RenamePasskey:input_type -> system.auth.v1.RenamePasskeyRequest
+	38, // 41: system.auth.v1.AuthService.DeletePasskey:input_type -> system.auth.v1.DeletePasskeyRequest
+	41, // 42: system.auth.v1.AuthService.ListOnlineSessions:input_type -> system.auth.v1.ListOnlineSessionsRequest
+	1,  // 43: system.auth.v1.AuthService.Login:output_type -> system.auth.v1.LoginReply
+	9,  // 44: system.auth.v1.AuthService.RefreshToken:output_type -> system.auth.v1.RefreshTokenReply
+	3,  // 45: system.auth.v1.AuthService.SendLoginCode:output_type -> system.auth.v1.SendLoginCodeReply
+	1,  // 46: system.auth.v1.AuthService.LoginByCode:output_type -> system.auth.v1.LoginReply
+	1,  // 47: system.auth.v1.AuthService.VerifyMfa:output_type -> system.auth.v1.LoginReply
+	47, // 48: system.auth.v1.AuthService.RequestPasswordReset:output_type -> google.protobuf.Empty
+	47, // 49: system.auth.v1.AuthService.ResetPasswordWithToken:output_type -> google.protobuf.Empty
+	11, // 50: system.auth.v1.AuthService.GetCaptcha:output_type -> system.auth.v1.GetCaptchaReply
+	13, // 51: system.auth.v1.AuthService.GetPermissionInfo:output_type -> system.auth.v1.GetPermissionInfoReply
+	47, // 52: system.auth.v1.AuthService.Logout:output_type -> google.protobuf.Empty
+	47, // 53: system.auth.v1.AuthService.KickoutUser:output_type -> google.protobuf.Empty
+	47, // 54: system.auth.v1.AuthService.KickoutSession:output_type -> google.protobuf.Empty
+	47, // 55: system.auth.v1.AuthService.UnlockUser:output_type -> google.protobuf.Empty
+	18, // 56: system.auth.v1.AuthService.GetMfaStatus:output_type -> system.auth.v1.GetMfaStatusReply
+	20, // 57: system.auth.v1.AuthService.BeginTotpEnroll:output_type -> system.auth.v1.BeginTotpEnrollReply
+	22, // 58: system.auth.v1.AuthService.ConfirmTotpEnroll:output_type -> system.auth.v1.ConfirmTotpEnrollReply
+	47, // 59: system.auth.v1.AuthService.DisableTotp:output_type -> google.protobuf.Empty
+	25, // 60: system.auth.v1.AuthService.CreateApiKey:output_type -> system.auth.v1.CreateApiKeyReply
+	27, // 61: system.auth.v1.AuthService.ListApiKeys:output_type -> system.auth.v1.ListApiKeysReply
+	47, // 62: system.auth.v1.AuthService.RevokeApiKey:output_type -> google.protobuf.Empty
+	33, // 63: system.auth.v1.AuthService.BeginPasskeyRegistration:output_type -> system.auth.v1.PasskeyCeremonyReply
+	39, // 64: system.auth.v1.AuthService.FinishPasskeyRegistration:output_type -> system.auth.v1.PasskeyInfo
+	33, // 65: system.auth.v1.AuthService.BeginPasskeyLogin:output_type -> system.auth.v1.PasskeyCeremonyReply
+	1,  // 66: system.auth.v1.AuthService.FinishPasskeyLogin:output_type -> system.auth.v1.LoginReply
+	36, // 67: system.auth.v1.AuthService.ListPasskeys:output_type -> system.auth.v1.ListPasskeysReply
+	47, // 68: system.auth.v1.AuthService.RenamePasskey:output_type -> google.protobuf.Empty
+	47, // 69: system.auth.v1.AuthService.DeletePasskey:output_type -> google.protobuf.Empty
+	42, // 70: system.auth.v1.AuthService.ListOnlineSessions:output_type -> system.auth.v1.ListOnlineSessionsReply
+	43, // [43:71] is the sub-list for method output_type
+	15, // [15:43] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
 }
 
 func init() { file_auth_v1_auth_proto_init() }
@@ -597,13 +3112,32 @@ func file_auth_v1_auth_proto_init() {
 		return
 	}
 	file_auth_v1_auth_proto_msgTypes[0].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[2].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[4].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[5].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[6].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[7].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[8].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[15].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[16].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[21].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[23].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[24].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[28].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[31].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[32].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[34].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[37].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[38].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[40].OneofWrappers = []any{}
+	file_auth_v1_auth_proto_msgTypes[41].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_auth_proto_rawDesc), len(file_auth_v1_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -10,6 +10,7 @@ import (
 	context "context"
 	http "github.com/go-kratos/kratos/v2/transport/http"
 	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,20 +20,124 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAuthServiceBeginPasskeyLogin = "/system.auth.v1.AuthService/BeginPasskeyLogin"
+const OperationAuthServiceBeginPasskeyRegistration = "/system.auth.v1.AuthService/BeginPasskeyRegistration"
+const OperationAuthServiceBeginTotpEnroll = "/system.auth.v1.AuthService/BeginTotpEnroll"
+const OperationAuthServiceConfirmTotpEnroll = "/system.auth.v1.AuthService/ConfirmTotpEnroll"
+const OperationAuthServiceCreateApiKey = "/system.auth.v1.AuthService/CreateApiKey"
+const OperationAuthServiceDeletePasskey = "/system.auth.v1.AuthService/DeletePasskey"
+const OperationAuthServiceDisableTotp = "/system.auth.v1.AuthService/DisableTotp"
+const OperationAuthServiceFinishPasskeyLogin = "/system.auth.v1.AuthService/FinishPasskeyLogin"
+const OperationAuthServiceFinishPasskeyRegistration = "/system.auth.v1.AuthService/FinishPasskeyRegistration"
+const OperationAuthServiceGetCaptcha = "/system.auth.v1.AuthService/GetCaptcha"
+const OperationAuthServiceGetMfaStatus = "/system.auth.v1.AuthService/GetMfaStatus"
 const OperationAuthServiceGetPermissionInfo = "/system.auth.v1.AuthService/GetPermissionInfo"
+const OperationAuthServiceKickoutSession = "/system.auth.v1.AuthService/KickoutSession"
+const OperationAuthServiceKickoutUser = "/system.auth.v1.AuthService/KickoutUser"
+const OperationAuthServiceListApiKeys = "/system.auth.v1.AuthService/ListApiKeys"
+const OperationAuthServiceListOnlineSessions = "/system.auth.v1.AuthService/ListOnlineSessions"
+const OperationAuthServiceListPasskeys = "/system.auth.v1.AuthService/ListPasskeys"
 const OperationAuthServiceLogin = "/system.auth.v1.AuthService/Login"
+const OperationAuthServiceLoginByCode = "/system.auth.v1.AuthService/LoginByCode"
+const OperationAuthServiceLogout = "/system.auth.v1.AuthService/Logout"
+const OperationAuthServiceRefreshToken = "/system.auth.v1.AuthService/RefreshToken"
+const OperationAuthServiceRenamePasskey = "/system.auth.v1.AuthService/RenamePasskey"
+const OperationAuthServiceRequestPasswordReset = "/system.auth.v1.AuthService/RequestPasswordReset"
+const OperationAuthServiceResetPasswordWithToken = "/system.auth.v1.AuthService/ResetPasswordWithToken"
+const OperationAuthServiceRevokeApiKey = "/system.auth.v1.AuthService/RevokeApiKey"
+const OperationAuthServiceSendLoginCode = "/system.auth.v1.AuthService/SendLoginCode"
+const OperationAuthServiceUnlockUser = "/system.auth.v1.AuthService/UnlockUser"
+const OperationAuthServiceVerifyMfa = "/system.auth.v1.AuthService/VerifyMfa"
 
 type AuthServiceHTTPServer interface {
+	// BeginPasskeyLogin 开始通行密钥登录
+	BeginPasskeyLogin(context.Context, *BeginPasskeyLoginRequest) (*PasskeyCeremonyReply, error)
+	// BeginPasskeyRegistration 开始注册通行密钥
+	BeginPasskeyRegistration(context.Context, *BeginPasskeyRegistrationRequest) (*PasskeyCeremonyReply, error)
+	// BeginTotpEnroll 开始绑定TOTP
+	BeginTotpEnroll(context.Context, *BeginTotpEnrollRequest) (*BeginTotpEnrollReply, error)
+	// ConfirmTotpEnroll 确认绑定TOTP
+	ConfirmTotpEnroll(context.Context, *ConfirmTotpEnrollRequest) (*ConfirmTotpEnrollReply, error)
+	// CreateApiKey 创建API Key
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyReply, error)
+	// DeletePasskey 删除通行密钥
+	DeletePasskey(context.Context, *DeletePasskeyRequest) (*emptypb.Empty, error)
+	// DisableTotp 关闭TOTP
+	DisableTotp(context.Context, *DisableTotpRequest) (*emptypb.Empty, error)
+	// FinishPasskeyLogin 完成通行密钥登录
+	FinishPasskeyLogin(context.Context, *FinishPasskeyLoginRequest) (*LoginReply, error)
+	// FinishPasskeyRegistration 完成注册通行密钥
+	FinishPasskeyRegistration(context.Context, *FinishPasskeyRegistrationRequest) (*PasskeyInfo, error)
+	// GetCaptcha 获取图形验证码
+	GetCaptcha(context.Context, *GetCaptchaRequest) (*GetCaptchaReply, error)
+	// GetMfaStatus 获取MFA状态
+	GetMfaStatus(context.Context, *GetMfaStatusRequest) (*GetMfaStatusReply, error)
 	// GetPermissionInfo 获取用户权限信息
 	GetPermissionInfo(context.Context, *GetPermissionInfoRequest) (*GetPermissionInfoReply, error)
+	// KickoutSession 踢出会话
+	KickoutSession(context.Context, *KickoutSessionRequest) (*emptypb.Empty, error)
+	// KickoutUser 踢出用户
+	KickoutUser(context.Context, *KickoutUserRequest) (*emptypb.Empty, error)
+	// ListApiKeys 获取API Key列表
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysReply, error)
+	// ListOnlineSessions 获取在线会话列表
+	ListOnlineSessions(context.Context, *ListOnlineSessionsRequest) (*ListOnlineSessionsReply, error)
+	// ListPasskeys 获取通行密钥列表
+	ListPasskeys(context.Context, *ListPasskeysRequest) (*ListPasskeysReply, error)
 	// Login 登录
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
+	// LoginByCode 验证码登录
+	LoginByCode(context.Context, *LoginByCodeRequest) (*LoginReply, error)
+	// Logout 退出登录
+	Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error)
+	// RefreshToken 刷新令牌
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenReply, error)
+	// RenamePasskey 重命名通行密钥
+	RenamePasskey(context.Context, *RenamePasskeyRequest) (*emptypb.Empty, error)
+	// RequestPasswordReset 申请找回密码
+	RequestPasswordReset(context.Context, *RequestPasswordResetRequest) (*emptypb.Empty, error)
+	// ResetPasswordWithToken 使用令牌重置密码
+	ResetPasswordWithToken(context.Context, *ResetPasswordWithTokenRequest) (*emptypb.Empty, error)
+	// RevokeApiKey 吊销API Key
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*emptypb.Empty, error)
+	// SendLoginCode 发送登录验证码
+	SendLoginCode(context.Context, *SendLoginCodeRequest) (*SendLoginCodeReply, error)
+	// UnlockUser 解除登录锁定
+	UnlockUser(context.Context, *UnlockUserRequest) (*emptypb.Empty, error)
+	// VerifyMfa MFA 二次验证
+	VerifyMfa(context.Context, *VerifyMfaRequest) (*LoginReply, error)
 }
 
 func RegisterAuthServiceHTTPServer(s *http.Server, srv AuthServiceHTTPServer) {
 	r := s.Route("/")
 	r.POST("/qs/v1/auth/admin/login", _AuthService_Login0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/admin/refresh-token", _AuthService_RefreshToken0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/admin/login-code/send", _AuthService_SendLoginCode0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/admin/login-by-code", _AuthService_LoginByCode0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/admin/verify-mfa", _AuthService_VerifyMfa0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/admin/password/forgot", _AuthService_RequestPasswordReset0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/admin/password/reset", _AuthService_ResetPasswordWithToken0_HTTP_Handler(srv))
+	r.GET("/qs/v1/auth/admin/captcha", _AuthService_GetCaptcha0_HTTP_Handler(srv))
 	r.GET("/qs/v1/auth/admin/permission-info", _AuthService_GetPermissionInfo0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/admin/logout", _AuthService_Logout0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/session/kickout-user", _AuthService_KickoutUser0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/session/kickout", _AuthService_KickoutSession0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/admin/unlock-user", _AuthService_UnlockUser0_HTTP_Handler(srv))
+	r.GET("/qs/v1/auth/mfa/status", _AuthService_GetMfaStatus0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/mfa/totp/begin", _AuthService_BeginTotpEnroll0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/mfa/totp/confirm", _AuthService_ConfirmTotpEnroll0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/mfa/totp/disable", _AuthService_DisableTotp0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/api-key/create", _AuthService_CreateApiKey0_HTTP_Handler(srv))
+	r.GET("/qs/v1/auth/api-key/list", _AuthService_ListApiKeys0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/api-key/revoke", _AuthService_RevokeApiKey0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/passkey/register/begin", _AuthService_BeginPasskeyRegistration0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/passkey/register/finish", _AuthService_FinishPasskeyRegistration0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/admin/passkey/begin", _AuthService_BeginPasskeyLogin0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/admin/passkey/finish", _AuthService_FinishPasskeyLogin0_HTTP_Handler(srv))
+	r.GET("/qs/v1/auth/passkey/list", _AuthService_ListPasskeys0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/passkey/rename", _AuthService_RenamePasskey0_HTTP_Handler(srv))
+	r.POST("/qs/v1/auth/passkey/delete", _AuthService_DeletePasskey0_HTTP_Handler(srv))
+	r.GET("/qs/v1/auth/session/list", _AuthService_ListOnlineSessions0_HTTP_Handler(srv))
 }
 
 func _AuthService_Login0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
@@ -57,30 +162,639 @@ func _AuthService_Login0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.C
 	}
 }
 
+func _AuthService_RefreshToken0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRefreshToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefreshToken(ctx, req.(*RefreshTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RefreshTokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_SendLoginCode0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SendLoginCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceSendLoginCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SendLoginCode(ctx, req.(*SendLoginCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SendLoginCodeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_LoginByCode0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LoginByCodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceLoginByCode)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LoginByCode(ctx, req.(*LoginByCodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_VerifyMfa0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyMfaRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceVerifyMfa)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyMfa(ctx, req.(*VerifyMfaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_RequestPasswordReset0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RequestPasswordResetRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRequestPasswordReset)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RequestPasswordReset(ctx, req.(*RequestPasswordResetRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ResetPasswordWithToken0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetPasswordWithTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceResetPasswordWithToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetPasswordWithToken(ctx, req.(*ResetPasswordWithTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_GetCaptcha0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetCaptchaRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceGetCaptcha)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetCaptcha(ctx, req.(*GetCaptchaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetCaptchaReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _AuthService_GetPermissionInfo0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetPermissionInfoRequest
+		var in GetPermissionInfoRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceGetPermissionInfo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetPermissionInfo(ctx, req.(*GetPermissionInfoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetPermissionInfoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_Logout0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_KickoutUser0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in KickoutUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceKickoutUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.KickoutUser(ctx, req.(*KickoutUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_KickoutSession0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in KickoutSessionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceKickoutSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.KickoutSession(ctx, req.(*KickoutSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_UnlockUser0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnlockUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceUnlockUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlockUser(ctx, req.(*UnlockUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_GetMfaStatus0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMfaStatusRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceGetMfaStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMfaStatus(ctx, req.(*GetMfaStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetMfaStatusReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_BeginTotpEnroll0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BeginTotpEnrollRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceBeginTotpEnroll)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BeginTotpEnroll(ctx, req.(*BeginTotpEnrollRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BeginTotpEnrollReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ConfirmTotpEnroll0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ConfirmTotpEnrollRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceConfirmTotpEnroll)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfirmTotpEnroll(ctx, req.(*ConfirmTotpEnrollRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ConfirmTotpEnrollReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_DisableTotp0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DisableTotpRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceDisableTotp)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DisableTotp(ctx, req.(*DisableTotpRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_CreateApiKey0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateApiKeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceCreateApiKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateApiKeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ListApiKeys0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListApiKeysRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceListApiKeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListApiKeys(ctx, req.(*ListApiKeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListApiKeysReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_RevokeApiKey0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeApiKeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRevokeApiKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_BeginPasskeyRegistration0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BeginPasskeyRegistrationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceBeginPasskeyRegistration)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BeginPasskeyRegistration(ctx, req.(*BeginPasskeyRegistrationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PasskeyCeremonyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_FinishPasskeyRegistration0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FinishPasskeyRegistrationRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceFinishPasskeyRegistration)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FinishPasskeyRegistration(ctx, req.(*FinishPasskeyRegistrationRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PasskeyInfo)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_BeginPasskeyLogin0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BeginPasskeyLoginRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceBeginPasskeyLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BeginPasskeyLogin(ctx, req.(*BeginPasskeyLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PasskeyCeremonyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_FinishPasskeyLogin0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in FinishPasskeyLoginRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceFinishPasskeyLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.FinishPasskeyLogin(ctx, req.(*FinishPasskeyLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LoginReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ListPasskeys0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPasskeysRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceListPasskeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPasskeys(ctx, req.(*ListPasskeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPasskeysReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_RenamePasskey0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RenamePasskeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceRenamePasskey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RenamePasskey(ctx, req.(*RenamePasskeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_DeletePasskey0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeletePasskeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthServiceDeletePasskey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeletePasskey(ctx, req.(*DeletePasskeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AuthService_ListOnlineSessions0_HTTP_Handler(srv AuthServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListOnlineSessionsRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationAuthServiceGetPermissionInfo)
+		http.SetOperation(ctx, OperationAuthServiceListOnlineSessions)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetPermissionInfo(ctx, req.(*GetPermissionInfoRequest))
+			return srv.ListOnlineSessions(ctx, req.(*ListOnlineSessionsRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetPermissionInfoReply)
+		reply := out.(*ListOnlineSessionsReply)
 		return ctx.Result(200, reply)
 	}
 }
 
 type AuthServiceHTTPClient interface {
+	// BeginPasskeyLogin 开始通行密钥登录
+	BeginPasskeyLogin(ctx context.Context, req *BeginPasskeyLoginRequest, opts ...http.CallOption) (rsp *PasskeyCeremonyReply, err error)
+	// BeginPasskeyRegistration 开始注册通行密钥
+	BeginPasskeyRegistration(ctx context.Context, req *BeginPasskeyRegistrationRequest, opts ...http.CallOption) (rsp *PasskeyCeremonyReply, err error)
+	// BeginTotpEnroll 开始绑定TOTP
+	BeginTotpEnroll(ctx context.Context, req *BeginTotpEnrollRequest, opts ...http.CallOption) (rsp *BeginTotpEnrollReply, err error)
+	// ConfirmTotpEnroll 确认绑定TOTP
+	ConfirmTotpEnroll(ctx context.Context, req *ConfirmTotpEnrollRequest, opts ...http.CallOption) (rsp *ConfirmTotpEnrollReply, err error)
+	// CreateApiKey 创建API Key
+	CreateApiKey(ctx context.Context, req *CreateApiKeyRequest, opts ...http.CallOption) (rsp *CreateApiKeyReply, err error)
+	// DeletePasskey 删除通行密钥
+	DeletePasskey(ctx context.Context, req *DeletePasskeyRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// DisableTotp 关闭TOTP
+	DisableTotp(ctx context.Context, req *DisableTotpRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// FinishPasskeyLogin 完成通行密钥登录
+	FinishPasskeyLogin(ctx context.Context, req *FinishPasskeyLoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	// FinishPasskeyRegistration 完成注册通行密钥
+	FinishPasskeyRegistration(ctx context.Context, req *FinishPasskeyRegistrationRequest, opts ...http.CallOption) (rsp *PasskeyInfo, err error)
+	// GetCaptcha 获取图形验证码
+	GetCaptcha(ctx context.Context, req *GetCaptchaRequest, opts ...http.CallOption) (rsp *GetCaptchaReply, err error)
+	// GetMfaStatus 获取MFA状态
+	GetMfaStatus(ctx context.Context, req *GetMfaStatusRequest, opts ...http.CallOption) (rsp *GetMfaStatusReply, err error)
 	// GetPermissionInfo 获取用户权限信息
 	GetPermissionInfo(ctx context.Context, req *GetPermissionInfoRequest, opts ...http.CallOption) (rsp *GetPermissionInfoReply, err error)
+	// KickoutSession 踢出会话
+	KickoutSession(ctx context.Context, req *KickoutSessionRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// KickoutUser 踢出用户
+	KickoutUser(ctx context.Context, req *KickoutUserRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// ListApiKeys 获取API Key列表
+	ListApiKeys(ctx context.Context, req *ListApiKeysRequest, opts ...http.CallOption) (rsp *ListApiKeysReply, err error)
+	// ListOnlineSessions 获取在线会话列表
+	ListOnlineSessions(ctx context.Context, req *ListOnlineSessionsRequest, opts ...http.CallOption) (rsp *ListOnlineSessionsReply, err error)
+	// ListPasskeys 获取通行密钥列表
+	ListPasskeys(ctx context.Context, req *ListPasskeysRequest, opts ...http.CallOption) (rsp *ListPasskeysReply, err error)
 	// Login 登录
 	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	// LoginByCode 验证码登录
+	LoginByCode(ctx context.Context, req *LoginByCodeRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	// Logout 退出登录
+	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// RefreshToken 刷新令牌
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *RefreshTokenReply, err error)
+	// RenamePasskey 重命名通行密钥
+	RenamePasskey(ctx context.Context, req *RenamePasskeyRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// RequestPasswordReset 申请找回密码
+	RequestPasswordReset(ctx context.Context, req *RequestPasswordResetRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// ResetPasswordWithToken 使用令牌重置密码
+	ResetPasswordWithToken(ctx context.Context, req *ResetPasswordWithTokenRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// RevokeApiKey 吊销API Key
+	RevokeApiKey(ctx context.Context, req *RevokeApiKeyRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// SendLoginCode 发送登录验证码
+	SendLoginCode(ctx context.Context, req *SendLoginCodeRequest, opts ...http.CallOption) (rsp *SendLoginCodeReply, err error)
+	// UnlockUser 解除登录锁定
+	UnlockUser(ctx context.Context, req *UnlockUserRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	// VerifyMfa MFA 二次验证
+	VerifyMfa(ctx context.Context, req *VerifyMfaRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
 }
 
 type AuthServiceHTTPClientImpl struct {
@@ -91,6 +805,160 @@ func NewAuthServiceHTTPClient(client *http.Client) AuthServiceHTTPClient {
 	return &AuthServiceHTTPClientImpl{client}
 }
 
+// BeginPasskeyLogin 开始通行密钥登录
+func (c *AuthServiceHTTPClientImpl) BeginPasskeyLogin(ctx context.Context, in *BeginPasskeyLoginRequest, opts ...http.CallOption) (*PasskeyCeremonyReply, error) {
+	var out PasskeyCeremonyReply
+	pattern := "/qs/v1/auth/admin/passkey/begin"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceBeginPasskeyLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BeginPasskeyRegistration 开始注册通行密钥
+func (c *AuthServiceHTTPClientImpl) BeginPasskeyRegistration(ctx context.Context, in *BeginPasskeyRegistrationRequest, opts ...http.CallOption) (*PasskeyCeremonyReply, error) {
+	var out PasskeyCeremonyReply
+	pattern := "/qs/v1/auth/passkey/register/begin"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceBeginPasskeyRegistration))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// BeginTotpEnroll 开始绑定TOTP
+func (c *AuthServiceHTTPClientImpl) BeginTotpEnroll(ctx context.Context, in *BeginTotpEnrollRequest, opts ...http.CallOption) (*BeginTotpEnrollReply, error) {
+	var out BeginTotpEnrollReply
+	pattern := "/qs/v1/auth/mfa/totp/begin"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceBeginTotpEnroll))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ConfirmTotpEnroll 确认绑定TOTP
+func (c *AuthServiceHTTPClientImpl) ConfirmTotpEnroll(ctx context.Context, in *ConfirmTotpEnrollRequest, opts ...http.CallOption) (*ConfirmTotpEnrollReply, error) {
+	var out ConfirmTotpEnrollReply
+	pattern := "/qs/v1/auth/mfa/totp/confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceConfirmTotpEnroll))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateApiKey 创建API Key
+func (c *AuthServiceHTTPClientImpl) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...http.CallOption) (*CreateApiKeyReply, error) {
+	var out CreateApiKeyReply
+	pattern := "/qs/v1/auth/api-key/create"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceCreateApiKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeletePasskey 删除通行密钥
+func (c *AuthServiceHTTPClientImpl) DeletePasskey(ctx context.Context, in *DeletePasskeyRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/qs/v1/auth/passkey/delete"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceDeletePasskey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DisableTotp 关闭TOTP
+func (c *AuthServiceHTTPClientImpl) DisableTotp(ctx context.Context, in *DisableTotpRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/qs/v1/auth/mfa/totp/disable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceDisableTotp))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FinishPasskeyLogin 完成通行密钥登录
+func (c *AuthServiceHTTPClientImpl) FinishPasskeyLogin(ctx context.Context, in *FinishPasskeyLoginRequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/qs/v1/auth/admin/passkey/finish"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceFinishPasskeyLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FinishPasskeyRegistration 完成注册通行密钥
+func (c *AuthServiceHTTPClientImpl) FinishPasskeyRegistration(ctx context.Context, in *FinishPasskeyRegistrationRequest, opts ...http.CallOption) (*PasskeyInfo, error) {
+	var out PasskeyInfo
+	pattern := "/qs/v1/auth/passkey/register/finish"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceFinishPasskeyRegistration))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCaptcha 获取图形验证码
+func (c *AuthServiceHTTPClientImpl) GetCaptcha(ctx context.Context, in *GetCaptchaRequest, opts ...http.CallOption) (*GetCaptchaReply, error) {
+	var out GetCaptchaReply
+	pattern := "/qs/v1/auth/admin/captcha"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceGetCaptcha))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMfaStatus 获取MFA状态
+func (c *AuthServiceHTTPClientImpl) GetMfaStatus(ctx context.Context, in *GetMfaStatusRequest, opts ...http.CallOption) (*GetMfaStatusReply, error) {
+	var out GetMfaStatusReply
+	pattern := "/qs/v1/auth/mfa/status"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceGetMfaStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // GetPermissionInfo 获取用户权限信息
 func (c *AuthServiceHTTPClientImpl) GetPermissionInfo(ctx context.Context, in *GetPermissionInfoRequest, opts ...http.CallOption) (*GetPermissionInfoReply, error) {
 	var out GetPermissionInfoReply
@@ -105,6 +973,76 @@ func (c *AuthServiceHTTPClientImpl) GetPermissionInfo(ctx context.Context, in *G
 	return &out, nil
 }
 
+// KickoutSession 踢出会话
+func (c *AuthServiceHTTPClientImpl) KickoutSession(ctx context.Context, in *KickoutSessionRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/qs/v1/auth/session/kickout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceKickoutSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// KickoutUser 踢出用户
+func (c *AuthServiceHTTPClientImpl) KickoutUser(ctx context.Context, in *KickoutUserRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/qs/v1/auth/session/kickout-user"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceKickoutUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListApiKeys 获取API Key列表
+func (c *AuthServiceHTTPClientImpl) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...http.CallOption) (*ListApiKeysReply, error) {
+	var out ListApiKeysReply
+	pattern := "/qs/v1/auth/api-key/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceListApiKeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListOnlineSessions 获取在线会话列表
+func (c *AuthServiceHTTPClientImpl) ListOnlineSessions(ctx context.Context, in *ListOnlineSessionsRequest, opts ...http.CallOption) (*ListOnlineSessionsReply, error) {
+	var out ListOnlineSessionsReply
+	pattern := "/qs/v1/auth/session/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceListOnlineSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListPasskeys 获取通行密钥列表
+func (c *AuthServiceHTTPClientImpl) ListPasskeys(ctx context.Context, in *ListPasskeysRequest, opts ...http.CallOption) (*ListPasskeysReply, error) {
+	var out ListPasskeysReply
+	pattern := "/qs/v1/auth/passkey/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthServiceListPasskeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // Login 登录
 func (c *AuthServiceHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts ...http.CallOption) (*LoginReply, error) {
 	var out LoginReply
@@ -118,3 +1056,143 @@ func (c *AuthServiceHTTPClientImpl) Login(ctx context.Context, in *LoginRequest,
 	}
 	return &out, nil
 }
+
+// LoginByCode 验证码登录
+func (c *AuthServiceHTTPClientImpl) LoginByCode(ctx context.Context, in *LoginByCodeRequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/qs/v1/auth/admin/login-by-code"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceLoginByCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Logout 退出登录
+func (c *AuthServiceHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/qs/v1/auth/admin/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RefreshToken 刷新令牌
+func (c *AuthServiceHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*RefreshTokenReply, error) {
+	var out RefreshTokenReply
+	pattern := "/qs/v1/auth/admin/refresh-token"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceRefreshToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RenamePasskey 重命名通行密钥
+func (c *AuthServiceHTTPClientImpl) RenamePasskey(ctx context.Context, in *RenamePasskeyRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/qs/v1/auth/passkey/rename"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceRenamePasskey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RequestPasswordReset 申请找回密码
+func (c *AuthServiceHTTPClientImpl) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/qs/v1/auth/admin/password/forgot"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceRequestPasswordReset))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResetPasswordWithToken 使用令牌重置密码
+func (c *AuthServiceHTTPClientImpl) ResetPasswordWithToken(ctx context.Context, in *ResetPasswordWithTokenRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/qs/v1/auth/admin/password/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceResetPasswordWithToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RevokeApiKey 吊销API Key
+func (c *AuthServiceHTTPClientImpl) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/qs/v1/auth/api-key/revoke"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceRevokeApiKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SendLoginCode 发送登录验证码
+func (c *AuthServiceHTTPClientImpl) SendLoginCode(ctx context.Context, in *SendLoginCodeRequest, opts ...http.CallOption) (*SendLoginCodeReply, error) {
+	var out SendLoginCodeReply
+	pattern := "/qs/v1/auth/admin/login-code/send"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceSendLoginCode))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnlockUser 解除登录锁定
+func (c *AuthServiceHTTPClientImpl) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/qs/v1/auth/admin/unlock-user"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceUnlockUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// VerifyMfa MFA 二次验证
+func (c *AuthServiceHTTPClientImpl) VerifyMfa(ctx context.Context, in *VerifyMfaRequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/qs/v1/auth/admin/verify-mfa"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthServiceVerifyMfa))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
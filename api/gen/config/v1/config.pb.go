@@ -13,6 +13,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	_ "quest-admin/api/gen/quest"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -698,7 +699,7 @@ var File_config_v1_config_proto protoreflect.FileDescriptor
 
 const file_config_v1_config_proto_rawDesc = "" +
 	"\n" +
-	"\x16config/v1/config.proto\x12\x10system.config.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\"\x87\x05\n" +
+	"\x16config/v1/config.proto\x12\x10system.config.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\x1a\x10quest/auth.proto\"\x87\x05\n" +
 	"\n" +
 	"ConfigInfo\x12D\n" +
 	"\x02id\x18\x01 \x01(\tB4\xbaG1:\v\x12\t123456789\x92\x02!配置唯一标识（含序号）R\x02id\x126\n" +
@@ -767,16 +768,21 @@ const file_config_v1_config_proto_rawDesc = "" +
 	"\a_status\"q\n" +
 	"\x13DeleteConfigRequest\x120\n" +
 	"\x02id\x18\x01 \x01(\tB\x1b\xbaG\x18:\v\x12\t123456789\x92\x02\b配置IDH\x00R\x02id\x88\x01\x01:!\xbaG\x1e\x92\x02\x1b删除配置请求请求体B\x05\n" +
-	"\x03_id2\x88\n" +
-	"\n" +
-	"\rConfigService\x12\xa2\x01\n" +
-	"\fCreateConfig\x12%.system.config.v1.CreateConfigRequest\x1a\x16.google.protobuf.Empty\"S\xbaG1\x12\f创建配置\x1a!创建一个新的系统配置项\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/qs/v1/config/create\x12\xae\x01\n" +
-	"\tGetConfig\x12\".system.config.v1.GetConfigRequest\x1a .system.config.v1.GetConfigReply\"[\xbaG?\x12\x12获取配置信息\x1a)根据配置ID获取配置的详细信息\x82\xd3\xe4\x93\x02\x13\x12\x11/qs/v1/config/get\x12\xbb\x01\n" +
-	"\x0eGetConfigByKey\x12'.system.config.v1.GetConfigByKeyRequest\x1a%.system.config.v1.GetConfigByKeyReply\"Y\xbaG=\x12\x18根据key获取配置值\x1a!根据配置键获取配置的值\x82\xd3\xe4\x93\x02\x13\x12\x11/qs/v1/config/key\x12\xce\x01\n" +
-	"\vListConfigs\x12$.system.config.v1.ListConfigsRequest\x1a\".system.config.v1.ListConfigsReply\"u\xbaGX\x12\x12获取配置列表\x1aB分页查询配置列表，支持关键字搜索、状态筛选等\x82\xd3\xe4\x93\x02\x14\x12\x12/qs/v1/config/list\x12\xbd\x01\n" +
-	"\fUpdateConfig\x12%.system.config.v1.UpdateConfigRequest\x1a\x16.google.protobuf.Empty\"n\xbaGL\x12\x12更新配置信息\x1a6更新配置的基本信息，如名称、键、值等\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/qs/v1/config/update\x12\xaf\x01\n" +
-	"\x12ChangeConfigStatus\x12+.system.config.v1.ChangeConfigStatusRequest\x1a\x16.google.protobuf.Empty\"T\xbaG+\x12\x12变更配置状态\x1a\x15启用或禁用配置\x82\xd3\xe4\x93\x02 :\x01*\x1a\x1b/qs/v1/config/update-status\x12\x9f\x01\n" +
-	"\fDeleteConfig\x12%.system.config.v1.DeleteConfigRequest\x1a\x16.google.protobuf.Empty\"P\xbaG1\x12\f删除配置\x1a!删除配置，此操作不可逆\x82\xd3\xe4\x93\x02\x16*\x14/qs/v1/config/deleteBP\xbaG+:)\n" +
+	"\x03_id2\xa3\v\n" +
+	"\rConfigService\x12\xbc\x01\n" +
+	"\fCreateConfig\x12%.system.config.v1.CreateConfigRequest\x1a\x16.google.protobuf.Empty\"m\xbaG1\x12\f创建配置\x1a!创建一个新的系统配置项\xca\xf3\x18\x16\n" +
+	"\x14system:config:create\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/qs/v1/config/create\x12\xc7\x01\n" +
+	"\tGetConfig\x12\".system.config.v1.GetConfigRequest\x1a .system.config.v1.GetConfigReply\"t\xbaG?\x12\x12获取配置信息\x1a)根据配置ID获取配置的详细信息\xca\xf3\x18\x15\n" +
+	"\x13system:config:query\x82\xd3\xe4\x93\x02\x13\x12\x11/qs/v1/config/get\x12\xbb\x01\n" +
+	"\x0eGetConfigByKey\x12'.system.config.v1.GetConfigByKeyRequest\x1a%.system.config.v1.GetConfigByKeyReply\"Y\xbaG=\x12\x18根据key获取配置值\x1a!根据配置键获取配置的值\x82\xd3\xe4\x93\x02\x13\x12\x11/qs/v1/config/key\x12\xe7\x01\n" +
+	"\vListConfigs\x12$.system.config.v1.ListConfigsRequest\x1a\".system.config.v1.ListConfigsReply\"\x8d\x01\xbaGX\x12\x12获取配置列表\x1aB分页查询配置列表，支持关键字搜索、状态筛选等\xca\xf3\x18\x14\n" +
+	"\x12system:config:list\x82\xd3\xe4\x93\x02\x14\x12\x12/qs/v1/config/list\x12\xd8\x01\n" +
+	"\fUpdateConfig\x12%.system.config.v1.UpdateConfigRequest\x1a\x16.google.protobuf.Empty\"\x88\x01\xbaGL\x12\x12更新配置信息\x1a6更新配置的基本信息，如名称、键、值等\xca\xf3\x18\x16\n" +
+	"\x14system:config:update\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/qs/v1/config/update\x12\xc9\x01\n" +
+	"\x12ChangeConfigStatus\x12+.system.config.v1.ChangeConfigStatusRequest\x1a\x16.google.protobuf.Empty\"n\xbaG+\x12\x12变更配置状态\x1a\x15启用或禁用配置\xca\xf3\x18\x16\n" +
+	"\x14system:config:update\x82\xd3\xe4\x93\x02 :\x01*\x1a\x1b/qs/v1/config/update-status\x12\xb9\x01\n" +
+	"\fDeleteConfig\x12%.system.config.v1.DeleteConfigRequest\x1a\x16.google.protobuf.Empty\"j\xbaG1\x12\f删除配置\x1a!删除配置，此操作不可逆\xca\xf3\x18\x16\n" +
+	"\x14system:config:delete\x82\xd3\xe4\x93\x02\x16*\x14/qs/v1/config/deleteBP\xbaG+:)\n" +
 	"\rConfigService\x12\x18配置管理相关操作Z quest-admin/api/gen/config/v1;v1b\x06proto3"
 
 var (
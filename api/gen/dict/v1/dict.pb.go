@@ -13,6 +13,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	_ "quest-admin/api/gen/quest"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -1213,7 +1214,7 @@ var File_dict_v1_dict_proto protoreflect.FileDescriptor
 
 const file_dict_v1_dict_proto_rawDesc = "" +
 	"\n" +
-	"\x12dict/v1/dict.proto\x12\x0esystem.dict.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\"\xba\x04\n" +
+	"\x12dict/v1/dict.proto\x12\x0esystem.dict.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\x1a\x10quest/auth.proto\"\xba\x04\n" +
 	"\fDictTypeInfo\x121\n" +
 	"\x02id\x18\x01 \x01(\tB!\xbaG\x1e:\v\x12\t123456789\x92\x02\x0e字典类型IDR\x02id\x12;\n" +
 	"\x04name\x18\x02 \x01(\tB'\xbaG$:\r\x12\vuser_gender\x92\x02\x12字典类型名称R\x04name\x12;\n" +
@@ -1369,18 +1370,28 @@ const file_dict_v1_dict_proto_rawDesc = "" +
 	"\a_remark\"y\n" +
 	"\x15DeleteDictDataRequest\x126\n" +
 	"\x02id\x18\x01 \x01(\tB!\xbaG\x1e:\v\x12\t123456789\x92\x02\x0e字典数据IDH\x00R\x02id\x88\x01\x01:!\xbaG\x1e\x92\x02\x1b删除字典数据请求体B\x05\n" +
-	"\x03_id2\xc8\x0e\n" +
-	"\vDictService\x12\xaa\x01\n" +
-	"\x0eCreateDictType\x12%.system.dict.v1.CreateDictTypeRequest\x1a\x16.google.protobuf.Empty\"Y\xbaG4\x12\x12创建字典类型\x1a\x1e创建一个新的字典类型\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/qs/v1/dict/type/create\x12\xcb\x01\n" +
-	"\vGetDictType\x12\".system.dict.v1.GetDictTypeRequest\x1a .system.dict.v1.GetDictTypeReply\"v\xbaGW\x12\x1e获取字典类型详细信息\x1a5根据字典类型ID获取字典类型的详细信息\x82\xd3\xe4\x93\x02\x16\x12\x14/qs/v1/dict/type/get\x12\xb8\x01\n" +
-	"\rListDictTypes\x12$.system.dict.v1.ListDictTypesRequest\x1a\".system.dict.v1.ListDictTypesReply\"]\xbaG:\x12\x18获取字典类型列表\x1a\x1e分页查询字典类型列表\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/qs/v1/dict/type/list\x12\xb3\x01\n" +
-	"\x0eUpdateDictType\x12%.system.dict.v1.UpdateDictTypeRequest\x1a\x16.google.protobuf.Empty\"b\xbaG=\x12\x18更新字典类型信息\x1a!更新字典类型的基本信息\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/qs/v1/dict/type/update\x12\xb0\x01\n" +
-	"\x0eDeleteDictType\x12%.system.dict.v1.DeleteDictTypeRequest\x1a\x16.google.protobuf.Empty\"_\xbaG=\x12\x12删除字典类型\x1a'删除字典类型，此操作不可逆\x82\xd3\xe4\x93\x02\x19*\x17/qs/v1/dict/type/delete\x12\xaa\x01\n" +
-	"\x0eCreateDictData\x12%.system.dict.v1.CreateDictDataRequest\x1a\x16.google.protobuf.Empty\"Y\xbaG4\x12\x12创建字典数据\x1a\x1e创建一个新的字典数据\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/qs/v1/dict/data/create\x12\xcb\x01\n" +
-	"\vGetDictData\x12\".system.dict.v1.GetDictDataRequest\x1a .system.dict.v1.GetDictDataReply\"v\xbaGW\x12\x1e获取字典数据详细信息\x1a5根据字典数据ID获取字典数据的详细信息\x82\xd3\xe4\x93\x02\x16\x12\x14/qs/v1/dict/data/get\x12\xb5\x01\n" +
-	"\fListDictData\x12#.system.dict.v1.ListDictDataRequest\x1a!.system.dict.v1.ListDictDataReply\"]\xbaG:\x12\x18获取字典数据列表\x1a\x1e分页查询字典数据列表\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/qs/v1/dict/data/list\x12\xb3\x01\n" +
-	"\x0eUpdateDictData\x12%.system.dict.v1.UpdateDictDataRequest\x1a\x16.google.protobuf.Empty\"b\xbaG=\x12\x18更新字典数据信息\x1a!更新字典数据的基本信息\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/qs/v1/dict/data/update\x12\xb0\x01\n" +
-	"\x0eDeleteDictData\x12%.system.dict.v1.DeleteDictDataRequest\x1a\x16.google.protobuf.Empty\"_\xbaG=\x12\x12删除字典数据\x1a'删除字典数据，此操作不可逆\x82\xd3\xe4\x93\x02\x19*\x17/qs/v1/dict/data/deleteBF\xbaG#:!\n" +
+	"\x03_id2\xb4\x10\n" +
+	"\vDictService\x12\xc2\x01\n" +
+	"\x0eCreateDictType\x12%.system.dict.v1.CreateDictTypeRequest\x1a\x16.google.protobuf.Empty\"q\xbaG4\x12\x12创建字典类型\x1a\x1e创建一个新的字典类型\xca\xf3\x18\x14\n" +
+	"\x12system:dict:create\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/qs/v1/dict/type/create\x12\xe3\x01\n" +
+	"\vGetDictType\x12\".system.dict.v1.GetDictTypeRequest\x1a .system.dict.v1.GetDictTypeReply\"\x8d\x01\xbaGW\x12\x1e获取字典类型详细信息\x1a5根据字典类型ID获取字典类型的详细信息\xca\xf3\x18\x13\n" +
+	"\x11system:dict:query\x82\xd3\xe4\x93\x02\x16\x12\x14/qs/v1/dict/type/get\x12\xce\x01\n" +
+	"\rListDictTypes\x12$.system.dict.v1.ListDictTypesRequest\x1a\".system.dict.v1.ListDictTypesReply\"s\xbaG:\x12\x18获取字典类型列表\x1a\x1e分页查询字典类型列表\xca\xf3\x18\x12\n" +
+	"\x10system:dict:list\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/qs/v1/dict/type/list\x12\xcb\x01\n" +
+	"\x0eUpdateDictType\x12%.system.dict.v1.UpdateDictTypeRequest\x1a\x16.google.protobuf.Empty\"z\xbaG=\x12\x18更新字典类型信息\x1a!更新字典类型的基本信息\xca\xf3\x18\x14\n" +
+	"\x12system:dict:update\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/qs/v1/dict/type/update\x12\xc8\x01\n" +
+	"\x0eDeleteDictType\x12%.system.dict.v1.DeleteDictTypeRequest\x1a\x16.google.protobuf.Empty\"w\xbaG=\x12\x12删除字典类型\x1a'删除字典类型，此操作不可逆\xca\xf3\x18\x14\n" +
+	"\x12system:dict:delete\x82\xd3\xe4\x93\x02\x19*\x17/qs/v1/dict/type/delete\x12\xc2\x01\n" +
+	"\x0eCreateDictData\x12%.system.dict.v1.CreateDictDataRequest\x1a\x16.google.protobuf.Empty\"q\xbaG4\x12\x12创建字典数据\x1a\x1e创建一个新的字典数据\xca\xf3\x18\x14\n" +
+	"\x12system:dict:create\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/qs/v1/dict/data/create\x12\xe3\x01\n" +
+	"\vGetDictData\x12\".system.dict.v1.GetDictDataRequest\x1a .system.dict.v1.GetDictDataReply\"\x8d\x01\xbaGW\x12\x1e获取字典数据详细信息\x1a5根据字典数据ID获取字典数据的详细信息\xca\xf3\x18\x13\n" +
+	"\x11system:dict:query\x82\xd3\xe4\x93\x02\x16\x12\x14/qs/v1/dict/data/get\x12\xcb\x01\n" +
+	"\fListDictData\x12#.system.dict.v1.ListDictDataRequest\x1a!.system.dict.v1.ListDictDataReply\"s\xbaG:\x12\x18获取字典数据列表\x1a\x1e分页查询字典数据列表\xca\xf3\x18\x12\n" +
+	"\x10system:dict:list\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/qs/v1/dict/data/list\x12\xcb\x01\n" +
+	"\x0eUpdateDictData\x12%.system.dict.v1.UpdateDictDataRequest\x1a\x16.google.protobuf.Empty\"z\xbaG=\x12\x18更新字典数据信息\x1a!更新字典数据的基本信息\xca\xf3\x18\x14\n" +
+	"\x12system:dict:update\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/qs/v1/dict/data/update\x12\xc8\x01\n" +
+	"\x0eDeleteDictData\x12%.system.dict.v1.DeleteDictDataRequest\x1a\x16.google.protobuf.Empty\"w\xbaG=\x12\x12删除字典数据\x1a'删除字典数据，此操作不可逆\xca\xf3\x18\x14\n" +
+	"\x12system:dict:delete\x82\xd3\xe4\x93\x02\x19*\x17/qs/v1/dict/data/deleteBF\xbaG#:!\n" +
 	"\vDictService\x12\x12字典相关操作Z\x1equest-admin/api/gen/dict/v1;v1b\x06proto3"
 
 var (
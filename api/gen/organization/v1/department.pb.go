@@ -13,6 +13,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	_ "quest-admin/api/gen/quest"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -521,7 +522,7 @@ var File_organization_v1_department_proto protoreflect.FileDescriptor
 
 const file_organization_v1_department_proto_rawDesc = "" +
 	"\n" +
-	" organization/v1/department.proto\x12\x16system.organization.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\"\xbb\x06\n" +
+	" organization/v1/department.proto\x12\x16system.organization.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\x1a\x10quest/auth.proto\"\xbb\x06\n" +
 	"\x0eDepartmentInfo\x128\n" +
 	"\x02id\x18\x01 \x01(\tB(\xbaG%:\v\x12\t123456789\x92\x02\x15部门唯一标识符R\x02id\x123\n" +
 	"\x04name\x18\x02 \x01(\tB\x1f\xbaG\x1c:\v\x12\t技术部\x92\x02\f部门名称R\x04name\x12E\n" +
@@ -579,13 +580,18 @@ const file_organization_v1_department_proto_rawDesc = "" +
 	"\a_status\"o\n" +
 	"\x17DeleteDepartmentRequest\x120\n" +
 	"\x02id\x18\x01 \x01(\tB\x1b\xbaG\x18:\v\x12\t123456789\x92\x02\b部门IDH\x00R\x02id\x88\x01\x01:\x1b\xbaG\x18\x92\x02\x15删除部门请求体B\x05\n" +
-	"\x03_id2\xf7\a\n" +
-	"\x11DepartmentService\x12\xb9\x01\n" +
-	"\x10CreateDepartment\x12/.system.organization.v1.CreateDepartmentRequest\x1a\x16.google.protobuf.Empty\"\\\xbaG(\x12\f创建部门\x1a\x18创建一个新的部门\x82\xd3\xe4\x93\x02+:\x01*\"&/qs/v1/organizations/department/create\x12\xde\x01\n" +
-	"\rGetDepartment\x12,.system.organization.v1.GetDepartmentRequest\x1a*.system.organization.v1.GetDepartmentReply\"s\xbaGE\x12\x18获取部门详细信息\x1a)根据部门ID获取部门的详细信息\x82\xd3\xe4\x93\x02%\x12#/qs/v1/organizations/department/get\x12\xbd\x01\n" +
-	"\x11GetDepartmentTree\x12\x16.google.protobuf.Empty\x1a..system.organization.v1.GetDepartmentTreeReply\"`\xbaG1\x12\x0f获取部门树\x1a\x1e获取完整的部门树结构\x82\xd3\xe4\x93\x02&\x12$/qs/v1/organizations/department/tree\x12\xc2\x01\n" +
-	"\x10UpdateDepartment\x12/.system.organization.v1.UpdateDepartmentRequest\x1a\x16.google.protobuf.Empty\"e\xbaG1\x12\x12更新部门信息\x1a\x1b更新部门的基本信息\x82\xd3\xe4\x93\x02+:\x01*\x1a&/qs/v1/organizations/department/update\x12\xbf\x01\n" +
-	"\x10DeleteDepartment\x12/.system.organization.v1.DeleteDepartmentRequest\x1a\x16.google.protobuf.Empty\"b\xbaG1\x12\f删除部门\x1a!删除部门，此操作不可逆\x82\xd3\xe4\x93\x02(*&/qs/v1/organizations/department/deleteBP\xbaG):'\n" +
+	"\x03_id2\xed\b\n" +
+	"\x11DepartmentService\x12\xd1\x01\n" +
+	"\x10CreateDepartment\x12/.system.organization.v1.CreateDepartmentRequest\x1a\x16.google.protobuf.Empty\"t\xbaG(\x12\f创建部门\x1a\x18创建一个新的部门\xca\xf3\x18\x14\n" +
+	"\x12system:dept:create\x82\xd3\xe4\x93\x02+:\x01*\"&/qs/v1/organizations/department/create\x12\xf6\x01\n" +
+	"\rGetDepartment\x12,.system.organization.v1.GetDepartmentRequest\x1a*.system.organization.v1.GetDepartmentReply\"\x8a\x01\xbaGE\x12\x18获取部门详细信息\x1a)根据部门ID获取部门的详细信息\xca\xf3\x18\x13\n" +
+	"\x11system:dept:query\x82\xd3\xe4\x93\x02%\x12#/qs/v1/organizations/department/get\x12\xd3\x01\n" +
+	"\x11GetDepartmentTree\x12\x16.google.protobuf.Empty\x1a..system.organization.v1.GetDepartmentTreeReply\"v\xbaG1\x12\x0f获取部门树\x1a\x1e获取完整的部门树结构\xca\xf3\x18\x12\n" +
+	"\x10system:dept:list\x82\xd3\xe4\x93\x02&\x12$/qs/v1/organizations/department/tree\x12\xda\x01\n" +
+	"\x10UpdateDepartment\x12/.system.organization.v1.UpdateDepartmentRequest\x1a\x16.google.protobuf.Empty\"}\xbaG1\x12\x12更新部门信息\x1a\x1b更新部门的基本信息\xca\xf3\x18\x14\n" +
+	"\x12system:dept:update\x82\xd3\xe4\x93\x02+:\x01*\x1a&/qs/v1/organizations/department/update\x12\xd7\x01\n" +
+	"\x10DeleteDepartment\x12/.system.organization.v1.DeleteDepartmentRequest\x1a\x16.google.protobuf.Empty\"z\xbaG1\x12\f删除部门\x1a!删除部门，此操作不可逆\xca\xf3\x18\x14\n" +
+	"\x12system:dept:delete\x82\xd3\xe4\x93\x02(*&/qs/v1/organizations/department/deleteBP\xbaG):'\n" +
 	"\x11DepartmentService\x12\x12部门相关操作Z\"quest-admin/api/organization/v1;v1b\x06proto3"
 
 var (
@@ -13,6 +13,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	_ "quest-admin/api/gen/quest"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -581,7 +582,7 @@ var File_organization_v1_post_proto protoreflect.FileDescriptor
 
 const file_organization_v1_post_proto_rawDesc = "" +
 	"\n" +
-	"\x1aorganization/v1/post.proto\x12\x16system.organization.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\"\xaf\x04\n" +
+	"\x1aorganization/v1/post.proto\x12\x16system.organization.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\x1a\x10quest/auth.proto\"\xaf\x04\n" +
 	"\bPostInfo\x128\n" +
 	"\x02id\x18\x01 \x01(\tB(\xbaG%:\v\x12\t123456789\x92\x02\x15岗位唯一标识符R\x02id\x129\n" +
 	"\x04name\x18\x02 \x01(\tB%\xbaG\":\x11\x12\x0f软件工程师\x92\x02\f岗位名称R\x04name\x12/\n" +
@@ -645,16 +646,21 @@ const file_organization_v1_post_proto_rawDesc = "" +
 	"\a_remark\"i\n" +
 	"\x11DeletePostRequest\x120\n" +
 	"\x02id\x18\x01 \x01(\tB\x1b\xbaG\x18:\v\x12\t123456789\x92\x02\b岗位IDH\x00R\x02id\x88\x01\x01:\x1b\xbaG\x18\x92\x02\x15删除岗位请求体B\x05\n" +
-	"\x03_id2\x9f\a\n" +
-	"\vPostService\x12\xa7\x01\n" +
+	"\x03_id2\x95\b\n" +
+	"\vPostService\x12\xbf\x01\n" +
 	"\n" +
-	"CreatePost\x12).system.organization.v1.CreatePostRequest\x1a\x16.google.protobuf.Empty\"V\xbaG(\x12\f创建岗位\x1a\x18创建一个新的岗位\x82\xd3\xe4\x93\x02%:\x01*\" /qs/v1/organizations/post/create\x12\xc6\x01\n" +
-	"\aGetPost\x12&.system.organization.v1.GetPostRequest\x1a$.system.organization.v1.GetPostReply\"m\xbaGE\x12\x18获取岗位详细信息\x1a)根据岗位ID获取岗位的详细信息\x82\xd3\xe4\x93\x02\x1f\x12\x1d/qs/v1/organizations/post/get\x12\xb9\x01\n" +
-	"\tListPosts\x12(.system.organization.v1.ListPostsRequest\x1a&.system.organization.v1.ListPostsReply\"Z\xbaG.\x12\x12获取岗位列表\x1a\x18分页查询岗位列表\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/qs/v1/organizations/post/list\x12\xb0\x01\n" +
+	"CreatePost\x12).system.organization.v1.CreatePostRequest\x1a\x16.google.protobuf.Empty\"n\xbaG(\x12\f创建岗位\x1a\x18创建一个新的岗位\xca\xf3\x18\x14\n" +
+	"\x12system:post:create\x82\xd3\xe4\x93\x02%:\x01*\" /qs/v1/organizations/post/create\x12\xde\x01\n" +
+	"\aGetPost\x12&.system.organization.v1.GetPostRequest\x1a$.system.organization.v1.GetPostReply\"\x84\x01\xbaGE\x12\x18获取岗位详细信息\x1a)根据岗位ID获取岗位的详细信息\xca\xf3\x18\x13\n" +
+	"\x11system:post:query\x82\xd3\xe4\x93\x02\x1f\x12\x1d/qs/v1/organizations/post/get\x12\xcf\x01\n" +
+	"\tListPosts\x12(.system.organization.v1.ListPostsRequest\x1a&.system.organization.v1.ListPostsReply\"p\xbaG.\x12\x12获取岗位列表\x1a\x18分页查询岗位列表\xca\xf3\x18\x12\n" +
+	"\x10system:post:list\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/qs/v1/organizations/post/list\x12\xc8\x01\n" +
 	"\n" +
-	"UpdatePost\x12).system.organization.v1.UpdatePostRequest\x1a\x16.google.protobuf.Empty\"_\xbaG1\x12\x12更新岗位信息\x1a\x1b更新岗位的基本信息\x82\xd3\xe4\x93\x02%:\x01*\x1a /qs/v1/organizations/post/update\x12\xad\x01\n" +
+	"UpdatePost\x12).system.organization.v1.UpdatePostRequest\x1a\x16.google.protobuf.Empty\"w\xbaG1\x12\x12更新岗位信息\x1a\x1b更新岗位的基本信息\xca\xf3\x18\x14\n" +
+	"\x12system:post:update\x82\xd3\xe4\x93\x02%:\x01*\x1a /qs/v1/organizations/post/update\x12\xc5\x01\n" +
 	"\n" +
-	"DeletePost\x12).system.organization.v1.DeletePostRequest\x1a\x16.google.protobuf.Empty\"\\\xbaG1\x12\f删除岗位\x1a!删除岗位，此操作不可逆\x82\xd3\xe4\x93\x02\"* /qs/v1/organizations/post/deleteBJ\xbaG#:!\n" +
+	"DeletePost\x12).system.organization.v1.DeletePostRequest\x1a\x16.google.protobuf.Empty\"t\xbaG1\x12\f删除岗位\x1a!删除岗位，此操作不可逆\xca\xf3\x18\x14\n" +
+	"\x12system:post:delete\x82\xd3\xe4\x93\x02\"* /qs/v1/organizations/post/deleteBJ\xbaG#:!\n" +
 	"\vPostService\x12\x12岗位相关操作Z\"quest-admin/api/organization/v1;v1b\x06proto3"
 
 var (
@@ -13,6 +13,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	_ "quest-admin/api/gen/quest"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -801,14 +802,14 @@ var File_permission_v1_role_proto protoreflect.FileDescriptor
 
 const file_permission_v1_role_proto_rawDesc = "" +
 	"\n" +
-	"\x18permission/v1/role.proto\x12\x14system.permission.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\"\xe4\x06\n" +
+	"\x18permission/v1/role.proto\x12\x14system.permission.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\x1a\x10quest/auth.proto\"\xff\x06\n" +
 	"\bRoleInfo\x128\n" +
 	"\x02id\x18\x01 \x01(\tB(\xbaG%:\v\x12\t123456789\x92\x02\x15角色唯一标识符R\x02id\x123\n" +
 	"\x04name\x18\x02 \x01(\tB\x1f\xbaG\x1c:\v\x12\t管理员\x92\x02\f角色名称R\x04name\x128\n" +
 	"\x04code\x18\x03 \x01(\tB$\xbaG!:\a\x12\x05admin\x92\x02\x15角色权限字符串R\x04code\x12+\n" +
-	"\x04sort\x18\x04 \x01(\x05B\x17\xbaG\x14:\x03\x12\x011\x92\x02\f显示顺序R\x04sort\x12\xa8\x01\n" +
+	"\x04sort\x18\x04 \x01(\x05B\x17\xbaG\x14:\x03\x12\x011\x92\x02\f显示顺序R\x04sort\x12\xc3\x01\n" +
 	"\n" +
-	"data_scope\x18\x05 \x01(\x05B\x88\x01\xbaG\x84\x01:\x03\x12\x011\x92\x02|数据范围（1：全部数据权限 2：自定数据权限 3：本部门数据权限 4：本部门及以下数据权限）R\tdataScope\x12U\n" +
+	"data_scope\x18\x05 \x01(\x05B\xa3\x01\xbaG\x9f\x01:\x03\x12\x011\x92\x02\x96\x01数据范围（1：全部数据权限 2：自定数据权限 3：本部门数据权限 4：本部门及以下数据权限 5：仅本人数据权限）R\tdataScope\x12U\n" +
 	"\x13data_scope_dept_ids\x18\x06 \x01(\tB&\xbaG#\x92\x02 数据范围(指定部门数组)R\x10dataScopeDeptIds\x12C\n" +
 	"\x06status\x18\a \x01(\x05B+\xbaG(:\x03\x12\x011\x92\x02 角色状态: 0-禁用, 1-正常R\x06status\x12+\n" +
 	"\x04type\x18\b \x01(\x05B\x17\xbaG\x14:\x03\x12\x011\x92\x02\f角色类型R\x04type\x12*\n" +
@@ -816,13 +817,13 @@ const file_permission_v1_role_proto_rawDesc = "" +
 	"\tcreate_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间R\bcreateAt\x12K\n" +
 	"\tupdate_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间R\bupdateAt\x12+\n" +
-	"\ttenant_id\x18\f \x01(\tB\x0e\xbaG\v\x92\x02\b租户IDR\btenantId:\x1b\xbaG\x18\x92\x02\x15角色的基本信息\"\xf5\x05\n" +
+	"\ttenant_id\x18\f \x01(\tB\x0e\xbaG\v\x92\x02\b租户IDR\btenantId:\x1b\xbaG\x18\x92\x02\x15角色的基本信息\"\x90\x06\n" +
 	"\x11CreateRoleRequest\x128\n" +
 	"\x04name\x18\x01 \x01(\tB\x1f\xbaG\x1c:\v\x12\t管理员\x92\x02\f角色名称H\x00R\x04name\x88\x01\x01\x12=\n" +
 	"\x04code\x18\x02 \x01(\tB$\xbaG!:\a\x12\x05admin\x92\x02\x15角色权限字符串H\x01R\x04code\x88\x01\x01\x120\n" +
-	"\x04sort\x18\x03 \x01(\x05B\x17\xbaG\x14:\x03\x12\x011\x92\x02\f显示顺序H\x02R\x04sort\x88\x01\x01\x12\xad\x01\n" +
+	"\x04sort\x18\x03 \x01(\x05B\x17\xbaG\x14:\x03\x12\x011\x92\x02\f显示顺序H\x02R\x04sort\x88\x01\x01\x12\xc8\x01\n" +
 	"\n" +
-	"data_scope\x18\x04 \x01(\x05B\x88\x01\xbaG\x84\x01:\x03\x12\x011\x92\x02|数据范围（1：全部数据权限 2：自定数据权限 3：本部门数据权限 4：本部门及以下数据权限）H\x03R\tdataScope\x88\x01\x01\x12Z\n" +
+	"data_scope\x18\x04 \x01(\x05B\xa3\x01\xbaG\x9f\x01:\x03\x12\x011\x92\x02\x96\x01数据范围（1：全部数据权限 2：自定数据权限 3：本部门数据权限 4：本部门及以下数据权限 5：仅本人数据权限）H\x03R\tdataScope\x88\x01\x01\x12Z\n" +
 	"\x13data_scope_dept_ids\x18\x05 \x01(\tB&\xbaG#\x92\x02 数据范围(指定部门数组)H\x04R\x10dataScopeDeptIds\x88\x01\x01\x12H\n" +
 	"\x06status\x18\x06 \x01(\x05B+\xbaG(:\x03\x12\x011\x92\x02 角色状态: 0-禁用, 1-正常H\x05R\x06status\x88\x01\x01\x120\n" +
 	"\x04type\x18\a \x01(\x05B\x17\xbaG\x14:\x03\x12\x011\x92\x02\f角色类型H\x06R\x04type\x88\x01\x01\x12/\n" +
@@ -863,14 +864,14 @@ const file_permission_v1_role_proto_rawDesc = "" +
 	"\x04page\x18\x03 \x01(\x05B\x17\xbaG\x14:\x03\x12\x011\x92\x02\f当前页码R\x04page\x125\n" +
 	"\tpage_size\x18\x04 \x01(\x05B\x18\xbaG\x15:\x04\x12\x0210\x92\x02\f每页数量R\bpageSize\x126\n" +
 	"\vtotal_pages\x18\x05 \x01(\x05B\x15\xbaG\x12:\x04\x12\x0210\x92\x02\t总页数R\n" +
-	"totalPages:!\xbaG\x1e\x92\x02\x1b查询角色列表响应体\"\xb4\x06\n" +
+	"totalPages:!\xbaG\x1e\x92\x02\x1b查询角色列表响应体\"\xcf\x06\n" +
 	"\x11UpdateRoleRequest\x120\n" +
 	"\x02id\x18\x01 \x01(\tB\x1b\xbaG\x18:\v\x12\t123456789\x92\x02\b角色IDH\x00R\x02id\x88\x01\x01\x128\n" +
 	"\x04name\x18\x02 \x01(\tB\x1f\xbaG\x1c:\v\x12\t管理员\x92\x02\f角色名称H\x01R\x04name\x88\x01\x01\x12=\n" +
 	"\x04code\x18\x03 \x01(\tB$\xbaG!:\a\x12\x05admin\x92\x02\x15角色权限字符串H\x02R\x04code\x88\x01\x01\x120\n" +
-	"\x04sort\x18\x04 \x01(\x05B\x17\xbaG\x14:\x03\x12\x011\x92\x02\f显示顺序H\x03R\x04sort\x88\x01\x01\x12\xad\x01\n" +
+	"\x04sort\x18\x04 \x01(\x05B\x17\xbaG\x14:\x03\x12\x011\x92\x02\f显示顺序H\x03R\x04sort\x88\x01\x01\x12\xc8\x01\n" +
 	"\n" +
-	"data_scope\x18\x05 \x01(\x05B\x88\x01\xbaG\x84\x01:\x03\x12\x011\x92\x02|数据范围（1：全部数据权限 2：自定数据权限 3：本部门数据权限 4：本部门及以下数据权限）H\x04R\tdataScope\x88\x01\x01\x12Z\n" +
+	"data_scope\x18\x05 \x01(\x05B\xa3\x01\xbaG\x9f\x01:\x03\x12\x011\x92\x02\x96\x01数据范围（1：全部数据权限 2：自定数据权限 3：本部门数据权限 4：本部门及以下数据权限 5：仅本人数据权限）H\x04R\tdataScope\x88\x01\x01\x12Z\n" +
 	"\x13data_scope_dept_ids\x18\x06 \x01(\tB&\xbaG#\x92\x02 数据范围(指定部门数组)H\x05R\x10dataScopeDeptIds\x88\x01\x01\x12H\n" +
 	"\x06status\x18\a \x01(\x05B+\xbaG(:\x03\x12\x011\x92\x02 角色状态: 0-禁用, 1-正常H\x06R\x06status\x88\x01\x01\x120\n" +
 	"\x04type\x18\b \x01(\x05B\x17\xbaG\x14:\x03\x12\x011\x92\x02\f角色类型H\aR\x04type\x88\x01\x01\x12/\n" +
@@ -895,19 +896,25 @@ const file_permission_v1_role_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tB\x1b\xbaG\x18:\v\x12\t123456789\x92\x02\b角色IDH\x00R\x02id\x88\x01\x01:'\xbaG$\x92\x02!获取角色菜单权限请求体B\x05\n" +
 	"\x03_id\"m\n" +
 	"\x11GetRoleMenusReply\x12/\n" +
-	"\bmenu_ids\x18\x01 \x03(\tB\x14\xbaG\x11\x92\x02\x0e菜单ID列表R\amenuIds:'\xbaG$\x92\x02!获取角色菜单权限响应体2\x9c\n" +
+	"\bmenu_ids\x18\x01 \x03(\tB\x14\xbaG\x11\x92\x02\x0e菜单ID列表R\amenuIds:'\xbaG$\x92\x02!获取角色菜单权限响应体2\xc8\v\n" +
+	"\vRoleService\x12\xba\x01\n" +
 	"\n" +
-	"\vRoleService\x12\xa2\x01\n" +
+	"CreateRole\x12'.system.permission.v1.CreateRoleRequest\x1a\x16.google.protobuf.Empty\"k\xbaG(\x12\f创建角色\x1a\x18创建一个新的角色\xca\xf3\x18\x14\n" +
+	"\x12system:role:create\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/qs/v1/permission/role/create\x12\xd7\x01\n" +
+	"\aGetRole\x12$.system.permission.v1.GetRoleRequest\x1a\".system.permission.v1.GetRoleReply\"\x81\x01\xbaGE\x12\x18获取角色详细信息\x1a)根据角色ID获取角色的详细信息\xca\xf3\x18\x13\n" +
+	"\x11system:role:query\x82\xd3\xe4\x93\x02\x1c\x12\x1a/qs/v1/permission/role/get\x12\xc8\x01\n" +
+	"\tListRoles\x12&.system.permission.v1.ListRolesRequest\x1a$.system.permission.v1.ListRolesReply\"m\xbaG.\x12\x12获取角色列表\x1a\x18分页查询角色列表\xca\xf3\x18\x12\n" +
+	"\x10system:role:list\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/qs/v1/permission/role/list\x12\xc3\x01\n" +
 	"\n" +
-	"CreateRole\x12'.system.permission.v1.CreateRoleRequest\x1a\x16.google.protobuf.Empty\"S\xbaG(\x12\f创建角色\x1a\x18创建一个新的角色\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/qs/v1/permission/role/create\x12\xbf\x01\n" +
-	"\aGetRole\x12$.system.permission.v1.GetRoleRequest\x1a\".system.permission.v1.GetRoleReply\"j\xbaGE\x12\x18获取角色详细信息\x1a)根据角色ID获取角色的详细信息\x82\xd3\xe4\x93\x02\x1c\x12\x1a/qs/v1/permission/role/get\x12\xb2\x01\n" +
-	"\tListRoles\x12&.system.permission.v1.ListRolesRequest\x1a$.system.permission.v1.ListRolesReply\"W\xbaG.\x12\x12获取角色列表\x1a\x18分页查询角色列表\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/qs/v1/permission/role/list\x12\xab\x01\n" +
+	"UpdateRole\x12'.system.permission.v1.UpdateRoleRequest\x1a\x16.google.protobuf.Empty\"t\xbaG1\x12\x12更新角色信息\x1a\x1b更新角色的基本信息\xca\xf3\x18\x14\n" +
+	"\x12system:role:update\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/qs/v1/permission/role/update\x12\xc0\x01\n" +
 	"\n" +
-	"UpdateRole\x12'.system.permission.v1.UpdateRoleRequest\x1a\x16.google.protobuf.Empty\"\\\xbaG1\x12\x12更新角色信息\x1a\x1b更新角色的基本信息\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/qs/v1/permission/role/update\x12\xa8\x01\n" +
-	"\n" +
-	"DeleteRole\x12'.system.permission.v1.DeleteRoleRequest\x1a\x16.google.protobuf.Empty\"Y\xbaG1\x12\f删除角色\x1a!删除角色，此操作不可逆\x82\xd3\xe4\x93\x02\x1f*\x1d/qs/v1/permission/role/delete\x12\xbe\x01\n" +
-	"\x0eAssignRoleMenu\x12+.system.permission.v1.AssignRoleMenuRequest\x1a\x16.google.protobuf.Empty\"g\xbaG7\x12\x18分配角色菜单权限\x1a\x1b为角色分配菜单权限\x82\xd3\xe4\x93\x02':\x01*\"\"/qs/v1/permission/role/assign-menu\x12\xd6\x01\n" +
-	"\fGetRoleMenus\x12).system.permission.v1.GetRoleMenusRequest\x1a'.system.permission.v1.GetRoleMenusReply\"r\xbaGF\x12\x18获取角色菜单权限\x1a*获取角色已分配的菜单权限列表\x82\xd3\xe4\x93\x02#\x12!/qs/v1/permission/role/list-menusBN\xbaG):'\n" +
+	"DeleteRole\x12'.system.permission.v1.DeleteRoleRequest\x1a\x16.google.protobuf.Empty\"q\xbaG1\x12\f删除角色\x1a!删除角色，此操作不可逆\xca\xf3\x18\x14\n" +
+	"\x12system:role:delete\x82\xd3\xe4\x93\x02\x1f*\x1d/qs/v1/permission/role/delete\x12\xdc\x01\n" +
+	"\x0eAssignRoleMenu\x12+.system.permission.v1.AssignRoleMenuRequest\x1a\x16.google.protobuf.Empty\"\x84\x01\xbaG7\x12\x18分配角色菜单权限\x1a\x1b为角色分配菜单权限\xca\xf3\x18\x19\n" +
+	"\x17system:role:assign-menu\x82\xd3\xe4\x93\x02':\x01*\"\"/qs/v1/permission/role/assign-menu\x12\xee\x01\n" +
+	"\fGetRoleMenus\x12).system.permission.v1.GetRoleMenusRequest\x1a'.system.permission.v1.GetRoleMenusReply\"\x89\x01\xbaGF\x12\x18获取角色菜单权限\x1a*获取角色已分配的菜单权限列表\xca\xf3\x18\x13\n" +
+	"\x11system:role:query\x82\xd3\xe4\x93\x02#\x12!/qs/v1/permission/role/list-menusBN\xbaG):'\n" +
 	"\vRoleService\x12\x18角色管理相关操作Z quest-admin/api/permission/v1;v1b\x06proto3"
 
 var (
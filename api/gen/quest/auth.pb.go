@@ -0,0 +1,161 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.5
+// source: quest/auth.proto
+
+package quest
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AuthRule 接口访问规则
+type AuthRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 所需权限码，为空时不校验权限
+	Permission string `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"`
+	// 允许访问的角色编码，满足其一即可，为空时不校验角色
+	Roles         []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthRule) Reset() {
+	*x = AuthRule{}
+	mi := &file_quest_auth_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthRule) ProtoMessage() {}
+
+func (x *AuthRule) ProtoReflect() protoreflect.Message {
+	mi := &file_quest_auth_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthRule.ProtoReflect.Descriptor instead.
+func (*AuthRule) Descriptor() ([]byte, []int) {
+	return file_quest_auth_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuthRule) GetPermission() string {
+	if x != nil {
+		return x.Permission
+	}
+	return ""
+}
+
+func (x *AuthRule) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+var file_quest_auth_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*AuthRule)(nil),
+		Field:         51001,
+		Name:          "quest.auth",
+		Tag:           "bytes,51001,opt,name=auth",
+		Filename:      "quest/auth.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// 接口访问规则，由认证中间件在启动时读取
+	//
+	// optional quest.AuthRule auth = 51001;
+	E_Auth = &file_quest_auth_proto_extTypes[0]
+)
+
+var File_quest_auth_proto protoreflect.FileDescriptor
+
+const file_quest_auth_proto_rawDesc = "" +
+	"\n" +
+	"\x10quest/auth.proto\x12\x05quest\x1a google/protobuf/descriptor.proto\"@\n" +
+	"\bAuthRule\x12\x1e\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\tR\n" +
+	"permission\x12\x14\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles:E\n" +
+	"\x04auth\x12\x1e.google.protobuf.MethodOptions\x18\xb9\x8e\x03 \x01(\v2\x0f.quest.AuthRuleR\x04authB!Z\x1fquest-admin/api/gen/quest;questb\x06proto3"
+
+var (
+	file_quest_auth_proto_rawDescOnce sync.Once
+	file_quest_auth_proto_rawDescData []byte
+)
+
+func file_quest_auth_proto_rawDescGZIP() []byte {
+	file_quest_auth_proto_rawDescOnce.Do(func() {
+		file_quest_auth_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_quest_auth_proto_rawDesc), len(file_quest_auth_proto_rawDesc)))
+	})
+	return file_quest_auth_proto_rawDescData
+}
+
+var file_quest_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_quest_auth_proto_goTypes = []any{
+	(*AuthRule)(nil),                   // 0: quest.AuthRule
+	(*descriptorpb.MethodOptions)(nil), // 1: google.protobuf.MethodOptions
+}
+var file_quest_auth_proto_depIdxs = []int32{
+	1, // 0: quest.auth:extendee -> google.protobuf.MethodOptions
+	0, // 1: quest.auth:type_name -> quest.AuthRule
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	1, // [1:2] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_quest_auth_proto_init() }
+func file_quest_auth_proto_init() {
+	if File_quest_auth_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_quest_auth_proto_rawDesc), len(file_quest_auth_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_quest_auth_proto_goTypes,
+		DependencyIndexes: file_quest_auth_proto_depIdxs,
+		MessageInfos:      file_quest_auth_proto_msgTypes,
+		ExtensionInfos:    file_quest_auth_proto_extTypes,
+	}.Build()
+	File_quest_auth_proto = out.File
+	file_quest_auth_proto_goTypes = nil
+	file_quest_auth_proto_depIdxs = nil
+}
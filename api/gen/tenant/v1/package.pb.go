@@ -13,6 +13,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	_ "quest-admin/api/gen/quest"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -549,7 +550,7 @@ var File_tenant_v1_package_proto protoreflect.FileDescriptor
 
 const file_tenant_v1_package_proto_rawDesc = "" +
 	"\n" +
-	"\x17tenant/v1/package.proto\x12\x10system.tenant.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\"\x84\x04\n" +
+	"\x17tenant/v1/package.proto\x12\x10system.tenant.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\x1a\x10quest/auth.proto\"\x84\x04\n" +
 	"\x11TenantPackageInfo\x125\n" +
 	"\x02id\x18\x01 \x01(\tB%\xbaG\":\b\x12\x06pkg001\x92\x02\x15套餐唯一标识符R\x02id\x123\n" +
 	"\x04name\x18\x02 \x01(\tB\x1f\xbaG\x1c:\v\x12\t基础版\x92\x02\f套餐名称R\x04name\x12C\n" +
@@ -607,13 +608,18 @@ const file_tenant_v1_package_proto_rawDesc = "" +
 	"\t_menu_ids\"u\n" +
 	"\x1aDeleteTenantPackageRequest\x12-\n" +
 	"\x02id\x18\x01 \x01(\tB\x18\xbaG\x15:\b\x12\x06pkg001\x92\x02\b套餐IDH\x00R\x02id\x88\x01\x01:!\xbaG\x1e\x92\x02\x1b删除租户套餐请求体B\x05\n" +
-	"\x03_id2\x8c\b\n" +
-	"\x14TenantPackageService\x12\xbb\x01\n" +
-	"\x13CreateTenantPackage\x12,.system.tenant.v1.CreateTenantPackageRequest\x1a\x16.google.protobuf.Empty\"^\xbaG4\x12\x12创建租户套餐\x1a\x1e创建一个新的租户套餐\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/qs/v1/tenant-package/create\x12\xd7\x01\n" +
-	"\x10GetTenantPackage\x12).system.tenant.v1.GetTenantPackageRequest\x1a'.system.tenant.v1.GetTenantPackageReply\"o\xbaGK\x12\x1e获取租户套餐详细信息\x1a)根据套餐ID获取套餐的详细信息\x82\xd3\xe4\x93\x02\x1b\x12\x19/qs/v1/tenant-package/get\x12\xd0\x01\n" +
-	"\x12ListTenantPackages\x12+.system.tenant.v1.ListTenantPackagesRequest\x1a).system.tenant.v1.ListTenantPackagesReply\"b\xbaG:\x12\x18获取租户套餐列表\x1a\x1e分页查询租户套餐列表\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/qs/v1/tenant-package/list\x12\xc4\x01\n" +
-	"\x13UpdateTenantPackage\x12,.system.tenant.v1.UpdateTenantPackageRequest\x1a\x16.google.protobuf.Empty\"g\xbaG=\x12\x18更新租户套餐信息\x1a!更新租户套餐的基本信息\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/qs/v1/tenant-package/update\x12\xc1\x01\n" +
-	"\x13DeleteTenantPackage\x12,.system.tenant.v1.DeleteTenantPackageRequest\x1a\x16.google.protobuf.Empty\"d\xbaG=\x12\x12删除租户套餐\x1a'删除租户套餐，此操作不可逆\x82\xd3\xe4\x93\x02\x1e*\x1c/qs/v1/tenant-package/deleteBS\xbaG2:0\n" +
+	"\x03_id2\xb8\t\n" +
+	"\x14TenantPackageService\x12\xde\x01\n" +
+	"\x13CreateTenantPackage\x12,.system.tenant.v1.CreateTenantPackageRequest\x1a\x16.google.protobuf.Empty\"\x80\x01\xbaG4\x12\x12创建租户套餐\x1a\x1e创建一个新的租户套餐\xca\xf3\x18\x1e\n" +
+	"\x1csystem:tenant-package:create\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/qs/v1/tenant-package/create\x12\xf9\x01\n" +
+	"\x10GetTenantPackage\x12).system.tenant.v1.GetTenantPackageRequest\x1a'.system.tenant.v1.GetTenantPackageReply\"\x90\x01\xbaGK\x12\x1e获取租户套餐详细信息\x1a)根据套餐ID获取套餐的详细信息\xca\xf3\x18\x1d\n" +
+	"\x1bsystem:tenant-package:query\x82\xd3\xe4\x93\x02\x1b\x12\x19/qs/v1/tenant-package/get\x12\xf1\x01\n" +
+	"\x12ListTenantPackages\x12+.system.tenant.v1.ListTenantPackagesRequest\x1a).system.tenant.v1.ListTenantPackagesReply\"\x82\x01\xbaG:\x12\x18获取租户套餐列表\x1a\x1e分页查询租户套餐列表\xca\xf3\x18\x1c\n" +
+	"\x1asystem:tenant-package:list\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/qs/v1/tenant-package/list\x12\xe7\x01\n" +
+	"\x13UpdateTenantPackage\x12,.system.tenant.v1.UpdateTenantPackageRequest\x1a\x16.google.protobuf.Empty\"\x89\x01\xbaG=\x12\x18更新租户套餐信息\x1a!更新租户套餐的基本信息\xca\xf3\x18\x1e\n" +
+	"\x1csystem:tenant-package:update\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/qs/v1/tenant-package/update\x12\xe4\x01\n" +
+	"\x13DeleteTenantPackage\x12,.system.tenant.v1.DeleteTenantPackageRequest\x1a\x16.google.protobuf.Empty\"\x86\x01\xbaG=\x12\x12删除租户套餐\x1a'删除租户套餐，此操作不可逆\xca\xf3\x18\x1e\n" +
+	"\x1csystem:tenant-package:delete\x82\xd3\xe4\x93\x02\x1e*\x1c/qs/v1/tenant-package/deleteBS\xbaG2:0\n" +
 	"\x14TenantPackageService\x12\x18租户套餐相关操作Z\x1cquest-admin/api/tenant/v1;v1b\x06proto3"
 
 var (
@@ -13,6 +13,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	_ "quest-admin/api/gen/quest"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -167,6 +168,7 @@ type CreateTenantRequest struct {
 	PackageId     *string                `protobuf:"bytes,6,opt,name=package_id,json=packageId,proto3,oneof" json:"package_id,omitempty"`
 	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expire_time,json=expireTime,proto3,oneof" json:"expire_time,omitempty"`
 	AccountCount  *int32                 `protobuf:"varint,8,opt,name=account_count,json=accountCount,proto3,oneof" json:"account_count,omitempty"`
+	AdminUsername *string                `protobuf:"bytes,9,opt,name=admin_username,json=adminUsername,proto3,oneof" json:"admin_username,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -257,6 +259,81 @@ func (x *CreateTenantRequest) GetAccountCount() int32 {
 	return 0
 }
 
+func (x *CreateTenantRequest) GetAdminUsername() string {
+	if x != nil && x.AdminUsername != nil {
+		return *x.AdminUsername
+	}
+	return ""
+}
+
+type CreateTenantReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantId      string                 `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantReply) Reset() {
+	*x = CreateTenantReply{}
+	mi := &file_tenant_v1_tenant_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantReply) ProtoMessage() {}
+
+func (x *CreateTenantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_tenant_v1_tenant_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantReply.ProtoReflect.Descriptor instead.
+func (*CreateTenantReply) Descriptor() ([]byte, []int) {
+	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateTenantReply) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *CreateTenantReply) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateTenantReply) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateTenantReply) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
 type GetTenantRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
@@ -266,7 +343,7 @@ type GetTenantRequest struct {
 
 func (x *GetTenantRequest) Reset() {
 	*x = GetTenantRequest{}
-	mi := &file_tenant_v1_tenant_proto_msgTypes[2]
+	mi := &file_tenant_v1_tenant_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -278,7 +355,7 @@ func (x *GetTenantRequest) String() string {
 func (*GetTenantRequest) ProtoMessage() {}
 
 func (x *GetTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_v1_tenant_proto_msgTypes[2]
+	mi := &file_tenant_v1_tenant_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -291,7 +368,7 @@ func (x *GetTenantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTenantRequest.ProtoReflect.Descriptor instead.
 func (*GetTenantRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{2}
+	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetTenantRequest) GetId() string {
@@ -310,7 +387,7 @@ type GetTenantReply struct {
 
 func (x *GetTenantReply) Reset() {
 	*x = GetTenantReply{}
-	mi := &file_tenant_v1_tenant_proto_msgTypes[3]
+	mi := &file_tenant_v1_tenant_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -322,7 +399,7 @@ func (x *GetTenantReply) String() string {
 func (*GetTenantReply) ProtoMessage() {}
 
 func (x *GetTenantReply) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_v1_tenant_proto_msgTypes[3]
+	mi := &file_tenant_v1_tenant_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -335,7 +412,7 @@ func (x *GetTenantReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTenantReply.ProtoReflect.Descriptor instead.
 func (*GetTenantReply) Descriptor() ([]byte, []int) {
-	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{3}
+	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetTenantReply) GetTenant() *TenantInfo {
@@ -359,7 +436,7 @@ type ListTenantsRequest struct {
 
 func (x *ListTenantsRequest) Reset() {
 	*x = ListTenantsRequest{}
-	mi := &file_tenant_v1_tenant_proto_msgTypes[4]
+	mi := &file_tenant_v1_tenant_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -371,7 +448,7 @@ func (x *ListTenantsRequest) String() string {
 func (*ListTenantsRequest) ProtoMessage() {}
 
 func (x *ListTenantsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_v1_tenant_proto_msgTypes[4]
+	mi := &file_tenant_v1_tenant_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -384,7 +461,7 @@ func (x *ListTenantsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTenantsRequest.ProtoReflect.Descriptor instead.
 func (*ListTenantsRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{4}
+	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ListTenantsRequest) GetPage() int32 {
@@ -442,7 +519,7 @@ type ListTenantsReply struct {
 
 func (x *ListTenantsReply) Reset() {
 	*x = ListTenantsReply{}
-	mi := &file_tenant_v1_tenant_proto_msgTypes[5]
+	mi := &file_tenant_v1_tenant_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -454,7 +531,7 @@ func (x *ListTenantsReply) String() string {
 func (*ListTenantsReply) ProtoMessage() {}
 
 func (x *ListTenantsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_v1_tenant_proto_msgTypes[5]
+	mi := &file_tenant_v1_tenant_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,7 +544,7 @@ func (x *ListTenantsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTenantsReply.ProtoReflect.Descriptor instead.
 func (*ListTenantsReply) Descriptor() ([]byte, []int) {
-	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{5}
+	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ListTenantsReply) GetTenants() []*TenantInfo {
@@ -523,7 +600,7 @@ type UpdateTenantRequest struct {
 
 func (x *UpdateTenantRequest) Reset() {
 	*x = UpdateTenantRequest{}
-	mi := &file_tenant_v1_tenant_proto_msgTypes[6]
+	mi := &file_tenant_v1_tenant_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -535,7 +612,7 @@ func (x *UpdateTenantRequest) String() string {
 func (*UpdateTenantRequest) ProtoMessage() {}
 
 func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_v1_tenant_proto_msgTypes[6]
+	mi := &file_tenant_v1_tenant_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,7 +625,7 @@ func (x *UpdateTenantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateTenantRequest.ProtoReflect.Descriptor instead.
 func (*UpdateTenantRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{6}
+	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UpdateTenantRequest) GetId() string {
@@ -630,7 +707,7 @@ type DeleteTenantRequest struct {
 
 func (x *DeleteTenantRequest) Reset() {
 	*x = DeleteTenantRequest{}
-	mi := &file_tenant_v1_tenant_proto_msgTypes[7]
+	mi := &file_tenant_v1_tenant_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -642,7 +719,7 @@ func (x *DeleteTenantRequest) String() string {
 func (*DeleteTenantRequest) ProtoMessage() {}
 
 func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_v1_tenant_proto_msgTypes[7]
+	mi := &file_tenant_v1_tenant_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -655,7 +732,7 @@ func (x *DeleteTenantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTenantRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTenantRequest) Descriptor() ([]byte, []int) {
-	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{7}
+	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *DeleteTenantRequest) GetId() string {
@@ -675,7 +752,7 @@ type TenantSimpleInfo struct {
 
 func (x *TenantSimpleInfo) Reset() {
 	*x = TenantSimpleInfo{}
-	mi := &file_tenant_v1_tenant_proto_msgTypes[8]
+	mi := &file_tenant_v1_tenant_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -687,7 +764,7 @@ func (x *TenantSimpleInfo) String() string {
 func (*TenantSimpleInfo) ProtoMessage() {}
 
 func (x *TenantSimpleInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_v1_tenant_proto_msgTypes[8]
+	mi := &file_tenant_v1_tenant_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -700,7 +777,7 @@ func (x *TenantSimpleInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TenantSimpleInfo.ProtoReflect.Descriptor instead.
 func (*TenantSimpleInfo) Descriptor() ([]byte, []int) {
-	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{8}
+	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TenantSimpleInfo) GetId() string {
@@ -726,7 +803,7 @@ type GetAllTenantsReply struct {
 
 func (x *GetAllTenantsReply) Reset() {
 	*x = GetAllTenantsReply{}
-	mi := &file_tenant_v1_tenant_proto_msgTypes[9]
+	mi := &file_tenant_v1_tenant_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -738,7 +815,7 @@ func (x *GetAllTenantsReply) String() string {
 func (*GetAllTenantsReply) ProtoMessage() {}
 
 func (x *GetAllTenantsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_tenant_v1_tenant_proto_msgTypes[9]
+	mi := &file_tenant_v1_tenant_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -751,7 +828,7 @@ func (x *GetAllTenantsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAllTenantsReply.ProtoReflect.Descriptor instead.
 func (*GetAllTenantsReply) Descriptor() ([]byte, []int) {
-	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{9}
+	return file_tenant_v1_tenant_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetAllTenantsReply) GetTenants() []*TenantSimpleInfo {
@@ -765,7 +842,7 @@ var File_tenant_v1_tenant_proto protoreflect.FileDescriptor
 
 const file_tenant_v1_tenant_proto_rawDesc = "" +
 	"\n" +
-	"\x16tenant/v1/tenant.proto\x12\x10system.tenant.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\"\xe6\x06\n" +
+	"\x16tenant/v1/tenant.proto\x12\x10system.tenant.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\x1a\x10quest/auth.proto\"\xe6\x06\n" +
 	"\n" +
 	"TenantInfo\x128\n" +
 	"\x02id\x18\x01 \x01(\tB(\xbaG%:\v\x12\t123456789\x92\x02\x15租户唯一标识符R\x02id\x126\n" +
@@ -782,18 +859,19 @@ const file_tenant_v1_tenant_proto_rawDesc = "" +
 	"\raccount_count\x18\n" +
 	" \x01(\x05B\x19\xbaG\x16:\x05\x12\x03100\x92\x02\f账号数量R\faccountCount\x12K\n" +
 	"\tcreate_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间R\bcreateAt\x12K\n" +
-	"\tupdate_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间R\bupdateAt:\x1b\xbaG\x18\x92\x02\x15租户的基本信息\"\xfc\x05\n" +
+	"\tupdate_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间R\bupdateAt:\x1b\xbaG\x18\x92\x02\x15租户的基本信息\"\x88\a\n" +
 	"\x13CreateTenantRequest\x12;\n" +
 	"\x04name\x18\x01 \x01(\tB\"\xbaG\x1f:\x0e\x12\f示例公司\x92\x02\f租户名称H\x00R\x04name\x88\x01\x01\x12V\n" +
 	"\x0fcontact_user_id\x18\x02 \x01(\tB)\xbaG&:\t\x12\auser123\x92\x02\x18联系人的用户编号H\x01R\rcontactUserId\x88\x01\x01\x12A\n" +
 	"\fcontact_name\x18\x03 \x01(\tB\x19\xbaG\x16:\b\x12\x06张三\x92\x02\t联系人H\x02R\vcontactName\x88\x01\x01\x12M\n" +
 	"\x0econtact_mobile\x18\x04 \x01(\tB!\xbaG\x1e:\r\x12\v13800138000\x92\x02\f联系手机H\x03R\rcontactMobile\x88\x01\x01\x12@\n" +
-	"\awebsite\x18\x05 \x01(\tB!\xbaG\x1e:\r\x12\vexample.com\x92\x02\f绑定域名H\x04R\awebsite\x88\x01\x01\x12F\n" +
+	"\awebsite\x18\x05 \x01(\tB!\xbaG\x1e:\r\x12\vexample.com\x92\x02\f绑定域名H\x04R\awebsite\x88\x01\x01\x12^\n" +
 	"\n" +
-	"package_id\x18\x06 \x01(\tB\"\xbaG\x1f:\b\x12\x06pkg001\x92\x02\x12租户套餐编号H\x05R\tpackageId\x88\x01\x01\x12T\n" +
+	"package_id\x18\x06 \x01(\tB:\xbaG7:\b\x12\x06pkg001\x92\x02*租户套餐编号，开通租户时必填H\x05R\tpackageId\x88\x01\x01\x12T\n" +
 	"\vexpire_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f过期时间H\x06R\n" +
 	"expireTime\x88\x01\x01\x12C\n" +
-	"\raccount_count\x18\b \x01(\x05B\x19\xbaG\x16:\x05\x12\x03100\x92\x02\f账号数量H\aR\faccountCount\x88\x01\x01:\x1b\xbaG\x18\x92\x02\x15创建租户请求体B\a\n" +
+	"\raccount_count\x18\b \x01(\x05B\x19\xbaG\x16:\x05\x12\x03100\x92\x02\f账号数量H\aR\faccountCount\x88\x01\x01\x12_\n" +
+	"\x0eadmin_username\x18\t \x01(\tB3\xbaG0:\a\x12\x05admin\x92\x02$管理员用户名，默认为 adminH\bR\radminUsername\x88\x01\x01:\x1b\xbaG\x18\x92\x02\x15创建租户请求体B\a\n" +
 	"\x05_nameB\x12\n" +
 	"\x10_contact_user_idB\x0f\n" +
 	"\r_contact_nameB\x11\n" +
@@ -802,7 +880,13 @@ const file_tenant_v1_tenant_proto_rawDesc = "" +
 	"\b_websiteB\r\n" +
 	"\v_package_idB\x0e\n" +
 	"\f_expire_timeB\x10\n" +
-	"\x0e_account_count\"n\n" +
+	"\x0e_account_countB\x11\n" +
+	"\x0f_admin_username\"\xe3\x02\n" +
+	"\x11CreateTenantReply\x12:\n" +
+	"\ttenant_id\x18\x01 \x01(\tB\x1d\xbaG\x1a:\t\x12\aTENA123\x92\x02\f租户编号R\btenantId\x12?\n" +
+	"\auser_id\x18\x02 \x01(\tB&\xbaG#:\t\x12\aAUID123\x92\x02\x15管理员用户编号R\x06userId\x12=\n" +
+	"\busername\x18\x03 \x01(\tB!\xbaG\x1e:\a\x12\x05admin\x92\x02\x12管理员用户名R\busername\x12u\n" +
+	"\bpassword\x18\x04 \x01(\tBY\xbaGV:\x12\x12\x10Xk3#mP9q@Lw2!vRt\x92\x02?管理员初始密码，仅返回一次，首次登录需修改R\bpassword:\x1b\xbaG\x18\x92\x02\x15创建租户响应体\"n\n" +
 	"\x10GetTenantRequest\x120\n" +
 	"\x02id\x18\x01 \x01(\tB\x1b\xbaG\x18:\v\x12\t123456789\x92\x02\b租户IDH\x00R\x02id\x88\x01\x01:!\xbaG\x1e\x92\x02\x1b获取租户信息请求体B\x05\n" +
 	"\x03_id\"\x83\x01\n" +
@@ -864,13 +948,19 @@ const file_tenant_v1_tenant_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tB(\xbaG%:\v\x12\t123456789\x92\x02\x15租户唯一标识符R\x02id\x126\n" +
 	"\x04name\x18\x02 \x01(\tB\"\xbaG\x1f:\x0e\x12\f示例公司\x92\x02\f租户名称R\x04name:\x18\xbaG\x15\x92\x02\x12租户简化信息\"\x8f\x01\n" +
 	"\x12GetAllTenantsReply\x12P\n" +
-	"\atenants\x18\x01 \x03(\v2\".system.tenant.v1.TenantSimpleInfoB\x12\xbaG\x0f\x92\x02\f租户列表R\atenants:'\xbaG$\x92\x02!获取全量租户列表响应体2\x9b\b\n" +
-	"\rTenantService\x12\x99\x01\n" +
-	"\fCreateTenant\x12%.system.tenant.v1.CreateTenantRequest\x1a\x16.google.protobuf.Empty\"J\xbaG(\x12\f创建租户\x1a\x18创建一个新的租户\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/qs/v1/tenant/create\x12\xb4\x01\n" +
-	"\tGetTenant\x12\".system.tenant.v1.GetTenantRequest\x1a .system.tenant.v1.GetTenantReply\"a\xbaGE\x12\x18获取租户详细信息\x1a)根据租户ID获取租户的详细信息\x82\xd3\xe4\x93\x02\x13\x12\x11/qs/v1/tenant/get\x12\xa7\x01\n" +
-	"\vListTenants\x12$.system.tenant.v1.ListTenantsRequest\x1a\".system.tenant.v1.ListTenantsReply\"N\xbaG.\x12\x12获取租户列表\x1a\x18分页查询租户列表\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/qs/v1/tenant/list\x12\xa2\x01\n" +
-	"\fUpdateTenant\x12%.system.tenant.v1.UpdateTenantRequest\x1a\x16.google.protobuf.Empty\"S\xbaG1\x12\x12更新租户信息\x1a\x1b更新租户的基本信息\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/qs/v1/tenant/update\x12\x9f\x01\n" +
-	"\fDeleteTenant\x12%.system.tenant.v1.DeleteTenantRequest\x1a\x16.google.protobuf.Empty\"P\xbaG1\x12\f删除租户\x1a!删除租户，此操作不可逆\x82\xd3\xe4\x93\x02\x16*\x14/qs/v1/tenant/delete\x12\xc5\x01\n" +
+	"\atenants\x18\x01 \x03(\v2\".system.tenant.v1.TenantSimpleInfoB\x12\xbaG\x0f\x92\x02\f租户列表R\atenants:'\xbaG$\x92\x02!获取全量租户列表响应体2\xa5\n" +
+	"\n" +
+	"\rTenantService\x12\xbe\x02\n" +
+	"\fCreateTenant\x12%.system.tenant.v1.CreateTenantRequest\x1a#.system.tenant.v1.CreateTenantReply\"\xe1\x01\xbaG\xa4\x01\x12\f创建租户\x1a\x93\x01创建一个新的租户，同时创建租户管理员账号和拥有套餐全部菜单的管理员角色，返回管理员的一次性登录凭证\xca\xf3\x18\x16\n" +
+	"\x14system:tenant:create\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/qs/v1/tenant/create\x12\xcd\x01\n" +
+	"\tGetTenant\x12\".system.tenant.v1.GetTenantRequest\x1a .system.tenant.v1.GetTenantReply\"z\xbaGE\x12\x18获取租户详细信息\x1a)根据租户ID获取租户的详细信息\xca\xf3\x18\x15\n" +
+	"\x13system:tenant:query\x82\xd3\xe4\x93\x02\x13\x12\x11/qs/v1/tenant/get\x12\xbf\x01\n" +
+	"\vListTenants\x12$.system.tenant.v1.ListTenantsRequest\x1a\".system.tenant.v1.ListTenantsReply\"f\xbaG.\x12\x12获取租户列表\x1a\x18分页查询租户列表\xca\xf3\x18\x14\n" +
+	"\x12system:tenant:list\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/qs/v1/tenant/list\x12\xbc\x01\n" +
+	"\fUpdateTenant\x12%.system.tenant.v1.UpdateTenantRequest\x1a\x16.google.protobuf.Empty\"m\xbaG1\x12\x12更新租户信息\x1a\x1b更新租户的基本信息\xca\xf3\x18\x16\n" +
+	"\x14system:tenant:update\x82\xd3\xe4\x93\x02\x19:\x01*\x1a\x14/qs/v1/tenant/update\x12\xb9\x01\n" +
+	"\fDeleteTenant\x12%.system.tenant.v1.DeleteTenantRequest\x1a\x16.google.protobuf.Empty\"j\xbaG1\x12\f删除租户\x1a!删除租户，此操作不可逆\xca\xf3\x18\x16\n" +
+	"\x14system:tenant:delete\x82\xd3\xe4\x93\x02\x16*\x14/qs/v1/tenant/delete\x12\xc5\x01\n" +
 	"\rGetAllTenants\x12\x16.google.protobuf.Empty\x1a$.system.tenant.v1.GetAllTenantsReply\"v\xbaGZ\x12\x18获取全量租户列表\x1a>获取所有租户的简化信息列表，仅包含ID和名称\x82\xd3\xe4\x93\x02\x13\x12\x11/qs/v1/tenant/allBF\xbaG%:#\n" +
 	"\rTenantService\x12\x12租户相关操作Z\x1cquest-admin/api/tenant/v1;v1b\x06proto3"
 
@@ -886,42 +976,43 @@ func file_tenant_v1_tenant_proto_rawDescGZIP() []byte {
 	return file_tenant_v1_tenant_proto_rawDescData
 }
 
-var file_tenant_v1_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_tenant_v1_tenant_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_tenant_v1_tenant_proto_goTypes = []any{
 	(*TenantInfo)(nil),            // 0: system.tenant.v1.TenantInfo
 	(*CreateTenantRequest)(nil),   // 1: system.tenant.v1.CreateTenantRequest
-	(*GetTenantRequest)(nil),      // 2: system.tenant.v1.GetTenantRequest
-	(*GetTenantReply)(nil),        // 3: system.tenant.v1.GetTenantReply
-	(*ListTenantsRequest)(nil),    // 4: system.tenant.v1.ListTenantsRequest
-	(*ListTenantsReply)(nil),      // 5: system.tenant.v1.ListTenantsReply
-	(*UpdateTenantRequest)(nil),   // 6: system.tenant.v1.UpdateTenantRequest
-	(*DeleteTenantRequest)(nil),   // 7: system.tenant.v1.DeleteTenantRequest
-	(*TenantSimpleInfo)(nil),      // 8: system.tenant.v1.TenantSimpleInfo
-	(*GetAllTenantsReply)(nil),    // 9: system.tenant.v1.GetAllTenantsReply
-	(*timestamppb.Timestamp)(nil), // 10: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 11: google.protobuf.Empty
+	(*CreateTenantReply)(nil),     // 2: system.tenant.v1.CreateTenantReply
+	(*GetTenantRequest)(nil),      // 3: system.tenant.v1.GetTenantRequest
+	(*GetTenantReply)(nil),        // 4: system.tenant.v1.GetTenantReply
+	(*ListTenantsRequest)(nil),    // 5: system.tenant.v1.ListTenantsRequest
+	(*ListTenantsReply)(nil),      // 6: system.tenant.v1.ListTenantsReply
+	(*UpdateTenantRequest)(nil),   // 7: system.tenant.v1.UpdateTenantRequest
+	(*DeleteTenantRequest)(nil),   // 8: system.tenant.v1.DeleteTenantRequest
+	(*TenantSimpleInfo)(nil),      // 9: system.tenant.v1.TenantSimpleInfo
+	(*GetAllTenantsReply)(nil),    // 10: system.tenant.v1.GetAllTenantsReply
+	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),         // 12: google.protobuf.Empty
 }
 var file_tenant_v1_tenant_proto_depIdxs = []int32{
-	10, // 0: system.tenant.v1.TenantInfo.expire_time:type_name -> google.protobuf.Timestamp
-	10, // 1: system.tenant.v1.TenantInfo.create_at:type_name -> google.protobuf.Timestamp
-	10, // 2: system.tenant.v1.TenantInfo.update_at:type_name -> google.protobuf.Timestamp
-	10, // 3: system.tenant.v1.CreateTenantRequest.expire_time:type_name -> google.protobuf.Timestamp
+	11, // 0: system.tenant.v1.TenantInfo.expire_time:type_name -> google.protobuf.Timestamp
+	11, // 1: system.tenant.v1.TenantInfo.create_at:type_name -> google.protobuf.Timestamp
+	11, // 2: system.tenant.v1.TenantInfo.update_at:type_name -> google.protobuf.Timestamp
+	11, // 3: system.tenant.v1.CreateTenantRequest.expire_time:type_name -> google.protobuf.Timestamp
 	0,  // 4: system.tenant.v1.GetTenantReply.tenant:type_name -> system.tenant.v1.TenantInfo
 	0,  // 5: system.tenant.v1.ListTenantsReply.tenants:type_name -> system.tenant.v1.TenantInfo
-	10, // 6: system.tenant.v1.UpdateTenantRequest.expire_time:type_name -> google.protobuf.Timestamp
-	8,  // 7: system.tenant.v1.GetAllTenantsReply.tenants:type_name -> system.tenant.v1.TenantSimpleInfo
+	11, // 6: system.tenant.v1.UpdateTenantRequest.expire_time:type_name -> google.protobuf.Timestamp
+	9,  // 7: system.tenant.v1.GetAllTenantsReply.tenants:type_name -> system.tenant.v1.TenantSimpleInfo
 	1,  // 8: system.tenant.v1.TenantService.CreateTenant:input_type -> system.tenant.v1.CreateTenantRequest
-	2,  // 9: system.tenant.v1.TenantService.GetTenant:input_type -> system.tenant.v1.GetTenantRequest
-	4,  // 10: system.tenant.v1.TenantService.ListTenants:input_type -> system.tenant.v1.ListTenantsRequest
-	6,  // 11: system.tenant.v1.TenantService.UpdateTenant:input_type -> system.tenant.v1.UpdateTenantRequest
-	7,  // 12: system.tenant.v1.TenantService.DeleteTenant:input_type -> system.tenant.v1.DeleteTenantRequest
-	11, // 13: system.tenant.v1.TenantService.GetAllTenants:input_type -> google.protobuf.Empty
-	11, // 14: system.tenant.v1.TenantService.CreateTenant:output_type -> google.protobuf.Empty
-	3,  // 15: system.tenant.v1.TenantService.GetTenant:output_type -> system.tenant.v1.GetTenantReply
-	5,  // 16: system.tenant.v1.TenantService.ListTenants:output_type -> system.tenant.v1.ListTenantsReply
-	11, // 17: system.tenant.v1.TenantService.UpdateTenant:output_type -> google.protobuf.Empty
-	11, // 18: system.tenant.v1.TenantService.DeleteTenant:output_type -> google.protobuf.Empty
-	9,  // 19: system.tenant.v1.TenantService.GetAllTenants:output_type -> system.tenant.v1.GetAllTenantsReply
+	3,  // 9: system.tenant.v1.TenantService.GetTenant:input_type -> system.tenant.v1.GetTenantRequest
+	5,  // 10: system.tenant.v1.TenantService.ListTenants:input_type -> system.tenant.v1.ListTenantsRequest
+	7,  // 11: system.tenant.v1.TenantService.UpdateTenant:input_type -> system.tenant.v1.UpdateTenantRequest
+	8,  // 12: system.tenant.v1.TenantService.DeleteTenant:input_type -> system.tenant.v1.DeleteTenantRequest
+	12, // 13: system.tenant.v1.TenantService.GetAllTenants:input_type -> google.protobuf.Empty
+	2,  // 14: system.tenant.v1.TenantService.CreateTenant:output_type -> system.tenant.v1.CreateTenantReply
+	4,  // 15: system.tenant.v1.TenantService.GetTenant:output_type -> system.tenant.v1.GetTenantReply
+	6,  // 16: system.tenant.v1.TenantService.ListTenants:output_type -> system.tenant.v1.ListTenantsReply
+	12, // 17: system.tenant.v1.TenantService.UpdateTenant:output_type -> google.protobuf.Empty
+	12, // 18: system.tenant.v1.TenantService.DeleteTenant:output_type -> google.protobuf.Empty
+	10, // 19: system.tenant.v1.TenantService.GetAllTenants:output_type -> system.tenant.v1.GetAllTenantsReply
 	14, // [14:20] is the sub-list for method output_type
 	8,  // [8:14] is the sub-list for method input_type
 	8,  // [8:8] is the sub-list for extension type_name
@@ -935,17 +1026,17 @@ func file_tenant_v1_tenant_proto_init() {
 		return
 	}
 	file_tenant_v1_tenant_proto_msgTypes[1].OneofWrappers = []any{}
-	file_tenant_v1_tenant_proto_msgTypes[2].OneofWrappers = []any{}
-	file_tenant_v1_tenant_proto_msgTypes[4].OneofWrappers = []any{}
-	file_tenant_v1_tenant_proto_msgTypes[6].OneofWrappers = []any{}
+	file_tenant_v1_tenant_proto_msgTypes[3].OneofWrappers = []any{}
+	file_tenant_v1_tenant_proto_msgTypes[5].OneofWrappers = []any{}
 	file_tenant_v1_tenant_proto_msgTypes[7].OneofWrappers = []any{}
+	file_tenant_v1_tenant_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_tenant_v1_tenant_proto_rawDesc), len(file_tenant_v1_tenant_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -33,7 +33,7 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TenantServiceClient interface {
 	// 创建租户
-	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantReply, error)
 	// 获取租户信息
 	GetTenant(ctx context.Context, in *GetTenantRequest, opts ...grpc.CallOption) (*GetTenantReply, error)
 	// 获取租户列表
@@ -54,9 +54,9 @@ func NewTenantServiceClient(cc grpc.ClientConnInterface) TenantServiceClient {
 	return &tenantServiceClient{cc}
 }
 
-func (c *tenantServiceClient) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *tenantServiceClient) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...grpc.CallOption) (*CreateTenantReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(CreateTenantReply)
 	err := c.cc.Invoke(ctx, TenantService_CreateTenant_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -119,7 +119,7 @@ func (c *tenantServiceClient) GetAllTenants(ctx context.Context, in *emptypb.Emp
 // for forward compatibility.
 type TenantServiceServer interface {
 	// 创建租户
-	CreateTenant(context.Context, *CreateTenantRequest) (*emptypb.Empty, error)
+	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantReply, error)
 	// 获取租户信息
 	GetTenant(context.Context, *GetTenantRequest) (*GetTenantReply, error)
 	// 获取租户列表
@@ -140,7 +140,7 @@ type TenantServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedTenantServiceServer struct{}
 
-func (UnimplementedTenantServiceServer) CreateTenant(context.Context, *CreateTenantRequest) (*emptypb.Empty, error) {
+func (UnimplementedTenantServiceServer) CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateTenant not implemented")
 }
 func (UnimplementedTenantServiceServer) GetTenant(context.Context, *GetTenantRequest) (*GetTenantReply, error) {
@@ -29,7 +29,7 @@ const OperationTenantServiceUpdateTenant = "/system.tenant.v1.TenantService/Upda
 
 type TenantServiceHTTPServer interface {
 	// CreateTenant 创建租户
-	CreateTenant(context.Context, *CreateTenantRequest) (*emptypb.Empty, error)
+	CreateTenant(context.Context, *CreateTenantRequest) (*CreateTenantReply, error)
 	// DeleteTenant 删除租户
 	DeleteTenant(context.Context, *DeleteTenantRequest) (*emptypb.Empty, error)
 	// GetAllTenants 获取全量租户列表
@@ -69,7 +69,7 @@ func _TenantService_CreateTenant0_HTTP_Handler(srv TenantServiceHTTPServer) func
 		if err != nil {
 			return err
 		}
-		reply := out.(*emptypb.Empty)
+		reply := out.(*CreateTenantReply)
 		return ctx.Result(200, reply)
 	}
 }
@@ -177,7 +177,7 @@ func _TenantService_GetAllTenants0_HTTP_Handler(srv TenantServiceHTTPServer) fun
 
 type TenantServiceHTTPClient interface {
 	// CreateTenant 创建租户
-	CreateTenant(ctx context.Context, req *CreateTenantRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
+	CreateTenant(ctx context.Context, req *CreateTenantRequest, opts ...http.CallOption) (rsp *CreateTenantReply, err error)
 	// DeleteTenant 删除租户
 	DeleteTenant(ctx context.Context, req *DeleteTenantRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// GetAllTenants 获取全量租户列表
@@ -199,8 +199,8 @@ func NewTenantServiceHTTPClient(client *http.Client) TenantServiceHTTPClient {
 }
 
 // CreateTenant 创建租户
-func (c *TenantServiceHTTPClientImpl) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
-	var out emptypb.Empty
+func (c *TenantServiceHTTPClientImpl) CreateTenant(ctx context.Context, in *CreateTenantRequest, opts ...http.CallOption) (*CreateTenantReply, error) {
+	var out CreateTenantReply
 	pattern := "/qs/v1/tenant/create"
 	path := binding.EncodeURL(pattern, in, false)
 	opts = append(opts, http.Operation(OperationTenantServiceCreateTenant))
@@ -13,6 +13,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	_ "quest-admin/api/gen/quest"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -766,6 +767,58 @@ func (x *ChangePasswordRequest) GetConfirmPassword() string {
 	return ""
 }
 
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Password      *string                `protobuf:"bytes,2,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResetPasswordRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
 type SetAvatarRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
@@ -776,7 +829,7 @@ type SetAvatarRequest struct {
 
 func (x *SetAvatarRequest) Reset() {
 	*x = SetAvatarRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[9]
+	mi := &file_user_v1_user_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -788,7 +841,7 @@ func (x *SetAvatarRequest) String() string {
 func (*SetAvatarRequest) ProtoMessage() {}
 
 func (x *SetAvatarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[9]
+	mi := &file_user_v1_user_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -801,7 +854,7 @@ func (x *SetAvatarRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetAvatarRequest.ProtoReflect.Descriptor instead.
 func (*SetAvatarRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{9}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *SetAvatarRequest) GetId() string {
@@ -828,7 +881,7 @@ type ChangeUserStatusRequest struct {
 
 func (x *ChangeUserStatusRequest) Reset() {
 	*x = ChangeUserStatusRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[10]
+	mi := &file_user_v1_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -840,7 +893,7 @@ func (x *ChangeUserStatusRequest) String() string {
 func (*ChangeUserStatusRequest) ProtoMessage() {}
 
 func (x *ChangeUserStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[10]
+	mi := &file_user_v1_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -853,7 +906,7 @@ func (x *ChangeUserStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ChangeUserStatusRequest.ProtoReflect.Descriptor instead.
 func (*ChangeUserStatusRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{10}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ChangeUserStatusRequest) GetId() string {
@@ -881,7 +934,7 @@ type AssignUserPostRequest struct {
 
 func (x *AssignUserPostRequest) Reset() {
 	*x = AssignUserPostRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[11]
+	mi := &file_user_v1_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -893,7 +946,7 @@ func (x *AssignUserPostRequest) String() string {
 func (*AssignUserPostRequest) ProtoMessage() {}
 
 func (x *AssignUserPostRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[11]
+	mi := &file_user_v1_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,7 +959,7 @@ func (x *AssignUserPostRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignUserPostRequest.ProtoReflect.Descriptor instead.
 func (*AssignUserPostRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AssignUserPostRequest) GetId() string {
@@ -941,7 +994,7 @@ type AssignUserDeptRequest struct {
 
 func (x *AssignUserDeptRequest) Reset() {
 	*x = AssignUserDeptRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[12]
+	mi := &file_user_v1_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -953,7 +1006,7 @@ func (x *AssignUserDeptRequest) String() string {
 func (*AssignUserDeptRequest) ProtoMessage() {}
 
 func (x *AssignUserDeptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[12]
+	mi := &file_user_v1_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -966,7 +1019,7 @@ func (x *AssignUserDeptRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignUserDeptRequest.ProtoReflect.Descriptor instead.
 func (*AssignUserDeptRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AssignUserDeptRequest) GetId() string {
@@ -999,7 +1052,7 @@ type DeleteUserRequest struct {
 
 func (x *DeleteUserRequest) Reset() {
 	*x = DeleteUserRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[13]
+	mi := &file_user_v1_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1011,7 +1064,7 @@ func (x *DeleteUserRequest) String() string {
 func (*DeleteUserRequest) ProtoMessage() {}
 
 func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[13]
+	mi := &file_user_v1_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1024,7 +1077,7 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
 func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteUserRequest) GetId() string {
@@ -1044,7 +1097,7 @@ type AssignUserRolesRequest struct {
 
 func (x *AssignUserRolesRequest) Reset() {
 	*x = AssignUserRolesRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[14]
+	mi := &file_user_v1_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1056,7 +1109,7 @@ func (x *AssignUserRolesRequest) String() string {
 func (*AssignUserRolesRequest) ProtoMessage() {}
 
 func (x *AssignUserRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[14]
+	mi := &file_user_v1_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,7 +1122,7 @@ func (x *AssignUserRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AssignUserRolesRequest.ProtoReflect.Descriptor instead.
 func (*AssignUserRolesRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AssignUserRolesRequest) GetId() string {
@@ -1095,7 +1148,7 @@ type GetUserRolesRequest struct {
 
 func (x *GetUserRolesRequest) Reset() {
 	*x = GetUserRolesRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[15]
+	mi := &file_user_v1_user_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1107,7 +1160,7 @@ func (x *GetUserRolesRequest) String() string {
 func (*GetUserRolesRequest) ProtoMessage() {}
 
 func (x *GetUserRolesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[15]
+	mi := &file_user_v1_user_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1120,7 +1173,7 @@ func (x *GetUserRolesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRolesRequest.ProtoReflect.Descriptor instead.
 func (*GetUserRolesRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{15}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetUserRolesRequest) GetId() string {
@@ -1139,7 +1192,7 @@ type GetUserRolesReply struct {
 
 func (x *GetUserRolesReply) Reset() {
 	*x = GetUserRolesReply{}
-	mi := &file_user_v1_user_proto_msgTypes[16]
+	mi := &file_user_v1_user_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1151,7 +1204,7 @@ func (x *GetUserRolesReply) String() string {
 func (*GetUserRolesReply) ProtoMessage() {}
 
 func (x *GetUserRolesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[16]
+	mi := &file_user_v1_user_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1164,7 +1217,7 @@ func (x *GetUserRolesReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserRolesReply.ProtoReflect.Descriptor instead.
 func (*GetUserRolesReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{16}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetUserRolesReply) GetRoleIds() []string {
@@ -1183,7 +1236,7 @@ type GetUserDeptsRequest struct {
 
 func (x *GetUserDeptsRequest) Reset() {
 	*x = GetUserDeptsRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[17]
+	mi := &file_user_v1_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1195,7 +1248,7 @@ func (x *GetUserDeptsRequest) String() string {
 func (*GetUserDeptsRequest) ProtoMessage() {}
 
 func (x *GetUserDeptsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[17]
+	mi := &file_user_v1_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1208,7 +1261,7 @@ func (x *GetUserDeptsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserDeptsRequest.ProtoReflect.Descriptor instead.
 func (*GetUserDeptsRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{17}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetUserDeptsRequest) GetId() string {
@@ -1227,7 +1280,7 @@ type GetUserDeptsReply struct {
 
 func (x *GetUserDeptsReply) Reset() {
 	*x = GetUserDeptsReply{}
-	mi := &file_user_v1_user_proto_msgTypes[18]
+	mi := &file_user_v1_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1239,7 +1292,7 @@ func (x *GetUserDeptsReply) String() string {
 func (*GetUserDeptsReply) ProtoMessage() {}
 
 func (x *GetUserDeptsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[18]
+	mi := &file_user_v1_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1252,7 +1305,7 @@ func (x *GetUserDeptsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserDeptsReply.ProtoReflect.Descriptor instead.
 func (*GetUserDeptsReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{18}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetUserDeptsReply) GetDeptIds() []string {
@@ -1271,7 +1324,7 @@ type GetUserPostsRequest struct {
 
 func (x *GetUserPostsRequest) Reset() {
 	*x = GetUserPostsRequest{}
-	mi := &file_user_v1_user_proto_msgTypes[19]
+	mi := &file_user_v1_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1283,7 +1336,7 @@ func (x *GetUserPostsRequest) String() string {
 func (*GetUserPostsRequest) ProtoMessage() {}
 
 func (x *GetUserPostsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[19]
+	mi := &file_user_v1_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,7 +1349,7 @@ func (x *GetUserPostsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserPostsRequest.ProtoReflect.Descriptor instead.
 func (*GetUserPostsRequest) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{19}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetUserPostsRequest) GetId() string {
@@ -1315,7 +1368,7 @@ type GetUserPostsReply struct {
 
 func (x *GetUserPostsReply) Reset() {
 	*x = GetUserPostsReply{}
-	mi := &file_user_v1_user_proto_msgTypes[20]
+	mi := &file_user_v1_user_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1327,7 +1380,7 @@ func (x *GetUserPostsReply) String() string {
 func (*GetUserPostsReply) ProtoMessage() {}
 
 func (x *GetUserPostsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_user_v1_user_proto_msgTypes[20]
+	mi := &file_user_v1_user_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1340,7 +1393,7 @@ func (x *GetUserPostsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserPostsReply.ProtoReflect.Descriptor instead.
 func (*GetUserPostsReply) Descriptor() ([]byte, []int) {
-	return file_user_v1_user_proto_rawDescGZIP(), []int{20}
+	return file_user_v1_user_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetUserPostsReply) GetPostIds() []string {
@@ -1354,7 +1407,7 @@ var File_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_user_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x12user/v1/user.proto\x12\x0esystem.user.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\"\xe4\b\n" +
+	"\x12user/v1/user.proto\x12\x0esystem.user.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1copenapi/v3/annotations.proto\x1a\x10quest/auth.proto\"\xe4\b\n" +
 	"\bUserInfo\x128\n" +
 	"\x02id\x18\x01 \x01(\tB(\xbaG%:\v\x12\t123456789\x92\x02\x15用户唯一标识符R\x02id\x12C\n" +
 	"\busername\x18\x02 \x01(\tB'\xbaG$:\a\x12\x05admin\x92\x02\x18用户名，用于登录R\busername\x12;\n" +
@@ -1370,17 +1423,17 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"login_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampB\x18\xbaG\x15\x92\x02\x12最后登录时间R\tloginDate\x12K\n" +
 	"\tcreate_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f创建时间R\bcreateAt\x12K\n" +
 	"\tupdate_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampB\x12\xbaG\x0f\x92\x02\f更新时间R\bupdateAt\x12+\n" +
-	"\ttenant_id\x18\x10 \x01(\tB\x0e\xbaG\v\x92\x02\b租户IDR\btenantId:\xcf\x01\xbaG\xcb\x01:\x92\x01\x12\x8f\x01{\"id\": \"123456789\", \"username\": \"admin\", \"nickname\": \"管理员\", \"email\": \"admin@example.com\", \"mobile\": \"13800138000\", \"sex\": 1, \"status\": 1}\x92\x023用户的基本信息，包含用户的所有属性\"\xd1\x06\n" +
+	"\ttenant_id\x18\x10 \x01(\tB\x0e\xbaG\v\x92\x02\b租户IDR\btenantId:\xcf\x01\xbaG\xcb\x01:\x92\x01\x12\x8f\x01{\"id\": \"123456789\", \"username\": \"admin\", \"nickname\": \"管理员\", \"email\": \"admin@example.com\", \"mobile\": \"13800138000\", \"sex\": 1, \"status\": 1}\x92\x023用户的基本信息，包含用户的所有属性\"\xf9\x06\n" +
 	"\x11CreateUserRequest\x12J\n" +
-	"\busername\x18\x01 \x01(\tB)\xbaG&:\t\x12\anewuser\x92\x02\x18用户名，必须唯一H\x00R\busername\x88\x01\x01\x12R\n" +
-	"\bpassword\x18\x02 \x01(\tB1\xbaG.:\r\x12\vpassword123\x92\x02\x1c密码，长度不少于6位H\x01R\bpassword\x88\x01\x01\x12@\n" +
+	"\busername\x18\x01 \x01(\tB)\xbaG&:\t\x12\anewuser\x92\x02\x18用户名，必须唯一H\x00R\busername\x88\x01\x01\x12y\n" +
+	"\bpassword\x18\x02 \x01(\tBX\xbaGU:\x0e\x12\fWelcome@2024\x92\x02B初始密码，需满足密码策略，用户首次登录需修改H\x01R\bpassword\x88\x01\x01\x12@\n" +
 	"\bnickname\x18\x03 \x01(\tB\x1f\xbaG\x1c:\v\x12\t新用户\x92\x02\f用户昵称H\x02R\bnickname\x88\x01\x01\x12D\n" +
 	"\x05email\x18\x04 \x01(\tB)\xbaG&:\x15\x12\x13newuser@example.com\x92\x02\f邮箱地址H\x03R\x05email\x88\x01\x01\x12>\n" +
 	"\x06mobile\x18\x05 \x01(\tB!\xbaG\x1e:\r\x12\v13900139000\x92\x02\f手机号码H\x04R\x06mobile\x88\x01\x01\x12@\n" +
 	"\x03sex\x18\x06 \x01(\x05B)\xbaG&:\x03\x12\x011\x92\x02\x1e性别: 0-未知, 1-男, 2-女H\x05R\x03sex\x88\x01\x01\x12T\n" +
 	"\x06avatar\x18\a \x01(\tB7\xbaG4: \x12\x1ehttps://example.com/avatar.jpg\x92\x02\x0f用户头像URLH\x06R\x06avatar\x88\x01\x01\x12/\n" +
 	"\x06remark\x18\n" +
-	" \x01(\tB\x12\xbaG\x0f\x92\x02\f备注信息H\aR\x06remark\x88\x01\x01:\xb0\x01\xbaG\xac\x01:\x91\x01\x12\x8e\x01{\"username\": \"newuser\", \"password\": \"password123\", \"nickname\": \"新用户\", \"email\": \"newuser@example.com\", \"mobile\": \"13900139000\", \"sex\": 1}\x92\x02\x15创建用户请求体B\v\n" +
+	" \x01(\tB\x12\xbaG\x0f\x92\x02\f备注信息H\aR\x06remark\x88\x01\x01:\xb1\x01\xbaG\xad\x01:\x92\x01\x12\x8f\x01{\"username\": \"newuser\", \"password\": \"Welcome@2024\", \"nickname\": \"新用户\", \"email\": \"newuser@example.com\", \"mobile\": \"13900139000\", \"sex\": 1}\x92\x02\x15创建用户请求体B\v\n" +
 	"\t_usernameB\v\n" +
 	"\t_passwordB\v\n" +
 	"\t_nicknameB\b\n" +
@@ -1444,16 +1497,22 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\a_avatarB\x06\n" +
 	"\x04_sexB\t\n" +
 	"\a_statusB\t\n" +
-	"\a_remark\"\x93\x03\n" +
-	"\x15ChangePasswordRequest\x120\n" +
-	"\x02id\x18\x01 \x01(\tB\x1b\xbaG\x18:\v\x12\t123456789\x92\x02\b用户IDH\x00R\x02id\x88\x01\x01\x12I\n" +
+	"\a_remark\"\xbe\x03\n" +
+	"\x15ChangePasswordRequest\x12[\n" +
+	"\x02id\x18\x01 \x01(\tBF\xbaGC:\v\x12\t123456789\x92\x023已忽略，始终修改当前登录用户的密码H\x00R\x02id\x88\x01\x01\x12I\n" +
 	"\fold_password\x18\x02 \x01(\tB!\xbaG\x1e:\x10\x12\x0eoldpassword123\x92\x02\t原密码H\x01R\voldPassword\x88\x01\x01\x12I\n" +
 	"\fnew_password\x18\x03 \x01(\tB!\xbaG\x1e:\x10\x12\x0enewpassword123\x92\x02\t新密码H\x02R\vnewPassword\x88\x01\x01\x12W\n" +
 	"\x10confirm_password\x18\x04 \x01(\tB'\xbaG$:\x10\x12\x0enewpassword123\x92\x02\x0f确认新密码H\x03R\x0fconfirmPassword\x88\x01\x01:\x1b\xbaG\x18\x92\x02\x15修改密码请求体B\x05\n" +
 	"\x03_idB\x0f\n" +
 	"\r_old_passwordB\x0f\n" +
 	"\r_new_passwordB\x13\n" +
-	"\x11_confirm_password\"\x98\x02\n" +
+	"\x11_confirm_password\"\xd1\x01\n" +
+	"\x14ResetPasswordRequest\x120\n" +
+	"\x02id\x18\x01 \x01(\tB\x1b\xbaG\x18:\v\x12\t123456789\x92\x02\b用户IDH\x00R\x02id\x88\x01\x01\x12V\n" +
+	"\bpassword\x18\x02 \x01(\tB5\xbaG2:\f\x12\n" +
+	"Reset@2024\x92\x02!新密码，需满足密码策略H\x01R\bpassword\x88\x01\x01:\x1b\xbaG\x18\x92\x02\x15重置密码请求体B\x05\n" +
+	"\x03_idB\v\n" +
+	"\t_password\"\x98\x02\n" +
 	"\x10SetAvatarRequest\x120\n" +
 	"\x02id\x18\x01 \x01(\tB\x1b\xbaG\x18:\v\x12\t123456789\x92\x02\b用户IDH\x00R\x02id\x88\x01\x01\x12Z\n" +
 	"\x06avatar\x18\x02 \x01(\tB=\xbaG:: \x12\x1ehttps://example.com/avatar.jpg\x92\x02\x15用户头像URL地址H\x01R\x06avatar\x88\x01\x01:d\xbaGa:A\x12?{\"id\": \"123456789\", \"avatar\": \"https://example.com/avatar.jpg\"}\x92\x02\x1b设置用户头像请求体B\x05\n" +
@@ -1499,25 +1558,39 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tB\x1b\xbaG\x18:\v\x12\t123456789\x92\x02\b用户IDH\x00R\x02id\x88\x01\x01:'\xbaG$\x92\x02!获取用户岗位列表请求体B\x05\n" +
 	"\x03_id\"m\n" +
 	"\x11GetUserPostsReply\x12/\n" +
-	"\bpost_ids\x18\x01 \x03(\tB\x14\xbaG\x11\x92\x02\x0e岗位ID列表R\apostIds:'\xbaG$\x92\x02!获取用户岗位列表响应体2\xa2\x14\n" +
-	"\vUserService\x12\xc4\x01\n" +
+	"\bpost_ids\x18\x01 \x03(\tB\x14\xbaG\x11\x92\x02\x0e岗位ID列表R\apostIds:'\xbaG$\x92\x02!获取用户岗位列表响应体2\xc3\x19\n" +
+	"\vUserService\x12\xdd\x01\n" +
 	"\n" +
-	"CreateUser\x12!.system.user.v1.CreateUserRequest\x1a\x16.google.protobuf.Empty\"{\xbaG[\x12\x0f创建新用户\x1aH创建一个新的用户，需要提供用户名、密码等基本信息\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/qs/v1/user/create\x12\xa8\x01\n" +
-	"\aGetUser\x12\x1e.system.user.v1.GetUserRequest\x1a\x1c.system.user.v1.GetUserReply\"_\xbaGE\x12\x18获取用户详细信息\x1a)根据用户ID获取用户的详细信息\x82\xd3\xe4\x93\x02\x11\x12\x0f/qs/v1/user/get\x12\xc2\x01\n" +
-	"\tListUsers\x12 .system.user.v1.ListUsersRequest\x1a\x1e.system.user.v1.ListUsersReply\"s\xbaGX\x12\x12获取用户列表\x1aB分页查询用户列表，支持关键字搜索、状态筛选等\x82\xd3\xe4\x93\x02\x12\x12\x10/qs/v1/user/list\x12\xbe\x01\n" +
+	"CreateUser\x12!.system.user.v1.CreateUserRequest\x1a\x16.google.protobuf.Empty\"\x93\x01\xbaG[\x12\x0f创建新用户\x1aH创建一个新的用户，需要提供用户名、密码等基本信息\xca\xf3\x18\x14\n" +
+	"\x12system:user:create\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/qs/v1/user/create\x12\xbf\x01\n" +
+	"\aGetUser\x12\x1e.system.user.v1.GetUserRequest\x1a\x1c.system.user.v1.GetUserReply\"v\xbaGE\x12\x18获取用户详细信息\x1a)根据用户ID获取用户的详细信息\xca\xf3\x18\x13\n" +
+	"\x11system:user:query\x82\xd3\xe4\x93\x02\x11\x12\x0f/qs/v1/user/get\x12\xd9\x01\n" +
+	"\tListUsers\x12 .system.user.v1.ListUsersRequest\x1a\x1e.system.user.v1.ListUsersReply\"\x89\x01\xbaGX\x12\x12获取用户列表\x1aB分页查询用户列表，支持关键字搜索、状态筛选等\xca\xf3\x18\x12\n" +
+	"\x10system:user:list\x82\xd3\xe4\x93\x02\x12\x12\x10/qs/v1/user/list\x12\xd7\x01\n" +
 	"\n" +
-	"UpdateUser\x12!.system.user.v1.UpdateUserRequest\x1a\x16.google.protobuf.Empty\"u\xbaGU\x12\x12更新用户信息\x1a?更新用户的基本信息，如昵称、邮箱、手机号等\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/qs/v1/user/update\x12\xcf\x01\n" +
-	"\x0eChangePassword\x12%.system.user.v1.ChangePasswordRequest\x1a\x16.google.protobuf.Empty\"~\xbaGU\x12\x12修改用户密码\x1a?用户主动修改自己的登录密码，需要验证原密码\x82\xd3\xe4\x93\x02 :\x01*\x1a\x1b/qs/v1/user/change-password\x12\xa5\x01\n" +
-	"\tSetAvatar\x12 .system.user.v1.SetAvatarRequest\x1a\x16.google.protobuf.Empty\"^\xbaG:\x12\x12设置用户头像\x1a$设置用户的头像图片URL地址\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/qs/v1/user/set-avatar\x12\xc5\x01\n" +
-	"\x10ChangeUserStatus\x12'.system.user.v1.ChangeUserStatusRequest\x1a\x16.google.protobuf.Empty\"p\xbaGI\x12\x12变更用户状态\x1a3启用或禁用用户，管理用户的使用权限\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/qs/v1/user/update-status\x12\xaa\x01\n" +
-	"\x0eAssignUserPost\x12%.system.user.v1.AssignUserPostRequest\x1a\x16.google.protobuf.Empty\"Y\xbaG4\x12\x12分配用户岗位\x1a\x1e为用户分配或移除岗位\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/qs/v1/user/assign-post\x12\xaa\x01\n" +
-	"\x0eAssignUserDept\x12%.system.user.v1.AssignUserDeptRequest\x1a\x16.google.protobuf.Empty\"Y\xbaG4\x12\x12分配用户部门\x1a\x1e为用户分配或移除部门\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/qs/v1/user/assign-dept\x12\x97\x01\n" +
+	"UpdateUser\x12!.system.user.v1.UpdateUserRequest\x1a\x16.google.protobuf.Empty\"\x8d\x01\xbaGU\x12\x12更新用户信息\x1a?更新用户的基本信息，如昵称、邮箱、手机号等\xca\xf3\x18\x14\n" +
+	"\x12system:user:update\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/qs/v1/user/update\x12\xac\x02\n" +
+	"\x0eChangePassword\x12%.system.user.v1.ChangePasswordRequest\x1a\x16.google.protobuf.Empty\"\xda\x01\xbaG\xb0\x01\x12\x12修改用户密码\x1a\x99\x01用户主动修改自己的登录密码，需要验证原密码，新密码需满足密码策略且不能与近期密码相同，修改后需重新登录\x82\xd3\xe4\x93\x02 :\x01*\x1a\x1b/qs/v1/user/change-password\x12\x94\x02\n" +
+	"\rResetPassword\x12$.system.user.v1.ResetPasswordRequest\x1a\x16.google.protobuf.Empty\"\xc4\x01\xbaG|\x12\x12重置用户密码\x1af管理员为用户设置新密码，用户已登录的会话全部下线，下次登录需修改密码\xca\xf3\x18\x1c\n" +
+	"\x1asystem:user:reset-password\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/qs/v1/user/reset-password\x12\xa5\x01\n" +
+	"\tSetAvatar\x12 .system.user.v1.SetAvatarRequest\x1a\x16.google.protobuf.Empty\"^\xbaG:\x12\x12设置用户头像\x1a$设置用户的头像图片URL地址\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/qs/v1/user/set-avatar\x12\xde\x01\n" +
+	"\x10ChangeUserStatus\x12'.system.user.v1.ChangeUserStatusRequest\x1a\x16.google.protobuf.Empty\"\x88\x01\xbaGI\x12\x12变更用户状态\x1a3启用或禁用用户，管理用户的使用权限\xca\xf3\x18\x14\n" +
+	"\x12system:user:update\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/qs/v1/user/update-status\x12\xc7\x01\n" +
+	"\x0eAssignUserPost\x12%.system.user.v1.AssignUserPostRequest\x1a\x16.google.protobuf.Empty\"v\xbaG4\x12\x12分配用户岗位\x1a\x1e为用户分配或移除岗位\xca\xf3\x18\x19\n" +
+	"\x17system:user:assign-post\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/qs/v1/user/assign-post\x12\xc7\x01\n" +
+	"\x0eAssignUserDept\x12%.system.user.v1.AssignUserDeptRequest\x1a\x16.google.protobuf.Empty\"v\xbaG4\x12\x12分配用户部门\x1a\x1e为用户分配或移除部门\xca\xf3\x18\x19\n" +
+	"\x17system:user:assign-dept\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/qs/v1/user/assign-dept\x12\xaf\x01\n" +
 	"\n" +
-	"DeleteUser\x12!.system.user.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"N\xbaG1\x12\f删除用户\x1a!删除用户，此操作不可逆\x82\xd3\xe4\x93\x02\x14*\x12/qs/v1/user/delete\x12\xad\x01\n" +
-	"\x0fAssignUserRoles\x12&.system.user.v1.AssignUserRolesRequest\x1a\x16.google.protobuf.Empty\"Z\xbaG4\x12\x12分配用户角色\x1a\x1e为用户分配或移除角色\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/qs/v1/users/assign-role\x12\xba\x01\n" +
-	"\fGetUserRoles\x12#.system.user.v1.GetUserRolesRequest\x1a!.system.user.v1.GetUserRolesReply\"b\xbaG@\x12\x18获取用户角色列表\x1a$获取用户已分配的角色列表\x82\xd3\xe4\x93\x02\x19\x12\x17/qs/v1/users/roles/{id}\x12\xba\x01\n" +
-	"\fGetUserDepts\x12#.system.user.v1.GetUserDeptsRequest\x1a!.system.user.v1.GetUserDeptsReply\"b\xbaG@\x12\x18获取用户部门列表\x1a$获取用户已分配的部门列表\x82\xd3\xe4\x93\x02\x19\x12\x17/qs/v1/users/depts/{id}\x12\xba\x01\n" +
-	"\fGetUserPosts\x12#.system.user.v1.GetUserPostsRequest\x1a!.system.user.v1.GetUserPostsReply\"b\xbaG@\x12\x18获取用户岗位列表\x1a$获取用户已分配的岗位列表\x82\xd3\xe4\x93\x02\x19\x12\x17/qs/v1/users/posts/{id}BB\xbaG#:!\n" +
+	"DeleteUser\x12!.system.user.v1.DeleteUserRequest\x1a\x16.google.protobuf.Empty\"f\xbaG1\x12\f删除用户\x1a!删除用户，此操作不可逆\xca\xf3\x18\x14\n" +
+	"\x12system:user:delete\x82\xd3\xe4\x93\x02\x14*\x12/qs/v1/user/delete\x12\xca\x01\n" +
+	"\x0fAssignUserRoles\x12&.system.user.v1.AssignUserRolesRequest\x1a\x16.google.protobuf.Empty\"w\xbaG4\x12\x12分配用户角色\x1a\x1e为用户分配或移除角色\xca\xf3\x18\x19\n" +
+	"\x17system:user:assign-role\x82\xd3\xe4\x93\x02\x1d:\x01*\x1a\x18/qs/v1/users/assign-role\x12\xd1\x01\n" +
+	"\fGetUserRoles\x12#.system.user.v1.GetUserRolesRequest\x1a!.system.user.v1.GetUserRolesReply\"y\xbaG@\x12\x18获取用户角色列表\x1a$获取用户已分配的角色列表\xca\xf3\x18\x13\n" +
+	"\x11system:user:query\x82\xd3\xe4\x93\x02\x19\x12\x17/qs/v1/users/roles/{id}\x12\xd1\x01\n" +
+	"\fGetUserDepts\x12#.system.user.v1.GetUserDeptsRequest\x1a!.system.user.v1.GetUserDeptsReply\"y\xbaG@\x12\x18获取用户部门列表\x1a$获取用户已分配的部门列表\xca\xf3\x18\x13\n" +
+	"\x11system:user:query\x82\xd3\xe4\x93\x02\x19\x12\x17/qs/v1/users/depts/{id}\x12\xd1\x01\n" +
+	"\fGetUserPosts\x12#.system.user.v1.GetUserPostsRequest\x1a!.system.user.v1.GetUserPostsReply\"y\xbaG@\x12\x18获取用户岗位列表\x1a$获取用户已分配的岗位列表\xca\xf3\x18\x13\n" +
+	"\x11system:user:query\x82\xd3\xe4\x93\x02\x19\x12\x17/qs/v1/users/posts/{id}BB\xbaG#:!\n" +
 	"\vUserService\x12\x12用户相关操作Z\x1aquest-admin/api/user/v1;v1b\x06proto3"
 
 var (
@@ -1532,7 +1605,7 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 	return file_user_v1_user_proto_rawDescData
 }
 
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_user_v1_user_proto_goTypes = []any{
 	(*UserInfo)(nil),                // 0: system.user.v1.UserInfo
 	(*CreateUserRequest)(nil),       // 1: system.user.v1.CreateUserRequest
@@ -1543,25 +1616,26 @@ var file_user_v1_user_proto_goTypes = []any{
 	(*ListUsersReply)(nil),          // 6: system.user.v1.ListUsersReply
 	(*UpdateUserRequest)(nil),       // 7: system.user.v1.UpdateUserRequest
 	(*ChangePasswordRequest)(nil),   // 8: system.user.v1.ChangePasswordRequest
-	(*SetAvatarRequest)(nil),        // 9: system.user.v1.SetAvatarRequest
-	(*ChangeUserStatusRequest)(nil), // 10: system.user.v1.ChangeUserStatusRequest
-	(*AssignUserPostRequest)(nil),   // 11: system.user.v1.AssignUserPostRequest
-	(*AssignUserDeptRequest)(nil),   // 12: system.user.v1.AssignUserDeptRequest
-	(*DeleteUserRequest)(nil),       // 13: system.user.v1.DeleteUserRequest
-	(*AssignUserRolesRequest)(nil),  // 14: system.user.v1.AssignUserRolesRequest
-	(*GetUserRolesRequest)(nil),     // 15: system.user.v1.GetUserRolesRequest
-	(*GetUserRolesReply)(nil),       // 16: system.user.v1.GetUserRolesReply
-	(*GetUserDeptsRequest)(nil),     // 17: system.user.v1.GetUserDeptsRequest
-	(*GetUserDeptsReply)(nil),       // 18: system.user.v1.GetUserDeptsReply
-	(*GetUserPostsRequest)(nil),     // 19: system.user.v1.GetUserPostsRequest
-	(*GetUserPostsReply)(nil),       // 20: system.user.v1.GetUserPostsReply
-	(*timestamppb.Timestamp)(nil),   // 21: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),           // 22: google.protobuf.Empty
+	(*ResetPasswordRequest)(nil),    // 9: system.user.v1.ResetPasswordRequest
+	(*SetAvatarRequest)(nil),        // 10: system.user.v1.SetAvatarRequest
+	(*ChangeUserStatusRequest)(nil), // 11: system.user.v1.ChangeUserStatusRequest
+	(*AssignUserPostRequest)(nil),   // 12: system.user.v1.AssignUserPostRequest
+	(*AssignUserDeptRequest)(nil),   // 13: system.user.v1.AssignUserDeptRequest
+	(*DeleteUserRequest)(nil),       // 14: system.user.v1.DeleteUserRequest
+	(*AssignUserRolesRequest)(nil),  // 15: system.user.v1.AssignUserRolesRequest
+	(*GetUserRolesRequest)(nil),     // 16: system.user.v1.GetUserRolesRequest
+	(*GetUserRolesReply)(nil),       // 17: system.user.v1.GetUserRolesReply
+	(*GetUserDeptsRequest)(nil),     // 18: system.user.v1.GetUserDeptsRequest
+	(*GetUserDeptsReply)(nil),       // 19: system.user.v1.GetUserDeptsReply
+	(*GetUserPostsRequest)(nil),     // 20: system.user.v1.GetUserPostsRequest
+	(*GetUserPostsReply)(nil),       // 21: system.user.v1.GetUserPostsReply
+	(*timestamppb.Timestamp)(nil),   // 22: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),           // 23: google.protobuf.Empty
 }
 var file_user_v1_user_proto_depIdxs = []int32{
-	21, // 0: system.user.v1.UserInfo.login_date:type_name -> google.protobuf.Timestamp
-	21, // 1: system.user.v1.UserInfo.create_at:type_name -> google.protobuf.Timestamp
-	21, // 2: system.user.v1.UserInfo.update_at:type_name -> google.protobuf.Timestamp
+	22, // 0: system.user.v1.UserInfo.login_date:type_name -> google.protobuf.Timestamp
+	22, // 1: system.user.v1.UserInfo.create_at:type_name -> google.protobuf.Timestamp
+	22, // 2: system.user.v1.UserInfo.update_at:type_name -> google.protobuf.Timestamp
 	0,  // 3: system.user.v1.GetUserReply.user:type_name -> system.user.v1.UserInfo
 	0,  // 4: system.user.v1.ListUsersReply.users:type_name -> system.user.v1.UserInfo
 	1,  // 5: system.user.v1.UserService.CreateUser:input_type -> system.user.v1.CreateUserRequest
@@ -1569,31 +1643,33 @@ var file_user_v1_user_proto_depIdxs = []int32{
 	5,  // 7: system.user.v1.UserService.ListUsers:input_type -> system.user.v1.ListUsersRequest
 	7,  // 8: system.user.v1.UserService.UpdateUser:input_type -> system.user.v1.UpdateUserRequest
 	8,  // 9: system.user.v1.UserService.ChangePassword:input_type -> system.user.v1.ChangePasswordRequest
-	9,  // 10: system.user.v1.UserService.SetAvatar:input_type -> system.user.v1.SetAvatarRequest
-	10, // 11: system.user.v1.UserService.ChangeUserStatus:input_type -> system.user.v1.ChangeUserStatusRequest
-	11, // 12: system.user.v1.UserService.AssignUserPost:input_type -> system.user.v1.AssignUserPostRequest
-	12, // 13: system.user.v1.UserService.AssignUserDept:input_type -> system.user.v1.AssignUserDeptRequest
-	13, // 14: system.user.v1.UserService.DeleteUser:input_type -> system.user.v1.DeleteUserRequest
-	14, // 15: system.user.v1.UserService.AssignUserRoles:input_type -> system.user.v1.AssignUserRolesRequest
-	15, // 16: system.user.v1.UserService.GetUserRoles:input_type -> system.user.v1.GetUserRolesRequest
-	17, // 17: system.user.v1.UserService.GetUserDepts:input_type -> system.user.v1.GetUserDeptsRequest
-	19, // 18: system.user.v1.UserService.GetUserPosts:input_type -> system.user.v1.GetUserPostsRequest
-	22, // 19: system.user.v1.UserService.CreateUser:output_type -> google.protobuf.Empty
-	4,  // 20: system.user.v1.UserService.GetUser:output_type -> system.user.v1.GetUserReply
-	6,  // 21: system.user.v1.UserService.ListUsers:output_type -> system.user.v1.ListUsersReply
-	22, // 22: system.user.v1.UserService.UpdateUser:output_type -> google.protobuf.Empty
-	22, // 23: system.user.v1.UserService.ChangePassword:output_type -> google.protobuf.Empty
-	22, // 24: system.user.v1.UserService.SetAvatar:output_type -> google.protobuf.Empty
-	22, // 25: system.user.v1.UserService.ChangeUserStatus:output_type -> google.protobuf.Empty
-	22, // 26: system.user.v1.UserService.AssignUserPost:output_type -> google.protobuf.Empty
-	22, // 27: system.user.v1.UserService.AssignUserDept:output_type -> google.protobuf.Empty
-	22, // 28: system.user.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
-	22, // 29: system.user.v1.UserService.AssignUserRoles:output_type -> google.protobuf.Empty
-	16, // 30: system.user.v1.UserService.GetUserRoles:output_type -> system.user.v1.GetUserRolesReply
-	18, // 31: system.user.v1.UserService.GetUserDepts:output_type -> system.user.v1.GetUserDeptsReply
-	20, // 32: system.user.v1.UserService.GetUserPosts:output_type -> system.user.v1.GetUserPostsReply
-	19, // [19:33] is the sub-list for method output_type
-	5,  // [5:19] is the sub-list for method input_type
+	9,  // 10: system.user.v1.UserService.ResetPassword:input_type -> system.user.v1.ResetPasswordRequest
+	10, // 11: system.user.v1.UserService.SetAvatar:input_type -> system.user.v1.SetAvatarRequest
+	11, // 12: system.user.v1.UserService.ChangeUserStatus:input_type -> system.user.v1.ChangeUserStatusRequest
+	12, // 13: system.user.v1.UserService.AssignUserPost:input_type -> system.user.v1.AssignUserPostRequest
+	13, // 14: system.user.v1.UserService.AssignUserDept:input_type -> system.user.v1.AssignUserDeptRequest
+	14, // 15: system.user.v1.UserService.DeleteUser:input_type -> system.user.v1.DeleteUserRequest
+	15, // 16: system.user.v1.UserService.AssignUserRoles:input_type -> system.user.v1.AssignUserRolesRequest
+	16, // 17: system.user.v1.UserService.GetUserRoles:input_type -> system.user.v1.GetUserRolesRequest
+	18, // 18: system.user.v1.UserService.GetUserDepts:input_type -> system.user.v1.GetUserDeptsRequest
+	20, // 19: system.user.v1.UserService.GetUserPosts:input_type -> system.user.v1.GetUserPostsRequest
+	23, // 20: system.user.v1.UserService.CreateUser:output_type -> google.protobuf.Empty
+	4,  // 21: system.user.v1.UserService.GetUser:output_type -> system.user.v1.GetUserReply
+	6,  // 22: system.user.v1.UserService.ListUsers:output_type -> system.user.v1.ListUsersReply
+	23, // 23: system.user.v1.UserService.UpdateUser:output_type -> google.protobuf.Empty
+	23, // 24: system.user.v1.UserService.ChangePassword:output_type -> google.protobuf.Empty
+	23, // 25: system.user.v1.UserService.ResetPassword:output_type -> google.protobuf.Empty
+	23, // 26: system.user.v1.UserService.SetAvatar:output_type -> google.protobuf.Empty
+	23, // 27: system.user.v1.UserService.ChangeUserStatus:output_type -> google.protobuf.Empty
+	23, // 28: system.user.v1.UserService.AssignUserPost:output_type -> google.protobuf.Empty
+	23, // 29: system.user.v1.UserService.AssignUserDept:output_type -> google.protobuf.Empty
+	23, // 30: system.user.v1.UserService.DeleteUser:output_type -> google.protobuf.Empty
+	23, // 31: system.user.v1.UserService.AssignUserRoles:output_type -> google.protobuf.Empty
+	17, // 32: system.user.v1.UserService.GetUserRoles:output_type -> system.user.v1.GetUserRolesReply
+	19, // 33: system.user.v1.UserService.GetUserDepts:output_type -> system.user.v1.GetUserDeptsReply
+	21, // 34: system.user.v1.UserService.GetUserPosts:output_type -> system.user.v1.GetUserPostsReply
+	20, // [20:35] is the sub-list for method output_type
+	5,  // [5:20] is the sub-list for method input_type
 	5,  // [5:5] is the sub-list for extension type_name
 	5,  // [5:5] is the sub-list for extension extendee
 	0,  // [0:5] is the sub-list for field type_name
@@ -1616,15 +1692,16 @@ func file_user_v1_user_proto_init() {
 	file_user_v1_user_proto_msgTypes[13].OneofWrappers = []any{}
 	file_user_v1_user_proto_msgTypes[14].OneofWrappers = []any{}
 	file_user_v1_user_proto_msgTypes[15].OneofWrappers = []any{}
-	file_user_v1_user_proto_msgTypes[17].OneofWrappers = []any{}
-	file_user_v1_user_proto_msgTypes[19].OneofWrappers = []any{}
+	file_user_v1_user_proto_msgTypes[16].OneofWrappers = []any{}
+	file_user_v1_user_proto_msgTypes[18].OneofWrappers = []any{}
+	file_user_v1_user_proto_msgTypes[20].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   21,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
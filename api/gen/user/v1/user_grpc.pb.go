@@ -25,6 +25,7 @@ const (
 	UserService_ListUsers_FullMethodName        = "/system.user.v1.UserService/ListUsers"
 	UserService_UpdateUser_FullMethodName       = "/system.user.v1.UserService/UpdateUser"
 	UserService_ChangePassword_FullMethodName   = "/system.user.v1.UserService/ChangePassword"
+	UserService_ResetPassword_FullMethodName    = "/system.user.v1.UserService/ResetPassword"
 	UserService_SetAvatar_FullMethodName        = "/system.user.v1.UserService/SetAvatar"
 	UserService_ChangeUserStatus_FullMethodName = "/system.user.v1.UserService/ChangeUserStatus"
 	UserService_AssignUserPost_FullMethodName   = "/system.user.v1.UserService/AssignUserPost"
@@ -50,6 +51,8 @@ type UserServiceClient interface {
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 修改用户密码
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 重置用户密码
+	ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 设置用户头像
 	SetAvatar(ctx context.Context, in *SetAvatarRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// 变更用户状态
@@ -128,6 +131,16 @@ func (c *userServiceClient) ChangePassword(ctx context.Context, in *ChangePasswo
 	return out, nil
 }
 
+func (c *userServiceClient) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_ResetPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) SetAvatar(ctx context.Context, in *SetAvatarRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -232,6 +245,8 @@ type UserServiceServer interface {
 	UpdateUser(context.Context, *UpdateUserRequest) (*emptypb.Empty, error)
 	// 修改用户密码
 	ChangePassword(context.Context, *ChangePasswordRequest) (*emptypb.Empty, error)
+	// 重置用户密码
+	ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error)
 	// 设置用户头像
 	SetAvatar(context.Context, *SetAvatarRequest) (*emptypb.Empty, error)
 	// 变更用户状态
@@ -275,6 +290,9 @@ func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserReq
 func (UnimplementedUserServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method ChangePassword not implemented")
 }
+func (UnimplementedUserServiceServer) ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetPassword not implemented")
+}
 func (UnimplementedUserServiceServer) SetAvatar(context.Context, *SetAvatarRequest) (*emptypb.Empty, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetAvatar not implemented")
 }
@@ -413,6 +431,24 @@ func _UserService_ChangePassword_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResetPassword(ctx, req.(*ResetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_SetAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetAvatarRequest)
 	if err := dec(in); err != nil {
@@ -602,6 +638,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangePassword",
 			Handler:    _UserService_ChangePassword_Handler,
 		},
+		{
+			MethodName: "ResetPassword",
+			Handler:    _UserService_ResetPassword_Handler,
+		},
 		{
 			MethodName: "SetAvatar",
 			Handler:    _UserService_SetAvatar_Handler,
@@ -32,6 +32,7 @@ const OperationUserServiceGetUserDepts = "/system.user.v1.UserService/GetUserDep
 const OperationUserServiceGetUserPosts = "/system.user.v1.UserService/GetUserPosts"
 const OperationUserServiceGetUserRoles = "/system.user.v1.UserService/GetUserRoles"
 const OperationUserServiceListUsers = "/system.user.v1.UserService/ListUsers"
+const OperationUserServiceResetPassword = "/system.user.v1.UserService/ResetPassword"
 const OperationUserServiceSetAvatar = "/system.user.v1.UserService/SetAvatar"
 const OperationUserServiceUpdateUser = "/system.user.v1.UserService/UpdateUser"
 
@@ -60,6 +61,8 @@ type UserServiceHTTPServer interface {
 	GetUserRoles(context.Context, *GetUserRolesRequest) (*GetUserRolesReply, error)
 	// ListUsers 用户列表查询
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersReply, error)
+	// ResetPassword 重置用户密码
+	ResetPassword(context.Context, *ResetPasswordRequest) (*emptypb.Empty, error)
 	// SetAvatar 设置用户头像
 	SetAvatar(context.Context, *SetAvatarRequest) (*emptypb.Empty, error)
 	// UpdateUser 更新用户信息
@@ -73,6 +76,7 @@ func RegisterUserServiceHTTPServer(s *http.Server, srv UserServiceHTTPServer) {
 	r.GET("/qs/v1/user/list", _UserService_ListUsers0_HTTP_Handler(srv))
 	r.PUT("/qs/v1/user/update", _UserService_UpdateUser0_HTTP_Handler(srv))
 	r.PUT("/qs/v1/user/change-password", _UserService_ChangePassword0_HTTP_Handler(srv))
+	r.PUT("/qs/v1/user/reset-password", _UserService_ResetPassword0_HTTP_Handler(srv))
 	r.PUT("/qs/v1/user/set-avatar", _UserService_SetAvatar0_HTTP_Handler(srv))
 	r.PUT("/qs/v1/user/update-status", _UserService_ChangeUserStatus0_HTTP_Handler(srv))
 	r.PUT("/qs/v1/user/assign-post", _UserService_AssignUserPost0_HTTP_Handler(srv))
@@ -188,6 +192,28 @@ func _UserService_ChangePassword0_HTTP_Handler(srv UserServiceHTTPServer) func(c
 	}
 }
 
+func _UserService_ResetPassword0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetPasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserServiceResetPassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetPassword(ctx, req.(*ResetPasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*emptypb.Empty)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _UserService_SetAvatar0_HTTP_Handler(srv UserServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in SetAvatarRequest
@@ -408,6 +434,8 @@ type UserServiceHTTPClient interface {
 	GetUserRoles(ctx context.Context, req *GetUserRolesRequest, opts ...http.CallOption) (rsp *GetUserRolesReply, err error)
 	// ListUsers 用户列表查询
 	ListUsers(ctx context.Context, req *ListUsersRequest, opts ...http.CallOption) (rsp *ListUsersReply, err error)
+	// ResetPassword 重置用户密码
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// SetAvatar 设置用户头像
 	SetAvatar(ctx context.Context, req *SetAvatarRequest, opts ...http.CallOption) (rsp *emptypb.Empty, err error)
 	// UpdateUser 更新用户信息
@@ -590,6 +618,20 @@ func (c *UserServiceHTTPClientImpl) ListUsers(ctx context.Context, in *ListUsers
 	return &out, nil
 }
 
+// ResetPassword 重置用户密码
+func (c *UserServiceHTTPClientImpl) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
+	var out emptypb.Empty
+	pattern := "/qs/v1/user/reset-password"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserServiceResetPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // SetAvatar 设置用户头像
 func (c *UserServiceHTTPClientImpl) SetAvatar(ctx context.Context, in *SetAvatarRequest, opts ...http.CallOption) (*emptypb.Empty, error) {
 	var out emptypb.Empty
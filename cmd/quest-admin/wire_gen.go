@@ -9,29 +9,49 @@ package main
 import (
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/log"
+	audit2 "quest-admin/internal/biz/audit"
 	auth2 "quest-admin/internal/biz/auth"
 	config2 "quest-admin/internal/biz/config"
+	ldap2 "quest-admin/internal/biz/ldap"
+	oauth2_2 "quest-admin/internal/biz/oauth2"
+	oidc2 "quest-admin/internal/biz/oidc"
+	"quest-admin/internal/biz/onboarding"
 	organization2 "quest-admin/internal/biz/organization"
 	permission2 "quest-admin/internal/biz/permission"
+	scim2 "quest-admin/internal/biz/scim"
+	social2 "quest-admin/internal/biz/social"
 	tenant2 "quest-admin/internal/biz/tenant"
 	user2 "quest-admin/internal/biz/user"
 	"quest-admin/internal/conf"
+	"quest-admin/internal/data/audit"
 	"quest-admin/internal/data/auth"
+	"quest-admin/internal/data/auth/guard"
 	"quest-admin/internal/data/config"
 	"quest-admin/internal/data/data"
 	"quest-admin/internal/data/idgen"
+	"quest-admin/internal/data/ldap"
+	"quest-admin/internal/data/mail"
+	"quest-admin/internal/data/oauth2"
+	"quest-admin/internal/data/oidc"
 	"quest-admin/internal/data/organization"
 	"quest-admin/internal/data/permission"
 	"quest-admin/internal/data/pg"
 	"quest-admin/internal/data/redis"
+	"quest-admin/internal/data/scim"
+	"quest-admin/internal/data/sms"
+	"quest-admin/internal/data/social"
 	"quest-admin/internal/data/tenant"
 	"quest-admin/internal/data/transaction"
 	"quest-admin/internal/data/user"
 	"quest-admin/internal/server"
+	audit3 "quest-admin/internal/service/audit"
 	auth3 "quest-admin/internal/service/auth"
 	config3 "quest-admin/internal/service/config"
+	oauth2_3 "quest-admin/internal/service/oauth2"
+	oidc3 "quest-admin/internal/service/oidc"
 	organization3 "quest-admin/internal/service/organization"
 	permission3 "quest-admin/internal/service/permission"
+	scim3 "quest-admin/internal/service/scim"
 	tenant3 "quest-admin/internal/service/tenant"
 	user3 "quest-admin/internal/service/user"
 )
@@ -44,44 +64,133 @@ import (
 
 // wireApp init kratos application.
 func wireApp(bootstrap *conf.Bootstrap, logger log.Logger) (*kratos.App, func(), error) {
+	client := redis.NewRedis(bootstrap)
+	manager := auth.NewAuthManager(bootstrap, client)
 	db := pg.NewDB(bootstrap, logger)
 	dataData := data.NewData(db)
 	userRepo := user.NewUserRepo(dataData, logger)
-	manager := transaction.NewManager(db)
+	transactionManager := transaction.NewManager(db)
 	idGenerator := idgen.NewIDGenerator()
 	userDeptRepo := user.NewUserDeptRepo(dataData, logger)
 	userPostRepo := user.NewUserPostRepo(dataData, logger)
 	userRoleRepo := user.NewUserRoleRepo(dataData, logger)
-	userUsecase := user2.NewUserUsecase(logger, userRepo, manager, idGenerator, userDeptRepo, userPostRepo, userRoleRepo)
+	userSessionRepo := auth.NewUserSessionRepo(manager, logger)
+	passwordRepo := user.NewPasswordRepo(bootstrap, dataData, logger)
+	tenantRepo := tenant.NewTenantRepo(dataData, logger)
+	tenantExpiryRepo := tenant.NewTenantExpiryRepo(bootstrap, client, logger)
+	sender, err := sms.NewSmsSender(bootstrap, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	tenantUsecase := tenant2.NewTenantUsecase(idGenerator, tenantRepo, tenantExpiryRepo, sender, logger)
+	userUsecase := user2.NewUserUsecase(logger, userRepo, transactionManager, idGenerator, userDeptRepo, userPostRepo, userRoleRepo, userSessionRepo, passwordRepo, tenantUsecase)
 	roleRepo := permission.NewRoleRepo(dataData, logger)
 	roleMenuRepo := permission.NewRoleMenuRepo(dataData, logger)
-	roleUsecase := permission2.NewRoleUsecase(manager, idGenerator, roleRepo, roleMenuRepo, logger)
+	menuRepo := permission.NewMenuRepo(dataData, logger)
+	tenantPackageRepo := tenant.NewTenantPackageRepo(dataData, logger)
+	tenantPackageUsecase := tenant2.NewTenantPackageUsecase(tenantPackageRepo, logger)
+	menuUsecase := permission2.NewMenuUsecase(idGenerator, menuRepo, tenantUsecase, tenantPackageUsecase, logger)
+	roleUsecase := permission2.NewRoleUsecase(transactionManager, idGenerator, roleRepo, roleMenuRepo, menuUsecase, logger)
 	departmentRepo := organization.NewDepartmentRepo(dataData, logger)
 	departmentUsecase := organization2.NewDepartmentUsecase(idGenerator, departmentRepo, logger)
 	postRepo := organization.NewPostRepo(dataData, logger)
 	postUsecase := organization2.NewPostUsecase(idGenerator, postRepo, logger)
-	userService := user3.NewUserService(userUsecase, roleUsecase, departmentUsecase, postUsecase, logger)
-	grpcServer := server.NewGRPCServer(bootstrap, logger, userService)
-	client := redis.NewRedis(bootstrap)
-	authManager := auth.NewAuthManager(client)
-	tenantRepo := tenant.NewTenantRepo(dataData, logger)
-	tenantUsecase := tenant2.NewTenantUsecase(tenantRepo, logger)
-	tenantPackageRepo := tenant.NewTenantPackageRepo(dataData, logger)
-	tenantPackageUsecase := tenant2.NewTenantPackageUsecase(tenantPackageRepo, logger)
-	tenantService := tenant3.NewTenantService(tenantUsecase, tenantPackageUsecase, logger)
-	menuRepo := permission.NewMenuRepo(dataData, logger)
-	menuUsecase := permission2.NewMenuUsecase(idGenerator, menuRepo, logger)
+	configRepo := config.NewConfigRepo(dataData, logger)
+	configUsecase := config2.NewConfigUsecase(logger, configRepo, idGenerator)
+	loginGuardRepo := guard.NewLoginGuardRepo(bootstrap, client, logger)
+	captchaRepo := guard.NewCaptchaRepo(bootstrap, client, logger)
+	userSecurityRepo, err := guard.NewUserSecurityRepo(bootstrap, dataData, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	mfaTicketRepo := guard.NewMfaTicketRepo(bootstrap, client, logger)
+	passwordResetRepo := guard.NewPasswordResetRepo(bootstrap, client, logger)
+	mailSender, err := mail.NewMailSender(bootstrap, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	authUsecase := auth2.NewAuthUsecase(manager, logger, userUsecase, roleUsecase, menuUsecase, configUsecase, loginGuardRepo, captchaRepo, userSecurityRepo, mfaTicketRepo, passwordResetRepo, mailSender, tenantUsecase)
+	userService := user3.NewUserService(userUsecase, roleUsecase, departmentUsecase, postUsecase, authUsecase, logger)
+	operateLogRepo := audit.NewOperateLogRepo(dataData, logger)
+	operateLogUsecase, cleanup := audit2.NewOperateLogUsecase(logger, operateLogRepo, idGenerator)
+	apiKeyRepo := guard.NewApiKeyRepo(dataData, logger)
+	apiKeyUsecase := auth2.NewApiKeyUsecase(logger, apiKeyRepo, idGenerator, authUsecase)
+	ipRuleRepo := guard.NewIpRuleRepo(dataData, logger)
+	loginLogRepo := audit.NewLoginLogRepo(dataData, logger)
+	loginLogUsecase := audit2.NewLoginLogUsecase(logger, loginLogRepo, idGenerator)
+	ipRuleUsecase := auth2.NewIpRuleUsecase(logger, ipRuleRepo, idGenerator, authUsecase, loginLogUsecase)
+	dataScopeUsecase := permission2.NewDataScopeUsecase(logger, roleUsecase, userUsecase, departmentUsecase)
+	grpcServer, err := server.NewGRPCServer(bootstrap, logger, manager, userService, operateLogUsecase, apiKeyUsecase, ipRuleUsecase, dataScopeUsecase, tenantUsecase)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	tenantOnboardingUsecase := onboarding.NewTenantOnboardingUsecase(logger, transactionManager, tenantUsecase, tenantPackageUsecase, userUsecase, roleUsecase, menuUsecase)
+	tenantService := tenant3.NewTenantService(tenantUsecase, tenantPackageUsecase, roleUsecase, tenantOnboardingUsecase, logger)
 	roleService := permission3.NewRoleService(roleUsecase, menuUsecase, logger)
 	menuService := permission3.NewMenuService(menuUsecase, logger)
 	departmentService := organization3.NewDepartmentService(departmentUsecase, logger)
 	postService := organization3.NewPostService(postUsecase, logger)
-	configRepo := config.NewConfigRepo(dataData, logger)
-	configUsecase := config2.NewConfigUsecase(logger, configRepo, idGenerator)
 	configService := config3.NewConfigService(configUsecase, logger)
-	authUsecase := auth2.NewAuthUsecase(authManager, logger, userUsecase, roleUsecase, menuUsecase)
-	authService := auth3.NewAuthService(logger, authUsecase, userUsecase, roleUsecase, menuUsecase)
-	httpServer := server.NewHTTPServer(bootstrap, logger, authManager, userService, tenantService, roleService, menuService, departmentService, postService, configService, authService)
-	app := newApp(logger, grpcServer, httpServer)
+	passkeyRepo, err := guard.NewPasskeyRepo(bootstrap, dataData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	passkeySessionRepo := guard.NewPasskeySessionRepo(bootstrap, client, logger)
+	passkeyUsecase := auth2.NewPasskeyUsecase(logger, passkeyRepo, passkeySessionRepo, idGenerator, authUsecase)
+	loginCodeRepo := guard.NewLoginCodeRepo(bootstrap, client, logger)
+	loginCodeUsecase := auth2.NewLoginCodeUsecase(logger, loginCodeRepo, sender, authUsecase)
+	keyRepo, err := oidc.NewKeyRepo(bootstrap, dataData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	oidcUsecase := oidc2.NewOidcUsecase(logger, keyRepo, idGenerator, manager, authUsecase, userUsecase, ipRuleUsecase, tenantUsecase)
+	redsync := redis.NewRedSync(client)
+	ldapConfigRepo, err := ldap.NewConfigRepo(bootstrap, dataData, redsync, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	linkRepo := ldap.NewLinkRepo(dataData, logger)
+	ldapUsecase := ldap2.NewLdapUsecase(logger, ldapConfigRepo, linkRepo, transactionManager, idGenerator, userUsecase, departmentUsecase, roleUsecase)
+	authService := auth3.NewAuthService(logger, authUsecase, userUsecase, roleUsecase, menuUsecase, loginLogUsecase, apiKeyUsecase, passkeyUsecase, loginCodeUsecase, ipRuleUsecase, oidcUsecase, ldapUsecase)
+	providerRepo, err := social.NewProviderRepo(bootstrap, dataData, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	userSocialRepo := social.NewUserSocialRepo(dataData, logger)
+	stateRepo := social.NewStateRepo(bootstrap, client, logger)
+	socialUsecase := social2.NewSocialUsecase(logger, providerRepo, userSocialRepo, stateRepo, transactionManager, idGenerator, userUsecase, departmentUsecase, roleUsecase)
+	socialService := auth3.NewSocialService(logger, socialUsecase, authService)
+	ldapService := auth3.NewLdapService(logger, ldapUsecase)
+	ipRuleService := auth3.NewIpRuleService(logger, ipRuleUsecase)
+	loginLogService := audit3.NewLoginLogService(loginLogUsecase, logger)
+	operateLogService := audit3.NewOperateLogService(operateLogUsecase, logger)
+	clientRepo := oauth2.NewClientRepo(dataData, logger)
+	clientUsecase := oauth2_2.NewClientUsecase(logger, clientRepo, idGenerator, menuUsecase, authUsecase, userUsecase)
+	authorizationCodeRepo := oauth2.NewAuthorizationCodeRepo(client, logger)
+	oAuth2Usecase := oauth2_2.NewOAuth2Usecase(logger, clientRepo, authorizationCodeRepo, manager, authUsecase, userUsecase, menuUsecase, oidcUsecase)
+	oAuth2Service := oauth2_3.NewOAuth2Service(clientUsecase, oAuth2Usecase, logger)
+	oidcService, err := oidc3.NewOidcService(bootstrap, oidcUsecase, logger)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	scimConfigRepo := scim.NewConfigRepo(dataData, logger)
+	scimUsecase := scim2.NewScimUsecase(logger, scimConfigRepo, idGenerator, userUsecase, departmentUsecase, roleUsecase)
+	scimService := scim3.NewScimService(scimUsecase, logger)
+	httpServer, err := server.NewHTTPServer(bootstrap, logger, manager, userService, tenantService, roleService, menuService, departmentService, postService, configService, authService, socialService, ldapService, ipRuleService, loginLogService, operateLogService, oAuth2Service, oidcService, scimService, operateLogUsecase, apiKeyUsecase, ipRuleUsecase, dataScopeUsecase, tenantUsecase)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	ldapSyncServer := server.NewLdapSyncServer(logger, ldapUsecase)
+	tenantExpiryServer := server.NewTenantExpiryServer(logger, tenantUsecase)
+	app := newApp(logger, grpcServer, httpServer, ldapSyncServer, tenantExpiryServer)
 	return app, func() {
+		cleanup()
 	}, nil
 }
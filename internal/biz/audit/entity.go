@@ -0,0 +1,103 @@
+package audit
+
+import "time"
+
+type LoginLog struct {
+	ID        string
+	Username  string
+	UserID    string
+	TenantID  string
+	LoginIP   string
+	UserAgent string
+	Device    string
+	Status    int32
+	Reason    string
+	LoginAt   time.Time
+}
+
+type ListLoginLogsQuery struct {
+	Page      int32
+	PageSize  int32
+	Username  string
+	UserID    string
+	LoginIP   string
+	Status    *int32
+	BeginTime *time.Time
+	EndTime   *time.Time
+	SortField string
+	SortOrder string
+}
+
+type WhereLoginLogOpt struct {
+	Limit     int32
+	Offset    int32
+	Username  string
+	UserID    string
+	LoginIP   string
+	Status    *int32
+	BeginTime *time.Time
+	EndTime   *time.Time
+	SortField string
+	SortOrder string
+}
+
+type ListLoginLogsResult struct {
+	LoginLogs  []*LoginLog
+	Total      int64
+	Page       int32
+	PageSize   int32
+	TotalPages int32
+}
+
+type OperateLog struct {
+	ID        string
+	Operation string
+	Method    string
+	Path      string
+	LoginID   string
+	TenantID  string
+	TraceID   string
+	Request   string
+	Code      int32
+	Reason    string
+	LatencyMs int64
+	ClientIP  string
+	UserAgent string
+	OperateAt time.Time
+	// SwitchTenantID 超级管理员切换租户访问时的目标租户
+	SwitchTenantID string
+}
+
+type ListOperateLogsQuery struct {
+	Page      int32
+	PageSize  int32
+	Operation string
+	LoginID   string
+	TraceID   string
+	Success   *bool
+	BeginTime *time.Time
+	EndTime   *time.Time
+	SortField string
+	SortOrder string
+}
+
+type WhereOperateLogOpt struct {
+	Limit     int32
+	Offset    int32
+	Operation string
+	LoginID   string
+	TraceID   string
+	Success   *bool
+	BeginTime *time.Time
+	EndTime   *time.Time
+	SortField string
+	SortOrder string
+}
+
+type ListOperateLogsResult struct {
+	OperateLogs []*OperateLog
+	Total       int64
+	Page        int32
+	PageSize    int32
+	TotalPages  int32
+}
@@ -0,0 +1,90 @@
+package audit
+
+import (
+	"context"
+	"quest-admin/internal/data/idgen"
+	"quest-admin/pkg/util/pagination"
+	"quest-admin/types/consts/id"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// maxUserAgentLen 与 qa_login_log.user_agent 字段长度保持一致
+const maxUserAgentLen = 512
+
+type LoginLogRepo interface {
+	Create(ctx context.Context, loginLog *LoginLog) error
+	List(ctx context.Context, opt *WhereLoginLogOpt) ([]*LoginLog, error)
+	Count(ctx context.Context, opt *WhereLoginLogOpt) (int64, error)
+}
+
+type LoginLogUsecase struct {
+	idgen        *idgen.IDGenerator
+	loginLogRepo LoginLogRepo
+	log          *log.Helper
+}
+
+func NewLoginLogUsecase(
+	logger log.Logger,
+	repo LoginLogRepo,
+	idgen *idgen.IDGenerator,
+) *LoginLogUsecase {
+	return &LoginLogUsecase{
+		log:          log.NewHelper(log.With(logger, "module", "audit/biz/login_log")),
+		idgen:        idgen,
+		loginLogRepo: repo,
+	}
+}
+
+// RecordLoginLog 记录一次登录尝试
+func (uc *LoginLogUsecase) RecordLoginLog(ctx context.Context, loginLog *LoginLog) error {
+	loginLog.ID = uc.idgen.NextID(id.LOGIN_LOG)
+	if len(loginLog.UserAgent) > maxUserAgentLen {
+		loginLog.UserAgent = loginLog.UserAgent[:maxUserAgentLen]
+	}
+	if loginLog.LoginAt.IsZero() {
+		loginLog.LoginAt = time.Now()
+	}
+	err := uc.loginLogRepo.Create(ctx, loginLog)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("记录登录日志失败,username:%s,error:%v", loginLog.Username, err)
+		return err
+	}
+	return nil
+}
+
+func (uc *LoginLogUsecase) ListLoginLogs(ctx context.Context, query *ListLoginLogsQuery) (*ListLoginLogsResult, error) {
+	opt := &WhereLoginLogOpt{
+		Limit:     query.PageSize,
+		Offset:    pagination.GetOffset(query.Page, query.PageSize),
+		Username:  query.Username,
+		UserID:    query.UserID,
+		LoginIP:   query.LoginIP,
+		Status:    query.Status,
+		BeginTime: query.BeginTime,
+		EndTime:   query.EndTime,
+		SortField: query.SortField,
+		SortOrder: query.SortOrder,
+	}
+
+	list, err := uc.loginLogRepo.List(ctx, opt)
+	if err != nil {
+		uc.log.WithContext(ctx).Error("查询登录日志列表失败", err)
+		return nil, err
+	}
+
+	total, err := uc.loginLogRepo.Count(ctx, opt)
+	if err != nil {
+		uc.log.WithContext(ctx).Error("查询登录日志总数失败", err)
+		return nil, err
+	}
+
+	return &ListLoginLogsResult{
+		LoginLogs:  list,
+		Total:      total,
+		Page:       query.Page,
+		PageSize:   query.PageSize,
+		TotalPages: pagination.GetTotalPages(total, int64(query.PageSize)),
+	}, nil
+}
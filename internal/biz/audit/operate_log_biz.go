@@ -0,0 +1,133 @@
+package audit
+
+import (
+	"context"
+	"quest-admin/internal/data/idgen"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/pagination"
+	"quest-admin/types/consts/id"
+	"quest-admin/types/errkey"
+	"sync"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// operateLogBufferSize 异步写入队列长度，队列满时丢弃日志以保证请求不被阻塞
+const operateLogBufferSize = 1024
+
+type OperateLogRepo interface {
+	Create(ctx context.Context, operateLog *OperateLog) error
+	FindByID(ctx context.Context, id string) (*OperateLog, error)
+	List(ctx context.Context, opt *WhereOperateLogOpt) ([]*OperateLog, error)
+	Count(ctx context.Context, opt *WhereOperateLogOpt) (int64, error)
+}
+
+type OperateLogUsecase struct {
+	idgen          *idgen.IDGenerator
+	operateLogRepo OperateLogRepo
+	queue          chan *OperateLog
+	// mu 保护 closed，关闭队列后不再接收日志，避免向已关闭的队列发送
+	mu     sync.RWMutex
+	closed bool
+	wg     sync.WaitGroup
+	log    *log.Helper
+}
+
+func NewOperateLogUsecase(
+	logger log.Logger,
+	repo OperateLogRepo,
+	idgen *idgen.IDGenerator,
+) (*OperateLogUsecase, func()) {
+	uc := &OperateLogUsecase{
+		log:            log.NewHelper(log.With(logger, "module", "audit/biz/operate_log")),
+		idgen:          idgen,
+		operateLogRepo: repo,
+		queue:          make(chan *OperateLog, operateLogBufferSize),
+	}
+	uc.wg.Add(1)
+	go uc.consume()
+
+	// 先停止接收新日志再关闭队列，消费协程写完队列中剩余的日志后退出
+	cleanup := func() {
+		uc.mu.Lock()
+		if !uc.closed {
+			uc.closed = true
+			close(uc.queue)
+		}
+		uc.mu.Unlock()
+		uc.wg.Wait()
+	}
+	return uc, cleanup
+}
+
+// RecordOperateLog 将操作日志放入异步队列，不等待落库
+func (uc *OperateLogUsecase) RecordOperateLog(ctx context.Context, operateLog *OperateLog) {
+	uc.mu.RLock()
+	defer uc.mu.RUnlock()
+	if uc.closed {
+		uc.log.WithContext(ctx).Warnf("操作日志队列已关闭,丢弃日志,operation:%s,traceID:%s", operateLog.Operation, operateLog.TraceID)
+		return
+	}
+	select {
+	case uc.queue <- operateLog:
+	default:
+		uc.log.WithContext(ctx).Warnf("操作日志队列已满,丢弃日志,operation:%s,traceID:%s", operateLog.Operation, operateLog.TraceID)
+	}
+}
+
+func (uc *OperateLogUsecase) consume() {
+	defer uc.wg.Done()
+	for operateLog := range uc.queue {
+		operateLog.ID = uc.idgen.NextID(id.OPERATE_LOG)
+		if err := uc.operateLogRepo.Create(context.Background(), operateLog); err != nil {
+			uc.log.Errorf("写入操作日志失败,operation:%s,traceID:%s,error:%v", operateLog.Operation, operateLog.TraceID, err)
+		}
+	}
+}
+
+func (uc *OperateLogUsecase) GetOperateLog(ctx context.Context, id string) (*OperateLog, error) {
+	operateLog, err := uc.operateLogRepo.FindByID(ctx, id)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("查询操作日志失败,id:%s,error:%v", id, err)
+		return nil, err
+	}
+	if operateLog == nil {
+		return nil, errorx.Err(errkey.ErrOperateLogNotFound)
+	}
+	return operateLog, nil
+}
+
+func (uc *OperateLogUsecase) ListOperateLogs(ctx context.Context, query *ListOperateLogsQuery) (*ListOperateLogsResult, error) {
+	opt := &WhereOperateLogOpt{
+		Limit:     query.PageSize,
+		Offset:    pagination.GetOffset(query.Page, query.PageSize),
+		Operation: query.Operation,
+		LoginID:   query.LoginID,
+		TraceID:   query.TraceID,
+		Success:   query.Success,
+		BeginTime: query.BeginTime,
+		EndTime:   query.EndTime,
+		SortField: query.SortField,
+		SortOrder: query.SortOrder,
+	}
+
+	list, err := uc.operateLogRepo.List(ctx, opt)
+	if err != nil {
+		uc.log.WithContext(ctx).Error("查询操作日志列表失败", err)
+		return nil, err
+	}
+
+	total, err := uc.operateLogRepo.Count(ctx, opt)
+	if err != nil {
+		uc.log.WithContext(ctx).Error("查询操作日志总数失败", err)
+		return nil, err
+	}
+
+	return &ListOperateLogsResult{
+		OperateLogs: list,
+		Total:       total,
+		Page:        query.Page,
+		PageSize:    query.PageSize,
+		TotalPages:  pagination.GetTotalPages(total, int64(query.PageSize)),
+	}, nil
+}
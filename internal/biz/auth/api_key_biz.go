@@ -0,0 +1,201 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"quest-admin/internal/data/idgen"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/lang/slices"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/consts/id"
+	"quest-admin/types/errkey"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ApiKeyRepo API Key 存储
+type ApiKeyRepo interface {
+	Create(ctx context.Context, key *ApiKey) error
+	// FindByHash 按密钥哈希查询，不限定租户，不存在或已吊销时返回 nil
+	FindByHash(ctx context.Context, hash string) (*ApiKey, error)
+	ListByUserID(ctx context.Context, userID string) ([]*ApiKey, error)
+	CountByUserID(ctx context.Context, userID string) (int64, error)
+	// Delete 吊销用户的 API Key，返回是否存在
+	Delete(ctx context.Context, userID, id string) (bool, error)
+	UpdateLastUsed(ctx context.Context, id string, at time.Time) error
+}
+
+const (
+	// ApiKeyPrefix API Key 的固定前缀，认证中间件据此区分 API Key 和登录令牌
+	ApiKeyPrefix = "qak_"
+
+	apiKeySecretBytes  = 32
+	apiKeyDisplayLen   = 12
+	maxApiKeysPerUser  = 20
+	defaultApiKeyTTL   = 90 * 24 * time.Hour
+	maxApiKeyTTL       = 365 * 24 * time.Hour
+	lastUsedUpdateStep = time.Minute
+)
+
+// ApiKeyUsecase API Key 用例
+type ApiKeyUsecase struct {
+	repo        ApiKeyRepo
+	idgen       *idgen.IDGenerator
+	authUsecase *AuthUsecase
+	log         *log.Helper
+}
+
+// NewApiKeyUsecase 创建 API Key 用例
+func NewApiKeyUsecase(logger log.Logger, repo ApiKeyRepo, idgen *idgen.IDGenerator, authUsecase *AuthUsecase) *ApiKeyUsecase {
+	return &ApiKeyUsecase{
+		repo:        repo,
+		idgen:       idgen,
+		authUsecase: authUsecase,
+		log:         log.NewHelper(log.With(logger, "module", "auth/biz/api_key")),
+	}
+}
+
+// IsApiKey 判断凭证是否为 API Key
+func IsApiKey(credential string) bool {
+	return strings.HasPrefix(credential, ApiKeyPrefix)
+}
+
+// CreateApiKey 创建 API Key，授权范围不能超出用户当前拥有的权限，返回的密钥明文只在创建时出现一次
+func (uc *ApiKeyUsecase) CreateApiKey(ctx context.Context, bo *CreateApiKeyBO) (*ApiKey, string, error) {
+	name := strings.TrimSpace(bo.Name)
+	if name == "" {
+		return nil, "", errorx.Err(errkey.ErrBadRequest, "name")
+	}
+	permissions := slices.Uniq(slices.Filter(bo.Permissions, func(item string, index int) bool { return item != "" }))
+	if len(permissions) == 0 {
+		return nil, "", errorx.Err(errkey.ErrBadRequest, "permissions")
+	}
+	now := time.Now()
+	expireAt := bo.ExpireAt
+	if expireAt.IsZero() {
+		expireAt = now.Add(defaultApiKeyTTL)
+	}
+	if !expireAt.After(now) || expireAt.After(now.Add(maxApiKeyTTL)) {
+		return nil, "", errorx.Err(errkey.ErrBadRequest, "expire_at")
+	}
+
+	user, err := uc.authUsecase.userUsecase.GetUser(ctx, bo.UserID)
+	if err != nil {
+		return nil, "", err
+	}
+	if user == nil {
+		return nil, "", errorx.Err(errkey.ErrUserNotFound)
+	}
+	_, granted, err := uc.authUsecase.UserAccess(ctx, user)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, permission := range permissions {
+		if !slices.Contains(granted, permission) {
+			return nil, "", errorx.Err(errkey.ErrApiKeyScopeDenied, permission)
+		}
+	}
+	count, err := uc.repo.CountByUserID(ctx, bo.UserID)
+	if err != nil {
+		return nil, "", err
+	}
+	if count >= maxApiKeysPerUser {
+		return nil, "", errorx.Err(errkey.ErrApiKeyLimitExceeded, maxApiKeysPerUser)
+	}
+
+	raw := make([]byte, apiKeySecretBytes)
+	if _, err = rand.Read(raw); err != nil {
+		return nil, "", err
+	}
+	secret := ApiKeyPrefix + base64.RawURLEncoding.EncodeToString(raw)
+	key := &ApiKey{
+		ID:          uc.idgen.NextID(id.API_KEY),
+		UserID:      bo.UserID,
+		Name:        name,
+		Prefix:      secret[:apiKeyDisplayLen],
+		Hash:        hashApiKey(secret),
+		Permissions: permissions,
+		ExpireAt:    expireAt,
+		CreateAt:    now,
+		TenantID:    ctxs.GetTenantID(ctx),
+	}
+	if err = uc.repo.Create(ctx, key); err != nil {
+		uc.log.WithContext(ctx).Errorf("创建API Key失败,userID:%s,error:%v", bo.UserID, err)
+		return nil, "", err
+	}
+	return key, secret, nil
+}
+
+// ListApiKeys 获取用户的 API Key 列表
+func (uc *ApiKeyUsecase) ListApiKeys(ctx context.Context, userID string) ([]*ApiKey, error) {
+	return uc.repo.ListByUserID(ctx, userID)
+}
+
+// RevokeApiKey 吊销用户的 API Key，立即生效
+func (uc *ApiKeyUsecase) RevokeApiKey(ctx context.Context, userID, keyID string) error {
+	ok, err := uc.repo.Delete(ctx, userID, keyID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.Err(errkey.ErrApiKeyNotFound)
+	}
+	return nil
+}
+
+// ResolveApiKey 校验 API Key 并解析出所属用户、租户和生效的权限码
+func (uc *ApiKeyUsecase) ResolveApiKey(ctx context.Context, secret string) (*ApiKeyPrincipal, error) {
+	if !IsApiKey(secret) {
+		return nil, errorx.Err(errkey.ErrApiKeyInvalid)
+	}
+	key, err := uc.repo.FindByHash(ctx, hashApiKey(secret))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if key == nil || !key.ExpireAt.After(now) {
+		return nil, errorx.Err(errkey.ErrApiKeyInvalid)
+	}
+
+	// 用户在 Key 所属租户下查询，用户被禁用、删除或需修改密码时 Key 随之失效
+	ctx = ctxs.WithTenantID(ctx, key.TenantID)
+	user, err := uc.authUsecase.userUsecase.GetUser(ctx, key.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errorx.Err(errkey.ErrApiKeyInvalid)
+	}
+	if ok, err := uc.authUsecase.userUsecase.VerifyStatus(ctx, user); err != nil || !ok {
+		return nil, errorx.Err(errkey.ErrApiKeyInvalid)
+	}
+	_, granted, err := uc.authUsecase.UserAccess(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	if now.Sub(key.LastUsedAt) >= lastUsedUpdateStep {
+		if err = uc.repo.UpdateLastUsed(ctx, key.ID, now); err != nil {
+			uc.log.WithContext(ctx).Errorf("更新API Key使用时间失败,keyID:%s,error:%v", key.ID, err)
+		}
+	}
+	return &ApiKeyPrincipal{
+		KeyID:    key.ID,
+		UserID:   key.UserID,
+		TenantID: key.TenantID,
+		Permissions: slices.Filter(key.Permissions, func(item string, index int) bool {
+			return slices.Contains(granted, item)
+		}),
+	}, nil
+}
+
+// hashApiKey 密钥为高熵随机串，只以 SHA-256 哈希形式存储
+func hashApiKey(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
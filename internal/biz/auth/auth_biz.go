@@ -2,20 +2,92 @@ package auth
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
+	"math"
+	configBiz "quest-admin/internal/biz/config"
 	permBiz "quest-admin/internal/biz/permission"
+	tenantBiz "quest-admin/internal/biz/tenant"
 	userBiz "quest-admin/internal/biz/user"
 	"quest-admin/internal/data/auth"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/mail"
+	"quest-admin/pkg/util/captcha"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/errkey"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+// LoginGuardRepo 登录失败计数与锁定，用户名和客户端 IP 分别计数
+type LoginGuardRepo interface {
+	// LockedFor 返回用户名或 IP 的剩余锁定时长，未锁定时为 0
+	LockedFor(ctx context.Context, username, ip string) (time.Duration, error)
+	// RecordFailure 记录一次登录失败，触发锁定时返回锁定时长
+	RecordFailure(ctx context.Context, username, ip string) (time.Duration, error)
+	// NeedCaptcha 用户名或 IP 的失败次数达到验证码阈值时返回 true
+	NeedCaptcha(ctx context.Context, username, ip string) (bool, error)
+	ClearFailure(ctx context.Context, username string) error
+	Unlock(ctx context.Context, username, ip string) error
+}
+
+// CaptchaRepo 图形验证码存储，验证码取出即失效
+type CaptchaRepo interface {
+	TTL() time.Duration
+	Save(ctx context.Context, id, code string) error
+	// Take 取出并删除验证码，不存在或已过期时返回空字符串
+	Take(ctx context.Context, id string) (string, error)
+}
+
+// UserSecurityRepo 用户安全设置存储
+type UserSecurityRepo interface {
+	// Issuer 认证器 App 中显示的发行方名称
+	Issuer() string
+	// FindByUserID 查询用户安全设置，不存在时返回 nil
+	FindByUserID(ctx context.Context, userID string) (*UserSecurity, error)
+	Save(ctx context.Context, security *UserSecurity) error
+	// UseTotpStep 时间步比已记录的新时写入并返回 true，否则说明验证码已被使用
+	UseTotpStep(ctx context.Context, userID string, step int64) (bool, error)
+}
+
+// MfaTicketRepo MFA 登录票据存储
+type MfaTicketRepo interface {
+	TTL() time.Duration
+	Save(ctx context.Context, ticket *MfaTicket) error
+	// Find 查询票据，不存在或已过期时返回 nil
+	Find(ctx context.Context, id string) (*MfaTicket, error)
+	// Fail 记录一次二次验证失败，返回该票据累计失败次数
+	Fail(ctx context.Context, id string) (int64, error)
+	Delete(ctx context.Context, id string) error
+}
+
+const (
+	// CaptchaEnabledKey 登录验证码开关配置键，租户配置优先于全局配置
+	CaptchaEnabledKey = "auth.captcha.enabled"
+
+	captchaLength = 4
 )
 
 // AuthUsecase 认证用例
 type AuthUsecase struct {
-	authManager *auth.Manager
-	userUsecase *userBiz.UserUsecase
-	roleUsecase *permBiz.RoleUsecase
-	menuUsecase *permBiz.MenuUsecase
-	log         *log.Helper
+	authManager       *auth.Manager
+	loginGuardRepo    LoginGuardRepo
+	captchaRepo       CaptchaRepo
+	securityRepo      UserSecurityRepo
+	ticketRepo        MfaTicketRepo
+	passwordResetRepo PasswordResetRepo
+	mailSender        mail.Sender
+	configUsecase     *configBiz.ConfigUsecase
+	userUsecase       *userBiz.UserUsecase
+	roleUsecase       *permBiz.RoleUsecase
+	menuUsecase       *permBiz.MenuUsecase
+	tenantUsecase     *tenantBiz.TenantUsecase
+	log               *log.Helper
 }
 
 // NewAuthUsecase 创建认证用例
@@ -24,47 +96,330 @@ func NewAuthUsecase(
 	logger log.Logger,
 	userUsecase *userBiz.UserUsecase,
 	roleUsecase *permBiz.RoleUsecase,
-	menuUsecase *permBiz.MenuUsecase) *AuthUsecase {
+	menuUsecase *permBiz.MenuUsecase,
+	configUsecase *configBiz.ConfigUsecase,
+	loginGuardRepo LoginGuardRepo,
+	captchaRepo CaptchaRepo,
+	securityRepo UserSecurityRepo,
+	ticketRepo MfaTicketRepo,
+	passwordResetRepo PasswordResetRepo,
+	mailSender mail.Sender,
+	tenantUsecase *tenantBiz.TenantUsecase) *AuthUsecase {
 	return &AuthUsecase{
-		authManager: manager,
-		userUsecase: userUsecase,
-		roleUsecase: roleUsecase,
-		menuUsecase: menuUsecase,
-		log:         log.NewHelper(log.With(logger, "module", "auth/biz/auth")),
+		authManager:       manager,
+		loginGuardRepo:    loginGuardRepo,
+		captchaRepo:       captchaRepo,
+		securityRepo:      securityRepo,
+		ticketRepo:        ticketRepo,
+		passwordResetRepo: passwordResetRepo,
+		mailSender:        mailSender,
+		configUsecase:     configUsecase,
+		userUsecase:       userUsecase,
+		roleUsecase:       roleUsecase,
+		menuUsecase:       menuUsecase,
+		tenantUsecase:     tenantUsecase,
+		log:               log.NewHelper(log.With(logger, "module", "auth/biz/auth")),
 	}
 }
 
-// AdminGenerateToken 生成访问令牌
-func (uc *AuthUsecase) AdminGenerateToken(ctx context.Context, bo *GenerateTokenBO) (string, error) {
-	token, err := uc.authManager.Admin.Login(bo.UserID)
+// AdminGenerateToken 生成访问令牌和刷新令牌，所属租户停用或已过期时拒绝登录
+func (uc *AuthUsecase) AdminGenerateToken(ctx context.Context, bo *GenerateTokenBO) (*TokenBO, error) {
+	if err := uc.tenantUsecase.CheckAvailable(ctx, ctxs.GetTenantID(ctx)); err != nil {
+		return nil, err
+	}
+	var devices []string
+	if bo.Device != "" {
+		devices = append(devices, bo.Device)
+	}
+	pair, err := uc.authManager.IssueAdminToken(ctx, bo.UserID, devices...)
 	if err != nil {
 		uc.log.WithContext(ctx).Errorf("生成令牌出现错误,userID:%s,error:%v", bo.UserID, err)
-		return "", err
+		return nil, err
 	}
-	return token, nil
+	return uc.toTokenBO(pair), nil
 }
 
-// SetRolesAndPermission 设置用户权限信息
+// RefreshToken 轮换刷新令牌，已使用过的刷新令牌再次出现时吊销整个令牌族；
+// 租户以刷新令牌登录时绑定的为准，所属租户停用或已过期时拒绝刷新
+func (uc *AuthUsecase) RefreshToken(ctx context.Context, refreshToken string) (*TokenBO, error) {
+	tenantID, err := uc.authManager.RefreshTokenTenant(ctx, refreshToken)
+	if errors.Is(err, auth.ErrRefreshTokenInvalid) {
+		return nil, errorx.Err(errkey.ErrRefreshTokenInvalid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = uc.tenantUsecase.CheckAvailable(ctx, tenantID); err != nil {
+		return nil, err
+	}
+	// OAuth2 签发的刷新令牌只能在令牌端点使用，避免换出不受授权范围限制的令牌
+	grant, err := uc.authManager.GetRefreshGrant(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if grant != nil {
+		return nil, errorx.Err(errkey.ErrRefreshTokenInvalid)
+	}
+	return uc.RotateRefreshToken(ctx, refreshToken)
+}
+
+// RotateRefreshToken 轮换刷新令牌，不区分令牌来源
+func (uc *AuthUsecase) RotateRefreshToken(ctx context.Context, refreshToken string) (*TokenBO, error) {
+	pair, err := uc.authManager.RefreshAdminToken(ctx, refreshToken)
+	switch {
+	case errors.Is(err, auth.ErrRefreshTokenInvalid):
+		return nil, errorx.Err(errkey.ErrRefreshTokenInvalid)
+	case errors.Is(err, auth.ErrRefreshTokenReused):
+		uc.log.WithContext(ctx).Warnf("检测到刷新令牌重放,已吊销令牌族")
+		return nil, errorx.Err(errkey.ErrRefreshTokenReused)
+	case err != nil:
+		uc.log.WithContext(ctx).Errorf("刷新令牌出现错误,error:%v", err)
+		return nil, err
+	}
+	return uc.toTokenBO(pair), nil
+}
+
+func (uc *AuthUsecase) toTokenBO(pair *auth.TokenPair) *TokenBO {
+	return &TokenBO{
+		UserID:           pair.LoginID,
+		TenantID:         pair.TenantID,
+		AccessToken:      pair.AccessToken,
+		RefreshToken:     pair.RefreshToken,
+		ExpiresIn:        int64(uc.authManager.AccessTTL().Seconds()),
+		RefreshExpiresIn: int64(uc.authManager.RefreshTTL().Seconds()),
+	}
+}
+
+// SetRolesAndPermission 覆盖写入用户的角色和权限，为空时同样写入空列表，避免会话中残留已撤销的权限
 func (uc *AuthUsecase) SetRolesAndPermission(ctx context.Context, userID string, roles []string, permissions []string) error {
-	if len(roles) != 0 {
-		err := uc.authManager.Admin.SetRoles(userID, roles)
-		if err != nil {
-			uc.log.WithContext(ctx).Errorf("设置用户角色出现错误,userID:%s,error:%v", userID, err)
-			return err
-		}
+	if roles == nil {
+		roles = []string{}
 	}
-	if len(permissions) != 0 {
-		err := uc.authManager.Admin.SetPermissions(userID, permissions)
-		if err != nil {
-			uc.log.WithContext(ctx).Errorf("设置用户权限出现错误,userID:%s,error:%v", userID, err)
-			return err
-		}
+	if permissions == nil {
+		permissions = []string{}
+	}
+	if err := uc.authManager.Admin.SetRoles(userID, roles); err != nil {
+		uc.log.WithContext(ctx).Errorf("设置用户角色出现错误,userID:%s,error:%v", userID, err)
+		return err
+	}
+	if err := uc.authManager.Admin.SetPermissions(userID, permissions); err != nil {
+		uc.log.WithContext(ctx).Errorf("设置用户权限出现错误,userID:%s,error:%v", userID, err)
+		return err
 	}
 	return nil
 }
 
+// UserAccess 计算用户当前生效的角色编码和权限码，停用的角色和菜单不生效，需修改密码的用户没有任何权限
+func (uc *AuthUsecase) UserAccess(ctx context.Context, user *userBiz.User) (roles []string, permissions []string, err error) {
+	if uc.userUsecase.PasswordChangeRequired(user) {
+		return nil, nil, nil
+	}
+	roleIDs, err := uc.userUsecase.GetUserRoles(ctx, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	roleList, err := uc.roleUsecase.ListByRoleIDs(ctx, roleIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, role := range roleList {
+		if role.Status == 1 {
+			roles = append(roles, role.Code)
+		}
+	}
+
+	menuIDs, err := uc.roleUsecase.GetMenusByRoleIDs(ctx, roleIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	menus, err := uc.menuUsecase.ListByMenuIDs(ctx, menuIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, menu := range uc.menuUsecase.ProcessDisabledMenus(menus) {
+		permissions = append(permissions, menu.Permission)
+	}
+	return roles, permissions, nil
+}
+
+// GrantUserAccess 将用户当前的角色和菜单权限覆盖写入会话，需要修改密码时不授予任何权限，
+// 并标记会话只能修改密码或退出登录
+func (uc *AuthUsecase) GrantUserAccess(ctx context.Context, user *userBiz.User) error {
+	required := uc.userUsecase.PasswordChangeRequired(user)
+	if err := uc.authManager.SetPasswordChangeRequired(ctx, user.ID, required); err != nil {
+		uc.log.WithContext(ctx).Errorf("标记用户需修改密码出现错误,userID:%s,error:%v", user.ID, err)
+		return err
+	}
+	roles, permissions, err := uc.UserAccess(ctx, user)
+	if err != nil {
+		return err
+	}
+	return uc.SetRolesAndPermission(ctx, user.ID, roles, permissions)
+}
+
 // Logout 用户登出
 func (uc *AuthUsecase) Logout(ctx context.Context, token string) error {
+	if token == "" {
+		return errorx.Err(errkey.ErrUnauthorized)
+	}
+	err := uc.authManager.RevokeRefreshByAccessToken(ctx, token)
+	if err == nil {
+		err = uc.authManager.Admin.LogoutByToken(token)
+	}
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("退出登录出现错误,error:%v", err)
+		return err
+	}
+	return nil
+}
 
+// KickoutUser 踢出用户的所有会话
+func (uc *AuthUsecase) KickoutUser(ctx context.Context, userID string) error {
+	err := uc.authManager.KickoutAdmin(ctx, userID)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("踢出用户出现错误,userID:%s,error:%v", userID, err)
+		return err
+	}
 	return nil
 }
+
+// KickoutSession 踢出当前租户下指定编号的会话
+func (uc *AuthUsecase) KickoutSession(ctx context.Context, sessionID string) error {
+	found, err := uc.authManager.KickoutAdminSession(ctx, ctxs.GetTenantID(ctx), sessionID)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("踢出会话出现错误,sessionID:%s,error:%v", sessionID, err)
+		return err
+	}
+	if !found {
+		return errorx.Err(errkey.ErrSessionNotFound)
+	}
+	return nil
+}
+
+// ListOnlineSessions 查询当前租户的在线会话，userID 为空时返回租户下全部
+func (uc *AuthUsecase) ListOnlineSessions(ctx context.Context, userID string) ([]*OnlineSession, error) {
+	sessions, err := uc.authManager.ListAdminSessions(ctx, ctxs.GetTenantID(ctx), userID)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("查询在线会话出现错误,userID:%s,error:%v", userID, err)
+		return nil, err
+	}
+	result := make([]*OnlineSession, 0, len(sessions))
+	for _, item := range sessions {
+		result = append(result, &OnlineSession{
+			ID:       item.ID,
+			UserID:   item.LoginID,
+			Device:   item.Device,
+			LoginAt:  time.Unix(item.CreateTime, 0),
+			ActiveAt: time.Unix(item.ActiveTime, 0),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].LoginAt.After(result[j].LoginAt)
+	})
+	return result, nil
+}
+
+// CheckLoginLock 用户名或 IP 处于锁定期时返回带剩余时间的错误
+func (uc *AuthUsecase) CheckLoginLock(ctx context.Context, username, ip string) error {
+	remaining, err := uc.loginGuardRepo.LockedFor(ctx, username, ip)
+	if err != nil {
+		return err
+	}
+	if remaining > 0 {
+		return loginLockedErr(remaining)
+	}
+	return nil
+}
+
+// RecordLoginFailure 记录登录失败，达到阈值触发锁定时返回锁定错误
+func (uc *AuthUsecase) RecordLoginFailure(ctx context.Context, username, ip string) error {
+	locked, err := uc.loginGuardRepo.RecordFailure(ctx, username, ip)
+	if err != nil {
+		return err
+	}
+	if locked > 0 {
+		uc.log.WithContext(ctx).Warnf("登录失败次数过多,已锁定,username:%s,ip:%s", username, ip)
+		return loginLockedErr(locked)
+	}
+	return nil
+}
+
+// ClearLoginFailure 登录成功后清除用户名的失败计数
+func (uc *AuthUsecase) ClearLoginFailure(ctx context.Context, username string) error {
+	return uc.loginGuardRepo.ClearFailure(ctx, username)
+}
+
+// UnlockLogin 提前解除用户名或 IP 的登录锁定
+func (uc *AuthUsecase) UnlockLogin(ctx context.Context, username, ip string) error {
+	err := uc.loginGuardRepo.Unlock(ctx, username, ip)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("解除登录锁定出现错误,username:%s,ip:%s,error:%v", username, ip, err)
+		return err
+	}
+	return nil
+}
+
+// GenerateCaptcha 生成图形验证码
+func (uc *AuthUsecase) GenerateCaptcha(ctx context.Context) (*CaptchaBO, error) {
+	code, img, err := captcha.Generate(captchaLength)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("生成验证码出现错误,error:%v", err)
+		return nil, err
+	}
+	id := strings.ReplaceAll(uuid.NewString(), "-", "")
+	if err = uc.captchaRepo.Save(ctx, id, code); err != nil {
+		return nil, err
+	}
+	return &CaptchaBO{
+		ID:        id,
+		Image:     "data:image/png;base64," + base64.StdEncoding.EncodeToString(img),
+		ExpiresIn: int64(uc.captchaRepo.TTL().Seconds()),
+	}, nil
+}
+
+// VerifyLoginCaptcha 开启验证码或失败次数达到阈值时校验验证码，验证码无论对错只能使用一次
+func (uc *AuthUsecase) VerifyLoginCaptcha(ctx context.Context, username, ip, captchaID, captchaCode string) error {
+	required, err := uc.captchaRequired(ctx, username, ip)
+	if err != nil {
+		return err
+	}
+	if !required {
+		return nil
+	}
+	if captchaID == "" || captchaCode == "" {
+		return errorx.Err(errkey.ErrCaptchaRequired)
+	}
+	code, err := uc.captchaRepo.Take(ctx, captchaID)
+	if err != nil {
+		return err
+	}
+	if code == "" || !strings.EqualFold(code, strings.TrimSpace(captchaCode)) {
+		return errorx.Err(errkey.ErrCaptchaInvalid)
+	}
+	return nil
+}
+
+func (uc *AuthUsecase) captchaRequired(ctx context.Context, username, ip string) (bool, error) {
+	if uc.configUsecase != nil {
+		value, ok, err := uc.configUsecase.GetEffectiveValue(ctx, CaptchaEnabledKey)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			enabled, err := strconv.ParseBool(strings.TrimSpace(value))
+			if err != nil {
+				uc.log.WithContext(ctx).Warnf("验证码开关配置无效,key:%s,value:%s", CaptchaEnabledKey, value)
+			}
+			if enabled {
+				return true, nil
+			}
+		}
+	}
+	return uc.loginGuardRepo.NeedCaptcha(ctx, username, ip)
+}
+
+func loginLockedErr(remaining time.Duration) error {
+	seconds := int64(math.Ceil(remaining.Seconds()))
+	return errorx.Err(errkey.ErrLoginLocked, seconds).
+		WithMetadata(map[string]string{"remaining_seconds": strconv.FormatInt(seconds, 10)})
+}
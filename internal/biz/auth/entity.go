@@ -1,6 +1,10 @@
 package auth
 
-import "time"
+import (
+	"time"
+
+	"github.com/go-webauthn/webauthn/webauthn"
+)
 
 // LoginRequest 登录请求
 type LoginRequest struct {
@@ -21,6 +25,218 @@ type GenerateTokenBO struct {
 	Device string
 }
 
+// TokenBO 访问令牌与刷新令牌，过期时间单位为秒
+type TokenBO struct {
+	UserID           string
+	TenantID         string
+	AccessToken      string
+	RefreshToken     string
+	ExpiresIn        int64
+	RefreshExpiresIn int64
+	// PasswordChangeRequired 需先修改密码，此时会话不具备任何角色和权限
+	PasswordChangeRequired bool
+	// IDToken OpenID Connect ID Token
+	IDToken string
+}
+
+// CaptchaBO 图形验证码，Image 为 base64 编码的 PNG data URI，过期时间单位为秒
+type CaptchaBO struct {
+	ID        string
+	Image     string
+	ExpiresIn int64
+}
+
+// UserSecurity 用户安全设置，TotpSecret 为明文，由存储层负责加解密；RecoveryCodes 为恢复码哈希
+type UserSecurity struct {
+	UserID        string
+	TotpSecret    string
+	TotpEnabled   bool
+	TotpLastStep  int64
+	RecoveryCodes []string
+}
+
+// MfaStatus 用户 MFA 状态，绑定了通行密钥同样视为启用 MFA
+type MfaStatus struct {
+	TotpEnabled            bool
+	RecoveryCodesRemaining int32
+	PasskeyCount           int32
+}
+
+// Enabled 是否需要二次验证
+func (s *MfaStatus) Enabled() bool {
+	return s.TotpEnabled || s.PasskeyCount > 0
+}
+
+// Methods 可用的二次验证方式
+func (s *MfaStatus) Methods() []string {
+	methods := make([]string, 0, 2)
+	if s.TotpEnabled {
+		methods = append(methods, MfaMethodTotp)
+	}
+	if s.PasskeyCount > 0 {
+		methods = append(methods, MfaMethodPasskey)
+	}
+	return methods
+}
+
+// TotpEnrollBO TOTP 绑定信息，QRCode 为 base64 编码的 PNG data URI
+type TotpEnrollBO struct {
+	Secret string
+	URI    string
+	QRCode string
+}
+
+// MfaTicket 密码校验通过后等待二次验证的登录票据
+type MfaTicket struct {
+	ID        string
+	UserID    string
+	Username  string
+	Device    string
+	TenantID  string
+	ExpiresIn int64
+	// Methods 可用的二次验证方式，只在签发时返回，不随票据保存
+	Methods []string
+}
+
+// Passkey 用户绑定的通行密钥（WebAuthn 凭证），CredentialID 全局唯一
+type Passkey struct {
+	ID              string
+	UserID          string
+	Name            string
+	CredentialID    []byte
+	PublicKey       []byte
+	AttestationType string
+	AAGUID          []byte
+	SignCount       uint32
+	Transports      []string
+	BackupEligible  bool
+	BackupState     bool
+	LastUsedAt      time.Time
+	CreateAt        time.Time
+	TenantID        string
+}
+
+// RelyingParty WebAuthn 依赖方配置，ID 为空时不启用通行密钥
+type RelyingParty struct {
+	ID      string
+	Name    string
+	Origins []string
+}
+
+// PasskeySession 注册或登录仪式的挑战状态；UserID 为空表示无账号的通行密钥登录，
+// MfaTicket 不为空表示作为密码登录的二次验证
+type PasskeySession struct {
+	ID        string
+	Kind      string
+	UserID    string
+	MfaTicket string
+	TenantID  string
+	Data      *webauthn.SessionData
+}
+
+// PasskeyCeremony 发给浏览器的仪式参数，Options 为 navigator.credentials 的 JSON 参数
+type PasskeyCeremony struct {
+	SessionID string
+	Options   string
+	ExpiresIn int64
+}
+
+// LoginCode 登录验证码，只保存验证码的哈希
+type LoginCode struct {
+	Channel  string
+	Target   string
+	Hash     string
+	UserID   string
+	TenantID string
+}
+
+// LoginCodePolicy 登录验证码的长度和发送频率限制
+type LoginCodePolicy struct {
+	Length       int
+	SendInterval time.Duration
+	DailyLimit   int64
+	IPDailyLimit int64
+}
+
+// LoginCodeQuota 一次发送申请的额度，Wait 大于 0 表示仍在发送间隔内，此时不计入发送次数
+type LoginCodeQuota struct {
+	Wait        time.Duration
+	TargetCount int64
+	IPCount     int64
+}
+
+// LoginCodeSent 发送结果，RetryAfter 为再次发送前需等待的秒数
+type LoginCodeSent struct {
+	ExpiresIn  int64
+	RetryAfter int64
+}
+
+// IpRule 管理端访问 IP 白名单规则。租户、角色、用户三个层级依次校验，
+// 某一层级存在启用的规则时，请求 IP 需命中该层级的任一规则
+type IpRule struct {
+	ID       string
+	Scope    string
+	TargetID string
+	Cidr     string
+	Remark   string
+	Status   int32
+	CreateAt time.Time
+	TenantID string
+}
+
+// ListIpRulesQuery 查询 IP 白名单规则，条件为空时不过滤
+type ListIpRulesQuery struct {
+	Scope    string
+	TargetID string
+	Status   *int32
+}
+
+// PasswordResetToken 找回密码令牌，只保存令牌的哈希
+type PasswordResetToken struct {
+	Hash     string
+	UserID   string
+	TenantID string
+}
+
+// ApiKey 用户创建的 API Key，只保存密钥的哈希
+type ApiKey struct {
+	ID          string
+	UserID      string
+	Name        string
+	Prefix      string
+	Hash        string
+	Permissions []string
+	ExpireAt    time.Time
+	LastUsedAt  time.Time
+	CreateAt    time.Time
+	TenantID    string
+}
+
+// CreateApiKeyBO 创建 API Key 参数，ExpireAt 为零值时使用默认有效期
+type CreateApiKeyBO struct {
+	UserID      string
+	Name        string
+	Permissions []string
+	ExpireAt    time.Time
+}
+
+// ApiKeyPrincipal API Key 解析出的调用方，Permissions 为 Key 授权范围与所属用户当前权限的交集
+type ApiKeyPrincipal struct {
+	KeyID       string
+	UserID      string
+	TenantID    string
+	Permissions []string
+}
+
+// OnlineSession 在线会话
+type OnlineSession struct {
+	ID       string
+	UserID   string
+	Device   string
+	LoginAt  time.Time
+	ActiveAt time.Time
+}
+
 // User 用户信息
 type User struct {
 	ID       string
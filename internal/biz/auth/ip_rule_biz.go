@@ -0,0 +1,254 @@
+package auth
+
+import (
+	"context"
+	auditBiz "quest-admin/internal/biz/audit"
+	"quest-admin/internal/data/idgen"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/lang/slices"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/pkg/util/ipx"
+	"quest-admin/types/consts/id"
+	"quest-admin/types/errkey"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// IpRuleRepo IP 白名单规则存储，按租户区分
+type IpRuleRepo interface {
+	Create(ctx context.Context, rule *IpRule) error
+	Update(ctx context.Context, rule *IpRule) error
+	Delete(ctx context.Context, id string) error
+	// FindByID 查询规则，不存在时返回 nil
+	FindByID(ctx context.Context, id string) (*IpRule, error)
+	List(ctx context.Context, query *ListIpRulesQuery) ([]*IpRule, error)
+}
+
+const (
+	// IpRuleScopeTenant 租户级规则，对租户内所有用户生效
+	IpRuleScopeTenant = "tenant"
+	// IpRuleScopeRole 角色级规则，对拥有该角色的用户生效
+	IpRuleScopeRole = "role"
+	// IpRuleScopeUser 用户级规则
+	IpRuleScopeUser = "user"
+)
+
+// IpRuleUsecase 管理端访问 IP 白名单用例
+type IpRuleUsecase struct {
+	repo        IpRuleRepo
+	idgen       *idgen.IDGenerator
+	authUsecase *AuthUsecase
+	loginLogUc  *auditBiz.LoginLogUsecase
+	log         *log.Helper
+}
+
+// NewIpRuleUsecase 创建 IP 白名单用例
+func NewIpRuleUsecase(logger log.Logger, repo IpRuleRepo, idgen *idgen.IDGenerator, authUsecase *AuthUsecase, loginLogUc *auditBiz.LoginLogUsecase) *IpRuleUsecase {
+	return &IpRuleUsecase{
+		repo:        repo,
+		idgen:       idgen,
+		authUsecase: authUsecase,
+		loginLogUc:  loginLogUc,
+		log:         log.NewHelper(log.With(logger, "module", "auth/biz/ip_rule")),
+	}
+}
+
+// CreateIpRule 创建规则，CIDR 按掩码归一化，单个 IP 保存为 /32 或 /128
+func (uc *IpRuleUsecase) CreateIpRule(ctx context.Context, rule *IpRule) (*IpRule, error) {
+	if err := uc.validate(ctx, rule); err != nil {
+		return nil, err
+	}
+	rule.ID = uc.idgen.NextID(id.IP_RULE)
+	rule.CreateAt = time.Now()
+	rule.TenantID = ctxs.GetTenantID(ctx)
+	if err := uc.checkLockout(ctx, rule, false); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.Create(ctx, rule); err != nil {
+		uc.log.WithContext(ctx).Errorf("创建IP白名单规则失败,error:%v", err)
+		return nil, err
+	}
+	return rule, nil
+}
+
+// UpdateIpRule 更新规则的网段、备注和状态，层级和对象不可修改
+func (uc *IpRuleUsecase) UpdateIpRule(ctx context.Context, rule *IpRule) (*IpRule, error) {
+	old, err := uc.repo.FindByID(ctx, rule.ID)
+	if err != nil {
+		return nil, err
+	}
+	if old == nil {
+		return nil, errorx.Err(errkey.ErrIpRuleNotFound)
+	}
+	rule.Scope, rule.TargetID = old.Scope, old.TargetID
+	rule.CreateAt, rule.TenantID = old.CreateAt, old.TenantID
+	if err = uc.validate(ctx, rule); err != nil {
+		return nil, err
+	}
+	if err = uc.checkLockout(ctx, rule, false); err != nil {
+		return nil, err
+	}
+	if err = uc.repo.Update(ctx, rule); err != nil {
+		uc.log.WithContext(ctx).Errorf("更新IP白名单规则失败,id:%s,error:%v", rule.ID, err)
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (uc *IpRuleUsecase) DeleteIpRule(ctx context.Context, id string) error {
+	rule, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if rule == nil {
+		return errorx.Err(errkey.ErrIpRuleNotFound)
+	}
+	if err = uc.checkLockout(ctx, rule, true); err != nil {
+		return err
+	}
+	return uc.repo.Delete(ctx, id)
+}
+
+func (uc *IpRuleUsecase) ListIpRules(ctx context.Context, query *ListIpRulesQuery) ([]*IpRule, error) {
+	return uc.repo.List(ctx, query)
+}
+
+// CheckAccess 校验用户能否从指定 IP 访问管理端，不满足任一层级的规则时返回 ErrIpNotAllowed
+func (uc *IpRuleUsecase) CheckAccess(ctx context.Context, userID, ip string) error {
+	enabled := int32(1)
+	rules, err := uc.repo.List(ctx, &ListIpRulesQuery{Status: &enabled})
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return nil
+	}
+	allowed, err := uc.allowed(ctx, rules, userID, ip)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errorx.Err(errkey.ErrIpNotAllowed, ip)
+	}
+	return nil
+}
+
+// Enforce 校验已登录请求的来源 IP，拒绝时记录一条登录失败日志
+func (uc *IpRuleUsecase) Enforce(ctx context.Context, userID string) error {
+	ip := ctxs.GetClientIP(ctx)
+	err := uc.CheckAccess(ctx, userID, ip)
+	if errors.Reason(err) != string(errkey.ErrIpNotAllowed) {
+		return err
+	}
+	loginLog := &auditBiz.LoginLog{
+		UserID:    userID,
+		TenantID:  ctxs.GetTenantID(ctx),
+		LoginIP:   ip,
+		UserAgent: ctxs.GetUserAgent(ctx),
+		Status:    0,
+		Reason:    string(errkey.ErrIpNotAllowed),
+		LoginAt:   time.Now(),
+	}
+	if user, _ := uc.authUsecase.userUsecase.GetUser(ctx, userID); user != nil {
+		loginLog.Username = user.Username
+	}
+	_ = uc.loginLogUc.RecordLoginLog(ctx, loginLog)
+	uc.log.WithContext(ctx).Warnf("IP不在白名单内,userID:%s,ip:%s", userID, ip)
+	return err
+}
+
+// allowed 依次校验租户、角色、用户层级，只在存在角色级规则时查询用户角色
+func (uc *IpRuleUsecase) allowed(ctx context.Context, rules []*IpRule, userID, ip string) (bool, error) {
+	var roleIDs []string
+	for _, rule := range rules {
+		if rule.Scope == IpRuleScopeRole {
+			var err error
+			if roleIDs, err = uc.authUsecase.userUsecase.GetUserRoles(ctx, userID); err != nil {
+				return false, err
+			}
+			break
+		}
+	}
+	levels := map[string][]string{}
+	for _, rule := range rules {
+		switch {
+		case rule.Scope == IpRuleScopeTenant,
+			rule.Scope == IpRuleScopeRole && slices.Contains(roleIDs, rule.TargetID),
+			rule.Scope == IpRuleScopeUser && rule.TargetID == userID:
+			levels[rule.Scope] = append(levels[rule.Scope], rule.Cidr)
+		}
+	}
+	for _, cidrs := range levels {
+		prefixes, err := ipx.ParsePrefixes(cidrs)
+		if err != nil {
+			return false, err
+		}
+		if !ipx.Contains(prefixes, ip) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// checkLockout 以变更后的规则校验当前操作人，避免管理员把自己的 IP 排除在外
+func (uc *IpRuleUsecase) checkLockout(ctx context.Context, changed *IpRule, deleted bool) error {
+	userID := ctxs.GetLoginID(ctx)
+	if userID == "" {
+		return nil
+	}
+	enabled := int32(1)
+	rules, err := uc.repo.List(ctx, &ListIpRulesQuery{Status: &enabled})
+	if err != nil {
+		return err
+	}
+	rules = slices.Filter(rules, func(item *IpRule, index int) bool { return item.ID != changed.ID })
+	if !deleted && changed.Status == 1 {
+		rules = append(rules, changed)
+	}
+	ip := ctxs.GetClientIP(ctx)
+	allowed, err := uc.allowed(ctx, rules, userID, ip)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return errorx.Err(errkey.ErrIpRuleSelfLockout, ip)
+	}
+	return nil
+}
+
+// validate 校验层级、对象和网段，角色和用户需存在于当前租户
+func (uc *IpRuleUsecase) validate(ctx context.Context, rule *IpRule) error {
+	prefix, err := ipx.ParsePrefix(rule.Cidr)
+	if err != nil {
+		return errorx.Err(errkey.ErrIpRuleInvalid, "cidr")
+	}
+	rule.Cidr = prefix.String()
+	if rule.Status != 0 && rule.Status != 1 {
+		return errorx.Err(errkey.ErrIpRuleInvalid, "status")
+	}
+	switch rule.Scope {
+	case IpRuleScopeTenant:
+		rule.TargetID = ""
+	case IpRuleScopeRole:
+		role, err := uc.authUsecase.roleUsecase.GetRole(ctx, rule.TargetID)
+		if err != nil {
+			return err
+		}
+		if role == nil {
+			return errorx.Err(errkey.ErrRoleNotFound)
+		}
+	case IpRuleScopeUser:
+		user, err := uc.authUsecase.userUsecase.GetUser(ctx, rule.TargetID)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return errorx.Err(errkey.ErrUserNotFound)
+		}
+	default:
+		return errorx.Err(errkey.ErrIpRuleInvalid, "scope")
+	}
+	return nil
+}
@@ -0,0 +1,228 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+	userBiz "quest-admin/internal/biz/user"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/mail"
+	"quest-admin/pkg/sms"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/pkg/util/validator"
+	"quest-admin/types/errkey"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// LoginCodeRepo 登录验证码存储，同一手机号或邮箱只保留最近一次发送的验证码，按租户区分
+type LoginCodeRepo interface {
+	TTL() time.Duration
+	Policy() *LoginCodePolicy
+	// Reserve 申请一次发送额度，发送间隔内只返回剩余等待时长，否则计入目标和 IP 当天的发送次数
+	Reserve(ctx context.Context, channel, target, ip string) (*LoginCodeQuota, error)
+	// Save 保存验证码，覆盖之前发送的验证码并清空失败次数
+	Save(ctx context.Context, code *LoginCode) error
+	// Find 查询目标的验证码，不存在或已过期时返回 nil
+	Find(ctx context.Context, channel, target string) (*LoginCode, error)
+	// Fail 记录一次校验失败，返回累计失败次数
+	Fail(ctx context.Context, channel, target string) (int64, error)
+	Delete(ctx context.Context, channel, target string) error
+}
+
+const (
+	// LoginCodeChannelSms 短信验证码
+	LoginCodeChannelSms = "sms"
+	// LoginCodeChannelEmail 邮件验证码
+	LoginCodeChannelEmail = "email"
+
+	// loginCodeMaxAttempts 单个验证码允许的校验失败次数，超过后需重新发送
+	loginCodeMaxAttempts = 5
+)
+
+// LoginCodeUsecase 短信、邮件验证码登录用例
+type LoginCodeUsecase struct {
+	repo        LoginCodeRepo
+	smsSender   sms.Sender
+	authUsecase *AuthUsecase
+	log         *log.Helper
+}
+
+// NewLoginCodeUsecase 创建验证码登录用例，邮件复用找回密码的发送器
+func NewLoginCodeUsecase(logger log.Logger, repo LoginCodeRepo, smsSender sms.Sender, authUsecase *AuthUsecase) *LoginCodeUsecase {
+	return &LoginCodeUsecase{
+		repo:        repo,
+		smsSender:   smsSender,
+		authUsecase: authUsecase,
+		log:         log.NewHelper(log.With(logger, "module", "auth/biz/login_code")),
+	}
+}
+
+// SendLoginCode 向手机号或邮箱发送登录验证码。先按目标和 IP 限流，再查找账号；
+// 账号不存在、对应多个用户或已禁用时同样返回成功，不暴露账号是否存在
+func (uc *LoginCodeUsecase) SendLoginCode(ctx context.Context, channel, target string) (*LoginCodeSent, error) {
+	target, err := normalizeLoginTarget(channel, target)
+	if err != nil {
+		return nil, err
+	}
+	policy := uc.repo.Policy()
+	quota, err := uc.repo.Reserve(ctx, channel, target, ctxs.GetClientIP(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if quota.Wait > 0 {
+		return nil, errorx.Err(errkey.ErrLoginCodeTooFrequent, int64(math.Ceil(quota.Wait.Seconds())))
+	}
+	if quota.TargetCount > policy.DailyLimit || quota.IPCount > policy.IPDailyLimit {
+		return nil, errorx.Err(errkey.ErrLoginCodeLimitExceeded)
+	}
+	sent := &LoginCodeSent{
+		ExpiresIn:  int64(uc.repo.TTL().Seconds()),
+		RetryAfter: int64(policy.SendInterval.Seconds()),
+	}
+
+	user, err := uc.findUser(ctx, channel, target)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		uc.log.WithContext(ctx).Infof("验证码登录账号不存在或不唯一,channel:%s,target:%s", channel, target)
+		return sent, nil
+	}
+	if ok, err := uc.authUsecase.userUsecase.VerifyStatus(ctx, user); err != nil || !ok {
+		uc.log.WithContext(ctx).Infof("验证码登录账号已禁用,userID:%s", user.ID)
+		return sent, nil
+	}
+
+	code, err := randomDigits(policy.Length)
+	if err != nil {
+		return nil, err
+	}
+	err = uc.repo.Save(ctx, &LoginCode{
+		Channel:  channel,
+		Target:   target,
+		Hash:     hashLoginCode(channel, target, code),
+		UserID:   user.ID,
+		TenantID: ctxs.GetTenantID(ctx),
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("保存登录验证码失败,userID:%s,error:%v", user.ID, err)
+		return nil, err
+	}
+
+	// 异步发送，避免响应耗时暴露账号是否存在
+	go func(ctx context.Context) {
+		if err := uc.send(ctx, channel, target, code); err != nil {
+			uc.log.WithContext(ctx).Errorf("发送登录验证码失败,channel:%s,userID:%s,error:%v", channel, user.ID, err)
+		}
+	}(context.WithoutCancel(ctx))
+	return sent, nil
+}
+
+// VerifyLoginCode 校验验证码并返回对应的用户，验证码只能使用一次，连续错误达到上限后作废
+func (uc *LoginCodeUsecase) VerifyLoginCode(ctx context.Context, channel, target, code string) (*userBiz.User, error) {
+	target, err := normalizeLoginTarget(channel, target)
+	if err != nil {
+		return nil, err
+	}
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return nil, errorx.Err(errkey.ErrLoginCodeInvalid)
+	}
+	stored, err := uc.repo.Find(ctx, channel, target)
+	if err != nil {
+		return nil, err
+	}
+	if stored == nil || stored.TenantID != ctxs.GetTenantID(ctx) {
+		return nil, errorx.Err(errkey.ErrLoginCodeInvalid)
+	}
+	if subtle.ConstantTimeCompare([]byte(stored.Hash), []byte(hashLoginCode(channel, target, code))) != 1 {
+		attempts, err := uc.repo.Fail(ctx, channel, target)
+		if err != nil {
+			return nil, err
+		}
+		if attempts >= loginCodeMaxAttempts {
+			_ = uc.repo.Delete(ctx, channel, target)
+		}
+		return nil, errorx.Err(errkey.ErrLoginCodeInvalid)
+	}
+	if err = uc.repo.Delete(ctx, channel, target); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.authUsecase.userUsecase.GetUser(ctx, stored.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errorx.Err(errkey.ErrLoginCodeInvalid)
+	}
+	return user, nil
+}
+
+func (uc *LoginCodeUsecase) findUser(ctx context.Context, channel, target string) (*userBiz.User, error) {
+	if channel == LoginCodeChannelSms {
+		return uc.authUsecase.userUsecase.GetUserByMobile(ctx, target)
+	}
+	return uc.authUsecase.userUsecase.GetUserByEmail(ctx, target)
+}
+
+func (uc *LoginCodeUsecase) send(ctx context.Context, channel, target, code string) error {
+	minutes := int(uc.repo.TTL().Minutes())
+	if channel == LoginCodeChannelSms {
+		return uc.smsSender.Send(ctx, &sms.Message{
+			Mobile:  target,
+			Content: fmt.Sprintf("您的登录验证码为 %s，%d 分钟内有效，请勿泄露给他人。", code, minutes),
+		})
+	}
+	return uc.authUsecase.mailSender.Send(ctx, &mail.Message{
+		To:      []string{target},
+		Subject: "登录验证码",
+		Body:    fmt.Sprintf("您好：\n\n您的登录验证码为 %s，%d 分钟内有效。\n\n如果不是您本人操作，请忽略本邮件。\n", code, minutes),
+	})
+}
+
+// normalizeLoginTarget 邮箱不区分大小写，手机号去除空白
+func normalizeLoginTarget(channel, target string) (string, error) {
+	target = strings.TrimSpace(target)
+	switch channel {
+	case LoginCodeChannelSms:
+		if target == "" || validator.ValidateMobile(target) != nil {
+			return "", errorx.Err(errkey.ErrBadRequest, "target")
+		}
+		return target, nil
+	case LoginCodeChannelEmail:
+		target = strings.ToLower(target)
+		if target == "" || validator.ValidateEmail(target) != nil {
+			return "", errorx.Err(errkey.ErrBadRequest, "target")
+		}
+		return target, nil
+	default:
+		return "", errorx.Err(errkey.ErrLoginCodeChannelInvalid, channel)
+	}
+}
+
+func randomDigits(n int) (string, error) {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		d, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(byte('0' + d.Int64()))
+	}
+	return b.String(), nil
+}
+
+// hashLoginCode 验证码与渠道、目标一起哈希后存储
+func hashLoginCode(channel, target, code string) string {
+	sum := sha256.Sum256([]byte(channel + ":" + target + ":" + code))
+	return hex.EncodeToString(sum[:])
+}
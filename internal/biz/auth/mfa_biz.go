@@ -0,0 +1,268 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/pkg/util/totp"
+	"quest-admin/types/errkey"
+	"strings"
+	"time"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+const (
+	// mfaMaxAttempts 单个票据允许的二次验证失败次数，超过后需重新输入密码
+	mfaMaxAttempts = 5
+	// totpSkew 允许前后一个时间步的时钟偏差
+	totpSkew = 1
+
+	// MfaMethodTotp 二次验证方式：TOTP 验证码或恢复码
+	MfaMethodTotp = "totp"
+	// MfaMethodPasskey 二次验证方式：通行密钥
+	MfaMethodPasskey = "passkey"
+
+	recoveryCodeCount = 10
+	qrCodeSize        = 256
+)
+
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GetMfaStatus 查询用户的 MFA 状态，未绑定时返回未启用
+func (uc *AuthUsecase) GetMfaStatus(ctx context.Context, userID string) (*MfaStatus, error) {
+	security, err := uc.securityRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	status := &MfaStatus{}
+	if security != nil && security.TotpEnabled {
+		status.TotpEnabled = true
+		status.RecoveryCodesRemaining = int32(len(security.RecoveryCodes))
+	}
+	return status, nil
+}
+
+// CreateMfaTicket 密码校验通过后签发 MFA 票据，凭票据和验证码换取访问令牌
+func (uc *AuthUsecase) CreateMfaTicket(ctx context.Context, userID, username, device string) (*MfaTicket, error) {
+	id, err := randomHex(32)
+	if err != nil {
+		return nil, err
+	}
+	ticket := &MfaTicket{
+		ID:        id,
+		UserID:    userID,
+		Username:  username,
+		Device:    device,
+		TenantID:  ctxs.GetTenantID(ctx),
+		ExpiresIn: int64(uc.ticketRepo.TTL().Seconds()),
+	}
+	if err = uc.ticketRepo.Save(ctx, ticket); err != nil {
+		uc.log.WithContext(ctx).Errorf("保存MFA票据出现错误,userID:%s,error:%v", userID, err)
+		return nil, err
+	}
+	return ticket, nil
+}
+
+// GetMfaTicket 查询 MFA 票据，票据不存在、已过期或不属于当前租户时返回错误
+func (uc *AuthUsecase) GetMfaTicket(ctx context.Context, id string) (*MfaTicket, error) {
+	if id == "" {
+		return nil, errorx.Err(errkey.ErrMfaTicketInvalid)
+	}
+	ticket, err := uc.ticketRepo.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ticket == nil || ticket.TenantID != ctxs.GetTenantID(ctx) {
+		return nil, errorx.Err(errkey.ErrMfaTicketInvalid)
+	}
+	return ticket, nil
+}
+
+// VerifyMfaCode 校验票据对应用户的 TOTP 验证码或恢复码，通过后票据立即失效
+func (uc *AuthUsecase) VerifyMfaCode(ctx context.Context, ticket *MfaTicket, code string) error {
+	security, err := uc.securityRepo.FindByUserID(ctx, ticket.UserID)
+	if err != nil {
+		return err
+	}
+	if security == nil || !security.TotpEnabled {
+		_ = uc.ticketRepo.Delete(ctx, ticket.ID)
+		return errorx.Err(errkey.ErrMfaTicketInvalid)
+	}
+	ok, err := uc.verifySecondFactor(ctx, security, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if err = uc.failMfaTicket(ctx, ticket); err != nil {
+			return err
+		}
+		return errorx.Err(errkey.ErrMfaCodeInvalid)
+	}
+	return uc.ticketRepo.Delete(ctx, ticket.ID)
+}
+
+// failMfaTicket 记录一次二次验证失败，达到上限后票据作废
+func (uc *AuthUsecase) failMfaTicket(ctx context.Context, ticket *MfaTicket) error {
+	attempts, err := uc.ticketRepo.Fail(ctx, ticket.ID)
+	if err != nil {
+		return err
+	}
+	if attempts >= mfaMaxAttempts {
+		_ = uc.ticketRepo.Delete(ctx, ticket.ID)
+	}
+	return nil
+}
+
+// BeginTotpEnroll 生成待确认的 TOTP 密钥，确认前不影响登录
+func (uc *AuthUsecase) BeginTotpEnroll(ctx context.Context, userID, account string) (*TotpEnrollBO, error) {
+	security, err := uc.securityRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if security != nil && security.TotpEnabled {
+		return nil, errorx.Err(errkey.ErrMfaAlreadyEnabled)
+	}
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+	uri := totp.URI(uc.securityRepo.Issuer(), account, secret)
+	png, err := qrcode.Encode(uri, qrcode.Medium, qrCodeSize)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("生成TOTP二维码出现错误,userID:%s,error:%v", userID, err)
+		return nil, err
+	}
+
+	err = uc.securityRepo.Save(ctx, &UserSecurity{UserID: userID, TotpSecret: secret})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("保存TOTP密钥出现错误,userID:%s,error:%v", userID, err)
+		return nil, err
+	}
+	return &TotpEnrollBO{
+		Secret: secret,
+		URI:    uri,
+		QRCode: "data:image/png;base64," + base64.StdEncoding.EncodeToString(png),
+	}, nil
+}
+
+// ConfirmTotpEnroll 校验认证器生成的验证码后启用 TOTP，返回仅展示一次的恢复码
+func (uc *AuthUsecase) ConfirmTotpEnroll(ctx context.Context, userID, code string) ([]string, error) {
+	security, err := uc.securityRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if security != nil && security.TotpEnabled {
+		return nil, errorx.Err(errkey.ErrMfaAlreadyEnabled)
+	}
+	if security == nil || security.TotpSecret == "" {
+		return nil, errorx.Err(errkey.ErrMfaNotEnrolling)
+	}
+	step, ok := totp.Validate(security.TotpSecret, code, time.Now(), totpSkew)
+	if !ok {
+		return nil, errorx.Err(errkey.ErrMfaCodeInvalid)
+	}
+
+	codes, hashes, err := generateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+	security.TotpEnabled = true
+	security.TotpLastStep = step
+	security.RecoveryCodes = hashes
+	if err = uc.securityRepo.Save(ctx, security); err != nil {
+		uc.log.WithContext(ctx).Errorf("启用TOTP出现错误,userID:%s,error:%v", userID, err)
+		return nil, err
+	}
+	return codes, nil
+}
+
+// DisableTotp 校验验证码或恢复码后关闭 TOTP 并清除密钥和恢复码
+func (uc *AuthUsecase) DisableTotp(ctx context.Context, userID, code string) error {
+	security, err := uc.securityRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if security == nil || !security.TotpEnabled {
+		return errorx.Err(errkey.ErrMfaNotEnabled)
+	}
+	ok, err := uc.verifySecondFactor(ctx, security, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.Err(errkey.ErrMfaCodeInvalid)
+	}
+	err = uc.securityRepo.Save(ctx, &UserSecurity{UserID: userID})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("关闭TOTP出现错误,userID:%s,error:%v", userID, err)
+		return err
+	}
+	return nil
+}
+
+// verifySecondFactor 6 位数字按 TOTP 校验，同一时间步只能使用一次；其余按恢复码校验，使用后作废
+func (uc *AuthUsecase) verifySecondFactor(ctx context.Context, security *UserSecurity, code string) (bool, error) {
+	code = strings.TrimSpace(code)
+	if code == "" {
+		return false, nil
+	}
+	if len(code) == totp.Digits {
+		step, ok := totp.Validate(security.TotpSecret, code, time.Now(), totpSkew)
+		if !ok || step <= security.TotpLastStep {
+			return false, nil
+		}
+		// 读取与写入之间可能有并发请求使用了同一验证码，由存储层比较并写入
+		used, err := uc.securityRepo.UseTotpStep(ctx, security.UserID, step)
+		if err != nil || !used {
+			return false, err
+		}
+		security.TotpLastStep = step
+		return true, nil
+	}
+
+	hash := hashRecoveryCode(code)
+	for i, item := range security.RecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(item), []byte(hash)) == 1 {
+			security.RecoveryCodes = append(security.RecoveryCodes[:i:i], security.RecoveryCodes[i+1:]...)
+			uc.log.WithContext(ctx).Infof("使用恢复码完成验证,userID:%s,剩余:%d", security.UserID, len(security.RecoveryCodes))
+			return true, uc.securityRepo.Save(ctx, security)
+		}
+	}
+	return false, nil
+}
+
+// generateRecoveryCodes 生成 xxxxx-xxxxx 格式的恢复码，同时返回用于存储的哈希
+func generateRecoveryCodes(n int) (codes []string, hashes []string, err error) {
+	for i := 0; i < n; i++ {
+		b := make([]byte, 7)
+		if _, err = rand.Read(b); err != nil {
+			return nil, nil, err
+		}
+		raw := strings.ToLower(recoveryEncoding.EncodeToString(b))[:10]
+		code := raw[:5] + "-" + raw[5:]
+		codes = append(codes, code)
+		hashes = append(hashes, hashRecoveryCode(code))
+	}
+	return codes, hashes, nil
+}
+
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
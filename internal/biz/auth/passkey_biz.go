@@ -0,0 +1,452 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	userBiz "quest-admin/internal/biz/user"
+	"quest-admin/internal/data/idgen"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/lang/slices"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/consts/id"
+	"quest-admin/types/errkey"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+)
+
+// PasskeyRepo 通行密钥存储
+type PasskeyRepo interface {
+	// RelyingParty 依赖方配置，未配置时返回 nil
+	RelyingParty() *RelyingParty
+	Create(ctx context.Context, passkey *Passkey) error
+	ListByUserID(ctx context.Context, userID string) ([]*Passkey, error)
+	// FindByCredentialID 按凭证 ID 查询当前租户的通行密钥，不存在时返回 nil
+	FindByCredentialID(ctx context.Context, credentialID []byte) (*Passkey, error)
+	// Rename 重命名用户的通行密钥，返回是否存在
+	Rename(ctx context.Context, userID, id, name string) (bool, error)
+	// Delete 删除用户的通行密钥，返回是否存在
+	Delete(ctx context.Context, userID, id string) (bool, error)
+	// UpdateUsage 登录后更新签名计数、备份状态和最近使用时间
+	UpdateUsage(ctx context.Context, passkey *Passkey) error
+}
+
+// PasskeySessionRepo 仪式挑战状态存储，挑战只能使用一次
+type PasskeySessionRepo interface {
+	TTL() time.Duration
+	Save(ctx context.Context, session *PasskeySession) error
+	// Take 取出并删除挑战状态，不存在或已过期时返回 nil
+	Take(ctx context.Context, id string) (*PasskeySession, error)
+}
+
+const (
+	passkeyKindRegister = "register"
+	passkeyKindLogin    = "login"
+
+	maxPasskeysPerUser = 10
+	maxPasskeyNameLen  = 64
+	defaultPasskeyName = "Passkey"
+)
+
+// PasskeyUsecase 通行密钥用例，既可作为免密登录，也可作为密码登录的二次验证
+type PasskeyUsecase struct {
+	repo        PasskeyRepo
+	sessionRepo PasskeySessionRepo
+	idgen       *idgen.IDGenerator
+	authUsecase *AuthUsecase
+	webauthn    *webauthn.WebAuthn
+	log         *log.Helper
+}
+
+// NewPasskeyUsecase 创建通行密钥用例，未配置依赖方时相关接口返回未启用
+func NewPasskeyUsecase(logger log.Logger, repo PasskeyRepo, sessionRepo PasskeySessionRepo, idgen *idgen.IDGenerator, authUsecase *AuthUsecase) *PasskeyUsecase {
+	uc := &PasskeyUsecase{
+		repo:        repo,
+		sessionRepo: sessionRepo,
+		idgen:       idgen,
+		authUsecase: authUsecase,
+		log:         log.NewHelper(log.With(logger, "module", "auth/biz/passkey")),
+	}
+	rp := repo.RelyingParty()
+	if rp == nil || rp.ID == "" {
+		return uc
+	}
+	timeout := webauthn.TimeoutConfig{Enforce: true, Timeout: sessionRepo.TTL(), TimeoutUVD: sessionRepo.TTL()}
+	wa, err := webauthn.New(&webauthn.Config{
+		RPID:          rp.ID,
+		RPDisplayName: rp.Name,
+		RPOrigins:     rp.Origins,
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			UserVerification: protocol.VerificationPreferred,
+		},
+		Timeouts: webauthn.TimeoutsConfig{Login: timeout, Registration: timeout},
+	})
+	if err != nil {
+		uc.log.Errorf("通行密钥配置无效,error:%v", err)
+		return uc
+	}
+	uc.webauthn = wa
+	return uc
+}
+
+// webauthnUser 适配 webauthn.User，用户句柄使用用户 ID
+type webauthnUser struct {
+	user     *userBiz.User
+	passkeys []*Passkey
+}
+
+func (u *webauthnUser) WebAuthnID() []byte {
+	return []byte(u.user.ID)
+}
+
+func (u *webauthnUser) WebAuthnName() string {
+	return u.user.Username
+}
+
+func (u *webauthnUser) WebAuthnDisplayName() string {
+	if u.user.Nickname != "" {
+		return u.user.Nickname
+	}
+	return u.user.Username
+}
+
+func (u *webauthnUser) WebAuthnCredentials() []webauthn.Credential {
+	return slices.Map(u.passkeys, func(item *Passkey, index int) webauthn.Credential {
+		return item.credential()
+	})
+}
+
+func (p *Passkey) credential() webauthn.Credential {
+	return webauthn.Credential{
+		ID:              p.CredentialID,
+		PublicKey:       p.PublicKey,
+		AttestationType: p.AttestationType,
+		Transport: slices.Map(p.Transports, func(item string, index int) protocol.AuthenticatorTransport {
+			return protocol.AuthenticatorTransport(item)
+		}),
+		Flags: webauthn.CredentialFlags{BackupEligible: p.BackupEligible, BackupState: p.BackupState},
+		Authenticator: webauthn.Authenticator{
+			AAGUID:    p.AAGUID,
+			SignCount: p.SignCount,
+		},
+	}
+}
+
+// Enabled 是否配置了通行密钥
+func (uc *PasskeyUsecase) Enabled() bool {
+	return uc.webauthn != nil
+}
+
+// BeginRegistration 开始注册仪式，排除用户已绑定的凭证，要求创建可发现凭证
+func (uc *PasskeyUsecase) BeginRegistration(ctx context.Context, user *userBiz.User) (*PasskeyCeremony, error) {
+	if !uc.Enabled() {
+		return nil, errorx.Err(errkey.ErrPasskeyDisabled)
+	}
+	passkeys, err := uc.repo.ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(passkeys) >= maxPasskeysPerUser {
+		return nil, errorx.Err(errkey.ErrPasskeyLimitExceeded, maxPasskeysPerUser)
+	}
+	wu := &webauthnUser{user: user, passkeys: passkeys}
+	creation, data, err := uc.webauthn.BeginRegistration(wu,
+		webauthn.WithExclusions(webauthn.Credentials(wu.WebAuthnCredentials()).CredentialDescriptors()),
+		webauthn.WithResidentKeyRequirement(protocol.ResidentKeyRequirementRequired),
+	)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("开始通行密钥注册出现错误,userID:%s,error:%v", user.ID, err)
+		return nil, err
+	}
+	return uc.startCeremony(ctx, &PasskeySession{Kind: passkeyKindRegister, UserID: user.ID, Data: data}, creation)
+}
+
+// FinishRegistration 校验浏览器返回的注册凭证并保存，credential 为 PublicKeyCredential 的 JSON
+func (uc *PasskeyUsecase) FinishRegistration(ctx context.Context, user *userBiz.User, sessionID, name, credential string) (*Passkey, error) {
+	if !uc.Enabled() {
+		return nil, errorx.Err(errkey.ErrPasskeyDisabled)
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = defaultPasskeyName
+	}
+	if utf8.RuneCountInString(name) > maxPasskeyNameLen {
+		return nil, errorx.Err(errkey.ErrBadRequest, "name")
+	}
+	session, err := uc.takeSession(ctx, sessionID, passkeyKindRegister)
+	if err != nil {
+		return nil, err
+	}
+	if session.UserID != user.ID {
+		return nil, errorx.Err(errkey.ErrPasskeySessionInvalid)
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes([]byte(credential))
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("解析通行密钥注册凭证失败,userID:%s,error:%v", user.ID, err)
+		return nil, errorx.Err(errkey.ErrPasskeyInvalid)
+	}
+	cred, err := uc.webauthn.CreateCredential(&webauthnUser{user: user}, *session.Data, parsed)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("通行密钥注册校验失败,userID:%s,error:%v", user.ID, err)
+		return nil, errorx.Err(errkey.ErrPasskeyInvalid)
+	}
+
+	passkeys, err := uc.repo.ListByUserID(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(passkeys) >= maxPasskeysPerUser {
+		return nil, errorx.Err(errkey.ErrPasskeyLimitExceeded, maxPasskeysPerUser)
+	}
+	existing, err := uc.repo.FindByCredentialID(ctx, cred.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errorx.Err(errkey.ErrPasskeyExists)
+	}
+
+	passkey := &Passkey{
+		ID:              uc.idgen.NextID(id.PASSKEY),
+		UserID:          user.ID,
+		Name:            name,
+		CredentialID:    cred.ID,
+		PublicKey:       cred.PublicKey,
+		AttestationType: cred.AttestationType,
+		AAGUID:          cred.Authenticator.AAGUID,
+		SignCount:       cred.Authenticator.SignCount,
+		Transports: slices.Map(cred.Transport, func(item protocol.AuthenticatorTransport, index int) string {
+			return string(item)
+		}),
+		BackupEligible: cred.Flags.BackupEligible,
+		BackupState:    cred.Flags.BackupState,
+		CreateAt:       time.Now(),
+		TenantID:       ctxs.GetTenantID(ctx),
+	}
+	if err = uc.repo.Create(ctx, passkey); err != nil {
+		uc.log.WithContext(ctx).Errorf("保存通行密钥失败,userID:%s,error:%v", user.ID, err)
+		return nil, err
+	}
+	return passkey, nil
+}
+
+// ListPasskeys 获取用户的通行密钥列表
+func (uc *PasskeyUsecase) ListPasskeys(ctx context.Context, userID string) ([]*Passkey, error) {
+	return uc.repo.ListByUserID(ctx, userID)
+}
+
+// CountPasskeys 统计用户绑定的通行密钥数量，未配置依赖方时视为没有
+func (uc *PasskeyUsecase) CountPasskeys(ctx context.Context, userID string) (int32, error) {
+	if !uc.Enabled() {
+		return 0, nil
+	}
+	passkeys, err := uc.repo.ListByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return int32(len(passkeys)), nil
+}
+
+// RenamePasskey 重命名用户的通行密钥
+func (uc *PasskeyUsecase) RenamePasskey(ctx context.Context, userID, passkeyID, name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxPasskeyNameLen {
+		return errorx.Err(errkey.ErrBadRequest, "name")
+	}
+	ok, err := uc.repo.Rename(ctx, userID, passkeyID, name)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.Err(errkey.ErrPasskeyNotFound)
+	}
+	return nil
+}
+
+// DeletePasskey 删除用户的通行密钥，立即生效
+func (uc *PasskeyUsecase) DeletePasskey(ctx context.Context, userID, passkeyID string) error {
+	ok, err := uc.repo.Delete(ctx, userID, passkeyID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.Err(errkey.ErrPasskeyNotFound)
+	}
+	return nil
+}
+
+// BeginLogin 开始登录仪式；ticket 为空时是免密登录，由浏览器选择可发现凭证并强制用户验证，
+// 否则作为二次验证，只允许票据对应用户的凭证
+func (uc *PasskeyUsecase) BeginLogin(ctx context.Context, ticket *MfaTicket) (*PasskeyCeremony, error) {
+	if !uc.Enabled() {
+		return nil, errorx.Err(errkey.ErrPasskeyDisabled)
+	}
+	if ticket == nil {
+		assertion, data, err := uc.webauthn.BeginDiscoverableLogin(webauthn.WithUserVerification(protocol.VerificationRequired))
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("开始通行密钥登录出现错误,error:%v", err)
+			return nil, err
+		}
+		return uc.startCeremony(ctx, &PasskeySession{Kind: passkeyKindLogin, Data: data}, assertion)
+	}
+
+	passkeys, err := uc.repo.ListByUserID(ctx, ticket.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(passkeys) == 0 {
+		return nil, errorx.Err(errkey.ErrPasskeyNotFound)
+	}
+	wu := &webauthnUser{user: &userBiz.User{ID: ticket.UserID, Username: ticket.Username}, passkeys: passkeys}
+	assertion, data, err := uc.webauthn.BeginLogin(wu)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("开始通行密钥二次验证出现错误,userID:%s,error:%v", ticket.UserID, err)
+		return nil, err
+	}
+	session := &PasskeySession{Kind: passkeyKindLogin, UserID: ticket.UserID, MfaTicket: ticket.ID, Data: data}
+	return uc.startCeremony(ctx, session, assertion)
+}
+
+// TakeLoginSession 取出登录仪式的挑战状态，挑战只能使用一次
+func (uc *PasskeyUsecase) TakeLoginSession(ctx context.Context, sessionID string) (*PasskeySession, error) {
+	if !uc.Enabled() {
+		return nil, errorx.Err(errkey.ErrPasskeyDisabled)
+	}
+	return uc.takeSession(ctx, sessionID, passkeyKindLogin)
+}
+
+// VerifyLogin 校验免密登录的断言，返回凭证所属的用户 ID
+func (uc *PasskeyUsecase) VerifyLogin(ctx context.Context, session *PasskeySession, credential string) (string, error) {
+	if session.MfaTicket != "" {
+		return "", errorx.Err(errkey.ErrPasskeySessionInvalid)
+	}
+	parsed, err := uc.parseAssertion(ctx, credential)
+	if err != nil {
+		return "", err
+	}
+	var passkey *Passkey
+	_, cred, err := uc.webauthn.ValidatePasskeyLogin(func(rawID, userHandle []byte) (webauthn.User, error) {
+		found, err := uc.repo.FindByCredentialID(ctx, rawID)
+		if err != nil {
+			return nil, err
+		}
+		if found == nil || found.UserID != string(userHandle) {
+			return nil, errorx.Err(errkey.ErrPasskeyNotFound)
+		}
+		passkey = found
+		return &webauthnUser{user: &userBiz.User{ID: found.UserID}, passkeys: []*Passkey{found}}, nil
+	}, *session.Data, parsed)
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("通行密钥登录校验失败,error:%v", err)
+		return "", errorx.Err(errkey.ErrPasskeyInvalid)
+	}
+	if err = uc.recordUsage(ctx, passkey, cred); err != nil {
+		return "", err
+	}
+	return passkey.UserID, nil
+}
+
+// VerifyMfaPasskey 以通行密钥完成二次验证，失败计入票据的失败次数，通过后票据立即失效
+func (uc *PasskeyUsecase) VerifyMfaPasskey(ctx context.Context, ticket *MfaTicket, session *PasskeySession, credential string) error {
+	if session.MfaTicket != ticket.ID || session.UserID != ticket.UserID {
+		return errorx.Err(errkey.ErrPasskeySessionInvalid)
+	}
+	passkeys, err := uc.repo.ListByUserID(ctx, ticket.UserID)
+	if err != nil {
+		return err
+	}
+	parsed, err := uc.parseAssertion(ctx, credential)
+	if err == nil {
+		wu := &webauthnUser{user: &userBiz.User{ID: ticket.UserID}, passkeys: passkeys}
+		var cred *webauthn.Credential
+		if cred, err = uc.webauthn.ValidateLogin(wu, *session.Data, parsed); err == nil {
+			passkey := findPasskey(passkeys, cred.ID)
+			if err = uc.recordUsage(ctx, passkey, cred); err != nil {
+				return err
+			}
+			return uc.authUsecase.ticketRepo.Delete(ctx, ticket.ID)
+		}
+		uc.log.WithContext(ctx).Warnf("通行密钥二次验证失败,userID:%s,error:%v", ticket.UserID, err)
+	}
+	if err = uc.authUsecase.failMfaTicket(ctx, ticket); err != nil {
+		return err
+	}
+	return errorx.Err(errkey.ErrPasskeyInvalid)
+}
+
+func findPasskey(passkeys []*Passkey, credentialID []byte) *Passkey {
+	for _, passkey := range passkeys {
+		if bytes.Equal(passkey.CredentialID, credentialID) {
+			return passkey
+		}
+	}
+	return nil
+}
+
+func (uc *PasskeyUsecase) parseAssertion(ctx context.Context, credential string) (*protocol.ParsedCredentialAssertionData, error) {
+	parsed, err := protocol.ParseCredentialRequestResponseBytes([]byte(credential))
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("解析通行密钥断言失败,error:%v", err)
+		return nil, errorx.Err(errkey.ErrPasskeyInvalid)
+	}
+	return parsed, nil
+}
+
+// recordUsage 签名计数回退说明凭证可能被克隆，拒绝登录
+func (uc *PasskeyUsecase) recordUsage(ctx context.Context, passkey *Passkey, cred *webauthn.Credential) error {
+	if passkey == nil {
+		return errorx.Err(errkey.ErrPasskeyInvalid)
+	}
+	if cred.Authenticator.CloneWarning {
+		uc.log.WithContext(ctx).Warnf("通行密钥签名计数回退,passkeyID:%s", passkey.ID)
+		return errorx.Err(errkey.ErrPasskeyInvalid)
+	}
+	passkey.SignCount = cred.Authenticator.SignCount
+	passkey.BackupState = cred.Flags.BackupState
+	passkey.LastUsedAt = time.Now()
+	if err := uc.repo.UpdateUsage(ctx, passkey); err != nil {
+		uc.log.WithContext(ctx).Errorf("更新通行密钥使用信息失败,passkeyID:%s,error:%v", passkey.ID, err)
+	}
+	return nil
+}
+
+func (uc *PasskeyUsecase) startCeremony(ctx context.Context, session *PasskeySession, options any) (*PasskeyCeremony, error) {
+	raw, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+	if session.ID, err = randomHex(32); err != nil {
+		return nil, err
+	}
+	session.TenantID = ctxs.GetTenantID(ctx)
+	if err = uc.sessionRepo.Save(ctx, session); err != nil {
+		uc.log.WithContext(ctx).Errorf("保存通行密钥挑战失败,error:%v", err)
+		return nil, err
+	}
+	return &PasskeyCeremony{
+		SessionID: session.ID,
+		Options:   string(raw),
+		ExpiresIn: int64(uc.sessionRepo.TTL().Seconds()),
+	}, nil
+}
+
+// takeSession 挑战不存在、已使用、类型不符或不属于当前租户时返回错误
+func (uc *PasskeyUsecase) takeSession(ctx context.Context, sessionID, kind string) (*PasskeySession, error) {
+	if sessionID == "" {
+		return nil, errorx.Err(errkey.ErrPasskeySessionInvalid)
+	}
+	session, err := uc.sessionRepo.Take(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil || session.Kind != kind || session.TenantID != ctxs.GetTenantID(ctx) || session.Data == nil {
+		return nil, errorx.Err(errkey.ErrPasskeySessionInvalid)
+	}
+	return session, nil
+}
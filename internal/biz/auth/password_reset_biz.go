@@ -0,0 +1,155 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	userBiz "quest-admin/internal/biz/user"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/mail"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/errkey"
+	"strings"
+	"time"
+)
+
+// PasswordResetRepo 找回密码令牌存储
+type PasswordResetRepo interface {
+	TTL() time.Duration
+	// ResetURL 前端重置密码页面地址
+	ResetURL() string
+	// Save 保存令牌，同一用户之前签发的令牌随之失效
+	Save(ctx context.Context, token *PasswordResetToken) error
+	// Find 按令牌哈希查询，不存在或已过期时返回 nil
+	Find(ctx context.Context, hash string) (*PasswordResetToken, error)
+	// Take 取出并删除令牌，不存在或已被使用时返回 nil
+	Take(ctx context.Context, hash string) (*PasswordResetToken, error)
+}
+
+const passwordResetTokenBytes = 32
+
+// RequestPasswordReset 向账号绑定的邮箱发送重置密码链接，account 为用户名或邮箱。
+// 账号不存在、已禁用或未绑定邮箱时同样返回成功，不暴露账号是否存在
+func (uc *AuthUsecase) RequestPasswordReset(ctx context.Context, account string) error {
+	account = strings.TrimSpace(account)
+	if account == "" {
+		return errorx.Err(errkey.ErrBadRequest, "account")
+	}
+	user, err := uc.findResetAccount(ctx, account)
+	if err != nil {
+		return err
+	}
+	if user == nil || user.Email == "" {
+		uc.log.WithContext(ctx).Infof("找回密码账号不存在或未绑定邮箱,account:%s", account)
+		return nil
+	}
+	if ok, err := uc.userUsecase.VerifyStatus(ctx, user); err != nil || !ok {
+		uc.log.WithContext(ctx).Infof("找回密码账号已禁用,userID:%s", user.ID)
+		return nil
+	}
+
+	raw := make([]byte, passwordResetTokenBytes)
+	if _, err = rand.Read(raw); err != nil {
+		return err
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	err = uc.passwordResetRepo.Save(ctx, &PasswordResetToken{
+		Hash:     hashResetToken(token),
+		UserID:   user.ID,
+		TenantID: ctxs.GetTenantID(ctx),
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("保存找回密码令牌失败,userID:%s,error:%v", user.ID, err)
+		return err
+	}
+
+	// 异步发送，避免响应耗时暴露账号是否存在
+	msg := uc.passwordResetMail(user, token)
+	go func(ctx context.Context) {
+		if err := uc.mailSender.Send(ctx, msg); err != nil {
+			uc.log.WithContext(ctx).Errorf("发送找回密码邮件失败,userID:%s,error:%v", user.ID, err)
+		}
+	}(context.WithoutCancel(ctx))
+	return nil
+}
+
+// ResetPasswordWithToken 使用找回密码令牌设置新密码，令牌只能使用一次
+func (uc *AuthUsecase) ResetPasswordWithToken(ctx context.Context, token, password string) error {
+	hash := hashResetToken(token)
+	resetToken, err := uc.passwordResetRepo.Find(ctx, hash)
+	if err != nil {
+		return err
+	}
+	if resetToken == nil || resetToken.TenantID != ctxs.GetTenantID(ctx) {
+		return errorx.Err(errkey.ErrPasswordResetTokenInvalid)
+	}
+	user, err := uc.userUsecase.GetUser(ctx, resetToken.UserID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return errorx.Err(errkey.ErrPasswordResetTokenInvalid)
+	}
+	if ok, err := uc.userUsecase.VerifyStatus(ctx, user); err != nil || !ok {
+		return errorx.Err(errkey.ErrUserDisabled)
+	}
+	// 密码不符合策略时保留令牌，用户可以重新输入
+	if err = uc.userUsecase.CheckNewPassword(ctx, user, password); err != nil {
+		return err
+	}
+
+	resetToken, err = uc.passwordResetRepo.Take(ctx, hash)
+	if err != nil {
+		return err
+	}
+	if resetToken == nil {
+		return errorx.Err(errkey.ErrPasswordResetTokenInvalid)
+	}
+	if err = uc.userUsecase.RecoverPassword(ctx, user, password); err != nil {
+		return err
+	}
+	if err = uc.loginGuardRepo.ClearFailure(ctx, user.Username); err != nil {
+		uc.log.WithContext(ctx).Errorf("清除登录失败次数失败,username:%s,error:%v", user.Username, err)
+	}
+	return nil
+}
+
+func (uc *AuthUsecase) findResetAccount(ctx context.Context, account string) (*userBiz.User, error) {
+	user, err := uc.userUsecase.GetUserByUsername(ctx, account)
+	if err != nil || user != nil || !strings.Contains(account, "@") {
+		return user, err
+	}
+	return uc.userUsecase.GetUserByEmail(ctx, account)
+}
+
+func (uc *AuthUsecase) passwordResetMail(user *userBiz.User, token string) *mail.Message {
+	link := token
+	if resetURL := uc.passwordResetRepo.ResetURL(); resetURL != "" {
+		sep := "?"
+		if strings.Contains(resetURL, "?") {
+			sep = "&"
+		}
+		link = resetURL + sep + "token=" + url.QueryEscape(token)
+	}
+	name := user.Nickname
+	if name == "" {
+		name = user.Username
+	}
+	body := fmt.Sprintf("%s，您好：\n\n我们收到了重置账号 %s 密码的请求，请在 %d 分钟内访问以下链接设置新密码：\n\n%s\n\n如果不是您本人操作，请忽略本邮件，您的密码不会被修改。\n",
+		name, user.Username, int(uc.passwordResetRepo.TTL().Minutes()), link)
+	return &mail.Message{
+		To:      []string{user.Email},
+		Subject: "重置密码",
+		Body:    body,
+	}
+}
+
+// hashResetToken 令牌只以 SHA-256 哈希形式存储
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
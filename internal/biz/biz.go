@@ -1,11 +1,18 @@
 package biz
 
 import (
+	"quest-admin/internal/biz/audit"
 	"quest-admin/internal/biz/auth"
 	"quest-admin/internal/biz/config"
 	"quest-admin/internal/biz/dict"
+	"quest-admin/internal/biz/ldap"
+	"quest-admin/internal/biz/oauth2"
+	"quest-admin/internal/biz/oidc"
+	"quest-admin/internal/biz/onboarding"
 	"quest-admin/internal/biz/organization"
 	"quest-admin/internal/biz/permission"
+	"quest-admin/internal/biz/scim"
+	"quest-admin/internal/biz/social"
 	"quest-admin/internal/biz/tenant"
 	"quest-admin/internal/biz/user"
 
@@ -19,10 +26,24 @@ var ProviderSet = wire.NewSet(
 	organization.NewPostUsecase,
 	tenant.NewTenantUsecase,
 	tenant.NewTenantPackageUsecase,
+	onboarding.NewTenantOnboardingUsecase,
 	permission.NewMenuUsecase,
 	permission.NewRoleUsecase,
+	permission.NewDataScopeUsecase,
 	config.NewConfigUsecase,
 	auth.NewAuthUsecase,
+	auth.NewApiKeyUsecase,
+	auth.NewPasskeyUsecase,
+	auth.NewLoginCodeUsecase,
+	auth.NewIpRuleUsecase,
+	oauth2.NewClientUsecase,
+	oauth2.NewOAuth2Usecase,
+	oidc.NewOidcUsecase,
+	social.NewSocialUsecase,
+	ldap.NewLdapUsecase,
+	scim.NewScimUsecase,
 	dict.NewDictTypeUsecase,
 	dict.NewDictDataUsecase,
+	audit.NewLoginLogUsecase,
+	audit.NewOperateLogUsecase,
 )
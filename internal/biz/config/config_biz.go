@@ -4,6 +4,7 @@ import (
 	"context"
 	"quest-admin/internal/data/idgen"
 	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/ctxs"
 	"quest-admin/pkg/util/pagination"
 	"quest-admin/types/consts/id"
 	"quest-admin/types/errkey"
@@ -86,6 +87,25 @@ func (uc *ConfigUsecase) GetConfigValue(ctx context.Context, key string) (string
 	return config.Value, nil
 }
 
+// GetEffectiveValue 优先取当前租户的启用配置，不存在时回退到全局配置，均未配置时 ok 为 false
+func (uc *ConfigUsecase) GetEffectiveValue(ctx context.Context, key string) (value string, ok bool, err error) {
+	scopes := []context.Context{ctx}
+	if ctxs.GetTenantID(ctx) != "" {
+		scopes = append(scopes, ctxs.WithTenantID(ctx, ""))
+	}
+	for _, scope := range scopes {
+		config, err := uc.configRepo.FindByKey(scope, key)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("查询配置失败,key:%s,error:%v", key, err)
+			return "", false, err
+		}
+		if config != nil && config.Status == 1 {
+			return config.Value, true, nil
+		}
+	}
+	return "", false, nil
+}
+
 func (uc *ConfigUsecase) ListConfigs(ctx context.Context, query *ListConfigsQuery) (*ListConfigsResult, error) {
 	opt := &WhereConfigOpt{
 		Limit:     query.PageSize,
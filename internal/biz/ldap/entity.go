@@ -0,0 +1,100 @@
+package ldap
+
+import "time"
+
+// 默认的搜索条件和属性，适用于 OpenLDAP，Active Directory 需按实际情况配置
+const (
+	DefaultUserFilter      = "(objectClass=person)"
+	DefaultGroupFilter     = "(|(objectClass=groupOfNames)(objectClass=group))"
+	DefaultDeptFilter      = "(objectClass=organizationalUnit)"
+	DefaultGroupMemberAttr = "member"
+	DefaultUsernameAttr    = "uid"
+	DefaultNicknameAttr    = "cn"
+	DefaultEmailAttr       = "mail"
+	DefaultMobileAttr      = "mobile"
+)
+
+// LinkType 目录条目关联的本地数据类型
+const (
+	LinkUser int32 = 1
+	LinkDept int32 = 2
+)
+
+// Options LDAP 全局配置
+type Options struct {
+	SyncCheckInterval time.Duration
+	Timeout           time.Duration
+}
+
+// Config 租户的 LDAP 配置，每个租户一份
+type Config struct {
+	ID string
+	// URL 形如 ldap://host:389 或 ldaps://host:636
+	URL                string
+	StartTLS           bool
+	InsecureSkipVerify bool
+	BindDN             string
+	BindPassword       string
+	BaseDN             string
+	UserFilter         string
+	Attributes         *AttributeMapping
+	// GroupBaseDN 为空时使用 BaseDN
+	GroupBaseDN     string
+	GroupFilter     string
+	GroupMemberAttr string
+	GroupRoles      []*GroupRole
+	// DeptFilter 导入为部门的 OU 条目
+	DeptFilter string
+	// RootDeptID OU 树挂载的本地上级部门，为空时作为顶级部门
+	RootDeptID string
+	// AuthEnabled 开启后从目录同步的用户使用 LDAP 密码登录，本地用户不受影响
+	AuthEnabled bool
+	SyncEnabled bool
+	// SyncInterval 自动同步间隔，单位分钟
+	SyncInterval   int32
+	Status         int32
+	LastSyncAt     time.Time
+	LastSyncResult *SyncResult
+	CreateBy       string
+	CreateAt       time.Time
+	UpdateBy       string
+	UpdateAt       time.Time
+	TenantID       string
+}
+
+// AttributeMapping 本地用户字段对应的目录属性，为空时使用默认属性
+type AttributeMapping struct {
+	Username string `json:"username,omitempty"`
+	Nickname string `json:"nickname,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Mobile   string `json:"mobile,omitempty"`
+}
+
+// GroupRole 目录组到本地角色的映射
+type GroupRole struct {
+	GroupDN string `json:"group_dn"`
+	RoleID  string `json:"role_id"`
+}
+
+// SyncResult 一次目录同步的结果
+type SyncResult struct {
+	Created      int32     `json:"created"`
+	Updated      int32     `json:"updated"`
+	Disabled     int32     `json:"disabled"`
+	Skipped      int32     `json:"skipped"`
+	DeptsCreated int32     `json:"depts_created"`
+	DeptsUpdated int32     `json:"depts_updated"`
+	StartAt      time.Time `json:"start_at"`
+	FinishAt     time.Time `json:"finish_at"`
+	Error        string    `json:"error,omitempty"`
+}
+
+// Link 目录条目与本地用户或部门的关联，用户以用户名属性、部门以 DN 作为外部标识
+type Link struct {
+	ID         string
+	Type       int32
+	ExternalID string
+	DN         string
+	TargetID   string
+	TenantID   string
+}
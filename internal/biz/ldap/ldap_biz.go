@@ -0,0 +1,306 @@
+package ldap
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	orgBiz "quest-admin/internal/biz/organization"
+	permBiz "quest-admin/internal/biz/permission"
+	userBiz "quest-admin/internal/biz/user"
+	"quest-admin/internal/data/idgen"
+	"quest-admin/internal/data/transaction"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/pkg/util/ldapclient"
+	"quest-admin/types/consts/id"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ConfigRepo 租户 LDAP 配置，服务账号密码加密存储
+type ConfigRepo interface {
+	Options() *Options
+	// Find 查询当前租户的配置，不存在时返回 nil
+	Find(ctx context.Context) (*Config, error)
+	// Save 新增或更新当前租户的配置，BindPassword 为空时保留原密码
+	Save(ctx context.Context, cfg *Config) error
+	Delete(ctx context.Context) error
+	// ListSyncEnabled 查询全部租户中已启用自动同步的配置
+	ListSyncEnabled(ctx context.Context) ([]*Config, error)
+	UpdateSyncResult(ctx context.Context, result *SyncResult) error
+	// Lock 获取当前租户的同步锁，已被占用时返回 ErrLdapSyncRunning
+	Lock(ctx context.Context) (unlock func(), err error)
+}
+
+// LinkRepo 目录条目与本地用户、部门的关联
+type LinkRepo interface {
+	ListByType(ctx context.Context, linkType int32) ([]*Link, error)
+	FindByTarget(ctx context.Context, linkType int32, targetID string) (*Link, error)
+	Create(ctx context.Context, link *Link) error
+	UpdateDN(ctx context.Context, id, dn string) error
+	Delete(ctx context.Context, id string) error
+	DeleteAll(ctx context.Context) error
+}
+
+// LdapUsecase LDAP / Active Directory 认证与目录同步
+type LdapUsecase struct {
+	repo        ConfigRepo
+	linkRepo    LinkRepo
+	tm          transaction.Manager
+	idgen       *idgen.IDGenerator
+	userUsecase *userBiz.UserUsecase
+	deptUsecase *orgBiz.DepartmentUsecase
+	roleUsecase *permBiz.RoleUsecase
+	log         *log.Helper
+}
+
+func NewLdapUsecase(
+	logger log.Logger,
+	repo ConfigRepo,
+	linkRepo LinkRepo,
+	tm transaction.Manager,
+	idgen *idgen.IDGenerator,
+	userUsecase *userBiz.UserUsecase,
+	deptUsecase *orgBiz.DepartmentUsecase,
+	roleUsecase *permBiz.RoleUsecase,
+) *LdapUsecase {
+	return &LdapUsecase{
+		repo:        repo,
+		linkRepo:    linkRepo,
+		tm:          tm,
+		idgen:       idgen,
+		userUsecase: userUsecase,
+		deptUsecase: deptUsecase,
+		roleUsecase: roleUsecase,
+		log:         log.NewHelper(log.With(logger, "module", "ldap/biz/ldap")),
+	}
+}
+
+func (uc *LdapUsecase) Options() *Options {
+	return uc.repo.Options()
+}
+
+// GetConfig 获取当前租户的 LDAP 配置
+func (uc *LdapUsecase) GetConfig(ctx context.Context) (*Config, error) {
+	cfg, err := uc.repo.Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, errorx.Err(errkey.ErrLdapConfigNotFound)
+	}
+	return cfg, nil
+}
+
+// SaveConfig 保存当前租户的 LDAP 配置，BindPassword 为空时保留原密码
+func (uc *LdapUsecase) SaveConfig(ctx context.Context, cfg *Config) error {
+	existing, err := uc.repo.Find(ctx)
+	if err != nil {
+		return err
+	}
+	if existing == nil {
+		cfg.ID = uc.idgen.NextID(id.LDAP_CONFIG)
+	} else {
+		cfg.ID = existing.ID
+	}
+	if cfg.BindDN != "" && cfg.BindPassword == "" && (existing == nil || existing.BindDN == "") {
+		return errorx.Err(errkey.ErrLdapConfigInvalid, "bind_password is required")
+	}
+	if err = uc.validateConfig(ctx, cfg); err != nil {
+		return err
+	}
+	cfg.TenantID = ctxs.GetTenantID(ctx)
+	if err = uc.repo.Save(ctx, cfg); err != nil {
+		uc.log.WithContext(ctx).Errorf("保存LDAP配置失败,error:%v", err)
+		return err
+	}
+	return nil
+}
+
+// DeleteConfig 删除 LDAP 配置及目录关联，已同步的用户和部门保留为本地数据
+func (uc *LdapUsecase) DeleteConfig(ctx context.Context) error {
+	if _, err := uc.GetConfig(ctx); err != nil {
+		return err
+	}
+	return uc.tm.Tx(ctx, func(ctx context.Context) error {
+		if err := uc.linkRepo.DeleteAll(ctx); err != nil {
+			return err
+		}
+		return uc.repo.Delete(ctx)
+	})
+}
+
+// TestConnection 使用已保存的配置连接目录并返回匹配的用户数
+func (uc *LdapUsecase) TestConnection(ctx context.Context) (int, error) {
+	cfg, err := uc.GetConfig(ctx)
+	if err != nil {
+		return 0, err
+	}
+	conn, err := uc.dial(ctx, cfg)
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Close()
+	entries, err := conn.Search(cfg.BaseDN, cfg.UserFilter, []string{cfg.Attributes.Username})
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("LDAP搜索用户失败,error:%v", err)
+		return 0, errorx.Err(errkey.ErrLdapUnavailable)
+	}
+	return len(entries), nil
+}
+
+// Authenticate 为从目录同步的用户校验 LDAP 密码，handled 为 false 时表示不适用，应继续校验本地密码
+func (uc *LdapUsecase) Authenticate(ctx context.Context, user *userBiz.User, password string) (handled bool, err error) {
+	cfg, err := uc.repo.Find(ctx)
+	if err != nil {
+		return false, err
+	}
+	if cfg == nil || cfg.Status != 1 || !cfg.AuthEnabled {
+		return false, nil
+	}
+	link, err := uc.linkRepo.FindByTarget(ctx, LinkUser, user.ID)
+	if err != nil {
+		return false, err
+	}
+	if link == nil {
+		return false, nil
+	}
+
+	conn, err := uc.dial(ctx, cfg)
+	if err != nil {
+		return true, err
+	}
+	defer conn.Close()
+	// 用户可能已在目录中移动，按用户名重新定位 DN
+	filter := fmt.Sprintf("(&%s(%s=%s))", cfg.UserFilter, cfg.Attributes.Username, ldapclient.EscapeFilter(user.Username))
+	entries, err := conn.Search(cfg.BaseDN, filter, []string{cfg.Attributes.Username})
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("LDAP搜索用户失败,username:%s,error:%v", user.Username, err)
+		return true, errorx.Err(errkey.ErrLdapUnavailable)
+	}
+	if len(entries) != 1 {
+		return true, errorx.Err(errkey.ErrPasswordNotMatch)
+	}
+	err = conn.Authenticate(entries[0].DN, password)
+	if errors.Is(err, ldapclient.ErrInvalidCredentials) {
+		return true, errorx.Err(errkey.ErrPasswordNotMatch)
+	}
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("LDAP绑定用户失败,username:%s,error:%v", user.Username, err)
+		return true, errorx.Err(errkey.ErrLdapUnavailable)
+	}
+	return true, nil
+}
+
+// SyncDue 同步全部到期的租户，由定时任务调用
+func (uc *LdapUsecase) SyncDue(ctx context.Context) {
+	configs, err := uc.repo.ListSyncEnabled(ctx)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("查询LDAP同步配置失败,error:%v", err)
+		return
+	}
+	now := time.Now()
+	for _, cfg := range configs {
+		interval := time.Duration(cfg.SyncInterval) * time.Minute
+		if cfg.Status != 1 || interval <= 0 || now.Sub(cfg.LastSyncAt) < interval {
+			continue
+		}
+		tenantCtx := ctxs.WithTenantID(ctx, cfg.TenantID)
+		if _, err = uc.Sync(tenantCtx); err != nil && errors.Reason(err) != string(errkey.ErrLdapSyncRunning) {
+			uc.log.WithContext(ctx).Errorf("LDAP定时同步失败,tenantID:%s,error:%v", cfg.TenantID, err)
+		}
+	}
+}
+
+func (uc *LdapUsecase) dial(ctx context.Context, cfg *Config) (*ldapclient.Conn, error) {
+	conn, err := ldapclient.Dial(ctx, &ldapclient.Config{
+		URL:                cfg.URL,
+		StartTLS:           cfg.StartTLS,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+		BindDN:             cfg.BindDN,
+		BindPassword:       cfg.BindPassword,
+		Timeout:            uc.repo.Options().Timeout,
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("连接LDAP失败,url:%s,error:%v", cfg.URL, err)
+		return nil, errorx.Err(errkey.ErrLdapUnavailable)
+	}
+	return conn, nil
+}
+
+func (uc *LdapUsecase) validateConfig(ctx context.Context, cfg *Config) error {
+	cfg.URL = strings.TrimSpace(cfg.URL)
+	cfg.BaseDN = strings.TrimSpace(cfg.BaseDN)
+	u, err := url.Parse(cfg.URL)
+	if err != nil || u.Host == "" || (u.Scheme != "ldap" && u.Scheme != "ldaps") {
+		return errorx.Err(errkey.ErrLdapConfigInvalid, "url must be ldap:// or ldaps://")
+	}
+	if ldapclient.NormalizeDN(cfg.BaseDN) == "" {
+		return errorx.Err(errkey.ErrLdapConfigInvalid, "base_dn is invalid")
+	}
+	if cfg.GroupBaseDN != "" && ldapclient.NormalizeDN(cfg.GroupBaseDN) == "" {
+		return errorx.Err(errkey.ErrLdapConfigInvalid, "group_base_dn is invalid")
+	}
+	if cfg.SyncEnabled && cfg.SyncInterval <= 0 {
+		return errorx.Err(errkey.ErrLdapConfigInvalid, "sync_interval must be positive")
+	}
+	if cfg.UserFilter == "" {
+		cfg.UserFilter = DefaultUserFilter
+	}
+	if cfg.GroupFilter == "" {
+		cfg.GroupFilter = DefaultGroupFilter
+	}
+	if cfg.DeptFilter == "" {
+		cfg.DeptFilter = DefaultDeptFilter
+	}
+	if cfg.GroupMemberAttr == "" {
+		cfg.GroupMemberAttr = DefaultGroupMemberAttr
+	}
+	if cfg.Attributes == nil {
+		cfg.Attributes = &AttributeMapping{}
+	}
+	cfg.Attributes.withDefaults()
+
+	if cfg.RootDeptID != "" {
+		dept, err := uc.deptUsecase.GetDepartment(ctx, cfg.RootDeptID)
+		if err != nil {
+			return err
+		}
+		if dept == nil {
+			return errorx.Err(errkey.ErrLdapConfigInvalid, "root department not found")
+		}
+	}
+	for _, item := range cfg.GroupRoles {
+		if ldapclient.NormalizeDN(item.GroupDN) == "" {
+			return errorx.Err(errkey.ErrLdapConfigInvalid, "group dn is invalid: "+item.GroupDN)
+		}
+		role, err := uc.roleUsecase.GetRole(ctx, item.RoleID)
+		if err != nil {
+			return err
+		}
+		if role == nil {
+			return errorx.Err(errkey.ErrLdapConfigInvalid, "role not found: "+item.RoleID)
+		}
+	}
+	return nil
+}
+
+func (m *AttributeMapping) withDefaults() {
+	if m.Username == "" {
+		m.Username = DefaultUsernameAttr
+	}
+	if m.Nickname == "" {
+		m.Nickname = DefaultNicknameAttr
+	}
+	if m.Email == "" {
+		m.Email = DefaultEmailAttr
+	}
+	if m.Mobile == "" {
+		m.Mobile = DefaultMobileAttr
+	}
+}
@@ -0,0 +1,411 @@
+package ldap
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	orgBiz "quest-admin/internal/biz/organization"
+	userBiz "quest-admin/internal/biz/user"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/lang/slices"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/pkg/util/ldapclient"
+	"quest-admin/types/consts/id"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// deptNameMaxLen 与 qa_dept.name 的长度一致
+const deptNameMaxLen = 32
+
+// Sync 同步当前租户的目录：OU 树导入为部门，用户按用户名属性导入或更新，按组映射分配角色，
+// 目录中已不存在的用户被停用。只调整由目录管理的部门和角色，手工分配的保持不变，重复执行结果一致
+func (uc *LdapUsecase) Sync(ctx context.Context) (*SyncResult, error) {
+	cfg, err := uc.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Status != 1 {
+		return nil, errorx.Err(errkey.ErrLdapConfigNotFound)
+	}
+	unlock, err := uc.repo.Lock(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	result := &SyncResult{StartAt: time.Now()}
+	err = uc.sync(ctx, cfg, result)
+	result.FinishAt = time.Now()
+	if err != nil {
+		result.Error = errors.FromError(err).Message
+	}
+	if e := uc.repo.UpdateSyncResult(ctx, result); e != nil {
+		uc.log.WithContext(ctx).Errorf("保存LDAP同步结果失败,error:%v", e)
+	}
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("LDAP同步失败,tenantID:%s,error:%v", cfg.TenantID, err)
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("LDAP同步完成,tenantID:%s,created:%d,updated:%d,disabled:%d,skipped:%d",
+		cfg.TenantID, result.Created, result.Updated, result.Disabled, result.Skipped)
+	return result, nil
+}
+
+func (uc *LdapUsecase) sync(ctx context.Context, cfg *Config, result *SyncResult) error {
+	conn, err := uc.dial(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ous, err := conn.Search(cfg.BaseDN, cfg.DeptFilter, []string{"ou"})
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("LDAP搜索部门失败,error:%v", err)
+		return errorx.Err(errkey.ErrLdapSyncFailed, "search departments")
+	}
+	deptByDN, err := uc.syncDepts(ctx, cfg, ous, result)
+	if err != nil {
+		return err
+	}
+
+	memberRoles, err := uc.memberRoles(ctx, conn, cfg)
+	if err != nil {
+		return err
+	}
+	attrs := cfg.Attributes
+	users, err := conn.Search(cfg.BaseDN, cfg.UserFilter, []string{attrs.Username, attrs.Nickname, attrs.Email, attrs.Mobile})
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("LDAP搜索用户失败,error:%v", err)
+		return errorx.Err(errkey.ErrLdapSyncFailed, "search users")
+	}
+	return uc.syncUsers(ctx, cfg, users, deptByDN, memberRoles, result)
+}
+
+// syncDepts 按 OU 树创建或更新部门，返回规范化 DN 到本地部门编号的映射
+func (uc *LdapUsecase) syncDepts(ctx context.Context, cfg *Config, ous []*ldapclient.Entry, result *SyncResult) (map[string]string, error) {
+	links, err := uc.linkRepo.ListByType(ctx, LinkDept)
+	if err != nil {
+		return nil, err
+	}
+	linkByDN := slices.ToMap(links, func(e *Link) (string, *Link) {
+		return e.ExternalID, e
+	})
+	depts, err := uc.deptUsecase.ListByDeptIDs(ctx, slices.Map(links, func(item *Link, index int) string {
+		return item.TargetID
+	}))
+	if err != nil {
+		return nil, err
+	}
+	deptByID := slices.ToMap(depts, func(e *orgBiz.Department) (string, *orgBiz.Department) {
+		return e.ID, e
+	})
+
+	// 上级 DN 是下级 DN 的后缀，按长度排序保证上级先于下级处理
+	entries := slices.FilterMap(ous, func(item *ldapclient.Entry, index int) (*ldapclient.Entry, bool) {
+		dn := ldapclient.NormalizeDN(item.DN)
+		return &ldapclient.Entry{DN: dn, Attributes: item.Attributes}, dn != ""
+	})
+	sort.SliceStable(entries, func(i, j int) bool {
+		return len(entries[i].DN) < len(entries[j].DN)
+	})
+
+	deptByDN := make(map[string]string, len(entries))
+	for _, entry := range entries {
+		name := entry.Get("ou")
+		if name == "" {
+			name = ldapclient.FirstRDNValue(entry.DN)
+		}
+		name = truncate(name, deptNameMaxLen)
+		parentID := cfg.RootDeptID
+		if id, ok := deptByDN[ldapclient.ParentDN(entry.DN)]; ok {
+			parentID = id
+		}
+
+		link := linkByDN[entry.DN]
+		if link != nil {
+			if dept := deptByID[link.TargetID]; dept != nil {
+				deptByDN[entry.DN] = dept.ID
+				if dept.Name != name || dept.ParentID != parentID {
+					dept.Name, dept.ParentID = name, parentID
+					if _, err = uc.deptUsecase.UpdateDepartment(ctx, dept); err != nil {
+						return nil, err
+					}
+					result.DeptsUpdated++
+				}
+				continue
+			}
+		}
+
+		// 本地部门已被删除时重新创建并替换关联
+		dept := &orgBiz.Department{
+			Name:     name,
+			ParentID: parentID,
+			Status:   1,
+			CreateBy: ctxs.GetLoginID(ctx),
+			UpdateBy: ctxs.GetLoginID(ctx),
+			TenantID: ctxs.GetTenantID(ctx),
+		}
+		err = uc.tm.Tx(ctx, func(ctx context.Context) error {
+			if link != nil {
+				if err := uc.linkRepo.Delete(ctx, link.ID); err != nil {
+					return err
+				}
+			}
+			if _, err := uc.deptUsecase.ImportDepartment(ctx, dept); err != nil {
+				return err
+			}
+			return uc.createLink(ctx, LinkDept, entry.DN, entry.DN, dept.ID)
+		})
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("导入LDAP部门失败,dn:%s,error:%v", entry.DN, err)
+			return nil, err
+		}
+		deptByDN[entry.DN] = dept.ID
+		result.DeptsCreated++
+	}
+	return deptByDN, nil
+}
+
+// memberRoles 按组映射计算成员应有的角色，成员以规范化 DN 或 uid: 前缀的用户名为键，不展开嵌套组
+func (uc *LdapUsecase) memberRoles(ctx context.Context, conn *ldapclient.Conn, cfg *Config) (map[string][]string, error) {
+	members := make(map[string][]string)
+	if len(cfg.GroupRoles) == 0 {
+		return members, nil
+	}
+	rolesByGroup := make(map[string][]string)
+	for _, item := range cfg.GroupRoles {
+		dn := ldapclient.NormalizeDN(item.GroupDN)
+		rolesByGroup[dn] = append(rolesByGroup[dn], item.RoleID)
+	}
+	baseDN := cfg.GroupBaseDN
+	if baseDN == "" {
+		baseDN = cfg.BaseDN
+	}
+	groups, err := conn.Search(baseDN, cfg.GroupFilter, []string{cfg.GroupMemberAttr})
+	if err != nil {
+		uc.log.WithContext(ctx).Warnf("LDAP搜索用户组失败,error:%v", err)
+		return nil, errorx.Err(errkey.ErrLdapSyncFailed, "search groups")
+	}
+	for _, group := range groups {
+		roles := rolesByGroup[ldapclient.NormalizeDN(group.DN)]
+		if len(roles) == 0 {
+			continue
+		}
+		for _, member := range group.Values(cfg.GroupMemberAttr) {
+			key := ldapclient.NormalizeDN(member)
+			// posixGroup 的 memberUid 是用户名而不是 DN
+			if key == "" || !strings.Contains(member, "=") {
+				key = memberUIDKey(member)
+			}
+			members[key] = append(members[key], roles...)
+		}
+	}
+	return members, nil
+}
+
+func (uc *LdapUsecase) syncUsers(ctx context.Context, cfg *Config, entries []*ldapclient.Entry,
+	deptByDN map[string]string, memberRoles map[string][]string, result *SyncResult) error {
+	links, err := uc.linkRepo.ListByType(ctx, LinkUser)
+	if err != nil {
+		return err
+	}
+	// 搜索条件配置错误时可能返回空结果，此时停用全部用户的代价过大
+	if len(entries) == 0 && len(links) > 0 {
+		return errorx.Err(errkey.ErrLdapSyncFailed, "user search returned no entries")
+	}
+	linkByUsername := slices.ToMap(links, func(e *Link) (string, *Link) {
+		return e.ExternalID, e
+	})
+
+	managedDepts := make([]string, 0, len(deptByDN)+1)
+	for _, deptID := range deptByDN {
+		managedDepts = append(managedDepts, deptID)
+	}
+	if cfg.RootDeptID != "" {
+		managedDepts = append(managedDepts, cfg.RootDeptID)
+	}
+	managedRoles := slices.Map(cfg.GroupRoles, func(item *GroupRole, index int) string {
+		return item.RoleID
+	})
+
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		attrs := cfg.Attributes
+		username := entry.Get(attrs.Username)
+		externalID := strings.ToLower(username)
+		if username == "" || seen[externalID] {
+			result.Skipped++
+			continue
+		}
+		seen[externalID] = true
+
+		user := &userBiz.User{
+			Username: username,
+			Nickname: entry.Get(attrs.Nickname),
+			Email:    entry.Get(attrs.Email),
+			Mobile:   entry.Get(attrs.Mobile),
+		}
+		if user.Nickname == "" {
+			user.Nickname = username
+		}
+		deptIDs := userDeptIDs(entry.DN, deptByDN, cfg.RootDeptID)
+		dn := ldapclient.NormalizeDN(entry.DN)
+		roleIDs := slices.Uniq(append(append([]string{}, memberRoles[dn]...), memberRoles[memberUIDKey(username)]...))
+
+		link := linkByUsername[externalID]
+		if link == nil {
+			created, err := uc.importUser(ctx, user, entry.DN, deptIDs, roleIDs)
+			if err != nil {
+				return err
+			}
+			if created {
+				result.Created++
+			} else {
+				result.Skipped++
+			}
+			continue
+		}
+
+		updated, err := uc.updateUser(ctx, link, user, entry.DN, deptIDs, roleIDs, managedDepts, managedRoles)
+		if err != nil {
+			return err
+		}
+		if updated {
+			result.Updated++
+		}
+	}
+
+	for _, link := range links {
+		if seen[link.ExternalID] {
+			continue
+		}
+		user, err := uc.userUsecase.GetUser(ctx, link.TargetID)
+		if err != nil {
+			return err
+		}
+		if user == nil || user.Status != 1 {
+			continue
+		}
+		if err = uc.userUsecase.ChangeUserStatus(ctx, &userBiz.UpdateStatusBO{UserID: user.ID, Status: 0}); err != nil {
+			return err
+		}
+		uc.log.WithContext(ctx).Infof("目录中已不存在,停用用户,userID:%s,username:%s", user.ID, user.Username)
+		result.Disabled++
+	}
+	return nil
+}
+
+// importUser 创建目录用户，本地已存在同名的非目录用户时跳过，避免接管本地账号
+func (uc *LdapUsecase) importUser(ctx context.Context, user *userBiz.User, dn string, deptIDs, roleIDs []string) (bool, error) {
+	user.Remark = "synced from LDAP"
+	err := uc.tm.Tx(ctx, func(ctx context.Context) error {
+		if err := uc.userUsecase.ProvisionUser(ctx, user, deptIDs, roleIDs); err != nil {
+			return err
+		}
+		return uc.createLink(ctx, LinkUser, strings.ToLower(user.Username), dn, user.ID)
+	})
+	if errors.Reason(err) == string(errkey.ErrUserExists) {
+		uc.log.WithContext(ctx).Warnf("本地已存在同名用户,跳过LDAP用户,username:%s", user.Username)
+		return false, nil
+	}
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("导入LDAP用户失败,username:%s,error:%v", user.Username, err)
+		return false, err
+	}
+	return true, nil
+}
+
+// updateUser 更新已关联用户的资料、部门和角色，本地用户已删除时不再创建
+func (uc *LdapUsecase) updateUser(ctx context.Context, link *Link, profile *userBiz.User, dn string,
+	deptIDs, roleIDs, managedDepts, managedRoles []string) (bool, error) {
+	user, err := uc.userUsecase.GetUser(ctx, link.TargetID)
+	if err != nil || user == nil {
+		return false, err
+	}
+	updated := false
+	if user.Nickname != profile.Nickname || user.Email != profile.Email || user.Mobile != profile.Mobile {
+		user.Nickname, user.Email, user.Mobile = profile.Nickname, profile.Email, profile.Mobile
+		if err = uc.userUsecase.UpdateUser(ctx, user); err != nil {
+			return false, err
+		}
+		updated = true
+	}
+	if link.DN != dn {
+		if err = uc.linkRepo.UpdateDN(ctx, link.ID, dn); err != nil {
+			return false, err
+		}
+		updated = true
+	}
+
+	currentDepts, err := uc.userUsecase.GetUserDepts(ctx, user.ID)
+	if err != nil {
+		return false, err
+	}
+	if depts, changed := reconcile(currentDepts, deptIDs, managedDepts); changed {
+		if err = uc.userUsecase.AssignUserDepts(ctx, &userBiz.AssignUserDeptsBO{UserID: user.ID, DeptIDs: depts}); err != nil {
+			return false, err
+		}
+		updated = true
+	}
+	currentRoles, err := uc.userUsecase.GetUserRoles(ctx, user.ID)
+	if err != nil {
+		return false, err
+	}
+	if roles, changed := reconcile(currentRoles, roleIDs, managedRoles); changed {
+		if err = uc.userUsecase.AssignUserRoles(ctx, &userBiz.AssignUserRolesBO{UserID: user.ID, RoleIDs: roles}); err != nil {
+			return false, err
+		}
+		updated = true
+	}
+	return updated, nil
+}
+
+func (uc *LdapUsecase) createLink(ctx context.Context, linkType int32, externalID, dn, targetID string) error {
+	return uc.linkRepo.Create(ctx, &Link{
+		ID:         uc.idgen.NextID(id.LDAP_LINK),
+		Type:       linkType,
+		ExternalID: externalID,
+		DN:         dn,
+		TargetID:   targetID,
+		TenantID:   ctxs.GetTenantID(ctx),
+	})
+}
+
+// reconcile 保留非目录管理的项，目录管理的项以 desired 为准
+func reconcile(current, desired, managed []string) ([]string, bool) {
+	next := slices.Filter(current, func(item string, index int) bool {
+		return !slices.Contains(managed, item)
+	})
+	next = slices.Uniq(append(next, desired...))
+	removed, added := slices.Difference(current, next)
+	return next, len(removed) > 0 || len(added) > 0
+}
+
+// userDeptIDs 用户所在的最近一级已同步 OU，不在任何 OU 下时归入根部门
+func userDeptIDs(dn string, deptByDN map[string]string, rootDeptID string) []string {
+	for parent := ldapclient.ParentDN(dn); parent != ""; parent = ldapclient.ParentDN(parent) {
+		if deptID, ok := deptByDN[parent]; ok {
+			return []string{deptID}
+		}
+	}
+	if rootDeptID != "" {
+		return []string{rootDeptID}
+	}
+	return nil
+}
+
+func memberUIDKey(username string) string {
+	return "uid:" + strings.ToLower(strings.TrimSpace(username))
+}
+
+func truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
@@ -0,0 +1,297 @@
+package oauth2
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"net"
+	"net/url"
+	authBiz "quest-admin/internal/biz/auth"
+	permBiz "quest-admin/internal/biz/permission"
+	userBiz "quest-admin/internal/biz/user"
+	"quest-admin/internal/data/idgen"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/lang/slices"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/pkg/util/pagination"
+	"quest-admin/types/consts/id"
+	"quest-admin/types/errkey"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ClientRepo OAuth2 客户端存储
+type ClientRepo interface {
+	Create(ctx context.Context, client *Client) error
+	FindByID(ctx context.Context, id string) (*Client, error)
+	// FindByClientID 按 client_id 查询，不限定租户，不存在时返回 nil
+	FindByClientID(ctx context.Context, clientID string) (*Client, error)
+	List(ctx context.Context, opt *WhereClientOpt) ([]*Client, error)
+	Count(ctx context.Context, opt *WhereClientOpt) (int64, error)
+	Update(ctx context.Context, client *Client) error
+	UpdateSecret(ctx context.Context, id, secret string) error
+	Delete(ctx context.Context, id string) error
+}
+
+const (
+	clientIDBytes     = 12
+	clientSecretBytes = 32
+)
+
+// ClientUsecase OAuth2 客户端管理
+type ClientUsecase struct {
+	repo        ClientRepo
+	idgen       *idgen.IDGenerator
+	menuUsecase *permBiz.MenuUsecase
+	authUsecase *authBiz.AuthUsecase
+	userUsecase *userBiz.UserUsecase
+	log         *log.Helper
+}
+
+func NewClientUsecase(logger log.Logger, repo ClientRepo, idgen *idgen.IDGenerator, menuUsecase *permBiz.MenuUsecase, authUsecase *authBiz.AuthUsecase, userUsecase *userBiz.UserUsecase) *ClientUsecase {
+	return &ClientUsecase{
+		repo:        repo,
+		idgen:       idgen,
+		menuUsecase: menuUsecase,
+		authUsecase: authUsecase,
+		userUsecase: userUsecase,
+		log:         log.NewHelper(log.With(logger, "module", "oauth2/biz/client")),
+	}
+}
+
+// CreateClient 注册客户端，机密客户端返回的密钥明文只在创建时出现一次
+func (uc *ClientUsecase) CreateClient(ctx context.Context, client *Client) (string, error) {
+	if err := uc.validateClient(ctx, client, nil); err != nil {
+		return "", err
+	}
+	clientID, err := randomString(clientIDBytes)
+	if err != nil {
+		return "", err
+	}
+	client.ID = uc.idgen.NextID(id.OAUTH2_CLIENT)
+	client.ClientID = clientID
+	client.TenantID = ctxs.GetTenantID(ctx)
+
+	var secret string
+	if client.ClientType == ClientTypeConfidential {
+		if secret, err = randomString(clientSecretBytes); err != nil {
+			return "", err
+		}
+		client.ClientSecret = hashSecret(secret)
+	}
+	if err = uc.repo.Create(ctx, client); err != nil {
+		uc.log.WithContext(ctx).Errorf("创建OAuth2客户端失败,error:%v", err)
+		return "", err
+	}
+	return secret, nil
+}
+
+func (uc *ClientUsecase) GetClient(ctx context.Context, id string) (*Client, error) {
+	client, err := uc.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return nil, errorx.Err(errkey.ErrOAuth2ClientNotFound)
+	}
+	return client, nil
+}
+
+func (uc *ClientUsecase) ListClients(ctx context.Context, query *ListClientsQuery) (*ListClientsResult, error) {
+	opt := &WhereClientOpt{
+		Limit:   query.PageSize,
+		Offset:  pagination.GetOffset(query.Page, query.PageSize),
+		Keyword: query.Keyword,
+		Status:  query.Status,
+	}
+	list, err := uc.repo.List(ctx, opt)
+	if err != nil {
+		uc.log.WithContext(ctx).Error("查询OAuth2客户端列表失败", err)
+		return nil, err
+	}
+	total, err := uc.repo.Count(ctx, opt)
+	if err != nil {
+		uc.log.WithContext(ctx).Error("查询OAuth2客户端总数失败", err)
+		return nil, err
+	}
+	return &ListClientsResult{
+		Clients:    list,
+		Total:      total,
+		Page:       query.Page,
+		PageSize:   query.PageSize,
+		TotalPages: pagination.GetTotalPages(total, int64(query.PageSize)),
+	}, nil
+}
+
+// UpdateClient 更新客户端，客户端类型和 client_id 不可修改；已有的授权范围保留，新增的需在当前用户的权限内
+func (uc *ClientUsecase) UpdateClient(ctx context.Context, client *Client) error {
+	current, err := uc.GetClient(ctx, client.ID)
+	if err != nil {
+		return err
+	}
+	client.ClientType = current.ClientType
+	if err = uc.validateClient(ctx, client, current.Scopes); err != nil {
+		return err
+	}
+	return uc.repo.Update(ctx, client)
+}
+
+// ResetClientSecret 重新生成机密客户端的密钥，旧密钥立即失效
+func (uc *ClientUsecase) ResetClientSecret(ctx context.Context, id string) (string, error) {
+	client, err := uc.GetClient(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if client.ClientType != ClientTypeConfidential {
+		return "", errorx.Err(errkey.ErrOAuth2ClientInvalid, "public client has no secret")
+	}
+	secret, err := randomString(clientSecretBytes)
+	if err != nil {
+		return "", err
+	}
+	if err = uc.repo.UpdateSecret(ctx, id, hashSecret(secret)); err != nil {
+		return "", err
+	}
+	return secret, nil
+}
+
+func (uc *ClientUsecase) DeleteClient(ctx context.Context, id string) error {
+	if _, err := uc.GetClient(ctx, id); err != nil {
+		return err
+	}
+	return uc.repo.Delete(ctx, id)
+}
+
+// validateClient 校验客户端配置，授权范围需在租户套餐内，且 existingScopes 之外的范围需是当前用户自己拥有的权限
+func (uc *ClientUsecase) validateClient(ctx context.Context, client *Client, existingScopes []string) error {
+	client.Name = strings.TrimSpace(client.Name)
+	if client.Name == "" {
+		return errorx.Err(errkey.ErrBadRequest, "name")
+	}
+	if client.ClientType != ClientTypeConfidential && client.ClientType != ClientTypePublic {
+		return errorx.Err(errkey.ErrOAuth2ClientInvalid, "client_type must be confidential or public")
+	}
+
+	client.GrantTypes = slices.Uniq(client.GrantTypes)
+	if len(client.GrantTypes) == 0 {
+		return errorx.Err(errkey.ErrOAuth2ClientInvalid, "grant_types is required")
+	}
+	for _, grantType := range client.GrantTypes {
+		switch grantType {
+		case GrantAuthorizationCode, GrantRefreshToken:
+		case GrantClientCredentials:
+			if client.ClientType == ClientTypePublic {
+				return errorx.Err(errkey.ErrOAuth2ClientInvalid, "public client cannot use client_credentials")
+			}
+		default:
+			return errorx.Err(errkey.ErrOAuth2ClientInvalid, "unsupported grant type "+grantType)
+		}
+	}
+
+	client.RedirectURIs = slices.Uniq(client.RedirectURIs)
+	if slices.Contains(client.GrantTypes, GrantAuthorizationCode) && len(client.RedirectURIs) == 0 {
+		return errorx.Err(errkey.ErrOAuth2ClientInvalid, "redirect_uris is required for authorization_code")
+	}
+	for _, redirectURI := range client.RedirectURIs {
+		if !validRedirectURI(redirectURI) {
+			return errorx.Err(errkey.ErrOAuth2ClientInvalid, "invalid redirect uri "+redirectURI)
+		}
+	}
+
+	client.Scopes = slices.Uniq(client.Scopes)
+	if len(client.Scopes) == 0 {
+		return errorx.Err(errkey.ErrOAuth2ClientInvalid, "scopes is required")
+	}
+	menus, err := uc.menuUsecase.ListPermissionMenus(ctx)
+	if err != nil {
+		return err
+	}
+	permissions := slices.Map(menus, func(item *permBiz.Menu, index int) string { return item.Permission })
+	for _, scope := range client.Scopes {
+		if !slices.Contains(permissions, scope) {
+			return errorx.Err(errkey.ErrOAuth2InvalidScope, scope)
+		}
+	}
+
+	added := slices.Filter(client.Scopes, func(item string, index int) bool { return !slices.Contains(existingScopes, item) })
+	if len(added) == 0 {
+		return nil
+	}
+	granted, err := uc.callerPermissions(ctx)
+	if err != nil {
+		return err
+	}
+	for _, scope := range added {
+		if !slices.Contains(granted, scope) {
+			return errorx.Err(errkey.ErrOAuth2ScopeDenied, scope)
+		}
+	}
+	return nil
+}
+
+// callerPermissions 当前登录用户生效的权限码，超级管理员切换租户时按其登录时所属的租户计算
+func (uc *ClientUsecase) callerPermissions(ctx context.Context) ([]string, error) {
+	if from, ok := ctxs.GetTenantSwitch(ctx); ok {
+		ctx = ctxs.WithTenantID(ctx, from)
+	}
+	user, err := uc.userUsecase.GetUser(ctx, ctxs.GetLoginID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errorx.Err(errkey.ErrUnauthorized)
+	}
+	_, granted, err := uc.authUsecase.UserAccess(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	// API Key 调用时不能超出 Key 本身的授权范围
+	if ctxs.IsScoped(ctx) {
+		scopes := ctxs.GetScopes(ctx)
+		granted = slices.Filter(granted, func(item string, index int) bool { return slices.Contains(scopes, item) })
+	}
+	return granted, nil
+}
+
+// validRedirectURI 回调地址必须是不含片段的绝对地址，除本机回环地址外只允许 https
+func validRedirectURI(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || u.Fragment != "" {
+		return false
+	}
+	switch u.Scheme {
+	case "https":
+		return true
+	case "http":
+		host := u.Hostname()
+		if host == "localhost" {
+			return true
+		}
+		ip := net.ParseIP(host)
+		return ip != nil && ip.IsLoopback()
+	default:
+		return false
+	}
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashSecret 客户端密钥、授权码均为高熵随机串，只以 SHA-256 哈希形式存储
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func secretMatches(secret, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(hashSecret(secret)), []byte(hash)) == 1
+}
@@ -0,0 +1,140 @@
+package oauth2
+
+import "time"
+
+const (
+	// ClientTypeConfidential 机密客户端，能安全保存密钥的服务端应用
+	ClientTypeConfidential = "confidential"
+	// ClientTypePublic 公开客户端，如单页应用和移动端，必须使用 PKCE
+	ClientTypePublic = "public"
+
+	GrantAuthorizationCode = "authorization_code"
+	GrantClientCredentials = "client_credentials"
+	GrantRefreshToken      = "refresh_token"
+
+	// CodeChallengeS256 仅支持 S256 方式的 PKCE
+	CodeChallengeS256 = "S256"
+
+	TokenTypeBearer = "Bearer"
+
+	// ScopeOpenID 申请该范围时令牌端点额外返回 ID Token
+	ScopeOpenID = "openid"
+)
+
+// Client OAuth2 客户端
+type Client struct {
+	ID           string
+	ClientID     string
+	Name         string
+	ClientSecret string
+	ClientType   string
+	RedirectURIs []string
+	GrantTypes   []string
+	// Scopes 客户端可申请的范围，对应菜单权限码
+	Scopes []string
+	// Trusted 第一方应用，授权时无需用户确认
+	Trusted  bool
+	Status   int32
+	Remark   string
+	CreateBy string
+	CreateAt time.Time
+	UpdateBy string
+	UpdateAt time.Time
+	TenantID string
+}
+
+type ListClientsQuery struct {
+	Page     int32
+	PageSize int32
+	Keyword  string
+	Status   *int32
+}
+
+type WhereClientOpt struct {
+	Limit   int32
+	Offset  int32
+	Keyword string
+	Status  *int32
+}
+
+type ListClientsResult struct {
+	Clients    []*Client
+	Total      int64
+	Page       int32
+	PageSize   int32
+	TotalPages int32
+}
+
+// AuthorizationCode 授权码，只保存授权码的哈希；RedirectURIProvided 表示授权请求显式携带了回调地址
+type AuthorizationCode struct {
+	Hash                string
+	ClientID            string
+	UserID              string
+	RedirectURI         string
+	RedirectURIProvided bool
+	Scopes              []string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	OpenID              bool
+	Nonce               string
+	TenantID            string
+}
+
+// AuthorizeRequest 授权请求参数
+type AuthorizeRequest struct {
+	ClientID            string
+	RedirectURI         string
+	ResponseType        string
+	Scope               string
+	State               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	Nonce               string
+}
+
+// Scope 授权范围及其对应的菜单名称
+type Scope struct {
+	Code string
+	Name string
+}
+
+// AuthorizeInfo 授权确认页展示的信息
+type AuthorizeInfo struct {
+	Client          *Client
+	RedirectURI     string
+	Scopes          []*Scope
+	OpenID          bool
+	ConsentRequired bool
+}
+
+// TokenRequest 令牌请求参数
+type TokenRequest struct {
+	GrantType    string
+	ClientID     string
+	ClientSecret string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+	RefreshToken string
+	Scope        string
+}
+
+// Token 令牌响应
+type Token struct {
+	AccessToken  string
+	TokenType    string
+	ExpiresIn    int64
+	RefreshToken string
+	Scope        string
+	IDToken      string
+}
+
+// Introspection 令牌自省结果
+type Introspection struct {
+	Active    bool
+	ClientID  string
+	Subject   string
+	Scope     string
+	TokenType string
+	ExpiresAt int64
+}
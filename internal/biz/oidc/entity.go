@@ -0,0 +1,48 @@
+package oidc
+
+import (
+	"crypto"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Options OpenID Connect 配置
+type Options struct {
+	Issuer         string
+	AuthorizeURL   string
+	Algorithm      string
+	Audience       string
+	IDTokenTTL     time.Duration
+	RotationPeriod time.Duration
+}
+
+// SigningKey ID Token 签名密钥，创建后即出现在 JWKS 中，ActivateAt 起用于签名，
+// ExpireAt 后不再用于签名，RetireAt 后不再出现在 JWKS 中
+type SigningKey struct {
+	ID         string
+	Algorithm  string
+	PrivateKey crypto.Signer
+	CreateAt   time.Time
+	ActivateAt time.Time
+	ExpireAt   time.Time
+	RetireAt   time.Time
+}
+
+// IDTokenBO 签发 ID Token 的参数，Audience 为空时使用配置的默认受众
+type IDTokenBO struct {
+	Audience string
+	Nonce    string
+}
+
+// Claims ID Token 和 UserInfo 的声明
+type Claims struct {
+	jwt.RegisteredClaims
+	Nonce             string   `json:"nonce,omitempty"`
+	PreferredUsername string   `json:"preferred_username,omitempty"`
+	Name              string   `json:"name,omitempty"`
+	Email             string   `json:"email,omitempty"`
+	Picture           string   `json:"picture,omitempty"`
+	TenantID          string   `json:"tenant_id"`
+	Roles             []string `json:"roles"`
+}
@@ -0,0 +1,339 @@
+package oidc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	authBiz "quest-admin/internal/biz/auth"
+	tenantBiz "quest-admin/internal/biz/tenant"
+	userBiz "quest-admin/internal/biz/user"
+	"quest-admin/internal/data/auth"
+	"quest-admin/internal/data/idgen"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/pkg/util/jwk"
+	"quest-admin/types/consts/id"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// KeyRepo 签名密钥存储
+type KeyRepo interface {
+	Options() *Options
+	// ListActive 查询未退役的密钥，按创建时间倒序
+	ListActive(ctx context.Context) ([]*SigningKey, error)
+	Create(ctx context.Context, key *SigningKey) error
+	// DeleteRetired 删除已退役的密钥
+	DeleteRetired(ctx context.Context) error
+}
+
+const (
+	// keyCacheTTL 密钥列表在内存中的缓存时间，多实例部署时其它实例轮换的密钥最迟在此时间后可见
+	keyCacheTTL = time.Minute
+	// JWKSMaxAge 客户端缓存 JWKS 的时间
+	JWKSMaxAge = 5 * time.Minute
+	// keyPublishLead 新密钥先只发布不签名的时长，覆盖客户端的 JWKS 缓存和其它实例的密钥缓存
+	keyPublishLead = JWKSMaxAge + keyCacheTTL
+)
+
+// OidcUsecase OpenID Connect 身份层，签发 ID Token 并发布验签公钥
+type OidcUsecase struct {
+	repo          KeyRepo
+	idgen         *idgen.IDGenerator
+	authManager   *auth.Manager
+	authUsecase   *authBiz.AuthUsecase
+	userUsecase   *userBiz.UserUsecase
+	ipRuleUsecase *authBiz.IpRuleUsecase
+	tenantUsecase *tenantBiz.TenantUsecase
+	log           *log.Helper
+
+	mu       sync.Mutex
+	keys     []*SigningKey
+	loadedAt time.Time
+}
+
+func NewOidcUsecase(
+	logger log.Logger,
+	repo KeyRepo,
+	idgen *idgen.IDGenerator,
+	authManager *auth.Manager,
+	authUsecase *authBiz.AuthUsecase,
+	userUsecase *userBiz.UserUsecase,
+	ipRuleUsecase *authBiz.IpRuleUsecase,
+	tenantUsecase *tenantBiz.TenantUsecase,
+) *OidcUsecase {
+	return &OidcUsecase{
+		repo:          repo,
+		idgen:         idgen,
+		authManager:   authManager,
+		authUsecase:   authUsecase,
+		userUsecase:   userUsecase,
+		ipRuleUsecase: ipRuleUsecase,
+		tenantUsecase: tenantUsecase,
+		log:           log.NewHelper(log.With(logger, "module", "oidc/biz/oidc")),
+	}
+}
+
+func (uc *OidcUsecase) Options() *Options {
+	return uc.repo.Options()
+}
+
+// IssueIDToken 为用户签发 ID Token，角色为用户当前生效的角色编码
+func (uc *OidcUsecase) IssueIDToken(ctx context.Context, user *userBiz.User, bo *IDTokenBO) (string, error) {
+	claims, err := uc.UserClaims(ctx, user)
+	if err != nil {
+		return "", err
+	}
+	opts := uc.repo.Options()
+	audience := bo.Audience
+	if audience == "" {
+		audience = opts.Audience
+	}
+	now := time.Now()
+	claims.Issuer = opts.Issuer
+	claims.Audience = jwt.ClaimStrings{audience}
+	claims.IssuedAt = jwt.NewNumericDate(now)
+	claims.ExpiresAt = jwt.NewNumericDate(now.Add(opts.IDTokenTTL))
+	claims.Nonce = bo.Nonce
+
+	key, err := uc.signingKey(ctx, now)
+	if err != nil {
+		return "", err
+	}
+	method, err := jwk.SigningMethod(key.Algorithm)
+	if err != nil {
+		return "", err
+	}
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = key.ID
+	signed, err := token.SignedString(key.PrivateKey)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("签发ID Token失败,userID:%s,error:%v", user.ID, err)
+		return "", err
+	}
+	return signed, nil
+}
+
+// UserClaims 用户的身份声明，用于 ID Token 和 UserInfo
+func (uc *OidcUsecase) UserClaims(ctx context.Context, user *userBiz.User) (*Claims, error) {
+	roles, _, err := uc.authUsecase.UserAccess(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if roles == nil {
+		roles = []string{}
+	}
+	return &Claims{
+		RegisteredClaims:  jwt.RegisteredClaims{Subject: user.ID},
+		PreferredUsername: user.Username,
+		Name:              user.Nickname,
+		Email:             user.Email,
+		Picture:           user.Avatar,
+		TenantID:          user.TenantID,
+		Roles:             roles,
+	}, nil
+}
+
+// UserInfo 按访问令牌查询用户的身份声明，所属租户停用、过期或来源 IP 不在白名单内时拒绝
+func (uc *OidcUsecase) UserInfo(ctx context.Context, accessToken string) (*Claims, error) {
+	if accessToken == "" {
+		return nil, errorx.Err(errkey.ErrTokenInvalid)
+	}
+	loginID, err := uc.authManager.Admin.GetLoginID(accessToken)
+	if err != nil {
+		return nil, errorx.Err(errkey.ErrTokenInvalid)
+	}
+	// 租户以令牌绑定的为准：OAuth2 令牌取授权记录，登录令牌取会话，不信任请求头
+	grant, err := uc.authManager.GetTokenGrant(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	var tenantID string
+	if grant != nil {
+		tenantID = grant.TenantID
+	} else {
+		var ok bool
+		if tenantID, ok, err = uc.authManager.SessionTenant(ctx, accessToken); err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, errorx.Err(errkey.ErrTokenInvalid)
+		}
+	}
+	ctx = ctxs.WithTenantID(ctx, tenantID)
+	if err = uc.tenantUsecase.CheckAvailable(ctx, tenantID); err != nil {
+		return nil, err
+	}
+	if err = uc.ipRuleUsecase.Enforce(ctx, loginID); err != nil {
+		return nil, err
+	}
+	user, err := uc.userUsecase.GetUser(ctx, loginID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errorx.Err(errkey.ErrTokenInvalid)
+	}
+	if ok, err := uc.userUsecase.VerifyStatus(ctx, user); err != nil || !ok {
+		return nil, errorx.Err(errkey.ErrUserDisabled)
+	}
+	return uc.UserClaims(ctx, user)
+}
+
+// JWKS 当前发布的验签公钥，包含仍在签名的密钥和已停止签名但未退役的密钥
+func (uc *OidcUsecase) JWKS(ctx context.Context) (*jwk.Set, error) {
+	now := time.Now()
+	if _, err := uc.signingKey(ctx, now); err != nil {
+		return nil, err
+	}
+	keys, err := uc.activeKeys(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	set := &jwk.Set{Keys: make([]*jwk.Key, 0, len(keys))}
+	for _, key := range keys {
+		if !key.RetireAt.After(now) {
+			continue
+		}
+		public, err := jwk.PublicKey(key.ID, key.Algorithm, key.PrivateKey.Public())
+		if err != nil {
+			return nil, err
+		}
+		set.Keys = append(set.Keys, public)
+	}
+	return set, nil
+}
+
+// signingKey 返回当前用于签名的密钥。当前密钥即将停止签名或算法配置变更时提前生成下一个密钥，
+// 新密钥先在 JWKS 中发布 keyPublishLead 后才开始签名，期间继续使用旧密钥
+func (uc *OidcUsecase) signingKey(ctx context.Context, now time.Time) (*SigningKey, error) {
+	keys, err := uc.activeKeys(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	if key := uc.currentKey(keys, now); key != nil && uc.hasSuccessor(keys, now) {
+		return key, nil
+	}
+
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	// 其它实例可能已经完成轮换，生成前强制重新加载
+	keys, err = uc.loadKeys(ctx, now, true)
+	if err != nil {
+		return nil, err
+	}
+	if !uc.hasSuccessor(keys, now) {
+		// 首次生成密钥时还没有签发过令牌，无需等待发布
+		activateAt := now
+		if len(keys) > 0 {
+			activateAt = now.Add(keyPublishLead)
+		}
+		key, err := uc.rotate(ctx, now, activateAt)
+		if err != nil {
+			return nil, err
+		}
+		keys = append([]*SigningKey{key}, keys...)
+		uc.keys = keys
+	}
+	if key := uc.currentKey(keys, now); key != nil {
+		return key, nil
+	}
+	// 长时间没有请求错过了提前发布的窗口，旧密钥已停止签名，只能提前启用待生效的密钥
+	key := uc.pendingKey(keys, now)
+	uc.log.WithContext(ctx).Warnf("签名密钥发布未满%s即启用,kid:%s", keyPublishLead, key.ID)
+	return key, nil
+}
+
+func (uc *OidcUsecase) rotate(ctx context.Context, now, activateAt time.Time) (*SigningKey, error) {
+	opts := uc.repo.Options()
+	privateKey, err := jwk.GenerateKey(opts.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+	key := &SigningKey{
+		ID:         uc.idgen.NextID(id.OIDC_KEY),
+		Algorithm:  opts.Algorithm,
+		PrivateKey: privateKey,
+		CreateAt:   now,
+		ActivateAt: activateAt,
+		ExpireAt:   activateAt.Add(opts.RotationPeriod),
+		// 停止签名后继续发布一个 ID Token 有效期，保证已签发的令牌可以验签
+		RetireAt: activateAt.Add(opts.RotationPeriod + opts.IDTokenTTL),
+	}
+	if err = uc.repo.Create(ctx, key); err != nil {
+		uc.log.WithContext(ctx).Errorf("保存签名密钥失败,error:%v", err)
+		return nil, err
+	}
+	if err = uc.repo.DeleteRetired(ctx); err != nil {
+		uc.log.WithContext(ctx).Warnf("清理退役签名密钥失败,error:%v", err)
+	}
+	uc.log.WithContext(ctx).Infof("已轮换ID Token签名密钥,kid:%s", key.ID)
+	return key, nil
+}
+
+// currentKey 已生效且未停止签名的密钥，优先使用配置的算法，算法变更后新密钥生效前继续使用旧算法的密钥
+func (uc *OidcUsecase) currentKey(keys []*SigningKey, now time.Time) *SigningKey {
+	algorithm := uc.repo.Options().Algorithm
+	var fallback *SigningKey
+	for _, key := range keys {
+		if key.ActivateAt.After(now) || !key.ExpireAt.After(now) {
+			continue
+		}
+		if key.Algorithm == algorithm {
+			return key
+		}
+		if fallback == nil {
+			fallback = key
+		}
+	}
+	return fallback
+}
+
+// hasSuccessor 是否已有配置算法的密钥在 keyPublishLead 之后仍可签名，没有时需要提前生成下一个密钥
+func (uc *OidcUsecase) hasSuccessor(keys []*SigningKey, now time.Time) bool {
+	algorithm := uc.repo.Options().Algorithm
+	for _, key := range keys {
+		if key.Algorithm == algorithm && key.ExpireAt.After(now.Add(keyPublishLead)) {
+			return true
+		}
+	}
+	return false
+}
+
+// pendingKey 最早生效的待生效密钥
+func (uc *OidcUsecase) pendingKey(keys []*SigningKey, now time.Time) *SigningKey {
+	var pending *SigningKey
+	for _, key := range keys {
+		if key.ActivateAt.After(now) && (pending == nil || key.ActivateAt.Before(pending.ActivateAt)) {
+			pending = key
+		}
+	}
+	return pending
+}
+
+func (uc *OidcUsecase) activeKeys(ctx context.Context, now time.Time) ([]*SigningKey, error) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	return uc.loadKeys(ctx, now, false)
+}
+
+// loadKeys 需持有 uc.mu
+func (uc *OidcUsecase) loadKeys(ctx context.Context, now time.Time, force bool) ([]*SigningKey, error) {
+	if !force && uc.keys != nil && now.Sub(uc.loadedAt) < keyCacheTTL {
+		return uc.keys, nil
+	}
+	keys, err := uc.repo.ListActive(ctx)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("查询签名密钥失败,error:%v", err)
+		return nil, err
+	}
+	if keys == nil {
+		keys = []*SigningKey{}
+	}
+	uc.keys = keys
+	uc.loadedAt = now
+	return keys, nil
+}
@@ -0,0 +1,18 @@
+package onboarding
+
+import tenantBiz "quest-admin/internal/biz/tenant"
+
+// OnboardTenantBO 开通租户，管理员使用租户联系人信息创建
+type OnboardTenantBO struct {
+	Tenant *tenantBiz.Tenant
+	// AdminUsername 管理员用户名，为空时使用 admin
+	AdminUsername string
+}
+
+// TenantCredentials 租户管理员的一次性登录凭证，密码只在开通时返回，首次登录需修改
+type TenantCredentials struct {
+	TenantID string
+	UserID   string
+	Username string
+	Password string
+}
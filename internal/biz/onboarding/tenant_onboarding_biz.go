@@ -0,0 +1,141 @@
+package onboarding
+
+import (
+	"context"
+	permBiz "quest-admin/internal/biz/permission"
+	tenantBiz "quest-admin/internal/biz/tenant"
+	userBiz "quest-admin/internal/biz/user"
+	"quest-admin/internal/data/transaction"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/lang/slices"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultAdminUsername = "admin"
+	tenantAdminRoleName  = "租户管理员"
+	tenantAdminRoleCode  = "tenant_admin"
+)
+
+// TenantOnboardingUsecase 开通租户，创建租户及其管理员账号和管理员角色
+type TenantOnboardingUsecase struct {
+	tm             transaction.Manager
+	tenantUsecase  *tenantBiz.TenantUsecase
+	packageUsecase *tenantBiz.TenantPackageUsecase
+	userUsecase    *userBiz.UserUsecase
+	roleUsecase    *permBiz.RoleUsecase
+	menuUsecase    *permBiz.MenuUsecase
+	log            *log.Helper
+}
+
+func NewTenantOnboardingUsecase(
+	logger log.Logger,
+	tm transaction.Manager,
+	tenantUsecase *tenantBiz.TenantUsecase,
+	packageUsecase *tenantBiz.TenantPackageUsecase,
+	userUsecase *userBiz.UserUsecase,
+	roleUsecase *permBiz.RoleUsecase,
+	menuUsecase *permBiz.MenuUsecase,
+) *TenantOnboardingUsecase {
+	return &TenantOnboardingUsecase{
+		tm:             tm,
+		tenantUsecase:  tenantUsecase,
+		packageUsecase: packageUsecase,
+		userUsecase:    userUsecase,
+		roleUsecase:    roleUsecase,
+		menuUsecase:    menuUsecase,
+		log:            log.NewHelper(log.With(logger, "module", "onboarding/biz/tenant")),
+	}
+}
+
+// OnboardTenant 在同一事务中创建租户、管理员账号和绑定套餐全部菜单的管理员角色，任一步骤失败时整体回滚
+func (uc *TenantOnboardingUsecase) OnboardTenant(ctx context.Context, bo *OnboardTenantBO) (*TenantCredentials, error) {
+	tenant := bo.Tenant
+	// 管理员角色的菜单以套餐为限，未绑定套餐的租户无法确定可用菜单
+	if tenant.PackageID == "" {
+		return nil, errorx.Err(errkey.ErrTenantPackageRequired)
+	}
+	pkg, err := uc.packageUsecase.GetTenantPackage(ctx, tenant.PackageID)
+	if err != nil {
+		return nil, err
+	}
+	if pkg == nil {
+		return nil, errorx.Err(errkey.ErrTenantPackageNotFound)
+	}
+	if pkg.Status != 1 {
+		return nil, errorx.Err(errkey.ErrInvalidTenantPackageStatus)
+	}
+	username := bo.AdminUsername
+	if username == "" {
+		username = defaultAdminUsername
+	}
+	password, err := uc.userUsecase.GeneratePassword()
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("生成初始密码出现错误,error:%v", err)
+		return nil, errorx.Err(errkey.ErrInternalServer)
+	}
+
+	credentials := &TenantCredentials{Username: username, Password: password}
+	err = uc.tm.Tx(ctx, func(ctx context.Context) error {
+		if err := uc.tenantUsecase.CreateTenant(ctx, tenant); err != nil {
+			return err
+		}
+		// 后续数据均归属于新租户
+		tctx := ctxs.WithTenantID(ctx, tenant.ID)
+
+		admin := &userBiz.User{
+			Username: username,
+			Password: password,
+			Nickname: tenant.ContactName,
+			Mobile:   tenant.ContactMobile,
+			Status:   1,
+			TenantID: tenant.ID,
+		}
+		if err := uc.userUsecase.CreateUser(tctx, admin); err != nil {
+			return err
+		}
+
+		role, err := uc.roleUsecase.CreateRole(tctx, &permBiz.Role{
+			Name:      tenantAdminRoleName,
+			Code:      tenantAdminRoleCode,
+			DataScope: permBiz.DataScopeAll,
+			Status:    1,
+			Type:      1,
+		})
+		if err != nil {
+			return err
+		}
+		// 新租户已绑定套餐，只会得到套餐内的菜单
+		menus, err := uc.menuUsecase.ListMenus(tctx)
+		if err != nil {
+			return err
+		}
+		err = uc.roleUsecase.AssignRoleMenu(tctx, &permBiz.AssignRoleMenuBO{
+			RoleID:  role.ID,
+			MenuIDs: slices.Map(menus, func(item *permBiz.Menu, index int) string { return item.ID }),
+		})
+		if err != nil {
+			return err
+		}
+		err = uc.userUsecase.AssignUserRoles(tctx, &userBiz.AssignUserRolesBO{UserID: admin.ID, RoleIDs: []string{role.ID}})
+		if err != nil {
+			return err
+		}
+
+		tenant.ContactUserID = admin.ID
+		if err := uc.tenantUsecase.UpdateTenant(ctx, tenant); err != nil {
+			return err
+		}
+		credentials.TenantID = tenant.ID
+		credentials.UserID = admin.ID
+		return nil
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("开通租户出现错误,name:%s,error:%v", tenant.Name, err)
+		return nil, err
+	}
+	return credentials, nil
+}
@@ -52,6 +52,12 @@ func (uc *DepartmentUsecase) CreateDepartment(ctx context.Context, dept *Departm
 	return uc.repo.Create(ctx, dept)
 }
 
+// ImportDepartment 创建目录同步导入的部门，目录中的部门名称只在同级唯一，不做重名校验
+func (uc *DepartmentUsecase) ImportDepartment(ctx context.Context, dept *Department) (*Department, error) {
+	dept.ID = uc.idgen.NextID(id.DEPT)
+	return uc.repo.Create(ctx, dept)
+}
+
 func (uc *DepartmentUsecase) GetDepartment(ctx context.Context, id string) (*Department, error) {
 	uc.log.WithContext(ctx).Infof("GetDepartment: id=%s", id)
 	return uc.repo.FindByID(ctx, id)
@@ -68,18 +74,24 @@ func (uc *DepartmentUsecase) GetDepartmentTree(ctx context.Context) ([]*Departme
 		deptMap[dept.ID] = dept
 	}
 
+	// 受数据范围限制时上级部门可能不可见，此时作为根节点展示
 	var roots []*Department
 	for _, dept := range deptMap {
-		if dept.ParentID == "" {
-			roots = append(roots, dept)
-		} else if parent, ok := deptMap[dept.ParentID]; ok {
+		if parent, ok := deptMap[dept.ParentID]; ok {
 			parent.Children = append(parent.Children, dept)
+		} else {
+			roots = append(roots, dept)
 		}
 	}
 
 	return roots, nil
 }
 
+// ListDepartments 获取全部部门的平铺列表
+func (uc *DepartmentUsecase) ListDepartments(ctx context.Context) ([]*Department, error) {
+	return uc.repo.List(ctx)
+}
+
 func (uc *DepartmentUsecase) UpdateDepartment(ctx context.Context, dept *Department) (*Department, error) {
 	_, err := uc.repo.FindByID(ctx, dept.ID)
 	if err != nil {
@@ -0,0 +1,133 @@
+package permission
+
+import (
+	"context"
+	orgBiz "quest-admin/internal/biz/organization"
+	userBiz "quest-admin/internal/biz/user"
+	"quest-admin/pkg/lang/slices"
+	"quest-admin/pkg/util/ctxs"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// DataScopeAll 全部数据
+	DataScopeAll int32 = 1
+	// DataScopeCustom 自定义部门，部门由 DataScopeDeptIDs 指定
+	DataScopeCustom int32 = 2
+	// DataScopeDept 本部门
+	DataScopeDept int32 = 3
+	// DataScopeDeptAndChild 本部门及以下
+	DataScopeDeptAndChild int32 = 4
+	// DataScopeSelf 仅本人
+	DataScopeSelf int32 = 5
+)
+
+// DataScopeUsecase 根据用户的角色和所属部门解析可访问的数据范围
+type DataScopeUsecase struct {
+	roleUsecase *RoleUsecase
+	userUsecase *userBiz.UserUsecase
+	deptUsecase *orgBiz.DepartmentUsecase
+	log         *log.Helper
+}
+
+func NewDataScopeUsecase(logger log.Logger, roleUsecase *RoleUsecase, userUsecase *userBiz.UserUsecase, deptUsecase *orgBiz.DepartmentUsecase) *DataScopeUsecase {
+	return &DataScopeUsecase{
+		roleUsecase: roleUsecase,
+		userUsecase: userUsecase,
+		deptUsecase: deptUsecase,
+		log:         log.NewHelper(log.With(logger, "module", "permission/biz/data_scope")),
+	}
+}
+
+// Resolve 合并用户所有启用角色的数据范围，任一角色为全部数据时不限制；没有可用角色时仅可访问本人数据。
+// 超级管理员切换租户后在目标租户没有角色，不限制数据范围
+func (uc *DataScopeUsecase) Resolve(ctx context.Context, userID string) (*ctxs.DataScope, error) {
+	if _, switched := ctxs.GetTenantSwitch(ctx); switched {
+		return &ctxs.DataScope{All: true, UserID: userID}, nil
+	}
+	roleIDs, err := uc.userUsecase.GetUserRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := uc.roleUsecase.ListByRoleIDs(ctx, roleIDs)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("查询用户角色失败,userID:%s,error:%v", userID, err)
+		return nil, err
+	}
+	roles = slices.Filter(roles, func(item *Role, index int) bool { return item.Status == 1 })
+
+	needOwnDept := false
+	for _, role := range roles {
+		if role.DataScope == DataScopeAll {
+			return &ctxs.DataScope{All: true, UserID: userID}, nil
+		}
+		needOwnDept = needOwnDept || role.DataScope == DataScopeDept || role.DataScope == DataScopeDeptAndChild
+	}
+	var ownDeptIDs []string
+	if needOwnDept {
+		if ownDeptIDs, err = uc.userUsecase.GetUserDepts(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	scope := &ctxs.DataScope{UserID: userID, Self: len(roles) == 0}
+	for _, role := range roles {
+		switch role.DataScope {
+		case DataScopeCustom:
+			scope.DeptIDs = append(scope.DeptIDs, ParseDataScopeDeptIDs(role.DataScopeDeptIDs)...)
+		case DataScopeDept, DataScopeDeptAndChild:
+			ids := ownDeptIDs
+			if role.DataScope == DataScopeDeptAndChild {
+				if ids, err = uc.withDescendants(ctx, ids); err != nil {
+					return nil, err
+				}
+			}
+			scope.DeptIDs = append(scope.DeptIDs, ids...)
+		case DataScopeSelf:
+			scope.Self = true
+		default:
+			uc.log.WithContext(ctx).Warnf("未知的数据范围,roleID:%s,dataScope:%d", role.ID, role.DataScope)
+		}
+	}
+	scope.DeptIDs = slices.Uniq(scope.DeptIDs)
+	return scope, nil
+}
+
+// withDescendants 返回指定部门及其全部下级部门
+func (uc *DataScopeUsecase) withDescendants(ctx context.Context, deptIDs []string) ([]string, error) {
+	if len(deptIDs) == 0 {
+		return deptIDs, nil
+	}
+	depts, err := uc.deptUsecase.ListDepartments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	children := make(map[string][]string, len(depts))
+	for _, dept := range depts {
+		children[dept.ParentID] = append(children[dept.ParentID], dept.ID)
+	}
+	result := append([]string{}, deptIDs...)
+	visited := slices.ToMap(deptIDs, func(item string) (string, bool) { return item, true })
+	for i := 0; i < len(result); i++ {
+		for _, child := range children[result[i]] {
+			if !visited[child] {
+				visited[child] = true
+				result = append(result, child)
+			}
+		}
+	}
+	return result, nil
+}
+
+// ParseDataScopeDeptIDs 解析自定义数据范围的部门，兼容逗号分隔和 JSON 数组两种写法
+func ParseDataScopeDeptIDs(s string) []string {
+	var ids []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.Trim(item, " \"[]"); item != "" {
+			ids = append(ids, item)
+		}
+	}
+	return ids
+}
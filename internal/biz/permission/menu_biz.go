@@ -2,8 +2,11 @@ package permission
 
 import (
 	"context"
+	tenantBiz "quest-admin/internal/biz/tenant"
 	"quest-admin/internal/data/idgen"
 	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/lang/slices"
+	"quest-admin/pkg/util/ctxs"
 	"quest-admin/types/consts/id"
 	"quest-admin/types/errkey"
 	"sort"
@@ -23,16 +26,20 @@ type MenuRepo interface {
 }
 
 type MenuUsecase struct {
-	idgen *idgen.IDGenerator
-	repo  MenuRepo
-	log   *log.Helper
+	idgen          *idgen.IDGenerator
+	repo           MenuRepo
+	tenantUsecase  *tenantBiz.TenantUsecase
+	packageUsecase *tenantBiz.TenantPackageUsecase
+	log            *log.Helper
 }
 
-func NewMenuUsecase(idgen *idgen.IDGenerator, repo MenuRepo, logger log.Logger) *MenuUsecase {
+func NewMenuUsecase(idgen *idgen.IDGenerator, repo MenuRepo, tenantUsecase *tenantBiz.TenantUsecase, packageUsecase *tenantBiz.TenantPackageUsecase, logger log.Logger) *MenuUsecase {
 	return &MenuUsecase{
-		idgen: idgen,
-		repo:  repo,
-		log:   log.NewHelper(log.With(logger, "module", "permission/biz/menu")),
+		idgen:          idgen,
+		repo:           repo,
+		tenantUsecase:  tenantUsecase,
+		packageUsecase: packageUsecase,
+		log:            log.NewHelper(log.With(logger, "module", "permission/biz/menu")),
 	}
 }
 
@@ -56,12 +63,15 @@ func (uc *MenuUsecase) GetMenu(ctx context.Context, id string) (*Menu, error) {
 	return uc.repo.FindByID(ctx, id)
 }
 
+// GetMenuTree 获取菜单树，只包含当前租户套餐内的菜单
 func (uc *MenuUsecase) GetMenuTree(ctx context.Context) ([]*Menu, error) {
-	// TODO: 根据租户套餐过滤菜单
 	menus, err := uc.repo.List(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if menus, err = uc.FilterByPackage(ctx, menus); err != nil {
+		return nil, err
+	}
 
 	menuTree, err := uc.BuildMenuTree(menus)
 	if err != nil {
@@ -141,6 +151,82 @@ func (uc *MenuUsecase) ListByMenuIDs(ctx context.Context, menuIDs []string) ([]*
 	return menus, nil
 }
 
+// ListMenus 获取当前租户套餐内的全部菜单
+func (uc *MenuUsecase) ListMenus(ctx context.Context) ([]*Menu, error) {
+	menus, err := uc.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return uc.FilterByPackage(ctx, menus)
+}
+
+// ListPermissionMenus 获取当前租户套餐内声明了权限码且未停用的菜单
+func (uc *MenuUsecase) ListPermissionMenus(ctx context.Context) ([]*Menu, error) {
+	menus, err := uc.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if menus, err = uc.FilterByPackage(ctx, menus); err != nil {
+		return nil, err
+	}
+	var result []*Menu
+	for _, menu := range uc.ProcessDisabledMenus(menus) {
+		if menu.Permission != "" {
+			result = append(result, menu)
+		}
+	}
+	return result, nil
+}
+
+// PackageMenuIDs 获取当前租户套餐可用的菜单，套餐内菜单的上级菜单同样可用。
+// 返回 nil 表示不限制，只有全局租户不受套餐限制；未登记、未绑定套餐的租户以及套餐停用或不存在时没有可用菜单
+func (uc *MenuUsecase) PackageMenuIDs(ctx context.Context) (map[string]bool, error) {
+	tenantID := ctxs.GetTenantID(ctx)
+	if tenantID == "" {
+		return nil, nil
+	}
+	tenant, err := uc.tenantUsecase.GetTenant(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	allowed := make(map[string]bool)
+	if tenant == nil || tenant.PackageID == "" {
+		return allowed, nil
+	}
+	pkg, err := uc.packageUsecase.GetTenantPackage(ctx, tenant.PackageID)
+	if err != nil {
+		return nil, err
+	}
+	if pkg == nil || pkg.Status != 1 {
+		return allowed, nil
+	}
+	menus, err := uc.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	menuMap := slices.ToMap(menus, func(item *Menu) (string, *Menu) { return item.ID, item })
+	for _, menuID := range pkg.MenuIDList() {
+		for menuID != "" && !allowed[menuID] {
+			allowed[menuID] = true
+			menu, ok := menuMap[menuID]
+			if !ok {
+				break
+			}
+			menuID = menu.ParentID
+		}
+	}
+	return allowed, nil
+}
+
+// FilterByPackage 过滤掉当前租户套餐外的菜单
+func (uc *MenuUsecase) FilterByPackage(ctx context.Context, menus []*Menu) ([]*Menu, error) {
+	allowed, err := uc.PackageMenuIDs(ctx)
+	if err != nil || allowed == nil {
+		return menus, err
+	}
+	return slices.Filter(menus, func(item *Menu, index int) bool { return allowed[item.ID] }), nil
+}
+
 func (uc *MenuUsecase) ProcessDisabledMenus(menus []*Menu) []*Menu {
 	var result []*Menu
 	for _, menu := range menus {
@@ -6,6 +6,7 @@ import (
 	"quest-admin/internal/data/transaction"
 	"quest-admin/pkg/errorx"
 	"quest-admin/pkg/lang/slices"
+	"quest-admin/pkg/util/ctxs"
 	"quest-admin/pkg/util/pagination"
 	"quest-admin/types/consts/id"
 	"quest-admin/types/errkey"
@@ -32,6 +33,8 @@ type RoleMenuRepo interface {
 	GetRoleMenus(ctx context.Context, roleID string) ([]*RoleMenu, error)
 	GetMenuIDs(ctx context.Context, roleID string) ([]string, error)
 	FindListByRoleIDs(ctx context.Context, roles []string) ([]*RoleMenu, error)
+	// List 获取当前租户全部角色的菜单关联
+	List(ctx context.Context) ([]*RoleMenu, error)
 }
 
 type RoleUsecase struct {
@@ -39,15 +42,17 @@ type RoleUsecase struct {
 	idgen        *idgen.IDGenerator
 	repo         RoleRepo
 	roleMenuRepo RoleMenuRepo
+	menuUsecase  *MenuUsecase
 	log          *log.Helper
 }
 
-func NewRoleUsecase(tm transaction.Manager, idgen *idgen.IDGenerator, repo RoleRepo, roleMenuRepo RoleMenuRepo, logger log.Logger) *RoleUsecase {
+func NewRoleUsecase(tm transaction.Manager, idgen *idgen.IDGenerator, repo RoleRepo, roleMenuRepo RoleMenuRepo, menuUsecase *MenuUsecase, logger log.Logger) *RoleUsecase {
 	return &RoleUsecase{
 		tm:           tm,
 		idgen:        idgen,
 		repo:         repo,
 		roleMenuRepo: roleMenuRepo,
+		menuUsecase:  menuUsecase,
 		log:          log.NewHelper(log.With(logger, "module", "permission/biz/role")),
 	}
 }
@@ -71,6 +76,9 @@ func (uc *RoleUsecase) CreateRole(ctx context.Context, role *Role) (*Role, error
 		return nil, errorx.Err(errkey.ErrRoleCodeExists)
 	}
 	role.ID = uc.idgen.NextID(id.ROLE)
+	if role.DataScope == 0 {
+		role.DataScope = DataScopeAll
+	}
 
 	return uc.repo.Create(ctx, role)
 }
@@ -151,6 +159,15 @@ func (uc *RoleUsecase) AssignRoleMenu(ctx context.Context, bo *AssignRoleMenuBO)
 	if role == nil {
 		return errorx.Err(errkey.ErrRoleNotFound)
 	}
+	allowed, err := uc.menuUsecase.PackageMenuIDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, menuID := range bo.MenuIDs {
+		if allowed != nil && !allowed[menuID] {
+			return errorx.Err(errkey.ErrMenuNotInPackage, menuID)
+		}
+	}
 
 	dbRoleMenus, err := uc.roleMenuRepo.GetRoleMenus(ctx, bo.RoleID)
 	if err != nil {
@@ -221,9 +238,61 @@ func (uc *RoleUsecase) GetMenusByRoleIDs(ctx context.Context, roles []string) ([
 	}
 	allMenuIDs = slices.Uniq(allMenuIDs)
 
+	// 套餐收缩后尚未清理的关联同样不生效
+	allowed, err := uc.menuUsecase.PackageMenuIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if allowed != nil {
+		allMenuIDs = slices.Filter(allMenuIDs, func(item string, index int) bool { return allowed[item] })
+	}
 	return allMenuIDs, nil
 }
 
+// PruneMenus 删除当前租户角色上超出套餐范围的菜单
+func (uc *RoleUsecase) PruneMenus(ctx context.Context) error {
+	allowed, err := uc.menuUsecase.PackageMenuIDs(ctx)
+	if err != nil || allowed == nil {
+		return err
+	}
+	roleMenus, err := uc.roleMenuRepo.List(ctx)
+	if err != nil {
+		return err
+	}
+	outside := slices.Filter(roleMenus, func(item *RoleMenu, index int) bool { return !allowed[item.MenuID] })
+	if len(outside) == 0 {
+		return nil
+	}
+	err = uc.tm.Tx(ctx, func(ctx context.Context) error {
+		for _, item := range outside {
+			if err := uc.roleMenuRepo.Delete(ctx, item.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("清理套餐外的角色菜单出现错误,tenantID:%s,error:%v", ctxs.GetTenantID(ctx), err)
+		return err
+	}
+	uc.log.WithContext(ctx).Infof("已清理套餐外的角色菜单,tenantID:%s,count:%d", ctxs.GetTenantID(ctx), len(outside))
+	return nil
+}
+
+// PrunePackageMenus 套餐变更后清理所有使用该套餐的租户的角色菜单
+func (uc *RoleUsecase) PrunePackageMenus(ctx context.Context, packageID string) error {
+	tenantIDs, err := uc.menuUsecase.tenantUsecase.ListTenantIDsByPackage(ctx, packageID)
+	if err != nil {
+		return err
+	}
+	for _, tenantID := range tenantIDs {
+		if err = uc.PruneMenus(ctxs.WithTenantID(ctx, tenantID)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (uc *RoleUsecase) ListByRoleIDs(ctx context.Context, roleIds []string) ([]*Role, error) {
 	var res []*Role
 	if len(roleIds) == 0 {
@@ -0,0 +1,69 @@
+package scim
+
+import (
+	"time"
+
+	"quest-admin/pkg/util/scimfilter"
+)
+
+// GroupTarget SCIM 组映射到的本地数据
+const (
+	GroupTargetDept int32 = 1
+	GroupTargetRole int32 = 2
+)
+
+// Config 租户的 SCIM 配置，每个租户一份，令牌只保存哈希
+type Config struct {
+	ID          string
+	TokenPrefix string
+	TokenHash   string
+	GroupTarget int32
+	// RootDeptID 组映射为部门时，新建部门挂载的上级部门，为空时作为顶级部门
+	RootDeptID string
+	Status     int32
+	CreateBy   string
+	CreateAt   time.Time
+	UpdateBy   string
+	UpdateAt   time.Time
+	TenantID   string
+}
+
+// User SCIM 用户资源中映射到本地用户的属性
+type User struct {
+	ID          string
+	UserName    string
+	DisplayName string
+	Email       string
+	Mobile      string
+	Active      bool
+	CreateAt    time.Time
+	UpdateAt    time.Time
+}
+
+// Group SCIM 组资源，Members 为成员用户编号
+type Group struct {
+	ID          string
+	DisplayName string
+	Members     []string
+	CreateAt    time.Time
+	UpdateAt    time.Time
+}
+
+// ListQuery SCIM 列表查询，StartIndex 从 1 开始
+type ListQuery struct {
+	Filter     []*scimfilter.Condition
+	StartIndex int32
+	Count      int32
+}
+
+type ListUsersResult struct {
+	Users      []*User
+	Total      int64
+	StartIndex int32
+}
+
+type ListGroupsResult struct {
+	Groups     []*Group
+	Total      int64
+	StartIndex int32
+}
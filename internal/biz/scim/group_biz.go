@@ -0,0 +1,304 @@
+package scim
+
+import (
+	"context"
+	"strings"
+
+	orgBiz "quest-admin/internal/biz/organization"
+	permBiz "quest-admin/internal/biz/permission"
+	userBiz "quest-admin/internal/biz/user"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/lang/slices"
+	"quest-admin/pkg/util/scimfilter"
+	"quest-admin/types/consts/id"
+	"quest-admin/types/errkey"
+)
+
+// GetGroup 获取 SCIM 组及其成员
+func (uc *ScimUsecase) GetGroup(ctx context.Context, id string) (*Group, error) {
+	cfg, err := uc.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	group, err := uc.findGroup(ctx, cfg, id)
+	if err != nil {
+		return nil, err
+	}
+	if group.Members, err = uc.groupMembers(ctx, cfg, id); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// ListGroups 查询组，支持按 displayName 和 id 过滤，excludeMembers 为 true 时不返回成员
+func (uc *ScimUsecase) ListGroups(ctx context.Context, query *ListQuery, excludeMembers bool) (*ListGroupsResult, error) {
+	query.normalize()
+	cfg, err := uc.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := uc.listGroups(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	matched := make([]*Group, 0, len(groups))
+	for _, group := range groups {
+		ok, err := matchGroup(group, query.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, group)
+		}
+	}
+	result := &ListGroupsResult{Groups: page(matched, query), Total: int64(len(matched)), StartIndex: query.StartIndex}
+	if !excludeMembers {
+		for _, group := range result.Groups {
+			if group.Members, err = uc.groupMembers(ctx, cfg, group.ID); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// CreateGroup 按配置创建部门或角色，IdP 推送的角色不带菜单权限，需由管理员授权
+func (uc *ScimUsecase) CreateGroup(ctx context.Context, item *Group) (*Group, error) {
+	cfg, err := uc.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	item.DisplayName = strings.TrimSpace(item.DisplayName)
+	if item.DisplayName == "" {
+		return nil, errorx.Err(errkey.ErrScimInvalidValue, "displayName is required")
+	}
+	if err = uc.checkMembers(ctx, item.Members); err != nil {
+		return nil, err
+	}
+	var groupID string
+	if cfg.GroupTarget == GroupTargetRole {
+		role, err := uc.roleUsecase.CreateRole(ctx, &permBiz.Role{
+			Name:      item.DisplayName,
+			Code:      "scim_" + uc.idgen.NextID(id.EMPTY),
+			DataScope: 3,
+			Status:    1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		groupID = role.ID
+	} else {
+		dept, err := uc.deptUsecase.CreateDepartment(ctx, &orgBiz.Department{
+			Name:     item.DisplayName,
+			ParentID: cfg.RootDeptID,
+			Status:   1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		groupID = dept.ID
+	}
+	for _, userID := range slices.Uniq(item.Members) {
+		if err = uc.addMember(ctx, cfg, groupID, userID); err != nil {
+			return nil, err
+		}
+	}
+	return uc.GetGroup(ctx, groupID)
+}
+
+// ReplaceGroup 更新组名称并将成员调整为 item.Members
+func (uc *ScimUsecase) ReplaceGroup(ctx context.Context, item *Group) (*Group, error) {
+	cfg, err := uc.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	group, err := uc.findGroup(ctx, cfg, item.ID)
+	if err != nil {
+		return nil, err
+	}
+	if name := strings.TrimSpace(item.DisplayName); name != "" && name != group.DisplayName {
+		if err = uc.renameGroup(ctx, cfg, item.ID, name); err != nil {
+			return nil, err
+		}
+	}
+	if err = uc.checkMembers(ctx, item.Members); err != nil {
+		return nil, err
+	}
+	current, err := uc.groupMembers(ctx, cfg, item.ID)
+	if err != nil {
+		return nil, err
+	}
+	needDelete, needInsert := slices.Difference(current, slices.Uniq(item.Members))
+	for _, userID := range needInsert {
+		if err = uc.addMember(ctx, cfg, item.ID, userID); err != nil {
+			return nil, err
+		}
+	}
+	for _, userID := range needDelete {
+		if err = uc.removeMember(ctx, cfg, item.ID, userID); err != nil {
+			return nil, err
+		}
+	}
+	return uc.GetGroup(ctx, item.ID)
+}
+
+// DeleteGroup 移除全部成员后删除部门或角色，有下级部门时删除失败
+func (uc *ScimUsecase) DeleteGroup(ctx context.Context, id string) error {
+	cfg, err := uc.GetConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err = uc.findGroup(ctx, cfg, id); err != nil {
+		return err
+	}
+	members, err := uc.groupMembers(ctx, cfg, id)
+	if err != nil {
+		return err
+	}
+	for _, userID := range members {
+		if err = uc.removeMember(ctx, cfg, id, userID); err != nil {
+			return err
+		}
+	}
+	if cfg.GroupTarget == GroupTargetRole {
+		return uc.roleUsecase.DeleteRole(ctx, id)
+	}
+	return uc.deptUsecase.DeleteDepartment(ctx, id)
+}
+
+func (uc *ScimUsecase) listGroups(ctx context.Context, cfg *Config) ([]*Group, error) {
+	if cfg.GroupTarget == GroupTargetRole {
+		result, err := uc.roleUsecase.ListRoles(ctx, &permBiz.ListRolesQuery{Page: 1, SortField: "id", SortOrder: "ASC"})
+		if err != nil {
+			return nil, err
+		}
+		return slices.Map(result.Roles, func(item *permBiz.Role, index int) *Group {
+			return roleToGroup(item)
+		}), nil
+	}
+	depts, err := uc.deptUsecase.ListDepartments(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return slices.Map(depts, func(item *orgBiz.Department, index int) *Group {
+		return deptToGroup(item)
+	}), nil
+}
+
+func (uc *ScimUsecase) findGroup(ctx context.Context, cfg *Config, id string) (*Group, error) {
+	if cfg.GroupTarget == GroupTargetRole {
+		role, err := uc.roleUsecase.GetRole(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if role == nil {
+			return nil, errorx.Err(errkey.ErrNotFound, "Group "+id)
+		}
+		return roleToGroup(role), nil
+	}
+	dept, err := uc.deptUsecase.GetDepartment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if dept == nil {
+		return nil, errorx.Err(errkey.ErrNotFound, "Group "+id)
+	}
+	return deptToGroup(dept), nil
+}
+
+func (uc *ScimUsecase) renameGroup(ctx context.Context, cfg *Config, id, name string) error {
+	if cfg.GroupTarget == GroupTargetRole {
+		role, err := uc.roleUsecase.GetRole(ctx, id)
+		if err != nil {
+			return err
+		}
+		role.Name = name
+		_, err = uc.roleUsecase.UpdateRole(ctx, role)
+		return err
+	}
+	dept, err := uc.deptUsecase.GetDepartment(ctx, id)
+	if err != nil {
+		return err
+	}
+	dept.Name = name
+	_, err = uc.deptUsecase.UpdateDepartment(ctx, dept)
+	return err
+}
+
+func (uc *ScimUsecase) groupMembers(ctx context.Context, cfg *Config, id string) ([]string, error) {
+	if cfg.GroupTarget == GroupTargetRole {
+		return uc.userUsecase.ListRoleUserIDs(ctx, id)
+	}
+	return uc.userUsecase.ListDeptUserIDs(ctx, id)
+}
+
+// checkMembers 成员必须是当前租户的用户
+func (uc *ScimUsecase) checkMembers(ctx context.Context, members []string) error {
+	for _, userID := range slices.Uniq(members) {
+		user, err := uc.userUsecase.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if user == nil {
+			return errorx.Err(errkey.ErrScimInvalidValue, "member "+userID+" not found")
+		}
+	}
+	return nil
+}
+
+func (uc *ScimUsecase) addMember(ctx context.Context, cfg *Config, groupID, userID string) error {
+	if cfg.GroupTarget == GroupTargetRole {
+		roleIDs, err := uc.userUsecase.GetUserRoles(ctx, userID)
+		if err != nil || slices.Contains(roleIDs, groupID) {
+			return err
+		}
+		return uc.userUsecase.AssignUserRoles(ctx, &userBiz.AssignUserRolesBO{UserID: userID, RoleIDs: append(roleIDs, groupID)})
+	}
+	deptIDs, err := uc.userUsecase.GetUserDepts(ctx, userID)
+	if err != nil || slices.Contains(deptIDs, groupID) {
+		return err
+	}
+	return uc.userUsecase.AssignUserDepts(ctx, &userBiz.AssignUserDeptsBO{UserID: userID, DeptIDs: append(deptIDs, groupID)})
+}
+
+func (uc *ScimUsecase) removeMember(ctx context.Context, cfg *Config, groupID, userID string) error {
+	keep := func(item string, index int) bool { return item != groupID }
+	if cfg.GroupTarget == GroupTargetRole {
+		roleIDs, err := uc.userUsecase.GetUserRoles(ctx, userID)
+		if err != nil || !slices.Contains(roleIDs, groupID) {
+			return err
+		}
+		return uc.userUsecase.AssignUserRoles(ctx, &userBiz.AssignUserRolesBO{UserID: userID, RoleIDs: slices.Filter(roleIDs, keep)})
+	}
+	deptIDs, err := uc.userUsecase.GetUserDepts(ctx, userID)
+	if err != nil || !slices.Contains(deptIDs, groupID) {
+		return err
+	}
+	return uc.userUsecase.AssignUserDepts(ctx, &userBiz.AssignUserDeptsBO{UserID: userID, DeptIDs: slices.Filter(deptIDs, keep)})
+}
+
+func matchGroup(group *Group, filter []*scimfilter.Condition) (bool, error) {
+	for _, cond := range filter {
+		var ok bool
+		switch strings.ToLower(cond.Attr) {
+		case "displayname":
+			ok = strings.EqualFold(group.DisplayName, cond.Value)
+		case "id":
+			ok = group.ID == cond.Value
+		default:
+			return false, errorx.Err(errkey.ErrScimInvalidFilter, "unsupported attribute "+cond.Attr)
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func roleToGroup(role *permBiz.Role) *Group {
+	return &Group{ID: role.ID, DisplayName: role.Name, CreateAt: role.CreateAt, UpdateAt: role.UpdateAt}
+}
+
+func deptToGroup(dept *orgBiz.Department) *Group {
+	return &Group{ID: dept.ID, DisplayName: dept.Name, CreateAt: dept.CreateAt, UpdateAt: dept.UpdateAt}
+}
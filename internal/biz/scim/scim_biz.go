@@ -0,0 +1,212 @@
+package scim
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	orgBiz "quest-admin/internal/biz/organization"
+	permBiz "quest-admin/internal/biz/permission"
+	userBiz "quest-admin/internal/biz/user"
+	"quest-admin/internal/data/idgen"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/consts/id"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// TokenPrefix SCIM 令牌的固定前缀
+	TokenPrefix = "qsc_"
+	// MaxResults 列表接口单页返回的最大条数
+	MaxResults = 200
+
+	tokenSecretBytes = 32
+	tokenDisplayLen  = 12
+)
+
+// ConfigRepo 租户 SCIM 配置
+type ConfigRepo interface {
+	// Find 查询当前租户的配置，不存在时返回 nil
+	Find(ctx context.Context) (*Config, error)
+	// FindByTokenHash 按令牌哈希查询，不限定租户，不存在时返回 nil
+	FindByTokenHash(ctx context.Context, hash string) (*Config, error)
+	// Save 新增或更新当前租户的配置，TokenHash 为空时保留原令牌
+	Save(ctx context.Context, cfg *Config) error
+	Delete(ctx context.Context) error
+}
+
+// ScimUsecase SCIM 2.0 用户和组的推送，用户对应本地用户，组按配置对应部门或角色
+type ScimUsecase struct {
+	repo        ConfigRepo
+	idgen       *idgen.IDGenerator
+	userUsecase *userBiz.UserUsecase
+	deptUsecase *orgBiz.DepartmentUsecase
+	roleUsecase *permBiz.RoleUsecase
+	log         *log.Helper
+}
+
+func NewScimUsecase(
+	logger log.Logger,
+	repo ConfigRepo,
+	idgen *idgen.IDGenerator,
+	userUsecase *userBiz.UserUsecase,
+	deptUsecase *orgBiz.DepartmentUsecase,
+	roleUsecase *permBiz.RoleUsecase,
+) *ScimUsecase {
+	return &ScimUsecase{
+		repo:        repo,
+		idgen:       idgen,
+		userUsecase: userUsecase,
+		deptUsecase: deptUsecase,
+		roleUsecase: roleUsecase,
+		log:         log.NewHelper(log.With(logger, "module", "scim/biz/scim")),
+	}
+}
+
+// GetConfig 获取当前租户的 SCIM 配置
+func (uc *ScimUsecase) GetConfig(ctx context.Context) (*Config, error) {
+	cfg, err := uc.repo.Find(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, errorx.Err(errkey.ErrScimConfigNotFound)
+	}
+	return cfg, nil
+}
+
+// SaveConfig 保存当前租户的 SCIM 配置，首次保存时生成令牌，返回的令牌明文只出现一次
+func (uc *ScimUsecase) SaveConfig(ctx context.Context, cfg *Config) (string, error) {
+	existing, err := uc.repo.Find(ctx)
+	if err != nil {
+		return "", err
+	}
+	if err = uc.validateConfig(ctx, cfg); err != nil {
+		return "", err
+	}
+	var token string
+	if existing == nil {
+		cfg.ID = uc.idgen.NextID(id.SCIM_CONFIG)
+		if token, err = uc.newToken(cfg); err != nil {
+			return "", err
+		}
+	} else {
+		cfg.ID = existing.ID
+	}
+	cfg.TenantID = ctxs.GetTenantID(ctx)
+	if err = uc.repo.Save(ctx, cfg); err != nil {
+		uc.log.WithContext(ctx).Errorf("保存SCIM配置失败,error:%v", err)
+		return "", err
+	}
+	return token, nil
+}
+
+// RotateToken 重新生成令牌，旧令牌立即失效
+func (uc *ScimUsecase) RotateToken(ctx context.Context) (string, error) {
+	cfg, err := uc.GetConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+	token, err := uc.newToken(cfg)
+	if err != nil {
+		return "", err
+	}
+	if err = uc.repo.Save(ctx, cfg); err != nil {
+		uc.log.WithContext(ctx).Errorf("更新SCIM令牌失败,error:%v", err)
+		return "", err
+	}
+	return token, nil
+}
+
+// DeleteConfig 删除 SCIM 配置，已推送的用户和组保留为本地数据
+func (uc *ScimUsecase) DeleteConfig(ctx context.Context) error {
+	if _, err := uc.GetConfig(ctx); err != nil {
+		return err
+	}
+	return uc.repo.Delete(ctx)
+}
+
+// ResolveToken 校验 SCIM 令牌，返回所属租户的配置
+func (uc *ScimUsecase) ResolveToken(ctx context.Context, token string) (*Config, error) {
+	if !strings.HasPrefix(token, TokenPrefix) {
+		return nil, errorx.Err(errkey.ErrScimUnauthorized)
+	}
+	cfg, err := uc.repo.FindByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil || cfg.Status != 1 {
+		return nil, errorx.Err(errkey.ErrScimUnauthorized)
+	}
+	return cfg, nil
+}
+
+func (uc *ScimUsecase) newToken(cfg *Config) (string, error) {
+	raw := make([]byte, tokenSecretBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	token := TokenPrefix + base64.RawURLEncoding.EncodeToString(raw)
+	cfg.TokenPrefix = token[:tokenDisplayLen]
+	cfg.TokenHash = hashToken(token)
+	return token, nil
+}
+
+func (uc *ScimUsecase) validateConfig(ctx context.Context, cfg *Config) error {
+	switch cfg.GroupTarget {
+	case GroupTargetDept:
+		if cfg.RootDeptID == "" {
+			return nil
+		}
+		dept, err := uc.deptUsecase.GetDepartment(ctx, cfg.RootDeptID)
+		if err != nil {
+			return err
+		}
+		if dept == nil {
+			return errorx.Err(errkey.ErrDepartmentNotFound)
+		}
+	case GroupTargetRole:
+		cfg.RootDeptID = ""
+	default:
+		return errorx.Err(errkey.ErrBadRequest, "group_target")
+	}
+	return nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// page 按 SCIM 的 startIndex 和 count 截取内存中的结果
+func page[T any](items []T, query *ListQuery) []T {
+	start := int(query.StartIndex) - 1
+	if start >= len(items) {
+		return []T{}
+	}
+	end := len(items)
+	if start+int(query.Count) < end {
+		end = start + int(query.Count)
+	}
+	return items[start:end]
+}
+
+// normalize 补全分页参数的默认值和上限
+func (q *ListQuery) normalize() {
+	if q.StartIndex < 1 {
+		q.StartIndex = 1
+	}
+	// count 为 0 时只返回总数，见 RFC 7644 3.4.2.4
+	if q.Count < 0 {
+		q.Count = 0
+	}
+	if q.Count > MaxResults {
+		q.Count = MaxResults
+	}
+}
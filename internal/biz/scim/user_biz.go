@@ -0,0 +1,191 @@
+package scim
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	userBiz "quest-admin/internal/biz/user"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/scimfilter"
+	"quest-admin/types/errkey"
+)
+
+// GetUser 获取 SCIM 用户
+func (uc *ScimUsecase) GetUser(ctx context.Context, id string) (*User, error) {
+	user, err := uc.userUsecase.GetUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errorx.Err(errkey.ErrNotFound, "User "+id)
+	}
+	return toScimUser(user), nil
+}
+
+// ListUsers 查询用户，过滤条件需包含 userName、emails 或 id 的等值比较
+func (uc *ScimUsecase) ListUsers(ctx context.Context, query *ListQuery) (*ListUsersResult, error) {
+	query.normalize()
+	if len(query.Filter) == 0 {
+		// count 为 0 时仍需查询总数，repo 的 Limit 为 0 表示不限制
+		limit := query.Count
+		if limit == 0 {
+			limit = 1
+		}
+		users, total, err := uc.userUsecase.SearchUsers(ctx, &userBiz.WhereUserOpt{
+			Offset: query.StartIndex - 1,
+			Limit:  limit,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result := &ListUsersResult{Users: make([]*User, 0, len(users)), Total: total, StartIndex: query.StartIndex}
+		if query.Count > 0 {
+			for _, user := range users {
+				result.Users = append(result.Users, toScimUser(user))
+			}
+		}
+		return result, nil
+	}
+
+	user, err := uc.findUserByFilter(ctx, query.Filter)
+	if err != nil {
+		return nil, err
+	}
+	var matched []*User
+	if user != nil {
+		item := toScimUser(user)
+		ok, err := matchUser(item, query.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, item)
+		}
+	}
+	return &ListUsersResult{Users: page(matched, query), Total: int64(len(matched)), StartIndex: query.StartIndex}, nil
+}
+
+// CreateUser 创建用户，本地密码为不可用的随机值，用户通过单点登录或重置密码登录
+func (uc *ScimUsecase) CreateUser(ctx context.Context, item *User) (*User, error) {
+	item.UserName = strings.TrimSpace(item.UserName)
+	if item.UserName == "" {
+		return nil, errorx.Err(errkey.ErrScimInvalidValue, "userName is required")
+	}
+	user := &userBiz.User{
+		Username: item.UserName,
+		Nickname: item.DisplayName,
+		Email:    item.Email,
+		Mobile:   item.Mobile,
+	}
+	if user.Nickname == "" {
+		user.Nickname = item.UserName
+	}
+	if err := uc.userUsecase.ProvisionUser(ctx, user, nil, nil); err != nil {
+		return nil, err
+	}
+	if !item.Active {
+		err := uc.userUsecase.ChangeUserStatus(ctx, &userBiz.UpdateStatusBO{UserID: user.ID, Status: 0})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return uc.GetUser(ctx, user.ID)
+}
+
+// ReplaceUser 更新用户资料和状态，用户名不可修改，停用时吊销用户的会话
+func (uc *ScimUsecase) ReplaceUser(ctx context.Context, item *User) (*User, error) {
+	user, err := uc.userUsecase.GetUser(ctx, item.ID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errorx.Err(errkey.ErrNotFound, "User "+item.ID)
+	}
+	if item.UserName != "" && !strings.EqualFold(strings.TrimSpace(item.UserName), user.Username) {
+		return nil, errorx.Err(errkey.ErrScimMutability, "userName")
+	}
+	if item.DisplayName != user.Nickname || item.Email != user.Email || item.Mobile != user.Mobile {
+		user.Nickname = item.DisplayName
+		user.Email = item.Email
+		user.Mobile = item.Mobile
+		if err = uc.userUsecase.UpdateUser(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+	status := int32(0)
+	if item.Active {
+		status = 1
+	}
+	if status != user.Status {
+		err = uc.userUsecase.ChangeUserStatus(ctx, &userBiz.UpdateStatusBO{UserID: user.ID, Status: status})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return uc.GetUser(ctx, user.ID)
+}
+
+// DeprovisionUser 身份提供方删除用户时停用本地用户并吊销其会话，保留用户数据用于审计
+func (uc *ScimUsecase) DeprovisionUser(ctx context.Context, id string) error {
+	user, err := uc.userUsecase.GetUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return errorx.Err(errkey.ErrNotFound, "User "+id)
+	}
+	return uc.userUsecase.ChangeUserStatus(ctx, &userBiz.UpdateStatusBO{UserID: id, Status: 0})
+}
+
+// findUserByFilter 按过滤条件中可精确定位的属性查询用户
+func (uc *ScimUsecase) findUserByFilter(ctx context.Context, filter []*scimfilter.Condition) (*userBiz.User, error) {
+	for _, cond := range filter {
+		switch strings.ToLower(cond.Attr) {
+		case "username":
+			return uc.userUsecase.GetUserByUsername(ctx, cond.Value)
+		case "emails", "emails.value":
+			return uc.userUsecase.GetUserByEmail(ctx, cond.Value)
+		case "id":
+			return uc.userUsecase.GetUser(ctx, cond.Value)
+		}
+	}
+	return nil, errorx.Err(errkey.ErrScimInvalidFilter, "filter must contain userName, emails or id")
+}
+
+func matchUser(user *User, filter []*scimfilter.Condition) (bool, error) {
+	for _, cond := range filter {
+		var ok bool
+		switch strings.ToLower(cond.Attr) {
+		case "username":
+			ok = strings.EqualFold(user.UserName, cond.Value)
+		case "emails", "emails.value":
+			ok = strings.EqualFold(user.Email, cond.Value)
+		case "id":
+			ok = user.ID == cond.Value
+		case "displayname":
+			ok = user.DisplayName == cond.Value
+		case "active":
+			ok = strconv.FormatBool(user.Active) == cond.Value
+		default:
+			return false, errorx.Err(errkey.ErrScimInvalidFilter, "unsupported attribute "+cond.Attr)
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func toScimUser(user *userBiz.User) *User {
+	return &User{
+		ID:          user.ID,
+		UserName:    user.Username,
+		DisplayName: user.Nickname,
+		Email:       user.Email,
+		Mobile:      user.Mobile,
+		Active:      user.Status == 1,
+		CreateAt:    user.CreateAt,
+		UpdateAt:    user.UpdateAt,
+	}
+}
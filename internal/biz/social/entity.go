@@ -0,0 +1,120 @@
+package social
+
+import (
+	"time"
+
+	userBiz "quest-admin/internal/biz/user"
+)
+
+// ScopeOpenID 上游授权时固定申请的范围
+const ScopeOpenID = "openid"
+
+// Options 第三方登录配置
+type Options struct {
+	// RedirectURL 前端回调页地址，需登记到上游身份提供方
+	RedirectURL string
+	StateTTL    time.Duration
+}
+
+// Provider 租户配置的上游 OIDC 身份提供方
+type Provider struct {
+	ID string
+	// Code 租户内唯一的标识，登录页以此选择身份提供方
+	Code         string
+	Name         string
+	Icon         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// Scopes 在 openid 之外额外申请的范围
+	Scopes       []string
+	ClaimMapping *ClaimMapping
+	// AutoCreate 首次登录且未绑定本地用户时自动创建用户
+	AutoCreate    bool
+	DefaultDeptID string
+	DefaultRoleID string
+	Sort          int32
+	Status        int32
+	Remark        string
+	CreateBy      string
+	CreateAt      time.Time
+	UpdateBy      string
+	UpdateAt      time.Time
+	TenantID      string
+}
+
+// ClaimMapping 本地用户字段对应的上游声明名称，为空时使用标准声明
+type ClaimMapping struct {
+	Username string `json:"username,omitempty"`
+	Nickname string `json:"nickname,omitempty"`
+	Email    string `json:"email,omitempty"`
+	Mobile   string `json:"mobile,omitempty"`
+	Avatar   string `json:"avatar,omitempty"`
+}
+
+type ListProvidersQuery struct {
+	Page     int32
+	PageSize int32
+	Keyword  string
+	Status   *int32
+}
+
+type WhereProviderOpt struct {
+	Limit   int32
+	Offset  int32
+	Keyword string
+	Status  *int32
+}
+
+type ListProvidersResult struct {
+	Providers  []*Provider
+	Total      int64
+	Page       int32
+	PageSize   int32
+	TotalPages int32
+}
+
+// UserSocial 本地用户绑定的上游身份
+type UserSocial struct {
+	ID         string
+	UserID     string
+	ProviderID string
+	// Subject 上游 ID Token 中的 sub
+	Subject     string
+	Username    string
+	Nickname    string
+	Email       string
+	Avatar      string
+	LastLoginAt time.Time
+	CreateAt    time.Time
+	TenantID    string
+}
+
+// AuthState 跳转上游前保存的授权状态，回调时以 state 取回并立即失效
+type AuthState struct {
+	State        string
+	ProviderID   string
+	TenantID     string
+	Nonce        string
+	CodeVerifier string
+	// BindUserID 不为空时为已登录用户绑定身份，否则为登录
+	BindUserID string
+}
+
+// Identity 按声明映射解析出的上游身份
+type Identity struct {
+	Subject  string
+	Username string
+	Nickname string
+	Email    string
+	Mobile   string
+	Avatar   string
+}
+
+// CallbackResult 回调处理结果，登录时 User 为待签发令牌的本地用户，绑定时 User 为空
+type CallbackResult struct {
+	User     *userBiz.User
+	Provider *Provider
+	Bound    bool
+	Created  bool
+}
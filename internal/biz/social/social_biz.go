@@ -0,0 +1,535 @@
+package social
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	orgBiz "quest-admin/internal/biz/organization"
+	permBiz "quest-admin/internal/biz/permission"
+	userBiz "quest-admin/internal/biz/user"
+	"quest-admin/internal/data/idgen"
+	"quest-admin/internal/data/transaction"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/lang/slices"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/pkg/util/oidcclient"
+	"quest-admin/pkg/util/pagination"
+	"quest-admin/types/consts/id"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// ProviderRepo 身份提供方配置，客户端密钥加密存储
+type ProviderRepo interface {
+	Create(ctx context.Context, provider *Provider) error
+	FindByID(ctx context.Context, id string) (*Provider, error)
+	FindByCode(ctx context.Context, code string) (*Provider, error)
+	List(ctx context.Context, opt *WhereProviderOpt) ([]*Provider, error)
+	Count(ctx context.Context, opt *WhereProviderOpt) (int64, error)
+	// Update 更新配置，ClientSecret 为空时保留原密钥
+	Update(ctx context.Context, provider *Provider) error
+	Delete(ctx context.Context, id string) error
+}
+
+// UserSocialRepo 本地用户与上游身份的绑定关系
+type UserSocialRepo interface {
+	Create(ctx context.Context, social *UserSocial) error
+	FindBySubject(ctx context.Context, providerID, subject string) (*UserSocial, error)
+	ListByUserID(ctx context.Context, userID string) ([]*UserSocial, error)
+	// UpdateLogin 更新上游身份的资料快照和最后登录时间
+	UpdateLogin(ctx context.Context, social *UserSocial) error
+	Delete(ctx context.Context, id string) error
+	DeleteByProviderID(ctx context.Context, providerID string) error
+}
+
+// StateRepo 授权状态，一次性使用
+type StateRepo interface {
+	Options() *Options
+	Save(ctx context.Context, state *AuthState) error
+	// Take 取出并删除授权状态，不存在或已过期时返回 nil
+	Take(ctx context.Context, state string) (*AuthState, error)
+}
+
+const stateBytes = 32
+
+var providerCodePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,31}$`)
+
+// SocialUsecase 第三方（上游 OIDC）登录
+type SocialUsecase struct {
+	providerRepo ProviderRepo
+	socialRepo   UserSocialRepo
+	stateRepo    StateRepo
+	tm           transaction.Manager
+	idgen        *idgen.IDGenerator
+	userUsecase  *userBiz.UserUsecase
+	deptUsecase  *orgBiz.DepartmentUsecase
+	roleUsecase  *permBiz.RoleUsecase
+	client       *oidcclient.Client
+	log          *log.Helper
+}
+
+func NewSocialUsecase(
+	logger log.Logger,
+	providerRepo ProviderRepo,
+	socialRepo UserSocialRepo,
+	stateRepo StateRepo,
+	tm transaction.Manager,
+	idgen *idgen.IDGenerator,
+	userUsecase *userBiz.UserUsecase,
+	deptUsecase *orgBiz.DepartmentUsecase,
+	roleUsecase *permBiz.RoleUsecase,
+) *SocialUsecase {
+	return &SocialUsecase{
+		providerRepo: providerRepo,
+		socialRepo:   socialRepo,
+		stateRepo:    stateRepo,
+		tm:           tm,
+		idgen:        idgen,
+		userUsecase:  userUsecase,
+		deptUsecase:  deptUsecase,
+		roleUsecase:  roleUsecase,
+		client:       oidcclient.New(nil),
+		log:          log.NewHelper(log.With(logger, "module", "social/biz/social")),
+	}
+}
+
+func (uc *SocialUsecase) CreateProvider(ctx context.Context, provider *Provider) error {
+	if provider.ClientSecret == "" {
+		return errorx.Err(errkey.ErrSocialProviderInvalid, "client_secret is required")
+	}
+	if err := uc.validateProvider(ctx, provider); err != nil {
+		return err
+	}
+	provider.ID = uc.idgen.NextID(id.SOCIAL_PROVIDER)
+	provider.TenantID = ctxs.GetTenantID(ctx)
+	if err := uc.providerRepo.Create(ctx, provider); err != nil {
+		uc.log.WithContext(ctx).Errorf("创建身份提供方失败,code:%s,error:%v", provider.Code, err)
+		return err
+	}
+	return nil
+}
+
+func (uc *SocialUsecase) GetProvider(ctx context.Context, id string) (*Provider, error) {
+	provider, err := uc.providerRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return nil, errorx.Err(errkey.ErrSocialProviderNotFound)
+	}
+	return provider, nil
+}
+
+func (uc *SocialUsecase) ListProviders(ctx context.Context, query *ListProvidersQuery) (*ListProvidersResult, error) {
+	opt := &WhereProviderOpt{
+		Limit:   query.PageSize,
+		Offset:  pagination.GetOffset(query.Page, query.PageSize),
+		Keyword: query.Keyword,
+		Status:  query.Status,
+	}
+	list, err := uc.providerRepo.List(ctx, opt)
+	if err != nil {
+		uc.log.WithContext(ctx).Error("查询身份提供方列表失败", err)
+		return nil, err
+	}
+	total, err := uc.providerRepo.Count(ctx, opt)
+	if err != nil {
+		uc.log.WithContext(ctx).Error("查询身份提供方总数失败", err)
+		return nil, err
+	}
+	return &ListProvidersResult{
+		Providers:  list,
+		Total:      total,
+		Page:       query.Page,
+		PageSize:   query.PageSize,
+		TotalPages: pagination.GetTotalPages(total, int64(query.PageSize)),
+	}, nil
+}
+
+// ListEnabledProviders 当前租户已启用的身份提供方，用于登录页
+func (uc *SocialUsecase) ListEnabledProviders(ctx context.Context) ([]*Provider, error) {
+	status := int32(1)
+	return uc.providerRepo.List(ctx, &WhereProviderOpt{Status: &status})
+}
+
+// UpdateProvider 更新身份提供方，ClientSecret 为空时保留原密钥
+func (uc *SocialUsecase) UpdateProvider(ctx context.Context, provider *Provider) error {
+	if _, err := uc.GetProvider(ctx, provider.ID); err != nil {
+		return err
+	}
+	if err := uc.validateProvider(ctx, provider); err != nil {
+		return err
+	}
+	return uc.providerRepo.Update(ctx, provider)
+}
+
+// DeleteProvider 删除身份提供方及其全部绑定关系
+func (uc *SocialUsecase) DeleteProvider(ctx context.Context, id string) error {
+	if _, err := uc.GetProvider(ctx, id); err != nil {
+		return err
+	}
+	return uc.tm.Tx(ctx, func(ctx context.Context) error {
+		if err := uc.socialRepo.DeleteByProviderID(ctx, id); err != nil {
+			return err
+		}
+		return uc.providerRepo.Delete(ctx, id)
+	})
+}
+
+// AuthorizeURL 生成跳转上游的授权地址，bindUserID 不为空时回调后为该用户绑定身份
+func (uc *SocialUsecase) AuthorizeURL(ctx context.Context, code, bindUserID string) (string, error) {
+	provider, err := uc.enabledProvider(ctx, func() (*Provider, error) {
+		return uc.providerRepo.FindByCode(ctx, code)
+	})
+	if err != nil {
+		return "", err
+	}
+	discovered, err := uc.client.Discover(ctx, provider.Issuer)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("获取上游发现文档失败,issuer:%s,error:%v", provider.Issuer, err)
+		return "", errorx.Err(errkey.ErrSocialLoginFailed)
+	}
+
+	state, err := oidcclient.RandomString(stateBytes)
+	if err != nil {
+		return "", err
+	}
+	nonce, err := oidcclient.RandomString(stateBytes)
+	if err != nil {
+		return "", err
+	}
+	verifier, challenge, err := oidcclient.NewCodeVerifier()
+	if err != nil {
+		return "", err
+	}
+	err = uc.stateRepo.Save(ctx, &AuthState{
+		State:        state,
+		ProviderID:   provider.ID,
+		TenantID:     ctxs.GetTenantID(ctx),
+		Nonce:        nonce,
+		CodeVerifier: verifier,
+		BindUserID:   bindUserID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return discovered.AuthCodeURL(&oidcclient.AuthCodeRequest{
+		ClientID:      provider.ClientID,
+		RedirectURI:   uc.stateRepo.Options().RedirectURL,
+		Scopes:        append([]string{ScopeOpenID}, provider.Scopes...),
+		State:         state,
+		Nonce:         nonce,
+		CodeChallenge: challenge,
+	}), nil
+}
+
+// Callback 处理上游回调：登录时返回绑定的本地用户，未绑定且允许自动创建时创建用户；绑定时为发起用户建立绑定关系
+func (uc *SocialUsecase) Callback(ctx context.Context, state, code string) (*CallbackResult, error) {
+	if state == "" || code == "" {
+		return nil, errorx.Err(errkey.ErrSocialStateInvalid)
+	}
+	authState, err := uc.stateRepo.Take(ctx, state)
+	if err != nil {
+		return nil, err
+	}
+	// 回调必须回到发起授权的租户
+	if authState == nil || authState.TenantID != ctxs.GetTenantID(ctx) {
+		return nil, errorx.Err(errkey.ErrSocialStateInvalid)
+	}
+	provider, err := uc.enabledProvider(ctx, func() (*Provider, error) {
+		return uc.providerRepo.FindByID(ctx, authState.ProviderID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	identity, err := uc.exchange(ctx, provider, authState, code)
+	if err != nil {
+		return nil, err
+	}
+
+	link, err := uc.socialRepo.FindBySubject(ctx, provider.ID, identity.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if authState.BindUserID != "" {
+		return uc.bind(ctx, provider, link, identity, authState.BindUserID)
+	}
+	if link == nil {
+		if !provider.AutoCreate {
+			return nil, errorx.Err(errkey.ErrSocialNotBound)
+		}
+		user, err := uc.provision(ctx, provider, identity)
+		if err != nil {
+			return nil, err
+		}
+		return &CallbackResult{User: user, Provider: provider, Created: true}, nil
+	}
+
+	user, err := uc.userUsecase.GetUser(ctx, link.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errorx.Err(errkey.ErrUserNotFound)
+	}
+	uc.refreshLink(ctx, link, identity)
+	return &CallbackResult{User: user, Provider: provider}, nil
+}
+
+// ListUserSocials 用户已绑定的上游身份
+func (uc *SocialUsecase) ListUserSocials(ctx context.Context, userID string) ([]*UserSocial, error) {
+	return uc.socialRepo.ListByUserID(ctx, userID)
+}
+
+// Unbind 解除用户绑定的上游身份
+func (uc *SocialUsecase) Unbind(ctx context.Context, userID, id string) error {
+	socials, err := uc.socialRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	for _, social := range socials {
+		if social.ID == id {
+			return uc.socialRepo.Delete(ctx, id)
+		}
+	}
+	return errorx.Err(errkey.ErrSocialNotFound)
+}
+
+func (uc *SocialUsecase) enabledProvider(ctx context.Context, find func() (*Provider, error)) (*Provider, error) {
+	provider, err := find()
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil || provider.Status != 1 {
+		return nil, errorx.Err(errkey.ErrSocialProviderNotFound)
+	}
+	return provider, nil
+}
+
+// exchange 以授权码换取并校验上游 ID Token，userinfo 中的声明补充 ID Token 未包含的字段
+func (uc *SocialUsecase) exchange(ctx context.Context, provider *Provider, authState *AuthState, code string) (*Identity, error) {
+	discovered, err := uc.client.Discover(ctx, provider.Issuer)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("获取上游发现文档失败,issuer:%s,error:%v", provider.Issuer, err)
+		return nil, errorx.Err(errkey.ErrSocialLoginFailed)
+	}
+	token, err := uc.client.Exchange(ctx, discovered, &oidcclient.ExchangeRequest{
+		ClientID:     provider.ClientID,
+		ClientSecret: provider.ClientSecret,
+		Code:         code,
+		RedirectURI:  uc.stateRepo.Options().RedirectURL,
+		CodeVerifier: authState.CodeVerifier,
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("上游授权码换取令牌失败,provider:%s,error:%v", provider.Code, err)
+		return nil, errorx.Err(errkey.ErrSocialLoginFailed)
+	}
+	claims, err := uc.client.VerifyIDToken(ctx, discovered, token.IDToken, provider.ClientID, authState.Nonce)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("上游ID Token校验失败,provider:%s,error:%v", provider.Code, err)
+		return nil, errorx.Err(errkey.ErrSocialLoginFailed)
+	}
+	if token.AccessToken != "" {
+		info, err := uc.client.UserInfo(ctx, discovered, token.AccessToken)
+		if err != nil {
+			uc.log.WithContext(ctx).Warnf("查询上游用户信息失败,provider:%s,error:%v", provider.Code, err)
+		}
+		// userinfo 的 sub 必须与 ID Token 一致，否则忽略
+		if info != nil && info["sub"] == claims["sub"] {
+			for key, value := range info {
+				if _, ok := claims[key]; !ok {
+					claims[key] = value
+				}
+			}
+		}
+	}
+	return mapIdentity(claims, provider.ClaimMapping), nil
+}
+
+func (uc *SocialUsecase) bind(ctx context.Context, provider *Provider, link *UserSocial, identity *Identity, userID string) (*CallbackResult, error) {
+	if link != nil {
+		if link.UserID != userID {
+			return nil, errorx.Err(errkey.ErrSocialAlreadyBound)
+		}
+		uc.refreshLink(ctx, link, identity)
+		return &CallbackResult{Provider: provider, Bound: true}, nil
+	}
+	if err := uc.createLink(ctx, provider, identity, userID); err != nil {
+		return nil, err
+	}
+	return &CallbackResult{Provider: provider, Bound: true}, nil
+}
+
+// provision 自动创建用户，用户名取映射的声明，缺失时由身份提供方标识和 sub 生成
+func (uc *SocialUsecase) provision(ctx context.Context, provider *Provider, identity *Identity) (*userBiz.User, error) {
+	username := identity.Username
+	if username == "" {
+		sum := sha256.Sum256([]byte(identity.Subject))
+		username = provider.Code + "_" + hex.EncodeToString(sum[:])[:12]
+	}
+	user := &userBiz.User{
+		Username: username,
+		Nickname: identity.Nickname,
+		Email:    identity.Email,
+		Mobile:   identity.Mobile,
+		Avatar:   identity.Avatar,
+		Remark:   "created by " + provider.Name,
+	}
+	if user.Nickname == "" {
+		user.Nickname = username
+	}
+	var deptIDs, roleIDs []string
+	if provider.DefaultDeptID != "" {
+		deptIDs = []string{provider.DefaultDeptID}
+	}
+	if provider.DefaultRoleID != "" {
+		roleIDs = []string{provider.DefaultRoleID}
+	}
+	err := uc.tm.Tx(ctx, func(ctx context.Context) error {
+		if err := uc.userUsecase.ProvisionUser(ctx, user, deptIDs, roleIDs); err != nil {
+			return err
+		}
+		return uc.createLink(ctx, provider, identity, user.ID)
+	})
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("自动创建第三方登录用户失败,provider:%s,username:%s,error:%v", provider.Code, username, err)
+		return nil, err
+	}
+	uc.log.WithContext(ctx).Infof("已自动创建第三方登录用户,provider:%s,userID:%s", provider.Code, user.ID)
+	return user, nil
+}
+
+func (uc *SocialUsecase) createLink(ctx context.Context, provider *Provider, identity *Identity, userID string) error {
+	return uc.socialRepo.Create(ctx, &UserSocial{
+		ID:          uc.idgen.NextID(id.USER_SOCIAL),
+		UserID:      userID,
+		ProviderID:  provider.ID,
+		Subject:     identity.Subject,
+		Username:    identity.Username,
+		Nickname:    identity.Nickname,
+		Email:       identity.Email,
+		Avatar:      identity.Avatar,
+		LastLoginAt: time.Now(),
+		TenantID:    ctxs.GetTenantID(ctx),
+	})
+}
+
+// refreshLink 资料快照更新失败不影响登录
+func (uc *SocialUsecase) refreshLink(ctx context.Context, link *UserSocial, identity *Identity) {
+	link.Username = identity.Username
+	link.Nickname = identity.Nickname
+	link.Email = identity.Email
+	link.Avatar = identity.Avatar
+	link.LastLoginAt = time.Now()
+	if err := uc.socialRepo.UpdateLogin(ctx, link); err != nil {
+		uc.log.WithContext(ctx).Warnf("更新第三方身份信息失败,id:%s,error:%v", link.ID, err)
+	}
+}
+
+func (uc *SocialUsecase) validateProvider(ctx context.Context, provider *Provider) error {
+	provider.Code = strings.TrimSpace(provider.Code)
+	provider.Name = strings.TrimSpace(provider.Name)
+	provider.Issuer = strings.TrimSuffix(strings.TrimSpace(provider.Issuer), "/")
+	if !providerCodePattern.MatchString(provider.Code) {
+		return errorx.Err(errkey.ErrSocialProviderInvalid, "code must be 1-32 lowercase letters, digits, '-' or '_'")
+	}
+	if provider.Name == "" {
+		return errorx.Err(errkey.ErrBadRequest, "name")
+	}
+	if !validIssuer(provider.Issuer) {
+		return errorx.Err(errkey.ErrSocialProviderInvalid, "issuer must be an https url")
+	}
+	if provider.ClientID == "" {
+		return errorx.Err(errkey.ErrSocialProviderInvalid, "client_id is required")
+	}
+	provider.Scopes = slices.Uniq(slices.Filter(provider.Scopes, func(item string, index int) bool {
+		return item != "" && item != ScopeOpenID
+	}))
+	if provider.ClaimMapping == nil {
+		provider.ClaimMapping = &ClaimMapping{}
+	}
+
+	existing, err := uc.providerRepo.FindByCode(ctx, provider.Code)
+	if err != nil {
+		return err
+	}
+	if existing != nil && existing.ID != provider.ID {
+		return errorx.Err(errkey.ErrSocialProviderExists)
+	}
+	if provider.DefaultDeptID != "" {
+		dept, err := uc.deptUsecase.GetDepartment(ctx, provider.DefaultDeptID)
+		if err != nil {
+			return err
+		}
+		if dept == nil {
+			return errorx.Err(errkey.ErrSocialProviderInvalid, "default department not found")
+		}
+	}
+	if provider.DefaultRoleID != "" {
+		role, err := uc.roleUsecase.GetRole(ctx, provider.DefaultRoleID)
+		if err != nil {
+			return err
+		}
+		if role == nil {
+			return errorx.Err(errkey.ErrSocialProviderInvalid, "default role not found")
+		}
+	}
+	return nil
+}
+
+// validIssuer 上游签发者必须是 https 地址，本机回环地址便于开发调试
+func validIssuer(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+		return false
+	}
+	switch u.Scheme {
+	case "https":
+		return true
+	case "http":
+		host := u.Hostname()
+		if host == "localhost" {
+			return true
+		}
+		ip := net.ParseIP(host)
+		return ip != nil && ip.IsLoopback()
+	default:
+		return false
+	}
+}
+
+// mapIdentity 按声明映射取值，映射为空时使用 OIDC 标准声明
+func mapIdentity(claims map[string]any, mapping *ClaimMapping) *Identity {
+	if mapping == nil {
+		mapping = &ClaimMapping{}
+	}
+	get := func(name, fallback string) string {
+		if name == "" {
+			name = fallback
+		}
+		switch value := claims[name].(type) {
+		case nil:
+			return ""
+		case string:
+			return strings.TrimSpace(value)
+		default:
+			return fmt.Sprint(value)
+		}
+	}
+	return &Identity{
+		Subject:  get("sub", ""),
+		Username: get(mapping.Username, "preferred_username"),
+		Nickname: get(mapping.Nickname, "name"),
+		Email:    get(mapping.Email, "email"),
+		Mobile:   get(mapping.Mobile, "phone_number"),
+		Avatar:   get(mapping.Avatar, "picture"),
+	}
+}
@@ -1,6 +1,9 @@
 package tenant
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 type Tenant struct {
 	ID            string
@@ -19,6 +22,24 @@ type Tenant struct {
 	UpdateAt      time.Time
 }
 
+// HasExpireTime 是否设置了过期时间，未设置（零值或 1970-01-01）时永不过期
+func (t *Tenant) HasExpireTime() bool {
+	return t.ExpireTime.After(time.Unix(0, 0))
+}
+
+// Expired 租户在指定时间是否已过期
+func (t *Tenant) Expired(now time.Time) bool {
+	return t.HasExpireTime() && !now.Before(t.ExpireTime)
+}
+
+// Options 租户全局配置
+type Options struct {
+	// ExpiryCheckInterval 检查租户到期的间隔
+	ExpiryCheckInterval time.Duration
+	// ExpiryWarnBefore 提前多久发送到期提醒
+	ExpiryWarnBefore time.Duration
+}
+
 type TenantPackage struct {
 	ID       string
 	Name     string
@@ -31,6 +52,17 @@ type TenantPackage struct {
 	UpdateAt time.Time
 }
 
+// MenuIDList 套餐包含的菜单，MenuIDs 以逗号分隔
+func (p *TenantPackage) MenuIDList() []string {
+	var ids []string
+	for _, item := range strings.Split(p.MenuIDs, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			ids = append(ids, item)
+		}
+	}
+	return ids
+}
+
 type ListTenantsQuery struct {
 	Page      int32
 	PageSize  int32
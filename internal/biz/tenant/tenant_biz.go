@@ -2,8 +2,15 @@ package tenant
 
 import (
 	"context"
+	"fmt"
+	"net"
+	"quest-admin/internal/data/idgen"
 	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/sms"
+	"quest-admin/types/consts/id"
 	"quest-admin/types/errkey"
+	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -11,22 +18,46 @@ import (
 type TenantRepo interface {
 	Create(ctx context.Context, tenant *Tenant) error
 	FindByID(ctx context.Context, id string) (*Tenant, error)
+	// LockByID 查询并锁定租户，需在事务中调用，锁在事务结束时释放
+	LockByID(ctx context.Context, id string) (*Tenant, error)
 	FindByName(ctx context.Context, name string) (*Tenant, error)
+	// FindByWebsite 按域名查询租户，忽略租户网站中的协议、端口和路径
+	FindByWebsite(ctx context.Context, host string) (*Tenant, error)
 	List(ctx context.Context, query *ListTenantsQuery) (*ListTenantsResult, error)
 	FindIDAndNameList(ctx context.Context) ([]*TenantSimple, error)
+	// FindIDsByPackageID 查询使用指定套餐的租户
+	FindIDsByPackageID(ctx context.Context, packageID string) ([]string, error)
+	// ListExpiring 查询已启用且在指定时间前到期的租户，包含已过期的租户
+	ListExpiring(ctx context.Context, before time.Time) ([]*Tenant, error)
 	Update(ctx context.Context, tenant *Tenant) error
+	UpdateStatus(ctx context.Context, id string, status int32) error
 	Delete(ctx context.Context, id string) error
 }
 
+// TenantExpiryRepo 租户到期提醒记录
+type TenantExpiryRepo interface {
+	Options() *Options
+	// MarkWarned 记录已发送到期提醒，同一租户同一过期时间只返回一次 true
+	MarkWarned(ctx context.Context, tenantID string, expireTime time.Time) (bool, error)
+	// UnmarkWarned 撤销到期提醒记录，提醒发送失败时调用以便下次检查重试
+	UnmarkWarned(ctx context.Context, tenantID string, expireTime time.Time) error
+}
+
 type TenantUsecase struct {
-	repo TenantRepo
-	log  *log.Helper
+	idgen      *idgen.IDGenerator
+	repo       TenantRepo
+	expiryRepo TenantExpiryRepo
+	smsSender  sms.Sender
+	log        *log.Helper
 }
 
-func NewTenantUsecase(repo TenantRepo, logger log.Logger) *TenantUsecase {
+func NewTenantUsecase(idgen *idgen.IDGenerator, repo TenantRepo, expiryRepo TenantExpiryRepo, smsSender sms.Sender, logger log.Logger) *TenantUsecase {
 	return &TenantUsecase{
-		repo: repo,
-		log:  log.NewHelper(log.With(logger, "module", "tenant/biz/tenant")),
+		idgen:      idgen,
+		repo:       repo,
+		expiryRepo: expiryRepo,
+		smsSender:  smsSender,
+		log:        log.NewHelper(log.With(logger, "module", "tenant/biz/tenant")),
 	}
 }
 
@@ -38,6 +69,7 @@ func (uc *TenantUsecase) CreateTenant(ctx context.Context, tenant *Tenant) error
 	if existing != nil {
 		return errorx.Err(errkey.ErrTenantNameExists)
 	}
+	tenant.ID = uc.idgen.NextID(id.TENANT)
 
 	return uc.repo.Create(ctx, tenant)
 }
@@ -46,6 +78,11 @@ func (uc *TenantUsecase) GetTenant(ctx context.Context, id string) (*Tenant, err
 	return uc.repo.FindByID(ctx, id)
 }
 
+// LockTenant 查询并锁定租户直到事务结束，用于串行化同一租户下依赖租户配置的写操作
+func (uc *TenantUsecase) LockTenant(ctx context.Context, id string) (*Tenant, error) {
+	return uc.repo.LockByID(ctx, id)
+}
+
 func (uc *TenantUsecase) ListTenants(ctx context.Context, query *ListTenantsQuery) (*ListTenantsResult, error) {
 	return uc.repo.List(ctx, query)
 }
@@ -70,3 +107,98 @@ func (uc *TenantUsecase) DeleteTenant(ctx context.Context, id string) error {
 func (uc *TenantUsecase) GetAllTenants(ctx context.Context) ([]*TenantSimple, error) {
 	return uc.repo.FindIDAndNameList(ctx)
 }
+
+// ListTenantIDsByPackage 获取使用指定套餐的租户
+func (uc *TenantUsecase) ListTenantIDsByPackage(ctx context.Context, packageID string) ([]string, error) {
+	return uc.repo.FindIDsByPackageID(ctx, packageID)
+}
+
+// ResolveByHost 按请求的 Host 匹配租户网站，未匹配到时返回空字符串即全局租户
+func (uc *TenantUsecase) ResolveByHost(ctx context.Context, host string) (string, error) {
+	host = strings.ToLower(strings.TrimSpace(host))
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if host == "" {
+		return "", nil
+	}
+	tenant, err := uc.repo.FindByWebsite(ctx, host)
+	if err != nil || tenant == nil {
+		return "", err
+	}
+	return tenant.ID, nil
+}
+
+// CheckAvailable 校验租户是否可用，租户停用或已过期时拒绝访问；全局租户和未登记的租户不受限制
+func (uc *TenantUsecase) CheckAvailable(ctx context.Context, tenantID string) error {
+	if tenantID == "" {
+		return nil
+	}
+	tenant, err := uc.repo.FindByID(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if tenant == nil {
+		return nil
+	}
+	if tenant.Status != 1 {
+		return errorx.Err(errkey.ErrTenantDisabled)
+	}
+	if tenant.Expired(time.Now()) {
+		return errorx.Err(errkey.ErrTenantExpired)
+	}
+	return nil
+}
+
+// Options 租户全局配置
+func (uc *TenantUsecase) Options() *Options {
+	return uc.expiryRepo.Options()
+}
+
+// CheckExpiry 停用已过期的租户，并向即将到期的租户联系人发送提醒，每个过期时间只提醒一次
+func (uc *TenantUsecase) CheckExpiry(ctx context.Context) {
+	now := time.Now()
+	tenants, err := uc.repo.ListExpiring(ctx, now.Add(uc.expiryRepo.Options().ExpiryWarnBefore))
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("查询即将到期的租户失败,error:%v", err)
+		return
+	}
+	for _, tenant := range tenants {
+		if tenant.Expired(now) {
+			if err = uc.repo.UpdateStatus(ctx, tenant.ID, 0); err != nil {
+				uc.log.WithContext(ctx).Errorf("停用过期租户失败,tenantID:%s,error:%v", tenant.ID, err)
+				continue
+			}
+			uc.log.WithContext(ctx).Infof("租户已过期,已自动停用,tenantID:%s,expireTime:%s", tenant.ID, tenant.ExpireTime)
+			continue
+		}
+		if err = uc.warnExpiry(ctx, tenant); err != nil {
+			uc.log.WithContext(ctx).Errorf("发送租户到期提醒失败,tenantID:%s,error:%v", tenant.ID, err)
+		}
+	}
+}
+
+// warnExpiry 向租户联系人发送到期提醒短信，未填写联系手机时跳过。
+// 发送前先占用提醒记录避免多实例重复发送，发送失败时撤销记录，下次检查时重试
+func (uc *TenantUsecase) warnExpiry(ctx context.Context, tenant *Tenant) error {
+	if tenant.ContactMobile == "" {
+		uc.log.WithContext(ctx).Warnf("租户即将到期但未填写联系手机,tenantID:%s,expireTime:%s", tenant.ID, tenant.ExpireTime)
+		return nil
+	}
+	first, err := uc.expiryRepo.MarkWarned(ctx, tenant.ID, tenant.ExpireTime)
+	if err != nil || !first {
+		return err
+	}
+	err = uc.smsSender.Send(ctx, &sms.Message{
+		Mobile: tenant.ContactMobile,
+		Content: fmt.Sprintf("您好，租户「%s」将于 %s 到期，到期后将无法登录和使用，请及时续期。",
+			tenant.Name, tenant.ExpireTime.Format(time.DateTime)),
+	})
+	if err != nil {
+		if unmarkErr := uc.expiryRepo.UnmarkWarned(ctx, tenant.ID, tenant.ExpireTime); unmarkErr != nil {
+			uc.log.WithContext(ctx).Errorf("撤销租户到期提醒记录失败,tenantID:%s,error:%v", tenant.ID, unmarkErr)
+		}
+		return err
+	}
+	return nil
+}
@@ -3,29 +3,47 @@ package user
 import "time"
 
 type User struct {
-	ID        string
-	Username  string
-	Password  string
-	Nickname  string
-	Email     string
-	Mobile    string
-	Sex       int32
-	Avatar    string
-	Status    int32
-	Remark    string
-	LoginIP   string
-	LoginDate time.Time
-	CreateBy  string
-	CreateAt  time.Time
-	UpdateBy  string
-	UpdateAt  time.Time
-	TenantID  string
+	ID               string
+	Username         string
+	Password         string
+	Nickname         string
+	Email            string
+	Mobile           string
+	Sex              int32
+	Avatar           string
+	Status           int32
+	Remark           string
+	LoginIP          string
+	LoginDate        time.Time
+	PasswordUpdateAt time.Time
+	PasswordReset    bool
+	CreateBy         string
+	CreateAt         time.Time
+	UpdateBy         string
+	UpdateAt         time.Time
+	TenantID         string
 }
 
 type UpdatePasswordBO struct {
 	UserID      string
 	OldPassword string
 	NewPassword string
+	// PasswordReset 为 true 时表示管理员重置，用户下次登录需修改密码
+	PasswordReset bool
+}
+
+// ResetPasswordBO 管理员重置密码
+type ResetPasswordBO struct {
+	UserID   string
+	Password string
+}
+
+// PasswordHistory 历史密码，Password 为哈希
+type PasswordHistory struct {
+	ID       string
+	UserID   string
+	Password string
+	CreateAt time.Time
 }
 
 type UpdateStatusBO struct {
@@ -2,13 +2,19 @@ package user
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	tenantBiz "quest-admin/internal/biz/tenant"
 	"quest-admin/internal/data/idgen"
 	"quest-admin/internal/data/transaction"
 	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/ctxs"
 	"quest-admin/pkg/util/pagination"
 	"quest-admin/pkg/util/pswd"
+	"quest-admin/pkg/util/validator"
 	"quest-admin/types/consts/id"
 	"quest-admin/types/errkey"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 )
@@ -17,8 +23,14 @@ type UserRepo interface {
 	Create(ctx context.Context, user *User) error
 	FindByID(ctx context.Context, id string) (*User, error)
 	FindByUsername(ctx context.Context, username string) (*User, error)
+	// FindByEmail 按邮箱查询用户，不存在或邮箱对应多个用户时返回 nil
+	FindByEmail(ctx context.Context, email string) (*User, error)
+	// FindByMobile 按手机号查询用户，不存在或手机号对应多个用户时返回 nil
+	FindByMobile(ctx context.Context, mobile string) (*User, error)
 	List(ctx context.Context, query *WhereUserOpt) ([]*User, error)
 	Count(ctx context.Context, query *WhereUserOpt) (int64, error)
+	// CountByTenant 统计当前租户的用户数，不受数据范围限制
+	CountByTenant(ctx context.Context) (int64, error)
 	Update(ctx context.Context, user *User) error
 	UpdatePassword(ctx context.Context, bo *UpdatePasswordBO) error
 	UpdateStatus(ctx context.Context, bo *UpdateStatusBO) error
@@ -28,6 +40,7 @@ type UserRepo interface {
 
 type UserDeptRepo interface {
 	GetUserDepts(ctx context.Context, userID string) ([]*UserDept, error)
+	ListByDeptID(ctx context.Context, deptID string) ([]*UserDept, error)
 	Delete(ctx context.Context, id string) error
 	Create(ctx context.Context, item *UserDept) error
 }
@@ -40,10 +53,24 @@ type UserPostRepo interface {
 
 type UserRoleRepo interface {
 	GetUserRoles(ctx context.Context, userID string) ([]*UserRole, error)
+	ListByRoleID(ctx context.Context, roleID string) ([]*UserRole, error)
 	Delete(ctx context.Context, id string) error
 	Create(ctx context.Context, item *UserRole) error
 }
 
+// UserSessionRepo 用户登录会话，禁用或删除用户后吊销其令牌
+type UserSessionRepo interface {
+	RevokeByUserID(ctx context.Context, userID string) error
+}
+
+// PasswordRepo 密码策略与历史密码
+type PasswordRepo interface {
+	Policy() *validator.PasswordPolicy
+	// ListHistory 返回最近使用过的密码哈希，按设置时间倒序
+	ListHistory(ctx context.Context, userID string, limit int) ([]string, error)
+	CreateHistory(ctx context.Context, history *PasswordHistory) error
+}
+
 type UserUsecase struct {
 	tm           transaction.Manager
 	idgen        *idgen.IDGenerator
@@ -51,6 +78,9 @@ type UserUsecase struct {
 	userDeptRepo UserDeptRepo
 	userPostRepo UserPostRepo
 	userRoleRepo UserRoleRepo
+	sessionRepo  UserSessionRepo
+	passwordRepo PasswordRepo
+	tenantUc     *tenantBiz.TenantUsecase
 	log          *log.Helper
 }
 
@@ -62,6 +92,9 @@ func NewUserUsecase(
 	deptRepo UserDeptRepo,
 	postRepo UserPostRepo,
 	roleRepo UserRoleRepo,
+	sessionRepo UserSessionRepo,
+	passwordRepo PasswordRepo,
+	tenantUc *tenantBiz.TenantUsecase,
 ) *UserUsecase {
 	return &UserUsecase{
 		log:          log.NewHelper(log.With(logger, "module", "user/biz/user")),
@@ -71,6 +104,9 @@ func NewUserUsecase(
 		userDeptRepo: deptRepo,
 		userPostRepo: postRepo,
 		userRoleRepo: roleRepo,
+		sessionRepo:  sessionRepo,
+		passwordRepo: passwordRepo,
+		tenantUc:     tenantUc,
 	}
 }
 
@@ -84,18 +120,104 @@ func (uc *UserUsecase) CreateUser(ctx context.Context, user *User) error {
 		uc.log.WithContext(ctx).Error("已存在相同用户名,username:%s", user.Username)
 		return errorx.Err(errkey.ErrUserExists)
 	}
-	if user.Password == "" {
-		user.Password = "123456"
+	if err = uc.validatePassword(user.Password, user.Username); err != nil {
+		return err
 	}
 	password, err := pswd.HashPassword(user.Password)
 	if err != nil {
-		uc.log.WithContext(ctx).Errorf("密码加密出现错误,password:%s,error:%v", user.Password, err)
+		uc.log.WithContext(ctx).Errorf("密码加密出现错误,error:%v", err)
 		return errorx.Err(errkey.ErrInternalServer)
 	}
 	user.Password = password
-	user.ID = uc.idgen.NextID(id.ADMIN_USER)
+	// 管理员设置的初始密码，用户首次登录需修改
+	user.PasswordReset = true
 
-	return uc.userRepo.Create(ctx, user)
+	return uc.tm.Tx(ctx, func(ctx context.Context) error {
+		if err := uc.checkAccountQuota(ctx); err != nil {
+			return err
+		}
+		user.ID = uc.idgen.NextID(id.ADMIN_USER)
+		if err := uc.userRepo.Create(ctx, user); err != nil {
+			return err
+		}
+		return uc.savePasswordHistory(ctx, user.ID, password)
+	})
+}
+
+// ProvisionUser 创建第三方登录或目录同步自动注册的用户并关联部门和角色，本地密码为不可用的随机值，
+// 调用方已开启事务时加入外层事务
+func (uc *UserUsecase) ProvisionUser(ctx context.Context, user *User, deptIDs, roleIDs []string) error {
+	existing, err := uc.userRepo.FindByUsername(ctx, user.Username)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("查询用户失败,username:%s,error:%v", user.Username, err)
+		return err
+	}
+	if existing != nil {
+		return errorx.Err(errkey.ErrUserExists)
+	}
+	random := make([]byte, 32)
+	if _, err = rand.Read(random); err != nil {
+		return err
+	}
+	password, err := pswd.HashPassword(base64.RawURLEncoding.EncodeToString(random))
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("密码加密出现错误,error:%v", err)
+		return errorx.Err(errkey.ErrInternalServer)
+	}
+	user.Password = password
+	user.PasswordReset = false
+	user.Status = 1
+	user.TenantID = ctxs.GetTenantID(ctx)
+
+	return uc.tm.Tx(ctx, func(ctx context.Context) error {
+		if err := uc.checkAccountQuota(ctx); err != nil {
+			return err
+		}
+		user.ID = uc.idgen.NextID(id.ADMIN_USER)
+		if err := uc.userRepo.Create(ctx, user); err != nil {
+			return err
+		}
+		for _, deptID := range deptIDs {
+			err := uc.userDeptRepo.Create(ctx, &UserDept{ID: uc.idgen.NextID(id.EMPTY), UserID: user.ID, DeptID: deptID})
+			if err != nil {
+				uc.log.WithContext(ctx).Errorf("添加用户部门出现错误,userID:%s,deptID:%s,error:%v", user.ID, deptID, err)
+				return err
+			}
+		}
+		for _, roleID := range roleIDs {
+			err := uc.userRoleRepo.Create(ctx, &UserRole{ID: uc.idgen.NextID(id.EMPTY), UserID: user.ID, RoleID: roleID})
+			if err != nil {
+				uc.log.WithContext(ctx).Errorf("添加用户角色出现错误,userID:%s,roleID:%s,error:%v", user.ID, roleID, err)
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// checkAccountQuota 校验当前租户的账号数量是否已达到上限，全局租户或账号数量不大于 0 时不限制。
+// 需在创建用户的事务中调用，锁定租户直到事务结束，避免并发创建超出上限
+func (uc *UserUsecase) checkAccountQuota(ctx context.Context) error {
+	tenantID := ctxs.GetTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	tenant, err := uc.tenantUc.LockTenant(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if tenant == nil || tenant.AccountCount <= 0 {
+		return nil
+	}
+	count, err := uc.userRepo.CountByTenant(ctx)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("统计租户用户数失败,tenantID:%s,error:%v", tenant.ID, err)
+		return err
+	}
+	if count >= int64(tenant.AccountCount) {
+		return errorx.Err(errkey.ErrTenantAccountLimit, tenant.AccountCount)
+	}
+	return nil
 }
 
 func (uc *UserUsecase) GetUser(ctx context.Context, id string) (*User, error) {
@@ -106,6 +228,14 @@ func (uc *UserUsecase) GetUserByUsername(ctx context.Context, username string) (
 	return uc.userRepo.FindByUsername(ctx, username)
 }
 
+func (uc *UserUsecase) GetUserByEmail(ctx context.Context, email string) (*User, error) {
+	return uc.userRepo.FindByEmail(ctx, email)
+}
+
+func (uc *UserUsecase) GetUserByMobile(ctx context.Context, mobile string) (*User, error) {
+	return uc.userRepo.FindByMobile(ctx, mobile)
+}
+
 func (uc *UserUsecase) VerifyPassword(ctx context.Context, hashedPassword, plainPassword string) (bool, error) {
 	ok, err := pswd.VerifyPassword(plainPassword, hashedPassword)
 	if err != nil {
@@ -149,6 +279,21 @@ func (uc *UserUsecase) ListUsers(ctx context.Context, query *ListUsersQuery) (*L
 	}, nil
 }
 
+// SearchUsers 按偏移量查询用户及总数，供 SCIM 等以 startIndex 分页的接口使用
+func (uc *UserUsecase) SearchUsers(ctx context.Context, opt *WhereUserOpt) ([]*User, int64, error) {
+	list, err := uc.userRepo.List(ctx, opt)
+	if err != nil {
+		uc.log.WithContext(ctx).Error("查询用户列表失败", err)
+		return nil, 0, err
+	}
+	total, err := uc.userRepo.Count(ctx, opt)
+	if err != nil {
+		uc.log.WithContext(ctx).Error("查询用户列表总数失败", err)
+		return nil, 0, err
+	}
+	return list, total, nil
+}
+
 func (uc *UserUsecase) UpdateUser(ctx context.Context, user *User) error {
 	return uc.userRepo.Update(ctx, user)
 }
@@ -156,26 +301,134 @@ func (uc *UserUsecase) UpdateUser(ctx context.Context, user *User) error {
 func (uc *UserUsecase) ChangePassword(ctx context.Context, bo *UpdatePasswordBO) error {
 	user, err := uc.userRepo.FindByID(ctx, bo.UserID)
 	if err != nil {
-		uc.log.Error("查询用户失败,userID:%s,error:%v", bo.UserID, err)
+		uc.log.WithContext(ctx).Errorf("查询用户失败,userID:%s,error:%v", bo.UserID, err)
 		return err
 	}
+	if user == nil {
+		return errorx.Err(errkey.ErrUserNotFound)
+	}
 	ok, err := pswd.VerifyPassword(bo.OldPassword, user.Password)
 	if err != nil {
-		uc.log.WithContext(ctx).Error("密码验证出现错误,req:%v,error:%v", bo.OldPassword, err)
+		uc.log.WithContext(ctx).Errorf("密码验证出现错误,userID:%s,error:%v", bo.UserID, err)
 		return err
 	}
 	if !ok {
 		uc.log.WithContext(ctx).Error("旧密码错误为匹配")
 		return errorx.Err(errkey.ErrPasswordNotMatch)
 	}
-	password, err := pswd.HashPassword(bo.NewPassword)
+	return uc.setPassword(ctx, user, bo.NewPassword, false)
+}
+
+// ResetPassword 管理员重置密码，用户下次登录需修改密码，已登录的会话全部下线
+func (uc *UserUsecase) ResetPassword(ctx context.Context, bo *ResetPasswordBO) error {
+	user, err := uc.userRepo.FindByID(ctx, bo.UserID)
 	if err != nil {
-		uc.log.WithContext(ctx).Error("密码加密出现错误,password:%s,error:%v", bo.NewPassword, err)
+		uc.log.WithContext(ctx).Errorf("查询用户失败,userID:%s,error:%v", bo.UserID, err)
+		return err
+	}
+	if user == nil {
+		return errorx.Err(errkey.ErrUserNotFound)
+	}
+	return uc.setPassword(ctx, user, bo.Password, true)
+}
+
+// RecoverPassword 用户通过找回密码设置新密码，已登录的会话全部下线
+func (uc *UserUsecase) RecoverPassword(ctx context.Context, user *User, password string) error {
+	return uc.setPassword(ctx, user, password, false)
+}
+
+// PasswordChangeRequired 密码由管理员设置或已超过最长使用期限时，用户需先修改密码
+func (uc *UserUsecase) PasswordChangeRequired(user *User) bool {
+	return user.PasswordReset || uc.passwordRepo.Policy().Expired(user.PasswordUpdateAt, time.Now())
+}
+
+// setPassword 校验密码策略和历史密码后更新密码，并吊销用户的所有会话
+func (uc *UserUsecase) setPassword(ctx context.Context, user *User, plainPassword string, reset bool) error {
+	if err := uc.CheckNewPassword(ctx, user, plainPassword); err != nil {
+		return err
+	}
+
+	password, err := pswd.HashPassword(plainPassword)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("密码加密出现错误,error:%v", err)
 		return errorx.Err(errkey.ErrInternalServer)
 	}
-	bo.NewPassword = password
+	err = uc.userRepo.UpdatePassword(ctx, &UpdatePasswordBO{
+		UserID:        user.ID,
+		NewPassword:   password,
+		PasswordReset: reset,
+	})
+	if err != nil {
+		return err
+	}
+	if err = uc.savePasswordHistory(ctx, user.ID, password); err != nil {
+		return err
+	}
+	return uc.sessionRepo.RevokeByUserID(ctx, user.ID)
+}
 
-	return uc.userRepo.UpdatePassword(ctx, bo)
+// CheckNewPassword 校验新密码是否符合密码策略且未与当前或历史密码重复
+func (uc *UserUsecase) CheckNewPassword(ctx context.Context, user *User, plainPassword string) error {
+	if err := uc.validatePassword(plainPassword, user.Username); err != nil {
+		return err
+	}
+	reused, err := uc.passwordReused(ctx, user, plainPassword)
+	if err != nil {
+		return err
+	}
+	if reused {
+		return errorx.Err(errkey.ErrPasswordReused)
+	}
+	return nil
+}
+
+// GeneratePassword 生成满足密码策略的随机初始密码
+func (uc *UserUsecase) GeneratePassword() (string, error) {
+	return pswd.GeneratePassword(max(16, uc.passwordRepo.Policy().MinLength))
+}
+
+func (uc *UserUsecase) validatePassword(password, username string) error {
+	if err := uc.passwordRepo.Policy().Validate(password, username); err != nil {
+		return errorx.Err(errkey.ErrPasswordPolicy, err.Error())
+	}
+	return nil
+}
+
+// passwordReused 新密码与当前密码或最近使用过的密码相同时返回 true
+func (uc *UserUsecase) passwordReused(ctx context.Context, user *User, plainPassword string) (bool, error) {
+	hashes := []string{user.Password}
+	if depth := uc.passwordRepo.Policy().HistoryDepth; depth > 0 {
+		history, err := uc.passwordRepo.ListHistory(ctx, user.ID, depth)
+		if err != nil {
+			return false, err
+		}
+		hashes = append(hashes, history...)
+	}
+	for _, hash := range hashes {
+		if hash == "" {
+			continue
+		}
+		ok, err := pswd.VerifyPassword(plainPassword, hash)
+		if err != nil {
+			uc.log.WithContext(ctx).Errorf("历史密码校验出现错误,userID:%s,error:%v", user.ID, err)
+			continue
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (uc *UserUsecase) savePasswordHistory(ctx context.Context, userID, password string) error {
+	if uc.passwordRepo.Policy().HistoryDepth <= 0 {
+		return nil
+	}
+	return uc.passwordRepo.CreateHistory(ctx, &PasswordHistory{
+		ID:       uc.idgen.NextID(id.PASSWORD_HIST),
+		UserID:   userID,
+		Password: password,
+	})
 }
 
 func (uc *UserUsecase) ChangeUserStatus(ctx context.Context, bo *UpdateStatusBO) error {
@@ -185,7 +438,13 @@ func (uc *UserUsecase) ChangeUserStatus(ctx context.Context, bo *UpdateStatusBO)
 		return err
 	}
 
-	return uc.userRepo.UpdateStatus(ctx, bo)
+	if err = uc.userRepo.UpdateStatus(ctx, bo); err != nil {
+		return err
+	}
+	if bo.Status != 1 {
+		return uc.sessionRepo.RevokeByUserID(ctx, bo.UserID)
+	}
+	return nil
 }
 
 func (uc *UserUsecase) DeleteUser(ctx context.Context, id string) error {
@@ -195,9 +454,13 @@ func (uc *UserUsecase) DeleteUser(ctx context.Context, id string) error {
 		return err
 	}
 
-	return uc.userRepo.Delete(ctx, &DeleteUserBO{
+	err = uc.userRepo.Delete(ctx, &DeleteUserBO{
 		UserID: id,
 	})
+	if err != nil {
+		return err
+	}
+	return uc.sessionRepo.RevokeByUserID(ctx, id)
 }
 
 func (uc *UserUsecase) UpdateLoginInfo(ctx context.Context, bo *UpdateLoginInfoBO) error {
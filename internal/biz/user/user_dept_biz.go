@@ -17,6 +17,18 @@ func (uc *UserUsecase) GetUserDepts(ctx context.Context, userID string) ([]strin
 	}), nil
 }
 
+// ListDeptUserIDs 获取直接关联到部门的用户
+func (uc *UserUsecase) ListDeptUserIDs(ctx context.Context, deptID string) ([]string, error) {
+	items, err := uc.userDeptRepo.ListByDeptID(ctx, deptID)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("获取部门关联用户出现错误,deptID:%s,error:%v", deptID, err)
+		return nil, err
+	}
+	return slices.Map(items, func(item *UserDept, index int) string {
+		return item.UserID
+	}), nil
+}
+
 func (uc *UserUsecase) AssignUserDepts(ctx context.Context, bo *AssignUserDeptsBO) error {
 	dbUserDepts, err := uc.userDeptRepo.GetUserDepts(ctx, bo.UserID)
 	if err != nil {
@@ -17,6 +17,18 @@ func (uc *UserUsecase) GetUserRoles(ctx context.Context, userID string) ([]strin
 	}), nil
 }
 
+// ListRoleUserIDs 获取拥有角色的用户
+func (uc *UserUsecase) ListRoleUserIDs(ctx context.Context, roleID string) ([]string, error) {
+	items, err := uc.userRoleRepo.ListByRoleID(ctx, roleID)
+	if err != nil {
+		uc.log.WithContext(ctx).Errorf("获取角色关联用户出现错误,roleID:%s,error:%v", roleID, err)
+		return nil, err
+	}
+	return slices.Map(items, func(item *UserRole, index int) string {
+		return item.UserID
+	}), nil
+}
+
 func (uc *UserUsecase) AssignUserRoles(ctx context.Context, bo *AssignUserRolesBO) error {
 	dbUserRoles, err := uc.userRoleRepo.GetUserRoles(ctx, bo.UserID)
 	if err != nil {
@@ -27,6 +27,10 @@ type Bootstrap struct {
 	Server        *Server                `protobuf:"bytes,2,opt,name=server,proto3" json:"server,omitempty"`
 	Data          *Data                  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	Log           *Log                   `protobuf:"bytes,4,opt,name=log,proto3" json:"log,omitempty"`
+	Auth          *Auth                  `protobuf:"bytes,5,opt,name=auth,proto3" json:"auth,omitempty"`
+	Mail          *Mail                  `protobuf:"bytes,6,opt,name=mail,proto3" json:"mail,omitempty"`
+	Sms           *Sms                   `protobuf:"bytes,7,opt,name=sms,proto3" json:"sms,omitempty"`
+	Tenant        *Tenant                `protobuf:"bytes,8,opt,name=tenant,proto3" json:"tenant,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -89,6 +93,34 @@ func (x *Bootstrap) GetLog() *Log {
 	return nil
 }
 
+func (x *Bootstrap) GetAuth() *Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetMail() *Mail {
+	if x != nil {
+		return x.Mail
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetSms() *Sms {
+	if x != nil {
+		return x.Sms
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetTenant() *Tenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
 type Env struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Active        string                 `protobuf:"bytes,1,opt,name=active,proto3" json:"active,omitempty"`
@@ -126,36 +158,940 @@ func (*Env) Descriptor() ([]byte, []int) {
 	return file_conf_conf_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Env) GetActive() string {
+func (x *Env) GetActive() string {
+	if x != nil {
+		return x.Active
+	}
+	return ""
+}
+
+type Server struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Http  *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	Grpc  *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	// 受信代理的网段，直连地址属于其中时才采信 X-Forwarded-For 和 X-Real-IP
+	TrustedProxies []string `protobuf:"bytes,3,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Server) Reset() {
+	*x = Server{}
+	mi := &file_conf_conf_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Server) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server) ProtoMessage() {}
+
+func (x *Server) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server.ProtoReflect.Descriptor instead.
+func (*Server) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Server) GetHttp() *Server_HTTP {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *Server) GetGrpc() *Server_GRPC {
+	if x != nil {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (x *Server) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
+type Data struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
+	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Data) Reset() {
+	*x = Data{}
+	mi := &file_conf_conf_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Data) ProtoMessage() {}
+
+func (x *Data) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Data.ProtoReflect.Descriptor instead.
+func (*Data) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Data) GetDatabase() *Data_Database {
+	if x != nil {
+		return x.Database
+	}
+	return nil
+}
+
+func (x *Data) GetRedis() *Data_Redis {
+	if x != nil {
+		return x.Redis
+	}
+	return nil
+}
+
+// 邮件发送
+type Mail struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 发送方式：smtp 或 file，file 只将邮件写入本地目录，用于开发和测试
+	Driver string `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	// 发件人地址
+	From          string     `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	Smtp          *Mail_Smtp `protobuf:"bytes,3,opt,name=smtp,proto3" json:"smtp,omitempty"`
+	File          *Mail_File `protobuf:"bytes,4,opt,name=file,proto3" json:"file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mail) Reset() {
+	*x = Mail{}
+	mi := &file_conf_conf_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mail) ProtoMessage() {}
+
+func (x *Mail) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mail.ProtoReflect.Descriptor instead.
+func (*Mail) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Mail) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Mail) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *Mail) GetSmtp() *Mail_Smtp {
+	if x != nil {
+		return x.Smtp
+	}
+	return nil
+}
+
+func (x *Mail) GetFile() *Mail_File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+// 短信发送
+type Sms struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 发送方式：log 或 file，均不真正发送，用于开发和测试，为空时默认 log
+	Driver        string    `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	File          *Sms_File `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sms) Reset() {
+	*x = Sms{}
+	mi := &file_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sms) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sms) ProtoMessage() {}
+
+func (x *Sms) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sms.ProtoReflect.Descriptor instead.
+func (*Sms) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Sms) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *Sms) GetFile() *Sms_File {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+type Log struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	MaxSize       int32                  `protobuf:"varint,3,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	MaxAge        int32                  `protobuf:"varint,4,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
+	MaxBackups    int32                  `protobuf:"varint,5,opt,name=maxBackups,proto3" json:"maxBackups,omitempty"`
+	Stdout        bool                   `protobuf:"varint,6,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Log) Reset() {
+	*x = Log{}
+	mi := &file_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Log) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Log) ProtoMessage() {}
+
+func (x *Log) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Log.ProtoReflect.Descriptor instead.
+func (*Log) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Log) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *Log) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Log) GetMaxSize() int32 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *Log) GetMaxAge() int32 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+func (x *Log) GetMaxBackups() int32 {
+	if x != nil {
+		return x.MaxBackups
+	}
+	return 0
+}
+
+func (x *Log) GetStdout() bool {
+	if x != nil {
+		return x.Stdout
+	}
+	return false
+}
+
+type Auth struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 访问令牌有效期，单位秒
+	AccessTokenTtl int64 `protobuf:"varint,1,opt,name=access_token_ttl,json=accessTokenTtl,proto3" json:"access_token_ttl,omitempty"`
+	// 刷新令牌有效期，单位秒
+	RefreshTokenTtl int64       `protobuf:"varint,2,opt,name=refresh_token_ttl,json=refreshTokenTtl,proto3" json:"refresh_token_ttl,omitempty"`
+	LoginLimit      *LoginLimit `protobuf:"bytes,3,opt,name=login_limit,json=loginLimit,proto3" json:"login_limit,omitempty"`
+	// 图形验证码有效期，单位秒
+	CaptchaTtl     int64           `protobuf:"varint,4,opt,name=captcha_ttl,json=captchaTtl,proto3" json:"captcha_ttl,omitempty"`
+	Mfa            *Mfa            `protobuf:"bytes,5,opt,name=mfa,proto3" json:"mfa,omitempty"`
+	PasswordPolicy *PasswordPolicy `protobuf:"bytes,6,opt,name=password_policy,json=passwordPolicy,proto3" json:"password_policy,omitempty"`
+	PasswordReset  *PasswordReset  `protobuf:"bytes,7,opt,name=password_reset,json=passwordReset,proto3" json:"password_reset,omitempty"`
+	Oidc           *Oidc           `protobuf:"bytes,8,opt,name=oidc,proto3" json:"oidc,omitempty"`
+	Social         *Social         `protobuf:"bytes,9,opt,name=social,proto3" json:"social,omitempty"`
+	Ldap           *Ldap           `protobuf:"bytes,10,opt,name=ldap,proto3" json:"ldap,omitempty"`
+	Passkey        *Passkey        `protobuf:"bytes,11,opt,name=passkey,proto3" json:"passkey,omitempty"`
+	LoginCode      *LoginCode      `protobuf:"bytes,12,opt,name=login_code,json=loginCode,proto3" json:"login_code,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Auth) Reset() {
+	*x = Auth{}
+	mi := &file_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth) ProtoMessage() {}
+
+func (x *Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth.ProtoReflect.Descriptor instead.
+func (*Auth) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Auth) GetAccessTokenTtl() int64 {
+	if x != nil {
+		return x.AccessTokenTtl
+	}
+	return 0
+}
+
+func (x *Auth) GetRefreshTokenTtl() int64 {
+	if x != nil {
+		return x.RefreshTokenTtl
+	}
+	return 0
+}
+
+func (x *Auth) GetLoginLimit() *LoginLimit {
+	if x != nil {
+		return x.LoginLimit
+	}
+	return nil
+}
+
+func (x *Auth) GetCaptchaTtl() int64 {
+	if x != nil {
+		return x.CaptchaTtl
+	}
+	return 0
+}
+
+func (x *Auth) GetMfa() *Mfa {
+	if x != nil {
+		return x.Mfa
+	}
+	return nil
+}
+
+func (x *Auth) GetPasswordPolicy() *PasswordPolicy {
+	if x != nil {
+		return x.PasswordPolicy
+	}
+	return nil
+}
+
+func (x *Auth) GetPasswordReset() *PasswordReset {
+	if x != nil {
+		return x.PasswordReset
+	}
+	return nil
+}
+
+func (x *Auth) GetOidc() *Oidc {
+	if x != nil {
+		return x.Oidc
+	}
+	return nil
+}
+
+func (x *Auth) GetSocial() *Social {
+	if x != nil {
+		return x.Social
+	}
+	return nil
+}
+
+func (x *Auth) GetLdap() *Ldap {
+	if x != nil {
+		return x.Ldap
+	}
+	return nil
+}
+
+func (x *Auth) GetPasskey() *Passkey {
+	if x != nil {
+		return x.Passkey
+	}
+	return nil
+}
+
+func (x *Auth) GetLoginCode() *LoginCode {
+	if x != nil {
+		return x.LoginCode
+	}
+	return nil
+}
+
+// 短信、邮件验证码登录
+type LoginCode struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 验证码有效期，单位秒，为 0 时默认 300
+	Ttl int64 `protobuf:"varint,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// 验证码位数，为 0 时默认 6
+	Length int32 `protobuf:"varint,2,opt,name=length,proto3" json:"length,omitempty"`
+	// 同一手机号或邮箱的发送间隔，单位秒，为 0 时默认 60
+	SendInterval int64 `protobuf:"varint,3,opt,name=send_interval,json=sendInterval,proto3" json:"send_interval,omitempty"`
+	// 同一手机号或邮箱每天最多发送次数，为 0 时默认 10
+	DailyLimit int32 `protobuf:"varint,4,opt,name=daily_limit,json=dailyLimit,proto3" json:"daily_limit,omitempty"`
+	// 同一 IP 每天最多发送次数，为 0 时默认 50
+	IpDailyLimit  int32 `protobuf:"varint,5,opt,name=ip_daily_limit,json=ipDailyLimit,proto3" json:"ip_daily_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginCode) Reset() {
+	*x = LoginCode{}
+	mi := &file_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginCode) ProtoMessage() {}
+
+func (x *LoginCode) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginCode.ProtoReflect.Descriptor instead.
+func (*LoginCode) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LoginCode) GetTtl() int64 {
+	if x != nil {
+		return x.Ttl
+	}
+	return 0
+}
+
+func (x *LoginCode) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *LoginCode) GetSendInterval() int64 {
+	if x != nil {
+		return x.SendInterval
+	}
+	return 0
+}
+
+func (x *LoginCode) GetDailyLimit() int32 {
+	if x != nil {
+		return x.DailyLimit
+	}
+	return 0
+}
+
+func (x *LoginCode) GetIpDailyLimit() int32 {
+	if x != nil {
+		return x.IpDailyLimit
+	}
+	return 0
+}
+
+// 第三方（上游 OIDC）登录
+type Social struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 加密存储上游客户端密钥的密钥口令，必填
+	SecretKey string `protobuf:"bytes,1,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	// 前端回调页地址，需登记到上游身份提供方，回调页将 code 和 state 提交给 SocialCallback
+	RedirectUrl string `protobuf:"bytes,2,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`
+	// 授权状态有效期，单位秒，为 0 时默认 600
+	StateTtl      int64 `protobuf:"varint,3,opt,name=state_ttl,json=stateTtl,proto3" json:"state_ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Social) Reset() {
+	*x = Social{}
+	mi := &file_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Social) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Social) ProtoMessage() {}
+
+func (x *Social) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Social.ProtoReflect.Descriptor instead.
+func (*Social) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Social) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *Social) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *Social) GetStateTtl() int64 {
+	if x != nil {
+		return x.StateTtl
+	}
+	return 0
+}
+
+// LDAP / Active Directory 认证与目录同步
+type Ldap struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 加密存储服务账号密码的密钥口令，必填
+	SecretKey string `protobuf:"bytes,1,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	// 检查同步任务是否到期的间隔，单位秒，为 0 时默认 60
+	SyncCheckInterval int64 `protobuf:"varint,2,opt,name=sync_check_interval,json=syncCheckInterval,proto3" json:"sync_check_interval,omitempty"`
+	// 连接和单次请求的超时时间，单位秒，为 0 时默认 10
+	Timeout       int64 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Ldap) Reset() {
+	*x = Ldap{}
+	mi := &file_conf_conf_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Ldap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ldap) ProtoMessage() {}
+
+func (x *Ldap) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ldap.ProtoReflect.Descriptor instead.
+func (*Ldap) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Ldap) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *Ldap) GetSyncCheckInterval() int64 {
+	if x != nil {
+		return x.SyncCheckInterval
+	}
+	return 0
+}
+
+func (x *Ldap) GetTimeout() int64 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+// 通行密钥（WebAuthn）
+type Passkey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 依赖方 ID，为前端页面的域名（不含协议和端口），为空时不启用通行密钥
+	RpId string `protobuf:"bytes,1,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`
+	// 认证器中显示的依赖方名称，为空时默认 Quest Admin
+	RpName string `protobuf:"bytes,2,opt,name=rp_name,json=rpName,proto3" json:"rp_name,omitempty"`
+	// 允许发起仪式的前端页面源，如 https://admin.example.com
+	Origins []string `protobuf:"bytes,3,rep,name=origins,proto3" json:"origins,omitempty"`
+	// 注册和登录仪式的挑战有效期，单位秒，为 0 时默认 300
+	SessionTtl    int64 `protobuf:"varint,4,opt,name=session_ttl,json=sessionTtl,proto3" json:"session_ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Passkey) Reset() {
+	*x = Passkey{}
+	mi := &file_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Passkey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Passkey) ProtoMessage() {}
+
+func (x *Passkey) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Passkey.ProtoReflect.Descriptor instead.
+func (*Passkey) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Passkey) GetRpId() string {
+	if x != nil {
+		return x.RpId
+	}
+	return ""
+}
+
+func (x *Passkey) GetRpName() string {
+	if x != nil {
+		return x.RpName
+	}
+	return ""
+}
+
+func (x *Passkey) GetOrigins() []string {
+	if x != nil {
+		return x.Origins
+	}
+	return nil
+}
+
+func (x *Passkey) GetSessionTtl() int64 {
+	if x != nil {
+		return x.SessionTtl
+	}
+	return 0
+}
+
+// OpenID Connect
+type Oidc struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 签发者，需与对外访问的服务地址一致，发现文档位于 {issuer}/.well-known/openid-configuration
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// 签名算法 RS256 或 ES256，为空时默认 RS256
+	Algorithm string `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// 登录接口签发的 ID Token 的受众，为空时默认 quest-admin
+	Audience string `protobuf:"bytes,3,opt,name=audience,proto3" json:"audience,omitempty"`
+	// ID Token 有效期，单位秒，为 0 时默认 3600
+	IdTokenTtl int64 `protobuf:"varint,4,opt,name=id_token_ttl,json=idTokenTtl,proto3" json:"id_token_ttl,omitempty"`
+	// 签名密钥轮换周期，单位秒，为 0 时默认 30 天
+	KeyRotationPeriod int64 `protobuf:"varint,5,opt,name=key_rotation_period,json=keyRotationPeriod,proto3" json:"key_rotation_period,omitempty"`
+	// 加密存储签名私钥的密钥
+	SecretKey string `protobuf:"bytes,6,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	// 前端授权确认页地址，写入发现文档的 authorization_endpoint，为空时使用 {issuer}/qs/v1/oauth2/authorize
+	AuthorizeUrl  string `protobuf:"bytes,7,opt,name=authorize_url,json=authorizeUrl,proto3" json:"authorize_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Oidc) Reset() {
+	*x = Oidc{}
+	mi := &file_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Oidc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Oidc) ProtoMessage() {}
+
+func (x *Oidc) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Oidc.ProtoReflect.Descriptor instead.
+func (*Oidc) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Oidc) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *Oidc) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *Oidc) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *Oidc) GetIdTokenTtl() int64 {
+	if x != nil {
+		return x.IdTokenTtl
+	}
+	return 0
+}
+
+func (x *Oidc) GetKeyRotationPeriod() int64 {
+	if x != nil {
+		return x.KeyRotationPeriod
+	}
+	return 0
+}
+
+func (x *Oidc) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *Oidc) GetAuthorizeUrl() string {
+	if x != nil {
+		return x.AuthorizeUrl
+	}
+	return ""
+}
+
+// 找回密码
+type PasswordReset struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 重置令牌有效期，单位秒
+	TokenTtl int64 `protobuf:"varint,1,opt,name=token_ttl,json=tokenTtl,proto3" json:"token_ttl,omitempty"`
+	// 前端重置密码页面地址，令牌以 token 参数拼接在其后
+	ResetUrl      string `protobuf:"bytes,2,opt,name=reset_url,json=resetUrl,proto3" json:"reset_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PasswordReset) Reset() {
+	*x = PasswordReset{}
+	mi := &file_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordReset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordReset) ProtoMessage() {}
+
+func (x *PasswordReset) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordReset.ProtoReflect.Descriptor instead.
+func (*PasswordReset) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PasswordReset) GetTokenTtl() int64 {
+	if x != nil {
+		return x.TokenTtl
+	}
+	return 0
+}
+
+func (x *PasswordReset) GetResetUrl() string {
 	if x != nil {
-		return x.Active
+		return x.ResetUrl
 	}
 	return ""
 }
 
-type Server struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
-	Grpc          *Server_GRPC           `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+// 密码策略
+type PasswordPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 最小长度，为 0 时默认 8
+	MinLength int32 `protobuf:"varint,1,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`
+	// 至少包含的字符类别数（大写、小写、数字、特殊字符），为 0 时默认 3
+	MinClasses int32 `protobuf:"varint,2,opt,name=min_classes,json=minClasses,proto3" json:"min_classes,omitempty"`
+	// 额外禁用的密码，内置常见弱密码列表
+	Denylist []string `protobuf:"bytes,3,rep,name=denylist,proto3" json:"denylist,omitempty"`
+	// 不允许与最近多少次使用过的密码相同，为 0 时不限制
+	HistoryDepth int32 `protobuf:"varint,4,opt,name=history_depth,json=historyDepth,proto3" json:"history_depth,omitempty"`
+	// 密码最长使用天数，超过后下次登录需修改密码，为 0 时不过期
+	MaxAgeDays    int32 `protobuf:"varint,5,opt,name=max_age_days,json=maxAgeDays,proto3" json:"max_age_days,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Server) Reset() {
-	*x = Server{}
-	mi := &file_conf_conf_proto_msgTypes[2]
+func (x *PasswordPolicy) Reset() {
+	*x = PasswordPolicy{}
+	mi := &file_conf_conf_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Server) String() string {
+func (x *PasswordPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Server) ProtoMessage() {}
+func (*PasswordPolicy) ProtoMessage() {}
 
-func (x *Server) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[2]
+func (x *PasswordPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,48 +1102,74 @@ func (x *Server) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Server.ProtoReflect.Descriptor instead.
-func (*Server) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use PasswordPolicy.ProtoReflect.Descriptor instead.
+func (*PasswordPolicy) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Server) GetHttp() *Server_HTTP {
+func (x *PasswordPolicy) GetMinLength() int32 {
 	if x != nil {
-		return x.Http
+		return x.MinLength
 	}
-	return nil
+	return 0
 }
 
-func (x *Server) GetGrpc() *Server_GRPC {
+func (x *PasswordPolicy) GetMinClasses() int32 {
 	if x != nil {
-		return x.Grpc
+		return x.MinClasses
+	}
+	return 0
+}
+
+func (x *PasswordPolicy) GetDenylist() []string {
+	if x != nil {
+		return x.Denylist
 	}
 	return nil
 }
 
-type Data struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Database      *Data_Database         `protobuf:"bytes,1,opt,name=database,proto3" json:"database,omitempty"`
-	Redis         *Data_Redis            `protobuf:"bytes,2,opt,name=redis,proto3" json:"redis,omitempty"`
+func (x *PasswordPolicy) GetHistoryDepth() int32 {
+	if x != nil {
+		return x.HistoryDepth
+	}
+	return 0
+}
+
+func (x *PasswordPolicy) GetMaxAgeDays() int32 {
+	if x != nil {
+		return x.MaxAgeDays
+	}
+	return 0
+}
+
+// 多因素认证
+type Mfa struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 认证器 App 中显示的发行方名称
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// 加密 TOTP 密钥使用的密钥口令，必填
+	SecretKey string `protobuf:"bytes,2,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	// 密码校验通过后 MFA 票据的有效期，单位秒
+	TicketTtl     int64 `protobuf:"varint,3,opt,name=ticket_ttl,json=ticketTtl,proto3" json:"ticket_ttl,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Data) Reset() {
-	*x = Data{}
-	mi := &file_conf_conf_proto_msgTypes[3]
+func (x *Mfa) Reset() {
+	*x = Mfa{}
+	mi := &file_conf_conf_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Data) String() string {
+func (x *Mfa) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Data) ProtoMessage() {}
+func (*Mfa) ProtoMessage() {}
 
-func (x *Data) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[3]
+func (x *Mfa) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -218,52 +1180,64 @@ func (x *Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Data.ProtoReflect.Descriptor instead.
-func (*Data) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Mfa.ProtoReflect.Descriptor instead.
+func (*Mfa) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Data) GetDatabase() *Data_Database {
+func (x *Mfa) GetIssuer() string {
 	if x != nil {
-		return x.Database
+		return x.Issuer
 	}
-	return nil
+	return ""
 }
 
-func (x *Data) GetRedis() *Data_Redis {
+func (x *Mfa) GetSecretKey() string {
 	if x != nil {
-		return x.Redis
+		return x.SecretKey
 	}
-	return nil
+	return ""
 }
 
-type Log struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Level         string                 `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
-	Filename      string                 `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
-	MaxSize       int32                  `protobuf:"varint,3,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
-	MaxAge        int32                  `protobuf:"varint,4,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
-	MaxBackups    int32                  `protobuf:"varint,5,opt,name=maxBackups,proto3" json:"maxBackups,omitempty"`
-	Stdout        bool                   `protobuf:"varint,6,opt,name=stdout,proto3" json:"stdout,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Mfa) GetTicketTtl() int64 {
+	if x != nil {
+		return x.TicketTtl
+	}
+	return 0
 }
 
-func (x *Log) Reset() {
-	*x = Log{}
-	mi := &file_conf_conf_proto_msgTypes[4]
+// 登录失败限制，各项为 0 时使用默认值
+type LoginLimit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 同一用户名在统计窗口内允许的最大失败次数
+	MaxUserFailures int32 `protobuf:"varint,1,opt,name=max_user_failures,json=maxUserFailures,proto3" json:"max_user_failures,omitempty"`
+	// 同一 IP 在统计窗口内允许的最大失败次数
+	MaxIpFailures int32 `protobuf:"varint,2,opt,name=max_ip_failures,json=maxIpFailures,proto3" json:"max_ip_failures,omitempty"`
+	// 失败次数统计窗口，单位秒
+	Window int64 `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+	// 触发锁定后的锁定时长，单位秒
+	LockDuration int64 `protobuf:"varint,4,opt,name=lock_duration,json=lockDuration,proto3" json:"lock_duration,omitempty"`
+	// 用户名或 IP 失败次数达到该值后登录必须携带验证码，为 0 时不自动开启
+	CaptchaAfterFailures int32 `protobuf:"varint,5,opt,name=captcha_after_failures,json=captchaAfterFailures,proto3" json:"captcha_after_failures,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *LoginLimit) Reset() {
+	*x = LoginLimit{}
+	mi := &file_conf_conf_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Log) String() string {
+func (x *LoginLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Log) ProtoMessage() {}
+func (*LoginLimit) ProtoMessage() {}
 
-func (x *Log) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[4]
+func (x *LoginLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -274,51 +1248,99 @@ func (x *Log) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Log.ProtoReflect.Descriptor instead.
-func (*Log) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use LoginLimit.ProtoReflect.Descriptor instead.
+func (*LoginLimit) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Log) GetLevel() string {
+func (x *LoginLimit) GetMaxUserFailures() int32 {
 	if x != nil {
-		return x.Level
+		return x.MaxUserFailures
 	}
-	return ""
+	return 0
 }
 
-func (x *Log) GetFilename() string {
+func (x *LoginLimit) GetMaxIpFailures() int32 {
 	if x != nil {
-		return x.Filename
+		return x.MaxIpFailures
 	}
-	return ""
+	return 0
 }
 
-func (x *Log) GetMaxSize() int32 {
+func (x *LoginLimit) GetWindow() int64 {
 	if x != nil {
-		return x.MaxSize
+		return x.Window
 	}
 	return 0
 }
 
-func (x *Log) GetMaxAge() int32 {
+func (x *LoginLimit) GetLockDuration() int64 {
 	if x != nil {
-		return x.MaxAge
+		return x.LockDuration
 	}
 	return 0
 }
 
-func (x *Log) GetMaxBackups() int32 {
+func (x *LoginLimit) GetCaptchaAfterFailures() int32 {
 	if x != nil {
-		return x.MaxBackups
+		return x.CaptchaAfterFailures
 	}
 	return 0
 }
 
-func (x *Log) GetStdout() bool {
+// 租户
+type Tenant struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 检查租户是否即将到期或已过期的间隔，单位秒，为 0 时默认 3600
+	ExpiryCheckInterval int64 `protobuf:"varint,1,opt,name=expiry_check_interval,json=expiryCheckInterval,proto3" json:"expiry_check_interval,omitempty"`
+	// 到期前多少天向租户联系人发送提醒，为 0 时默认 7
+	ExpiryWarnDays int32 `protobuf:"varint,2,opt,name=expiry_warn_days,json=expiryWarnDays,proto3" json:"expiry_warn_days,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Tenant) Reset() {
+	*x = Tenant{}
+	mi := &file_conf_conf_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tenant) ProtoMessage() {}
+
+func (x *Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[17]
 	if x != nil {
-		return x.Stdout
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tenant.ProtoReflect.Descriptor instead.
+func (*Tenant) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Tenant) GetExpiryCheckInterval() int64 {
+	if x != nil {
+		return x.ExpiryCheckInterval
+	}
+	return 0
+}
+
+func (x *Tenant) GetExpiryWarnDays() int32 {
+	if x != nil {
+		return x.ExpiryWarnDays
+	}
+	return 0
 }
 
 type Server_HTTP struct {
@@ -332,7 +1354,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -344,7 +1366,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[5]
+	mi := &file_conf_conf_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -392,7 +1414,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -404,7 +1426,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -454,7 +1476,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -466,7 +1488,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -533,7 +1555,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -545,7 +1567,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -617,22 +1639,203 @@ func (x *Data_Redis) GetMinIdleConns() int32 {
 	return 0
 }
 
+type Mail_Smtp struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Host     string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port     int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Username string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// 是否直接建立 TLS 连接（通常为 465 端口），否则在服务器支持时使用 STARTTLS
+	ImplicitTls bool `protobuf:"varint,5,opt,name=implicit_tls,json=implicitTls,proto3" json:"implicit_tls,omitempty"`
+	// 连接超时，单位秒
+	Timeout       int32 `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mail_Smtp) Reset() {
+	*x = Mail_Smtp{}
+	mi := &file_conf_conf_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mail_Smtp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mail_Smtp) ProtoMessage() {}
+
+func (x *Mail_Smtp) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mail_Smtp.ProtoReflect.Descriptor instead.
+func (*Mail_Smtp) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *Mail_Smtp) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Mail_Smtp) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Mail_Smtp) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Mail_Smtp) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Mail_Smtp) GetImplicitTls() bool {
+	if x != nil {
+		return x.ImplicitTls
+	}
+	return false
+}
+
+func (x *Mail_Smtp) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type Mail_File struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 邮件文件写入目录
+	Dir           string `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Mail_File) Reset() {
+	*x = Mail_File{}
+	mi := &file_conf_conf_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Mail_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mail_File) ProtoMessage() {}
+
+func (x *Mail_File) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mail_File.ProtoReflect.Descriptor instead.
+func (*Mail_File) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *Mail_File) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+type Sms_File struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 短信文件写入目录
+	Dir           string `protobuf:"bytes,1,opt,name=dir,proto3" json:"dir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sms_File) Reset() {
+	*x = Sms_File{}
+	mi := &file_conf_conf_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sms_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sms_File) ProtoMessage() {}
+
+func (x *Sms_File) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sms_File.ProtoReflect.Descriptor instead.
+func (*Sms_File) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *Sms_File) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
 var File_conf_conf_proto protoreflect.FileDescriptor
 
 const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\"\xa3\x01\n" +
+	"kratos.api\"\xbe\x02\n" +
 	"\tBootstrap\x12!\n" +
 	"\x03env\x18\x01 \x01(\v2\x0f.kratos.api.EnvR\x03env\x12*\n" +
 	"\x06server\x18\x02 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x03 \x01(\v2\x10.kratos.api.DataR\x04data\x12!\n" +
-	"\x03log\x18\x04 \x01(\v2\x0f.kratos.api.LogR\x03log\"\x1d\n" +
+	"\x03log\x18\x04 \x01(\v2\x0f.kratos.api.LogR\x03log\x12$\n" +
+	"\x04auth\x18\x05 \x01(\v2\x10.kratos.api.AuthR\x04auth\x12$\n" +
+	"\x04mail\x18\x06 \x01(\v2\x10.kratos.api.MailR\x04mail\x12!\n" +
+	"\x03sms\x18\a \x01(\v2\x0f.kratos.api.SmsR\x03sms\x12*\n" +
+	"\x06tenant\x18\b \x01(\v2\x12.kratos.api.TenantR\x06tenant\"\x1d\n" +
 	"\x03Env\x12\x16\n" +
-	"\x06active\x18\x01 \x01(\tR\x06active\"\x82\x02\n" +
+	"\x06active\x18\x01 \x01(\tR\x06active\"\xab\x02\n" +
 	"\x06Server\x12+\n" +
 	"\x04http\x18\x01 \x01(\v2\x17.kratos.api.Server.HTTPR\x04http\x12+\n" +
-	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x1aN\n" +
+	"\x04grpc\x18\x02 \x01(\v2\x17.kratos.api.Server.GRPCR\x04grpc\x12'\n" +
+	"\x0ftrusted_proxies\x18\x03 \x03(\tR\x0etrustedProxies\x1aN\n" +
 	"\x04HTTP\x12\x18\n" +
 	"\anetwork\x18\x01 \x01(\tR\anetwork\x12\x12\n" +
 	"\x04addr\x18\x02 \x01(\tR\x04addr\x12\x18\n" +
@@ -658,7 +1861,26 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\bpassword\x18\x05 \x01(\tR\bpassword\x12\x0e\n" +
 	"\x02db\x18\x06 \x01(\x05R\x02db\x12\x1b\n" +
 	"\tpool_size\x18\a \x01(\x05R\bpoolSize\x12$\n" +
-	"\x0emin_idle_conns\x18\b \x01(\x05R\fminIdleConns\"\xa1\x01\n" +
+	"\x0emin_idle_conns\x18\b \x01(\x05R\fminIdleConns\"\xc8\x02\n" +
+	"\x04Mail\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12)\n" +
+	"\x04smtp\x18\x03 \x01(\v2\x15.kratos.api.Mail.SmtpR\x04smtp\x12)\n" +
+	"\x04file\x18\x04 \x01(\v2\x15.kratos.api.Mail.FileR\x04file\x1a\xa3\x01\n" +
+	"\x04Smtp\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12!\n" +
+	"\fimplicit_tls\x18\x05 \x01(\bR\vimplicitTls\x12\x18\n" +
+	"\atimeout\x18\x06 \x01(\x05R\atimeout\x1a\x18\n" +
+	"\x04File\x12\x10\n" +
+	"\x03dir\x18\x01 \x01(\tR\x03dir\"a\n" +
+	"\x03Sms\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12(\n" +
+	"\x04file\x18\x02 \x01(\v2\x14.kratos.api.Sms.FileR\x04file\x1a\x18\n" +
+	"\x04File\x12\x10\n" +
+	"\x03dir\x18\x01 \x01(\tR\x03dir\"\xa1\x01\n" +
 	"\x03Log\x12\x14\n" +
 	"\x05level\x18\x01 \x01(\tR\x05level\x12\x1a\n" +
 	"\bfilename\x18\x02 \x01(\tR\bfilename\x12\x18\n" +
@@ -667,7 +1889,85 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"maxBackups\x18\x05 \x01(\x05R\n" +
 	"maxBackups\x12\x16\n" +
-	"\x06stdout\x18\x06 \x01(\bR\x06stdoutB Z\x1equest-admin/internal/conf;confb\x06proto3"
+	"\x06stdout\x18\x06 \x01(\bR\x06stdout\"\xbd\x04\n" +
+	"\x04Auth\x12(\n" +
+	"\x10access_token_ttl\x18\x01 \x01(\x03R\x0eaccessTokenTtl\x12*\n" +
+	"\x11refresh_token_ttl\x18\x02 \x01(\x03R\x0frefreshTokenTtl\x127\n" +
+	"\vlogin_limit\x18\x03 \x01(\v2\x16.kratos.api.LoginLimitR\n" +
+	"loginLimit\x12\x1f\n" +
+	"\vcaptcha_ttl\x18\x04 \x01(\x03R\n" +
+	"captchaTtl\x12!\n" +
+	"\x03mfa\x18\x05 \x01(\v2\x0f.kratos.api.MfaR\x03mfa\x12C\n" +
+	"\x0fpassword_policy\x18\x06 \x01(\v2\x1a.kratos.api.PasswordPolicyR\x0epasswordPolicy\x12@\n" +
+	"\x0epassword_reset\x18\a \x01(\v2\x19.kratos.api.PasswordResetR\rpasswordReset\x12$\n" +
+	"\x04oidc\x18\b \x01(\v2\x10.kratos.api.OidcR\x04oidc\x12*\n" +
+	"\x06social\x18\t \x01(\v2\x12.kratos.api.SocialR\x06social\x12$\n" +
+	"\x04ldap\x18\n" +
+	" \x01(\v2\x10.kratos.api.LdapR\x04ldap\x12-\n" +
+	"\apasskey\x18\v \x01(\v2\x13.kratos.api.PasskeyR\apasskey\x124\n" +
+	"\n" +
+	"login_code\x18\f \x01(\v2\x15.kratos.api.LoginCodeR\tloginCode\"\xa1\x01\n" +
+	"\tLoginCode\x12\x10\n" +
+	"\x03ttl\x18\x01 \x01(\x03R\x03ttl\x12\x16\n" +
+	"\x06length\x18\x02 \x01(\x05R\x06length\x12#\n" +
+	"\rsend_interval\x18\x03 \x01(\x03R\fsendInterval\x12\x1f\n" +
+	"\vdaily_limit\x18\x04 \x01(\x05R\n" +
+	"dailyLimit\x12$\n" +
+	"\x0eip_daily_limit\x18\x05 \x01(\x05R\fipDailyLimit\"g\n" +
+	"\x06Social\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x01 \x01(\tR\tsecretKey\x12!\n" +
+	"\fredirect_url\x18\x02 \x01(\tR\vredirectUrl\x12\x1b\n" +
+	"\tstate_ttl\x18\x03 \x01(\x03R\bstateTtl\"o\n" +
+	"\x04Ldap\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x01 \x01(\tR\tsecretKey\x12.\n" +
+	"\x13sync_check_interval\x18\x02 \x01(\x03R\x11syncCheckInterval\x12\x18\n" +
+	"\atimeout\x18\x03 \x01(\x03R\atimeout\"r\n" +
+	"\aPasskey\x12\x13\n" +
+	"\x05rp_id\x18\x01 \x01(\tR\x04rpId\x12\x17\n" +
+	"\arp_name\x18\x02 \x01(\tR\x06rpName\x12\x18\n" +
+	"\aorigins\x18\x03 \x03(\tR\aorigins\x12\x1f\n" +
+	"\vsession_ttl\x18\x04 \x01(\x03R\n" +
+	"sessionTtl\"\xee\x01\n" +
+	"\x04Oidc\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x1c\n" +
+	"\talgorithm\x18\x02 \x01(\tR\talgorithm\x12\x1a\n" +
+	"\baudience\x18\x03 \x01(\tR\baudience\x12 \n" +
+	"\fid_token_ttl\x18\x04 \x01(\x03R\n" +
+	"idTokenTtl\x12.\n" +
+	"\x13key_rotation_period\x18\x05 \x01(\x03R\x11keyRotationPeriod\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x06 \x01(\tR\tsecretKey\x12#\n" +
+	"\rauthorize_url\x18\a \x01(\tR\fauthorizeUrl\"I\n" +
+	"\rPasswordReset\x12\x1b\n" +
+	"\ttoken_ttl\x18\x01 \x01(\x03R\btokenTtl\x12\x1b\n" +
+	"\treset_url\x18\x02 \x01(\tR\bresetUrl\"\xb3\x01\n" +
+	"\x0ePasswordPolicy\x12\x1d\n" +
+	"\n" +
+	"min_length\x18\x01 \x01(\x05R\tminLength\x12\x1f\n" +
+	"\vmin_classes\x18\x02 \x01(\x05R\n" +
+	"minClasses\x12\x1a\n" +
+	"\bdenylist\x18\x03 \x03(\tR\bdenylist\x12#\n" +
+	"\rhistory_depth\x18\x04 \x01(\x05R\fhistoryDepth\x12 \n" +
+	"\fmax_age_days\x18\x05 \x01(\x05R\n" +
+	"maxAgeDays\"[\n" +
+	"\x03Mfa\x12\x16\n" +
+	"\x06issuer\x18\x01 \x01(\tR\x06issuer\x12\x1d\n" +
+	"\n" +
+	"secret_key\x18\x02 \x01(\tR\tsecretKey\x12\x1d\n" +
+	"\n" +
+	"ticket_ttl\x18\x03 \x01(\x03R\tticketTtl\"\xd3\x01\n" +
+	"\n" +
+	"LoginLimit\x12*\n" +
+	"\x11max_user_failures\x18\x01 \x01(\x05R\x0fmaxUserFailures\x12&\n" +
+	"\x0fmax_ip_failures\x18\x02 \x01(\x05R\rmaxIpFailures\x12\x16\n" +
+	"\x06window\x18\x03 \x01(\x03R\x06window\x12#\n" +
+	"\rlock_duration\x18\x04 \x01(\x03R\flockDuration\x124\n" +
+	"\x16captcha_after_failures\x18\x05 \x01(\x05R\x14captchaAfterFailures\"f\n" +
+	"\x06Tenant\x122\n" +
+	"\x15expiry_check_interval\x18\x01 \x01(\x03R\x13expiryCheckInterval\x12(\n" +
+	"\x10expiry_warn_days\x18\x02 \x01(\x05R\x0eexpiryWarnDaysB Z\x1equest-admin/internal/conf;confb\x06proto3"
 
 var (
 	file_conf_conf_proto_rawDescOnce sync.Once
@@ -681,32 +1981,64 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
 var file_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),     // 0: kratos.api.Bootstrap
-	(*Env)(nil),           // 1: kratos.api.Env
-	(*Server)(nil),        // 2: kratos.api.Server
-	(*Data)(nil),          // 3: kratos.api.Data
-	(*Log)(nil),           // 4: kratos.api.Log
-	(*Server_HTTP)(nil),   // 5: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),   // 6: kratos.api.Server.GRPC
-	(*Data_Database)(nil), // 7: kratos.api.Data.Database
-	(*Data_Redis)(nil),    // 8: kratos.api.Data.Redis
+	(*Bootstrap)(nil),      // 0: kratos.api.Bootstrap
+	(*Env)(nil),            // 1: kratos.api.Env
+	(*Server)(nil),         // 2: kratos.api.Server
+	(*Data)(nil),           // 3: kratos.api.Data
+	(*Mail)(nil),           // 4: kratos.api.Mail
+	(*Sms)(nil),            // 5: kratos.api.Sms
+	(*Log)(nil),            // 6: kratos.api.Log
+	(*Auth)(nil),           // 7: kratos.api.Auth
+	(*LoginCode)(nil),      // 8: kratos.api.LoginCode
+	(*Social)(nil),         // 9: kratos.api.Social
+	(*Ldap)(nil),           // 10: kratos.api.Ldap
+	(*Passkey)(nil),        // 11: kratos.api.Passkey
+	(*Oidc)(nil),           // 12: kratos.api.Oidc
+	(*PasswordReset)(nil),  // 13: kratos.api.PasswordReset
+	(*PasswordPolicy)(nil), // 14: kratos.api.PasswordPolicy
+	(*Mfa)(nil),            // 15: kratos.api.Mfa
+	(*LoginLimit)(nil),     // 16: kratos.api.LoginLimit
+	(*Tenant)(nil),         // 17: kratos.api.Tenant
+	(*Server_HTTP)(nil),    // 18: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),    // 19: kratos.api.Server.GRPC
+	(*Data_Database)(nil),  // 20: kratos.api.Data.Database
+	(*Data_Redis)(nil),     // 21: kratos.api.Data.Redis
+	(*Mail_Smtp)(nil),      // 22: kratos.api.Mail.Smtp
+	(*Mail_File)(nil),      // 23: kratos.api.Mail.File
+	(*Sms_File)(nil),       // 24: kratos.api.Sms.File
 }
 var file_conf_conf_proto_depIdxs = []int32{
-	1, // 0: kratos.api.Bootstrap.env:type_name -> kratos.api.Env
-	2, // 1: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
-	3, // 2: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
-	4, // 3: kratos.api.Bootstrap.log:type_name -> kratos.api.Log
-	5, // 4: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	6, // 5: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	7, // 6: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	8, // 7: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	1,  // 0: kratos.api.Bootstrap.env:type_name -> kratos.api.Env
+	2,  // 1: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
+	3,  // 2: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
+	6,  // 3: kratos.api.Bootstrap.log:type_name -> kratos.api.Log
+	7,  // 4: kratos.api.Bootstrap.auth:type_name -> kratos.api.Auth
+	4,  // 5: kratos.api.Bootstrap.mail:type_name -> kratos.api.Mail
+	5,  // 6: kratos.api.Bootstrap.sms:type_name -> kratos.api.Sms
+	17, // 7: kratos.api.Bootstrap.tenant:type_name -> kratos.api.Tenant
+	18, // 8: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	19, // 9: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	20, // 10: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	21, // 11: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	22, // 12: kratos.api.Mail.smtp:type_name -> kratos.api.Mail.Smtp
+	23, // 13: kratos.api.Mail.file:type_name -> kratos.api.Mail.File
+	24, // 14: kratos.api.Sms.file:type_name -> kratos.api.Sms.File
+	16, // 15: kratos.api.Auth.login_limit:type_name -> kratos.api.LoginLimit
+	15, // 16: kratos.api.Auth.mfa:type_name -> kratos.api.Mfa
+	14, // 17: kratos.api.Auth.password_policy:type_name -> kratos.api.PasswordPolicy
+	13, // 18: kratos.api.Auth.password_reset:type_name -> kratos.api.PasswordReset
+	12, // 19: kratos.api.Auth.oidc:type_name -> kratos.api.Oidc
+	9,  // 20: kratos.api.Auth.social:type_name -> kratos.api.Social
+	10, // 21: kratos.api.Auth.ldap:type_name -> kratos.api.Ldap
+	11, // 22: kratos.api.Auth.passkey:type_name -> kratos.api.Passkey
+	8,  // 23: kratos.api.Auth.login_code:type_name -> kratos.api.LoginCode
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -720,7 +2052,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
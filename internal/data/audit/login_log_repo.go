@@ -0,0 +1,162 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"quest-admin/internal/data/data"
+	"quest-admin/pkg/util/ctxs"
+	"strings"
+	"time"
+
+	biz "quest-admin/internal/biz/audit"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/uptrace/bun"
+)
+
+type LoginLog struct {
+	bun.BaseModel `bun:"table:qa_login_log,alias:ll"`
+
+	ID        string    `bun:"id,pk"`
+	Username  string    `bun:"username,notnull"`
+	UserID    string    `bun:"user_id"`
+	LoginIP   string    `bun:"login_ip"`
+	UserAgent string    `bun:"user_agent"`
+	Device    string    `bun:"device"`
+	Status    int32     `bun:"status,notnull"`
+	Reason    string    `bun:"reason"`
+	LoginAt   time.Time `bun:"login_at,notnull,default:current_timestamp()"`
+	TenantID  string    `bun:"tenant_id"`
+}
+
+// loginLogSortFields 允许排序的字段
+var loginLogSortFields = map[string]struct{}{
+	"login_at": {},
+	"username": {},
+	"login_ip": {},
+	"status":   {},
+}
+
+type loginLogRepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+func NewLoginLogRepo(data *data.Data, logger log.Logger) biz.LoginLogRepo {
+	return &loginLogRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *loginLogRepo) Create(ctx context.Context, loginLog *biz.LoginLog) error {
+	dbLoginLog := &LoginLog{
+		ID:        loginLog.ID,
+		Username:  loginLog.Username,
+		UserID:    loginLog.UserID,
+		LoginIP:   loginLog.LoginIP,
+		UserAgent: loginLog.UserAgent,
+		Device:    loginLog.Device,
+		Status:    loginLog.Status,
+		Reason:    loginLog.Reason,
+		LoginAt:   loginLog.LoginAt,
+		TenantID:  loginLog.TenantID,
+	}
+
+	_, err := r.data.DB(ctx).NewInsert().Model(dbLoginLog).Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *loginLogRepo) List(ctx context.Context, opt *biz.WhereLoginLogOpt) ([]*biz.LoginLog, error) {
+	var dbLoginLogs []*LoginLog
+	q := r.data.DB(ctx).NewSelect().Model(&dbLoginLogs)
+	q, err := r.applyFilter(ctx, q, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if opt.Offset != 0 {
+		q = q.Offset(int(opt.Offset))
+	}
+	if opt.Limit != 0 {
+		q = q.Limit(int(opt.Limit))
+	}
+	if _, ok := loginLogSortFields[opt.SortField]; ok && opt.SortOrder != "" {
+		order := "ASC"
+		if strings.EqualFold(opt.SortOrder, "desc") {
+			order = "DESC"
+		}
+		q = q.Order(fmt.Sprintf("ll.%s %s", opt.SortField, order))
+	} else {
+		q = q.Order("ll.login_at DESC")
+	}
+
+	err = q.Scan(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+
+	loginLogs := make([]*biz.LoginLog, 0, len(dbLoginLogs))
+	for _, dbLoginLog := range dbLoginLogs {
+		loginLogs = append(loginLogs, r.toBizLoginLog(dbLoginLog))
+	}
+	return loginLogs, nil
+}
+
+func (r *loginLogRepo) Count(ctx context.Context, opt *biz.WhereLoginLogOpt) (int64, error) {
+	q := r.data.DB(ctx).NewSelect().Model((*LoginLog)(nil))
+	q, err := r.applyFilter(ctx, q, opt)
+	if err != nil {
+		return 0, err
+	}
+
+	total, err := q.Count(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return 0, err
+	}
+	return int64(total), nil
+}
+
+func (r *loginLogRepo) applyFilter(ctx context.Context, q *bun.SelectQuery, opt *biz.WhereLoginLogOpt) (*bun.SelectQuery, error) {
+	if opt.Username != "" {
+		q = q.Where("ll.username LIKE ?", "%"+opt.Username+"%")
+	}
+	if opt.UserID != "" {
+		q = q.Where("ll.user_id = ?", opt.UserID)
+	}
+	if opt.LoginIP != "" {
+		q = q.Where("ll.login_ip LIKE ?", "%"+opt.LoginIP+"%")
+	}
+	if opt.Status != nil {
+		q = q.Where("ll.status = ?", *opt.Status)
+	}
+	if opt.BeginTime != nil {
+		q = q.Where("ll.login_at >= ?", *opt.BeginTime)
+	}
+	if opt.EndTime != nil {
+		q = q.Where("ll.login_at <= ?", *opt.EndTime)
+	}
+	q = q.Where("ll.tenant_id = ?", ctxs.GetTenantID(ctx))
+	return data.ScopeByUser(ctx, q, "ll.user_id")
+}
+
+func (r *loginLogRepo) toBizLoginLog(dbLoginLog *LoginLog) *biz.LoginLog {
+	return &biz.LoginLog{
+		ID:        dbLoginLog.ID,
+		Username:  dbLoginLog.Username,
+		UserID:    dbLoginLog.UserID,
+		TenantID:  dbLoginLog.TenantID,
+		LoginIP:   dbLoginLog.LoginIP,
+		UserAgent: dbLoginLog.UserAgent,
+		Device:    dbLoginLog.Device,
+		Status:    dbLoginLog.Status,
+		Reason:    dbLoginLog.Reason,
+		LoginAt:   dbLoginLog.LoginAt,
+	}
+}
@@ -0,0 +1,201 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"quest-admin/internal/data/data"
+	"quest-admin/pkg/util/ctxs"
+	"strings"
+	"time"
+
+	biz "quest-admin/internal/biz/audit"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/uptrace/bun"
+)
+
+type OperateLog struct {
+	bun.BaseModel `bun:"table:qa_operate_log,alias:ol"`
+
+	ID             string    `bun:"id,pk"`
+	Operation      string    `bun:"operation,notnull"`
+	Method         string    `bun:"method"`
+	Path           string    `bun:"path"`
+	LoginID        string    `bun:"login_id"`
+	TraceID        string    `bun:"trace_id"`
+	Request        string    `bun:"request"`
+	Code           int32     `bun:"code,notnull"`
+	Reason         string    `bun:"reason"`
+	LatencyMs      int64     `bun:"latency_ms,notnull"`
+	ClientIP       string    `bun:"client_ip"`
+	UserAgent      string    `bun:"user_agent"`
+	OperateAt      time.Time `bun:"operate_at,notnull,default:current_timestamp()"`
+	TenantID       string    `bun:"tenant_id"`
+	SwitchTenantID string    `bun:"switch_tenant_id"`
+}
+
+// operateLogSortFields 允许排序的字段
+var operateLogSortFields = map[string]struct{}{
+	"operate_at": {},
+	"operation":  {},
+	"latency_ms": {},
+	"code":       {},
+}
+
+type operateLogRepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+func NewOperateLogRepo(data *data.Data, logger log.Logger) biz.OperateLogRepo {
+	return &operateLogRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *operateLogRepo) Create(ctx context.Context, operateLog *biz.OperateLog) error {
+	dbOperateLog := &OperateLog{
+		ID:             operateLog.ID,
+		Operation:      operateLog.Operation,
+		Method:         operateLog.Method,
+		Path:           operateLog.Path,
+		LoginID:        operateLog.LoginID,
+		TraceID:        operateLog.TraceID,
+		Request:        operateLog.Request,
+		Code:           operateLog.Code,
+		Reason:         operateLog.Reason,
+		LatencyMs:      operateLog.LatencyMs,
+		ClientIP:       operateLog.ClientIP,
+		UserAgent:      operateLog.UserAgent,
+		OperateAt:      operateLog.OperateAt,
+		TenantID:       operateLog.TenantID,
+		SwitchTenantID: operateLog.SwitchTenantID,
+	}
+
+	_, err := r.data.DB(ctx).NewInsert().Model(dbOperateLog).Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *operateLogRepo) FindByID(ctx context.Context, id string) (*biz.OperateLog, error) {
+	dbOperateLog := &OperateLog{}
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(dbOperateLog).
+		Where("id = ?", id).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return r.toBizOperateLog(dbOperateLog), nil
+}
+
+func (r *operateLogRepo) List(ctx context.Context, opt *biz.WhereOperateLogOpt) ([]*biz.OperateLog, error) {
+	var dbOperateLogs []*OperateLog
+	q := r.data.DB(ctx).NewSelect().Model(&dbOperateLogs)
+	q, err := r.applyFilter(ctx, q, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	if opt.Offset != 0 {
+		q = q.Offset(int(opt.Offset))
+	}
+	if opt.Limit != 0 {
+		q = q.Limit(int(opt.Limit))
+	}
+	if _, ok := operateLogSortFields[opt.SortField]; ok && opt.SortOrder != "" {
+		order := "ASC"
+		if strings.EqualFold(opt.SortOrder, "desc") {
+			order = "DESC"
+		}
+		q = q.Order(fmt.Sprintf("ol.%s %s", opt.SortField, order))
+	} else {
+		q = q.Order("ol.operate_at DESC")
+	}
+
+	err = q.Scan(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+
+	operateLogs := make([]*biz.OperateLog, 0, len(dbOperateLogs))
+	for _, dbOperateLog := range dbOperateLogs {
+		operateLogs = append(operateLogs, r.toBizOperateLog(dbOperateLog))
+	}
+	return operateLogs, nil
+}
+
+func (r *operateLogRepo) Count(ctx context.Context, opt *biz.WhereOperateLogOpt) (int64, error) {
+	q := r.data.DB(ctx).NewSelect().Model((*OperateLog)(nil))
+	q, err := r.applyFilter(ctx, q, opt)
+	if err != nil {
+		return 0, err
+	}
+
+	total, err := q.Count(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return 0, err
+	}
+	return int64(total), nil
+}
+
+func (r *operateLogRepo) applyFilter(ctx context.Context, q *bun.SelectQuery, opt *biz.WhereOperateLogOpt) (*bun.SelectQuery, error) {
+	if opt.Operation != "" {
+		q = q.Where("ol.operation LIKE ?", "%"+opt.Operation+"%")
+	}
+	if opt.LoginID != "" {
+		q = q.Where("ol.login_id = ?", opt.LoginID)
+	}
+	if opt.TraceID != "" {
+		q = q.Where("ol.trace_id = ?", opt.TraceID)
+	}
+	if opt.Success != nil {
+		if *opt.Success {
+			q = q.Where("ol.code = ?", 200)
+		} else {
+			q = q.Where("ol.code <> ?", 200)
+		}
+	}
+	if opt.BeginTime != nil {
+		q = q.Where("ol.operate_at >= ?", *opt.BeginTime)
+	}
+	if opt.EndTime != nil {
+		q = q.Where("ol.operate_at <= ?", *opt.EndTime)
+	}
+	q = q.Where("ol.tenant_id = ?", ctxs.GetTenantID(ctx))
+	return data.ScopeByUser(ctx, q, "ol.login_id")
+}
+
+func (r *operateLogRepo) toBizOperateLog(dbOperateLog *OperateLog) *biz.OperateLog {
+	return &biz.OperateLog{
+		ID:             dbOperateLog.ID,
+		Operation:      dbOperateLog.Operation,
+		Method:         dbOperateLog.Method,
+		Path:           dbOperateLog.Path,
+		LoginID:        dbOperateLog.LoginID,
+		TenantID:       dbOperateLog.TenantID,
+		TraceID:        dbOperateLog.TraceID,
+		Request:        dbOperateLog.Request,
+		Code:           dbOperateLog.Code,
+		Reason:         dbOperateLog.Reason,
+		LatencyMs:      dbOperateLog.LatencyMs,
+		ClientIP:       dbOperateLog.ClientIP,
+		UserAgent:      dbOperateLog.UserAgent,
+		OperateAt:      dbOperateLog.OperateAt,
+		SwitchTenantID: dbOperateLog.SwitchTenantID,
+	}
+}
@@ -0,0 +1,178 @@
+package guard
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"quest-admin/internal/data/data"
+	"quest-admin/pkg/util/ctxs"
+	"time"
+
+	biz "quest-admin/internal/biz/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/uptrace/bun"
+)
+
+type ApiKey struct {
+	bun.BaseModel `bun:"table:qa_api_key,alias:ak"`
+
+	ID          string     `bun:"id,pk"`
+	UserID      string     `bun:"user_id,notnull"`
+	Name        string     `bun:"name,notnull"`
+	KeyPrefix   string     `bun:"key_prefix,notnull"`
+	KeyHash     string     `bun:"key_hash,notnull"`
+	Permissions string     `bun:"permissions"`
+	ExpireAt    time.Time  `bun:"expire_at,notnull"`
+	LastUsedAt  *time.Time `bun:"last_used_at,nullzero"`
+	CreateBy    string     `bun:"create_by"`
+	CreateAt    time.Time  `bun:"create_at,notnull,default:current_timestamp()"`
+	TenantID    string     `bun:"tenant_id"`
+	DeleteAt    *time.Time `bun:"delete_at,soft_delete,nullzero"`
+}
+
+type apiKeyRepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+// NewApiKeyRepo API Key 存储，只保存密钥的 SHA-256 哈希，吊销为软删除
+func NewApiKeyRepo(data *data.Data, logger log.Logger) biz.ApiKeyRepo {
+	return &apiKeyRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "auth/data/api_key")),
+	}
+}
+
+func (r *apiKeyRepo) Create(ctx context.Context, key *biz.ApiKey) error {
+	permissions, err := json.Marshal(key.Permissions)
+	if err != nil {
+		return err
+	}
+	dbKey := &ApiKey{
+		ID:          key.ID,
+		UserID:      key.UserID,
+		Name:        key.Name,
+		KeyPrefix:   key.Prefix,
+		KeyHash:     key.Hash,
+		Permissions: string(permissions),
+		ExpireAt:    key.ExpireAt,
+		CreateBy:    ctxs.GetLoginID(ctx),
+		CreateAt:    key.CreateAt,
+		TenantID:    key.TenantID,
+	}
+	_, err = r.data.DB(ctx).NewInsert().Model(dbKey).Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *apiKeyRepo) FindByHash(ctx context.Context, hash string) (*biz.ApiKey, error) {
+	dbKey := &ApiKey{}
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(dbKey).
+		Where("key_hash = ?", hash).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return r.toBizApiKey(dbKey)
+}
+
+func (r *apiKeyRepo) ListByUserID(ctx context.Context, userID string) ([]*biz.ApiKey, error) {
+	var dbKeys []*ApiKey
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(&dbKeys).
+		Where("user_id = ?", userID).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Order("create_at DESC").
+		Scan(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	keys := make([]*biz.ApiKey, 0, len(dbKeys))
+	for _, dbKey := range dbKeys {
+		key, err := r.toBizApiKey(dbKey)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (r *apiKeyRepo) CountByUserID(ctx context.Context, userID string) (int64, error) {
+	total, err := r.data.DB(ctx).
+		NewSelect().
+		Model((*ApiKey)(nil)).
+		Where("user_id = ?", userID).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Where("expire_at > ?", time.Now()).
+		Count(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return 0, err
+	}
+	return int64(total), nil
+}
+
+func (r *apiKeyRepo) Delete(ctx context.Context, userID, id string) (bool, error) {
+	res, err := r.data.DB(ctx).
+		NewDelete().
+		Model((*ApiKey)(nil)).
+		Where("id = ?", id).
+		Where("user_id = ?", userID).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *apiKeyRepo) UpdateLastUsed(ctx context.Context, id string, at time.Time) error {
+	_, err := r.data.DB(ctx).
+		NewUpdate().
+		Model((*ApiKey)(nil)).
+		Set("last_used_at = ?", at).
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
+
+func (r *apiKeyRepo) toBizApiKey(dbKey *ApiKey) (*biz.ApiKey, error) {
+	key := &biz.ApiKey{
+		ID:       dbKey.ID,
+		UserID:   dbKey.UserID,
+		Name:     dbKey.Name,
+		Prefix:   dbKey.KeyPrefix,
+		Hash:     dbKey.KeyHash,
+		ExpireAt: dbKey.ExpireAt,
+		CreateAt: dbKey.CreateAt,
+		TenantID: dbKey.TenantID,
+	}
+	if dbKey.LastUsedAt != nil {
+		key.LastUsedAt = *dbKey.LastUsedAt
+	}
+	if dbKey.Permissions != "" {
+		if err := json.Unmarshal([]byte(dbKey.Permissions), &key.Permissions); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
@@ -0,0 +1,62 @@
+package guard
+
+import (
+	"context"
+	"errors"
+	"quest-admin/internal/conf"
+	"time"
+
+	biz "quest-admin/internal/biz/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	captchaKeyPrefix = "qa:admin:captcha:"
+
+	defaultCaptchaTTL = 2 * time.Minute
+)
+
+type captchaRepo struct {
+	redis *redis.Client
+	ttl   time.Duration
+	log   *log.Helper
+}
+
+// NewCaptchaRepo 基于 redis 的图形验证码存储，验证码取出即失效
+func NewCaptchaRepo(c *conf.Bootstrap, redisClient *redis.Client, logger log.Logger) biz.CaptchaRepo {
+	r := &captchaRepo{
+		redis: redisClient,
+		ttl:   defaultCaptchaTTL,
+		log:   log.NewHelper(log.With(logger, "module", "auth/data/captcha")),
+	}
+	if ttl := c.GetAuth().GetCaptchaTtl(); ttl > 0 {
+		r.ttl = time.Duration(ttl) * time.Second
+	}
+	return r
+}
+
+func (r *captchaRepo) TTL() time.Duration {
+	return r.ttl
+}
+
+func (r *captchaRepo) Save(ctx context.Context, id, code string) error {
+	err := r.redis.Set(ctx, captchaKeyPrefix+id, code, r.ttl).Err()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("保存验证码失败,id:%s,error:%v", id, err)
+	}
+	return err
+}
+
+func (r *captchaRepo) Take(ctx context.Context, id string) (string, error) {
+	code, err := r.redis.GetDel(ctx, captchaKeyPrefix+id).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("读取验证码失败,id:%s,error:%v", id, err)
+		return "", err
+	}
+	return code, nil
+}
@@ -0,0 +1,22 @@
+package guard
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// incrScript 计数加一，首次计数时设置过期时间，两步在同一脚本中执行，避免进程中断后计数永不过期
+var incrScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+if count == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+end
+return count
+`)
+
+// incrWithTTL 固定窗口计数，窗口从第一次计数开始
+func incrWithTTL(ctx context.Context, client *redis.Client, key string, ttl time.Duration) (int64, error) {
+	return incrScript.Run(ctx, client, []string{key}, ttl.Milliseconds()).Int64()
+}
@@ -0,0 +1,152 @@
+package guard
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"quest-admin/internal/data/data"
+	"quest-admin/pkg/util/ctxs"
+	"time"
+
+	biz "quest-admin/internal/biz/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/uptrace/bun"
+)
+
+type IpRule struct {
+	bun.BaseModel `bun:"table:qa_ip_rule,alias:ipr"`
+
+	ID       string     `bun:"id,pk"`
+	Scope    string     `bun:"scope,notnull"`
+	TargetID string     `bun:"target_id,notnull"`
+	Cidr     string     `bun:"cidr,notnull"`
+	Remark   string     `bun:"remark"`
+	Status   int32      `bun:"status,notnull"`
+	CreateAt time.Time  `bun:"create_at,notnull,default:current_timestamp()"`
+	UpdateAt time.Time  `bun:"update_at,notnull,default:current_timestamp()"`
+	TenantID string     `bun:"tenant_id"`
+	DeleteAt *time.Time `bun:"delete_at,soft_delete,nullzero"`
+}
+
+type ipRuleRepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+// NewIpRuleRepo IP 白名单规则存储，删除为软删除
+func NewIpRuleRepo(data *data.Data, logger log.Logger) biz.IpRuleRepo {
+	return &ipRuleRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "auth/data/ip_rule")),
+	}
+}
+
+func (r *ipRuleRepo) Create(ctx context.Context, rule *biz.IpRule) error {
+	dbRule := &IpRule{
+		ID:       rule.ID,
+		Scope:    rule.Scope,
+		TargetID: rule.TargetID,
+		Cidr:     rule.Cidr,
+		Remark:   rule.Remark,
+		Status:   rule.Status,
+		CreateAt: rule.CreateAt,
+		UpdateAt: rule.CreateAt,
+		TenantID: rule.TenantID,
+	}
+	_, err := r.data.DB(ctx).NewInsert().Model(dbRule).Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *ipRuleRepo) Update(ctx context.Context, rule *biz.IpRule) error {
+	_, err := r.data.DB(ctx).
+		NewUpdate().
+		Model((*IpRule)(nil)).
+		Set("cidr = ?", rule.Cidr).
+		Set("remark = ?", rule.Remark).
+		Set("status = ?", rule.Status).
+		Set("update_at = ?", time.Now()).
+		Where("id = ?", rule.ID).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *ipRuleRepo) Delete(ctx context.Context, id string) error {
+	_, err := r.data.DB(ctx).
+		NewDelete().
+		Model((*IpRule)(nil)).
+		Where("id = ?", id).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *ipRuleRepo) FindByID(ctx context.Context, id string) (*biz.IpRule, error) {
+	dbRule := &IpRule{}
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(dbRule).
+		Where("id = ?", id).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return r.toBizIpRule(dbRule), nil
+}
+
+func (r *ipRuleRepo) List(ctx context.Context, query *biz.ListIpRulesQuery) ([]*biz.IpRule, error) {
+	var dbRules []*IpRule
+	q := r.data.DB(ctx).
+		NewSelect().
+		Model(&dbRules).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx))
+	if query.Scope != "" {
+		q = q.Where("scope = ?", query.Scope)
+	}
+	if query.TargetID != "" {
+		q = q.Where("target_id = ?", query.TargetID)
+	}
+	if query.Status != nil {
+		q = q.Where("status = ?", *query.Status)
+	}
+	if err := q.Order("create_at ASC").Scan(ctx); err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	rules := make([]*biz.IpRule, 0, len(dbRules))
+	for _, dbRule := range dbRules {
+		rules = append(rules, r.toBizIpRule(dbRule))
+	}
+	return rules, nil
+}
+
+func (r *ipRuleRepo) toBizIpRule(dbRule *IpRule) *biz.IpRule {
+	return &biz.IpRule{
+		ID:       dbRule.ID,
+		Scope:    dbRule.Scope,
+		TargetID: dbRule.TargetID,
+		Cidr:     dbRule.Cidr,
+		Remark:   dbRule.Remark,
+		Status:   dbRule.Status,
+		CreateAt: dbRule.CreateAt,
+		TenantID: dbRule.TenantID,
+	}
+}
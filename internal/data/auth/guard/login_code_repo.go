@@ -0,0 +1,173 @@
+package guard
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"quest-admin/internal/conf"
+	"quest-admin/pkg/util/ctxs"
+	"time"
+
+	biz "quest-admin/internal/biz/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	loginCodeKeyPrefix     = "qa:admin:login:code:value:"
+	loginCodeFailKeyPrefix = "qa:admin:login:code:fail:"
+	loginCodeWaitKeyPrefix = "qa:admin:login:code:wait:"
+	loginCodeDayKeyPrefix  = "qa:admin:login:code:day:"
+
+	defaultLoginCodeTTL          = 5 * time.Minute
+	defaultLoginCodeLength       = 6
+	defaultLoginCodeSendInterval = time.Minute
+	defaultLoginCodeDailyLimit   = 10
+	defaultLoginCodeIPDailyLimit = 50
+)
+
+type loginCode struct {
+	Hash     string `json:"hash"`
+	UserID   string `json:"userId"`
+	TenantID string `json:"tenantId"`
+}
+
+type loginCodeRepo struct {
+	redis  *redis.Client
+	ttl    time.Duration
+	policy *biz.LoginCodePolicy
+	log    *log.Helper
+}
+
+// NewLoginCodeRepo 基于 redis 的登录验证码存储与发送限流，目标按租户区分，发送次数按自然日统计
+func NewLoginCodeRepo(c *conf.Bootstrap, redisClient *redis.Client, logger log.Logger) biz.LoginCodeRepo {
+	r := &loginCodeRepo{
+		redis: redisClient,
+		ttl:   defaultLoginCodeTTL,
+		policy: &biz.LoginCodePolicy{
+			Length:       defaultLoginCodeLength,
+			SendInterval: defaultLoginCodeSendInterval,
+			DailyLimit:   defaultLoginCodeDailyLimit,
+			IPDailyLimit: defaultLoginCodeIPDailyLimit,
+		},
+		log: log.NewHelper(log.With(logger, "module", "auth/data/login_code")),
+	}
+	cfg := c.GetAuth().GetLoginCode()
+	if cfg.GetTtl() > 0 {
+		r.ttl = time.Duration(cfg.GetTtl()) * time.Second
+	}
+	if cfg.GetLength() > 0 {
+		r.policy.Length = int(cfg.GetLength())
+	}
+	if cfg.GetSendInterval() > 0 {
+		r.policy.SendInterval = time.Duration(cfg.GetSendInterval()) * time.Second
+	}
+	if cfg.GetDailyLimit() > 0 {
+		r.policy.DailyLimit = int64(cfg.GetDailyLimit())
+	}
+	if cfg.GetIpDailyLimit() > 0 {
+		r.policy.IPDailyLimit = int64(cfg.GetIpDailyLimit())
+	}
+	return r
+}
+
+func (r *loginCodeRepo) TTL() time.Duration {
+	return r.ttl
+}
+
+func (r *loginCodeRepo) Policy() *biz.LoginCodePolicy {
+	return r.policy
+}
+
+func (r *loginCodeRepo) Reserve(ctx context.Context, channel, target, ip string) (*biz.LoginCodeQuota, error) {
+	subject := r.subject(ctx, channel, target)
+	ok, err := r.redis.SetNX(ctx, loginCodeWaitKeyPrefix+subject, 1, r.policy.SendInterval).Result()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("申请验证码发送额度失败,error:%v", err)
+		return nil, err
+	}
+	if !ok {
+		wait, err := r.redis.PTTL(ctx, loginCodeWaitKeyPrefix+subject).Result()
+		if err != nil {
+			return nil, err
+		}
+		return &biz.LoginCodeQuota{Wait: max(wait, time.Second)}, nil
+	}
+
+	day := time.Now().Format("20060102")
+	quota := &biz.LoginCodeQuota{}
+	if quota.TargetCount, err = r.incrDaily(ctx, loginCodeDayKeyPrefix+subject+":"+day); err != nil {
+		return nil, err
+	}
+	if ip != "" {
+		if quota.IPCount, err = r.incrDaily(ctx, loginCodeDayKeyPrefix+"ip:"+ip+":"+day); err != nil {
+			return nil, err
+		}
+	}
+	return quota, nil
+}
+
+func (r *loginCodeRepo) Save(ctx context.Context, code *biz.LoginCode) error {
+	data, err := json.Marshal(&loginCode{Hash: code.Hash, UserID: code.UserID, TenantID: code.TenantID})
+	if err != nil {
+		return err
+	}
+	subject := r.subject(ctx, code.Channel, code.Target)
+	_, err = r.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, loginCodeKeyPrefix+subject, data, r.ttl)
+		pipe.Del(ctx, loginCodeFailKeyPrefix+subject)
+		return nil
+	})
+	return err
+}
+
+func (r *loginCodeRepo) Find(ctx context.Context, channel, target string) (*biz.LoginCode, error) {
+	data, err := r.redis.Get(ctx, loginCodeKeyPrefix+r.subject(ctx, channel, target)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("查询登录验证码失败,error:%v", err)
+		return nil, err
+	}
+	var code loginCode
+	if err = json.Unmarshal(data, &code); err != nil {
+		return nil, nil
+	}
+	return &biz.LoginCode{
+		Channel:  channel,
+		Target:   target,
+		Hash:     code.Hash,
+		UserID:   code.UserID,
+		TenantID: code.TenantID,
+	}, nil
+}
+
+func (r *loginCodeRepo) Fail(ctx context.Context, channel, target string) (int64, error) {
+	key := loginCodeFailKeyPrefix + r.subject(ctx, channel, target)
+	count, err := incrWithTTL(ctx, r.redis, key, r.ttl)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("记录验证码失败次数失败,error:%v", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *loginCodeRepo) Delete(ctx context.Context, channel, target string) error {
+	subject := r.subject(ctx, channel, target)
+	return r.redis.Del(ctx, loginCodeKeyPrefix+subject, loginCodeFailKeyPrefix+subject).Err()
+}
+
+func (r *loginCodeRepo) subject(ctx context.Context, channel, target string) string {
+	return ctxs.GetTenantID(ctx) + ":" + channel + ":" + target
+}
+
+func (r *loginCodeRepo) incrDaily(ctx context.Context, key string) (int64, error) {
+	count, err := incrWithTTL(ctx, r.redis, key, 24*time.Hour)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("记录验证码发送次数失败,error:%v", err)
+		return 0, err
+	}
+	return count, nil
+}
@@ -0,0 +1,154 @@
+package guard
+
+import (
+	"context"
+	"errors"
+	"quest-admin/internal/conf"
+	"quest-admin/pkg/util/ctxs"
+	"time"
+
+	biz "quest-admin/internal/biz/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	loginFailKeyPrefix = "qa:admin:login:fail:"
+	loginLockKeyPrefix = "qa:admin:login:lock:"
+
+	defaultMaxUserFailures = 5
+	defaultMaxIPFailures   = 20
+	defaultFailureWindow   = 15 * time.Minute
+	defaultLockDuration    = 15 * time.Minute
+)
+
+type loginGuardRepo struct {
+	redis           *redis.Client
+	maxUserFailures int64
+	maxIPFailures   int64
+	window          time.Duration
+	lockDuration    time.Duration
+	captchaAfter    int64
+	log             *log.Helper
+}
+
+// NewLoginGuardRepo 基于 redis 的登录失败计数与锁定，用户名按租户区分
+func NewLoginGuardRepo(c *conf.Bootstrap, redisClient *redis.Client, logger log.Logger) biz.LoginGuardRepo {
+	r := &loginGuardRepo{
+		redis:           redisClient,
+		maxUserFailures: defaultMaxUserFailures,
+		maxIPFailures:   defaultMaxIPFailures,
+		window:          defaultFailureWindow,
+		lockDuration:    defaultLockDuration,
+		captchaAfter:    int64(c.GetAuth().GetLoginLimit().GetCaptchaAfterFailures()),
+		log:             log.NewHelper(log.With(logger, "module", "auth/data/login_guard")),
+	}
+	limit := c.GetAuth().GetLoginLimit()
+	if limit.GetMaxUserFailures() > 0 {
+		r.maxUserFailures = int64(limit.GetMaxUserFailures())
+	}
+	if limit.GetMaxIpFailures() > 0 {
+		r.maxIPFailures = int64(limit.GetMaxIpFailures())
+	}
+	if limit.GetWindow() > 0 {
+		r.window = time.Duration(limit.GetWindow()) * time.Second
+	}
+	if limit.GetLockDuration() > 0 {
+		r.lockDuration = time.Duration(limit.GetLockDuration()) * time.Second
+	}
+	return r
+}
+
+func (r *loginGuardRepo) LockedFor(ctx context.Context, username, ip string) (time.Duration, error) {
+	var remaining time.Duration
+	for _, key := range r.subjects(ctx, username, ip) {
+		ttl, err := r.redis.PTTL(ctx, loginLockKeyPrefix+key).Result()
+		if err != nil {
+			r.log.WithContext(ctx).Errorf("查询登录锁定失败,key:%s,error:%v", key, err)
+			return 0, err
+		}
+		if ttl > remaining {
+			remaining = ttl
+		}
+	}
+	return remaining, nil
+}
+
+func (r *loginGuardRepo) RecordFailure(ctx context.Context, username, ip string) (time.Duration, error) {
+	var locked time.Duration
+	for _, key := range r.subjects(ctx, username, ip) {
+		limit := r.maxUserFailures
+		if key == r.ipKey(ip) {
+			limit = r.maxIPFailures
+		}
+
+		count, err := incrWithTTL(ctx, r.redis, loginFailKeyPrefix+key, r.window)
+		if err != nil {
+			r.log.WithContext(ctx).Errorf("记录登录失败次数失败,key:%s,error:%v", key, err)
+			return 0, err
+		}
+		if count >= limit {
+			pipe := r.redis.TxPipeline()
+			pipe.Set(ctx, loginLockKeyPrefix+key, count, r.lockDuration)
+			pipe.Del(ctx, loginFailKeyPrefix+key)
+			if _, err = pipe.Exec(ctx); err != nil {
+				r.log.WithContext(ctx).Errorf("锁定登录失败,key:%s,error:%v", key, err)
+				return 0, err
+			}
+			locked = r.lockDuration
+		}
+	}
+	return locked, nil
+}
+
+func (r *loginGuardRepo) NeedCaptcha(ctx context.Context, username, ip string) (bool, error) {
+	if r.captchaAfter <= 0 {
+		return false, nil
+	}
+	for _, key := range r.subjects(ctx, username, ip) {
+		count, err := r.redis.Get(ctx, loginFailKeyPrefix+key).Int64()
+		if err != nil && !errors.Is(err, redis.Nil) {
+			r.log.WithContext(ctx).Errorf("查询登录失败次数失败,key:%s,error:%v", key, err)
+			return false, err
+		}
+		if count >= r.captchaAfter {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *loginGuardRepo) ClearFailure(ctx context.Context, username string) error {
+	return r.redis.Del(ctx, loginFailKeyPrefix+r.userKey(ctx, username)).Err()
+}
+
+func (r *loginGuardRepo) Unlock(ctx context.Context, username, ip string) error {
+	keys := make([]string, 0, 4)
+	for _, key := range r.subjects(ctx, username, ip) {
+		keys = append(keys, loginFailKeyPrefix+key, loginLockKeyPrefix+key)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return r.redis.Del(ctx, keys...).Err()
+}
+
+func (r *loginGuardRepo) subjects(ctx context.Context, username, ip string) []string {
+	subjects := make([]string, 0, 2)
+	if username != "" {
+		subjects = append(subjects, r.userKey(ctx, username))
+	}
+	if ip != "" {
+		subjects = append(subjects, r.ipKey(ip))
+	}
+	return subjects
+}
+
+func (r *loginGuardRepo) userKey(ctx context.Context, username string) string {
+	return "user:" + ctxs.GetTenantID(ctx) + ":" + username
+}
+
+func (r *loginGuardRepo) ipKey(ip string) string {
+	return "ip:" + ip
+}
@@ -0,0 +1,99 @@
+package guard
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"quest-admin/internal/conf"
+	"time"
+
+	biz "quest-admin/internal/biz/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	mfaTicketKeyPrefix  = "qa:admin:mfa:ticket:"
+	mfaAttemptKeyPrefix = "qa:admin:mfa:attempt:"
+
+	defaultMfaTicketTTL = 5 * time.Minute
+)
+
+type mfaTicket struct {
+	UserID   string `json:"userId"`
+	Username string `json:"username"`
+	Device   string `json:"device"`
+	TenantID string `json:"tenantId"`
+}
+
+type mfaTicketRepo struct {
+	redis *redis.Client
+	ttl   time.Duration
+	log   *log.Helper
+}
+
+// NewMfaTicketRepo 基于 redis 的 MFA 登录票据存储
+func NewMfaTicketRepo(c *conf.Bootstrap, redisClient *redis.Client, logger log.Logger) biz.MfaTicketRepo {
+	r := &mfaTicketRepo{
+		redis: redisClient,
+		ttl:   defaultMfaTicketTTL,
+		log:   log.NewHelper(log.With(logger, "module", "auth/data/mfa_ticket")),
+	}
+	if ttl := c.GetAuth().GetMfa().GetTicketTtl(); ttl > 0 {
+		r.ttl = time.Duration(ttl) * time.Second
+	}
+	return r
+}
+
+func (r *mfaTicketRepo) TTL() time.Duration {
+	return r.ttl
+}
+
+func (r *mfaTicketRepo) Save(ctx context.Context, ticket *biz.MfaTicket) error {
+	data, err := json.Marshal(&mfaTicket{
+		UserID:   ticket.UserID,
+		Username: ticket.Username,
+		Device:   ticket.Device,
+		TenantID: ticket.TenantID,
+	})
+	if err != nil {
+		return err
+	}
+	return r.redis.Set(ctx, mfaTicketKeyPrefix+ticket.ID, data, r.ttl).Err()
+}
+
+func (r *mfaTicketRepo) Find(ctx context.Context, id string) (*biz.MfaTicket, error) {
+	data, err := r.redis.Get(ctx, mfaTicketKeyPrefix+id).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("查询MFA票据失败,error:%v", err)
+		return nil, err
+	}
+	var ticket mfaTicket
+	if err = json.Unmarshal(data, &ticket); err != nil {
+		return nil, nil
+	}
+	return &biz.MfaTicket{
+		ID:       id,
+		UserID:   ticket.UserID,
+		Username: ticket.Username,
+		Device:   ticket.Device,
+		TenantID: ticket.TenantID,
+	}, nil
+}
+
+func (r *mfaTicketRepo) Fail(ctx context.Context, id string) (int64, error) {
+	count, err := incrWithTTL(ctx, r.redis, mfaAttemptKeyPrefix+id, r.ttl)
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("记录MFA失败次数失败,error:%v", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *mfaTicketRepo) Delete(ctx context.Context, id string) error {
+	return r.redis.Del(ctx, mfaTicketKeyPrefix+id, mfaAttemptKeyPrefix+id).Err()
+}
@@ -0,0 +1,230 @@
+package guard
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"quest-admin/internal/conf"
+	"quest-admin/internal/data/data"
+	"quest-admin/pkg/util/ctxs"
+	"time"
+
+	biz "quest-admin/internal/biz/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/uptrace/bun"
+)
+
+const defaultRelyingPartyName = "Quest Admin"
+
+type Passkey struct {
+	bun.BaseModel `bun:"table:qa_passkey,alias:pk"`
+
+	ID              string     `bun:"id,pk"`
+	UserID          string     `bun:"user_id,notnull"`
+	Name            string     `bun:"name,notnull"`
+	CredentialID    string     `bun:"credential_id,notnull"`
+	PublicKey       []byte     `bun:"public_key,notnull"`
+	AttestationType string     `bun:"attestation_type"`
+	AAGUID          []byte     `bun:"aaguid"`
+	SignCount       int64      `bun:"sign_count,notnull"`
+	Transports      string     `bun:"transports"`
+	BackupEligible  bool       `bun:"backup_eligible,notnull"`
+	BackupState     bool       `bun:"backup_state,notnull"`
+	LastUsedAt      *time.Time `bun:"last_used_at,nullzero"`
+	CreateAt        time.Time  `bun:"create_at,notnull,default:current_timestamp()"`
+	TenantID        string     `bun:"tenant_id"`
+	DeleteAt        *time.Time `bun:"delete_at,soft_delete,nullzero"`
+}
+
+type passkeyRepo struct {
+	data *data.Data
+	rp   *biz.RelyingParty
+	log  *log.Helper
+}
+
+// NewPasskeyRepo 通行密钥存储，凭证 ID 以 base64url 文本保存，删除为软删除
+func NewPasskeyRepo(c *conf.Bootstrap, data *data.Data, logger log.Logger) (biz.PasskeyRepo, error) {
+	r := &passkeyRepo{
+		data: data,
+		log:  log.NewHelper(log.With(logger, "module", "auth/data/passkey")),
+	}
+	passkey := c.GetAuth().GetPasskey()
+	if passkey.GetRpId() == "" {
+		return r, nil
+	}
+	if len(passkey.GetOrigins()) == 0 {
+		return nil, fmt.Errorf("auth.passkey.origins: required when rp_id is set")
+	}
+	r.rp = &biz.RelyingParty{
+		ID:      passkey.GetRpId(),
+		Name:    passkey.GetRpName(),
+		Origins: passkey.GetOrigins(),
+	}
+	if r.rp.Name == "" {
+		r.rp.Name = defaultRelyingPartyName
+	}
+	return r, nil
+}
+
+func (r *passkeyRepo) RelyingParty() *biz.RelyingParty {
+	return r.rp
+}
+
+func (r *passkeyRepo) Create(ctx context.Context, passkey *biz.Passkey) error {
+	transports, err := json.Marshal(passkey.Transports)
+	if err != nil {
+		return err
+	}
+	dbPasskey := &Passkey{
+		ID:              passkey.ID,
+		UserID:          passkey.UserID,
+		Name:            passkey.Name,
+		CredentialID:    encodeCredentialID(passkey.CredentialID),
+		PublicKey:       passkey.PublicKey,
+		AttestationType: passkey.AttestationType,
+		AAGUID:          passkey.AAGUID,
+		SignCount:       int64(passkey.SignCount),
+		Transports:      string(transports),
+		BackupEligible:  passkey.BackupEligible,
+		BackupState:     passkey.BackupState,
+		CreateAt:        passkey.CreateAt,
+		TenantID:        passkey.TenantID,
+	}
+	_, err = r.data.DB(ctx).NewInsert().Model(dbPasskey).Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *passkeyRepo) ListByUserID(ctx context.Context, userID string) ([]*biz.Passkey, error) {
+	var dbPasskeys []*Passkey
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(&dbPasskeys).
+		Where("user_id = ?", userID).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Order("create_at ASC").
+		Scan(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	passkeys := make([]*biz.Passkey, 0, len(dbPasskeys))
+	for _, dbPasskey := range dbPasskeys {
+		passkey, err := r.toBizPasskey(dbPasskey)
+		if err != nil {
+			return nil, err
+		}
+		passkeys = append(passkeys, passkey)
+	}
+	return passkeys, nil
+}
+
+func (r *passkeyRepo) FindByCredentialID(ctx context.Context, credentialID []byte) (*biz.Passkey, error) {
+	dbPasskey := &Passkey{}
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(dbPasskey).
+		Where("credential_id = ?", encodeCredentialID(credentialID)).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return r.toBizPasskey(dbPasskey)
+}
+
+func (r *passkeyRepo) Rename(ctx context.Context, userID, id, name string) (bool, error) {
+	res, err := r.data.DB(ctx).
+		NewUpdate().
+		Model((*Passkey)(nil)).
+		Set("name = ?", name).
+		Where("id = ?", id).
+		Where("user_id = ?", userID).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *passkeyRepo) Delete(ctx context.Context, userID, id string) (bool, error) {
+	res, err := r.data.DB(ctx).
+		NewDelete().
+		Model((*Passkey)(nil)).
+		Where("id = ?", id).
+		Where("user_id = ?", userID).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+func (r *passkeyRepo) UpdateUsage(ctx context.Context, passkey *biz.Passkey) error {
+	_, err := r.data.DB(ctx).
+		NewUpdate().
+		Model((*Passkey)(nil)).
+		Set("sign_count = ?", int64(passkey.SignCount)).
+		Set("backup_state = ?", passkey.BackupState).
+		Set("last_used_at = ?", passkey.LastUsedAt).
+		Where("id = ?", passkey.ID).
+		Exec(ctx)
+	return err
+}
+
+func (r *passkeyRepo) toBizPasskey(dbPasskey *Passkey) (*biz.Passkey, error) {
+	credentialID, err := base64.RawURLEncoding.DecodeString(dbPasskey.CredentialID)
+	if err != nil {
+		return nil, err
+	}
+	passkey := &biz.Passkey{
+		ID:              dbPasskey.ID,
+		UserID:          dbPasskey.UserID,
+		Name:            dbPasskey.Name,
+		CredentialID:    credentialID,
+		PublicKey:       dbPasskey.PublicKey,
+		AttestationType: dbPasskey.AttestationType,
+		AAGUID:          dbPasskey.AAGUID,
+		SignCount:       uint32(dbPasskey.SignCount),
+		BackupEligible:  dbPasskey.BackupEligible,
+		BackupState:     dbPasskey.BackupState,
+		CreateAt:        dbPasskey.CreateAt,
+		TenantID:        dbPasskey.TenantID,
+	}
+	if dbPasskey.LastUsedAt != nil {
+		passkey.LastUsedAt = *dbPasskey.LastUsedAt
+	}
+	if dbPasskey.Transports != "" {
+		if err = json.Unmarshal([]byte(dbPasskey.Transports), &passkey.Transports); err != nil {
+			return nil, err
+		}
+	}
+	return passkey, nil
+}
+
+func encodeCredentialID(credentialID []byte) string {
+	return base64.RawURLEncoding.EncodeToString(credentialID)
+}
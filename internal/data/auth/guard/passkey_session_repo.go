@@ -0,0 +1,89 @@
+package guard
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"quest-admin/internal/conf"
+	"time"
+
+	biz "quest-admin/internal/biz/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	passkeySessionKeyPrefix = "qa:admin:passkey:session:"
+
+	defaultPasskeySessionTTL = 5 * time.Minute
+)
+
+type passkeySession struct {
+	Kind      string                `json:"kind"`
+	UserID    string                `json:"userId"`
+	MfaTicket string                `json:"mfaTicket"`
+	TenantID  string                `json:"tenantId"`
+	Data      *webauthn.SessionData `json:"data"`
+}
+
+type passkeySessionRepo struct {
+	redis *redis.Client
+	ttl   time.Duration
+	log   *log.Helper
+}
+
+// NewPasskeySessionRepo 基于 redis 的通行密钥挑战存储
+func NewPasskeySessionRepo(c *conf.Bootstrap, redisClient *redis.Client, logger log.Logger) biz.PasskeySessionRepo {
+	r := &passkeySessionRepo{
+		redis: redisClient,
+		ttl:   defaultPasskeySessionTTL,
+		log:   log.NewHelper(log.With(logger, "module", "auth/data/passkey_session")),
+	}
+	if ttl := c.GetAuth().GetPasskey().GetSessionTtl(); ttl > 0 {
+		r.ttl = time.Duration(ttl) * time.Second
+	}
+	return r
+}
+
+func (r *passkeySessionRepo) TTL() time.Duration {
+	return r.ttl
+}
+
+func (r *passkeySessionRepo) Save(ctx context.Context, session *biz.PasskeySession) error {
+	data, err := json.Marshal(&passkeySession{
+		Kind:      session.Kind,
+		UserID:    session.UserID,
+		MfaTicket: session.MfaTicket,
+		TenantID:  session.TenantID,
+		Data:      session.Data,
+	})
+	if err != nil {
+		return err
+	}
+	return r.redis.Set(ctx, passkeySessionKeyPrefix+session.ID, data, r.ttl).Err()
+}
+
+func (r *passkeySessionRepo) Take(ctx context.Context, id string) (*biz.PasskeySession, error) {
+	data, err := r.redis.GetDel(ctx, passkeySessionKeyPrefix+id).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("查询通行密钥挑战失败,error:%v", err)
+		return nil, err
+	}
+	var session passkeySession
+	if err = json.Unmarshal(data, &session); err != nil {
+		return nil, nil
+	}
+	return &biz.PasskeySession{
+		ID:        id,
+		Kind:      session.Kind,
+		UserID:    session.UserID,
+		MfaTicket: session.MfaTicket,
+		TenantID:  session.TenantID,
+		Data:      session.Data,
+	}, nil
+}
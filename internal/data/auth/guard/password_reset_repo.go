@@ -0,0 +1,114 @@
+package guard
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"quest-admin/internal/conf"
+	"time"
+
+	biz "quest-admin/internal/biz/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	passwordResetKeyPrefix     = "qa:admin:pwdreset:token:"
+	passwordResetUserKeyPrefix = "qa:admin:pwdreset:user:"
+
+	defaultPasswordResetTTL = 30 * time.Minute
+)
+
+type passwordResetToken struct {
+	UserID   string `json:"userId"`
+	TenantID string `json:"tenantId"`
+}
+
+type passwordResetRepo struct {
+	redis    *redis.Client
+	ttl      time.Duration
+	resetURL string
+	log      *log.Helper
+}
+
+// NewPasswordResetRepo 基于 redis 的找回密码令牌存储，只保存令牌哈希，每个用户仅保留最新的令牌
+func NewPasswordResetRepo(c *conf.Bootstrap, redisClient *redis.Client, logger log.Logger) biz.PasswordResetRepo {
+	r := &passwordResetRepo{
+		redis:    redisClient,
+		ttl:      defaultPasswordResetTTL,
+		resetURL: c.GetAuth().GetPasswordReset().GetResetUrl(),
+		log:      log.NewHelper(log.With(logger, "module", "auth/data/password_reset")),
+	}
+	if ttl := c.GetAuth().GetPasswordReset().GetTokenTtl(); ttl > 0 {
+		r.ttl = time.Duration(ttl) * time.Second
+	}
+	return r
+}
+
+func (r *passwordResetRepo) TTL() time.Duration {
+	return r.ttl
+}
+
+func (r *passwordResetRepo) ResetURL() string {
+	return r.resetURL
+}
+
+func (r *passwordResetRepo) Save(ctx context.Context, token *biz.PasswordResetToken) error {
+	data, err := json.Marshal(&passwordResetToken{UserID: token.UserID, TenantID: token.TenantID})
+	if err != nil {
+		return err
+	}
+	userKey := passwordResetUserKeyPrefix + token.TenantID + ":" + token.UserID
+	previous, err := r.redis.Get(ctx, userKey).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		r.log.WithContext(ctx).Errorf("查询找回密码令牌失败,error:%v", err)
+		return err
+	}
+
+	pipe := r.redis.TxPipeline()
+	if previous != "" {
+		pipe.Del(ctx, passwordResetKeyPrefix+previous)
+	}
+	pipe.Set(ctx, passwordResetKeyPrefix+token.Hash, data, r.ttl)
+	pipe.Set(ctx, userKey, token.Hash, r.ttl)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+func (r *passwordResetRepo) Find(ctx context.Context, hash string) (*biz.PasswordResetToken, error) {
+	data, err := r.redis.Get(ctx, passwordResetKeyPrefix+hash).Bytes()
+	return r.decode(ctx, hash, data, err)
+}
+
+func (r *passwordResetRepo) Take(ctx context.Context, hash string) (*biz.PasswordResetToken, error) {
+	data, err := r.redis.GetDel(ctx, passwordResetKeyPrefix+hash).Bytes()
+	token, err := r.decode(ctx, hash, data, err)
+	if token == nil {
+		return nil, err
+	}
+	userKey := passwordResetUserKeyPrefix + token.TenantID + ":" + token.UserID
+	if current, _ := r.redis.Get(ctx, userKey).Result(); current == hash {
+		r.redis.Del(ctx, userKey)
+	}
+	return token, nil
+}
+
+func (r *passwordResetRepo) decode(ctx context.Context, hash string, data []byte, err error) (*biz.PasswordResetToken, error) {
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("查询找回密码令牌失败,error:%v", err)
+		return nil, err
+	}
+	var token passwordResetToken
+	if err = json.Unmarshal(data, &token); err != nil {
+		return nil, nil
+	}
+	return &biz.PasswordResetToken{
+		Hash:     hash,
+		UserID:   token.UserID,
+		TenantID: token.TenantID,
+	}, nil
+}
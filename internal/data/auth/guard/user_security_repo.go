@@ -0,0 +1,163 @@
+package guard
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"quest-admin/internal/conf"
+	"quest-admin/internal/data/data"
+	"quest-admin/pkg/lang/crypto"
+	"quest-admin/pkg/util/ctxs"
+	"time"
+
+	biz "quest-admin/internal/biz/auth"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/uptrace/bun"
+)
+
+const defaultMfaIssuer = "Quest Admin"
+
+type UserSecurity struct {
+	bun.BaseModel `bun:"table:qa_user_security,alias:us"`
+
+	UserID        string    `bun:"user_id,pk"`
+	TotpSecret    string    `bun:"totp_secret"`
+	TotpEnabled   int32     `bun:"totp_enabled,notnull"`
+	TotpLastStep  int64     `bun:"totp_last_step,notnull"`
+	RecoveryCodes string    `bun:"recovery_codes"`
+	CreateAt      time.Time `bun:"create_at,notnull,default:current_timestamp()"`
+	UpdateAt      time.Time `bun:"update_at,notnull,default:current_timestamp()"`
+	TenantID      string    `bun:"tenant_id"`
+}
+
+type userSecurityRepo struct {
+	data   *data.Data
+	cipher *crypto.Cipher
+	issuer string
+	log    *log.Helper
+}
+
+// NewUserSecurityRepo 用户安全设置存储，TOTP 密钥使用 auth.mfa.secret_key 加密后落库
+func NewUserSecurityRepo(c *conf.Bootstrap, data *data.Data, logger log.Logger) (biz.UserSecurityRepo, error) {
+	mfa := c.GetAuth().GetMfa()
+	cipher, err := crypto.NewCipher(mfa.GetSecretKey())
+	if err != nil {
+		return nil, fmt.Errorf("auth.mfa.secret_key: %w", err)
+	}
+	r := &userSecurityRepo{
+		data:   data,
+		cipher: cipher,
+		issuer: defaultMfaIssuer,
+		log:    log.NewHelper(log.With(logger, "module", "auth/data/user_security")),
+	}
+	if mfa.GetIssuer() != "" {
+		r.issuer = mfa.GetIssuer()
+	}
+	return r, nil
+}
+
+func (r *userSecurityRepo) Issuer() string {
+	return r.issuer
+}
+
+func (r *userSecurityRepo) FindByUserID(ctx context.Context, userID string) (*biz.UserSecurity, error) {
+	dbSecurity := &UserSecurity{}
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(dbSecurity).
+		Where("user_id = ?", userID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+
+	security := &biz.UserSecurity{
+		UserID:       dbSecurity.UserID,
+		TotpEnabled:  dbSecurity.TotpEnabled == 1,
+		TotpLastStep: dbSecurity.TotpLastStep,
+	}
+	if dbSecurity.TotpSecret != "" {
+		security.TotpSecret, err = r.cipher.Decrypt(dbSecurity.TotpSecret)
+		if err != nil {
+			r.log.WithContext(ctx).Errorf("解密TOTP密钥失败,userID:%s,error:%v", userID, err)
+			return nil, err
+		}
+	}
+	if dbSecurity.RecoveryCodes != "" {
+		if err = json.Unmarshal([]byte(dbSecurity.RecoveryCodes), &security.RecoveryCodes); err != nil {
+			r.log.WithContext(ctx).Errorf("解析恢复码失败,userID:%s,error:%v", userID, err)
+			return nil, err
+		}
+	}
+	return security, nil
+}
+
+func (r *userSecurityRepo) Save(ctx context.Context, security *biz.UserSecurity) error {
+	dbSecurity := &UserSecurity{
+		UserID:       security.UserID,
+		TotpLastStep: security.TotpLastStep,
+		UpdateAt:     time.Now(),
+		TenantID:     ctxs.GetTenantID(ctx),
+	}
+	if security.TotpEnabled {
+		dbSecurity.TotpEnabled = 1
+	}
+	if security.TotpSecret != "" {
+		secret, err := r.cipher.Encrypt(security.TotpSecret)
+		if err != nil {
+			return err
+		}
+		dbSecurity.TotpSecret = secret
+	}
+	if len(security.RecoveryCodes) != 0 {
+		codes, err := json.Marshal(security.RecoveryCodes)
+		if err != nil {
+			return err
+		}
+		dbSecurity.RecoveryCodes = string(codes)
+	}
+
+	_, err := r.data.DB(ctx).
+		NewInsert().
+		Model(dbSecurity).
+		On("CONFLICT (user_id) DO UPDATE").
+		Set("totp_secret = EXCLUDED.totp_secret").
+		Set("totp_enabled = EXCLUDED.totp_enabled").
+		Set("totp_last_step = EXCLUDED.totp_last_step").
+		Set("recovery_codes = EXCLUDED.recovery_codes").
+		Set("update_at = EXCLUDED.update_at").
+		Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+// UseTotpStep 比较并写入已使用的时间步，只有比已记录的时间步新时才写入，并发提交同一验证码时只有一次成功
+func (r *userSecurityRepo) UseTotpStep(ctx context.Context, userID string, step int64) (bool, error) {
+	res, err := r.data.DB(ctx).
+		NewUpdate().
+		Model((*UserSecurity)(nil)).
+		Set("totp_last_step = ?", step).
+		Set("update_at = ?", time.Now()).
+		Where("user_id = ?", userID).
+		Where("totp_last_step < ?", step).
+		Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
@@ -0,0 +1,120 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// OAuth2 签发的令牌仍是普通的后台会话，另外按令牌记录授权的客户端、范围和租户，
+// 认证中间件据此把请求限制在授权范围内。
+const (
+	oauth2AccessKeyPrefix  = adminKeyPrefix + "oauth2:access:"
+	oauth2RefreshKeyPrefix = adminKeyPrefix + "oauth2:refresh:"
+)
+
+// ErrTokenGrantExists 访问令牌已记录过授权信息，每次授权都必须签发新的令牌
+var ErrTokenGrantExists = errors.New("token grant exists")
+
+// saveGrantScript 访问令牌的授权信息不存在时才写入，同时写入刷新令牌的授权信息，避免覆盖其他授权
+var saveGrantScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return 0
+end
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+if #KEYS > 1 then
+	redis.call("SET", KEYS[2], ARGV[1], "PX", ARGV[3])
+end
+return 1
+`)
+
+// TokenGrant OAuth2 令牌的授权信息
+type TokenGrant struct {
+	ClientID string   `json:"clientId"`
+	LoginID  string   `json:"loginId"`
+	Scopes   []string `json:"scopes"`
+	TenantID string   `json:"tenantId"`
+	// ExpireAt 访问令牌的过期时间戳，单位秒
+	ExpireAt int64 `json:"expireAt"`
+}
+
+// SaveTokenGrant 记录访问令牌和刷新令牌的授权信息，refreshToken 为空时只记录访问令牌。
+// 访问令牌已有授权信息时返回 ErrTokenGrantExists，不覆盖原有授权
+func (m *Manager) SaveTokenGrant(ctx context.Context, accessToken, refreshToken string, grant *TokenGrant) error {
+	if grant.Scopes == nil {
+		grant.Scopes = []string{}
+	}
+	grant.ExpireAt = time.Now().Add(m.accessTTL).Unix()
+	data, err := json.Marshal(grant)
+	if err != nil {
+		return err
+	}
+	keys := []string{oauth2AccessKeyPrefix + accessToken}
+	if refreshToken != "" {
+		keys = append(keys, oauth2RefreshKeyPrefix+refreshToken)
+	}
+	ok, err := saveGrantScript.Run(ctx, m.redis, keys, data, m.accessTTL.Milliseconds(), m.refreshTTL.Milliseconds()).Int()
+	if err != nil {
+		return err
+	}
+	if ok == 0 {
+		return ErrTokenGrantExists
+	}
+	return nil
+}
+
+// GetTokenGrant 查询访问令牌的授权信息，非 OAuth2 签发的令牌返回 nil
+func (m *Manager) GetTokenGrant(ctx context.Context, accessToken string) (*TokenGrant, error) {
+	return m.getTokenGrant(ctx, oauth2AccessKeyPrefix+accessToken)
+}
+
+// GetRefreshGrant 查询刷新令牌的授权信息，非 OAuth2 签发的令牌返回 nil
+func (m *Manager) GetRefreshGrant(ctx context.Context, refreshToken string) (*TokenGrant, error) {
+	return m.getTokenGrant(ctx, oauth2RefreshKeyPrefix+refreshToken)
+}
+
+// RevokeAccessToken 吊销访问令牌及其所属的令牌族
+func (m *Manager) RevokeAccessToken(ctx context.Context, accessToken string) error {
+	if err := m.RevokeRefreshByAccessToken(ctx, accessToken); err != nil {
+		return err
+	}
+	_ = m.Admin.LogoutByToken(accessToken)
+	return m.redis.Del(ctx, oauth2AccessKeyPrefix+accessToken).Err()
+}
+
+// RevokeRefreshToken 吊销刷新令牌所属的令牌族，族内的访问令牌一并下线
+func (m *Manager) RevokeRefreshToken(ctx context.Context, token string) error {
+	data, err := m.redis.Get(ctx, refreshKeyPrefix+token).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var current refreshToken
+	if err = json.Unmarshal(data, &current); err != nil {
+		return nil
+	}
+	if err = m.RevokeRefreshFamily(ctx, current.Family); err != nil {
+		return err
+	}
+	return m.redis.Del(ctx, refreshKeyPrefix+token, oauth2RefreshKeyPrefix+token).Err()
+}
+
+func (m *Manager) getTokenGrant(ctx context.Context, key string) (*TokenGrant, error) {
+	data, err := m.redis.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var grant TokenGrant
+	if err = json.Unmarshal(data, &grant); err != nil {
+		return nil, nil
+	}
+	return &grant, nil
+}
@@ -0,0 +1,279 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"quest-admin/pkg/util/ctxs"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// 刷新令牌以族（family）为单位管理：一次登录产生一个族，每次刷新都会轮换出新的刷新令牌，
+// 旧令牌只保留用于重放检测。已轮换的刷新令牌再次出现时，说明令牌可能被盗用，整族吊销。
+const (
+	refreshKeyPrefix       = adminKeyPrefix + "refresh:token:"
+	refreshUsedKeyPrefix   = adminKeyPrefix + "refresh:used:"
+	refreshFamilyKeyPrefix = adminKeyPrefix + "refresh:family:"
+	refreshUserKeyPrefix   = adminKeyPrefix + "refresh:user:"
+	refreshAccessKeyPrefix = adminKeyPrefix + "refresh:access:"
+	// sessionTenantKeyPrefix 访问令牌登录时所属的租户，与访问令牌同时过期
+	sessionTenantKeyPrefix = adminKeyPrefix + "session:tenant:"
+	// passwordChangeKeyPrefix 需要先修改密码的账号，修改密码后会话全部吊销，重新登录时清除
+	passwordChangeKeyPrefix = adminKeyPrefix + "session:password_change:"
+)
+
+var (
+	ErrRefreshTokenInvalid = errors.New("refresh token invalid")
+	ErrRefreshTokenReused  = errors.New("refresh token reused")
+)
+
+// TokenPair 访问令牌与刷新令牌
+type TokenPair struct {
+	LoginID      string
+	TenantID     string
+	AccessToken  string
+	RefreshToken string
+}
+
+type refreshToken struct {
+	Family   string `json:"family"`
+	LoginID  string `json:"loginId"`
+	TenantID string `json:"tenantId"`
+	Device   string `json:"device"`
+}
+
+type refreshFamily struct {
+	LoginID     string `json:"loginId"`
+	TenantID    string `json:"tenantId"`
+	Device      string `json:"device"`
+	AccessToken string `json:"accessToken"`
+}
+
+// IssueAdminToken 登录并签发一组新的访问令牌和刷新令牌，会话绑定 ctx 中的租户
+func (m *Manager) IssueAdminToken(ctx context.Context, loginID string, device ...string) (*TokenPair, error) {
+	accessToken, err := m.Admin.Login(loginID, device...)
+	if err != nil {
+		return nil, err
+	}
+	family, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	tenantID := ctxs.GetTenantID(ctx)
+	refresh, err := m.saveRefreshToken(ctx, family, loginID, tenantID, firstDevice(device), accessToken)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{LoginID: loginID, TenantID: tenantID, AccessToken: accessToken, RefreshToken: refresh}, nil
+}
+
+// RefreshTokenTenant 查询刷新令牌所属的租户，不会使令牌失效
+func (m *Manager) RefreshTokenTenant(ctx context.Context, token string) (string, error) {
+	current, err := m.getRefreshToken(ctx, token)
+	if err != nil {
+		return "", err
+	}
+	return current.TenantID, nil
+}
+
+// RefreshAdminToken 使用刷新令牌换取新的令牌对，旧的访问令牌和刷新令牌同时失效，新会话沿用原会话的租户
+func (m *Manager) RefreshAdminToken(ctx context.Context, token string) (*TokenPair, error) {
+	current, err := m.getRefreshToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// 标记为已使用，标记失败说明该令牌已经被轮换过，按重放处理
+	ok, err := m.redis.SetNX(ctx, refreshUsedKeyPrefix+token, 1, m.refreshTTL).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		if err = m.RevokeRefreshFamily(ctx, current.Family); err != nil {
+			return nil, err
+		}
+		return nil, ErrRefreshTokenReused
+	}
+
+	family, err := m.getRefreshFamily(ctx, current.Family)
+	if err != nil {
+		return nil, err
+	}
+	if family == nil {
+		return nil, ErrRefreshTokenInvalid
+	}
+
+	if family.AccessToken != "" {
+		_ = m.Admin.LogoutByToken(family.AccessToken)
+		_ = m.redis.Del(ctx, refreshAccessKeyPrefix+family.AccessToken, sessionTenantKeyPrefix+family.AccessToken).Err()
+	}
+	var devices []string
+	if family.Device != "" {
+		devices = append(devices, family.Device)
+	}
+	accessToken, err := m.Admin.Login(family.LoginID, devices...)
+	if err != nil {
+		return nil, err
+	}
+	refresh, err := m.saveRefreshToken(ctx, current.Family, family.LoginID, family.TenantID, family.Device, accessToken)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenPair{LoginID: family.LoginID, TenantID: family.TenantID, AccessToken: accessToken, RefreshToken: refresh}, nil
+}
+
+// RevokeRefreshFamily 吊销整个令牌族，族内签发的访问令牌一并踢下线
+func (m *Manager) RevokeRefreshFamily(ctx context.Context, familyID string) error {
+	family, err := m.getRefreshFamily(ctx, familyID)
+	if err != nil {
+		return err
+	}
+	if family == nil {
+		return nil
+	}
+	if family.AccessToken != "" {
+		_ = m.Admin.GetManager().KickoutByToken(family.AccessToken)
+		_ = m.redis.Del(ctx, refreshAccessKeyPrefix+family.AccessToken, sessionTenantKeyPrefix+family.AccessToken).Err()
+	}
+	pipe := m.redis.TxPipeline()
+	pipe.Del(ctx, refreshFamilyKeyPrefix+familyID)
+	pipe.SRem(ctx, refreshUserKeyPrefix+family.LoginID, familyID)
+	_, err = pipe.Exec(ctx)
+	return err
+}
+
+// RevokeRefreshByAccessToken 吊销访问令牌所属的令牌族
+func (m *Manager) RevokeRefreshByAccessToken(ctx context.Context, accessToken string) error {
+	families, err := m.redis.SMembers(ctx, refreshAccessKeyPrefix+accessToken).Result()
+	if err != nil {
+		return err
+	}
+	for _, family := range families {
+		if err = m.RevokeRefreshFamily(ctx, family); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RevokeRefreshByLoginID 吊销用户的所有令牌族
+func (m *Manager) RevokeRefreshByLoginID(ctx context.Context, loginID string) error {
+	families, err := m.redis.SMembers(ctx, refreshUserKeyPrefix+loginID).Result()
+	if err != nil {
+		return err
+	}
+	for _, family := range families {
+		if err = m.RevokeRefreshFamily(ctx, family); err != nil {
+			return err
+		}
+	}
+	return m.redis.Del(ctx, refreshUserKeyPrefix+loginID).Err()
+}
+
+// SessionTenant 查询访问令牌登录时所属的租户，ok 为 false 表示令牌没有绑定租户
+func (m *Manager) SessionTenant(ctx context.Context, accessToken string) (tenantID string, ok bool, err error) {
+	tenantID, err = m.redis.Get(ctx, sessionTenantKeyPrefix+accessToken).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return tenantID, true, nil
+}
+
+// SetPasswordChangeRequired 标记账号的会话只能修改密码或退出登录，标记与刷新令牌同时过期
+func (m *Manager) SetPasswordChangeRequired(ctx context.Context, loginID string, required bool) error {
+	if required {
+		return m.redis.Set(ctx, passwordChangeKeyPrefix+loginID, 1, m.refreshTTL).Err()
+	}
+	return m.redis.Del(ctx, passwordChangeKeyPrefix+loginID).Err()
+}
+
+// PasswordChangeRequired 账号的会话是否需要先修改密码
+func (m *Manager) PasswordChangeRequired(ctx context.Context, loginID string) (bool, error) {
+	n, err := m.redis.Exists(ctx, passwordChangeKeyPrefix+loginID).Result()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (m *Manager) saveRefreshToken(ctx context.Context, familyID, loginID, tenantID, device, accessToken string) (string, error) {
+	token, err := randomToken()
+	if err != nil {
+		return "", err
+	}
+	tokenData, err := json.Marshal(&refreshToken{Family: familyID, LoginID: loginID, TenantID: tenantID, Device: device})
+	if err != nil {
+		return "", err
+	}
+	familyData, err := json.Marshal(&refreshFamily{LoginID: loginID, TenantID: tenantID, Device: device, AccessToken: accessToken})
+	if err != nil {
+		return "", err
+	}
+
+	pipe := m.redis.TxPipeline()
+	pipe.Set(ctx, refreshKeyPrefix+token, tokenData, m.refreshTTL)
+	pipe.Set(ctx, refreshFamilyKeyPrefix+familyID, familyData, m.refreshTTL)
+	pipe.SAdd(ctx, refreshUserKeyPrefix+loginID, familyID)
+	pipe.Expire(ctx, refreshUserKeyPrefix+loginID, m.refreshTTL)
+	pipe.SAdd(ctx, refreshAccessKeyPrefix+accessToken, familyID)
+	pipe.Expire(ctx, refreshAccessKeyPrefix+accessToken, m.accessTTL)
+	pipe.Set(ctx, sessionTenantKeyPrefix+accessToken, tenantID, m.accessTTL)
+	if _, err = pipe.Exec(ctx); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (m *Manager) getRefreshToken(ctx context.Context, token string) (*refreshToken, error) {
+	if token == "" {
+		return nil, ErrRefreshTokenInvalid
+	}
+	data, err := m.redis.Get(ctx, refreshKeyPrefix+token).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrRefreshTokenInvalid
+	}
+	if err != nil {
+		return nil, err
+	}
+	var current refreshToken
+	if err = json.Unmarshal(data, &current); err != nil {
+		return nil, ErrRefreshTokenInvalid
+	}
+	return &current, nil
+}
+
+func (m *Manager) getRefreshFamily(ctx context.Context, familyID string) (*refreshFamily, error) {
+	data, err := m.redis.Get(ctx, refreshFamilyKeyPrefix+familyID).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var family refreshFamily
+	if err = json.Unmarshal(data, &family); err != nil {
+		return nil, err
+	}
+	return &family, nil
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func firstDevice(device []string) string {
+	if len(device) > 0 {
+		return device[0]
+	}
+	return ""
+}
@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	userBiz "quest-admin/internal/biz/user"
+
+	"github.com/click33/sa-token-go/core/manager"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// OnlineSession 在线会话，ID 为令牌的摘要，不暴露令牌本身
+type OnlineSession struct {
+	ID         string
+	LoginID    string
+	TenantID   string
+	Device     string
+	CreateTime int64
+	ActiveTime int64
+
+	token string
+}
+
+// SessionID 由访问令牌生成会话编号
+func SessionID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:16])
+}
+
+// ListAdminSessions 扫描账号与令牌的映射，返回租户下所有有效的后台会话，loginID 为空时返回租户下全部
+func (m *Manager) ListAdminSessions(ctx context.Context, tenantID, loginID string) ([]*OnlineSession, error) {
+	pattern := adminKeyPrefix + manager.AccountKeyPrefix + "*"
+	if loginID != "" {
+		pattern = adminKeyPrefix + manager.AccountKeyPrefix + loginID + ":*"
+	}
+	sessions := make([]*OnlineSession, 0)
+	iter := m.redis.Scan(ctx, 0, pattern, 1000).Iterator()
+	for iter.Next(ctx) {
+		token, err := m.redis.Get(ctx, iter.Val()).Result()
+		if err != nil || token == "" {
+			continue
+		}
+		sessionTenant, ok, err := m.tokenTenant(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		if !ok || sessionTenant != tenantID {
+			continue
+		}
+		info, err := m.Admin.GetTokenInfo(token)
+		if err != nil || info == nil {
+			continue
+		}
+		sessions = append(sessions, &OnlineSession{
+			ID:         SessionID(token),
+			LoginID:    info.LoginID,
+			TenantID:   sessionTenant,
+			Device:     info.Device,
+			CreateTime: info.CreateTime,
+			ActiveTime: info.ActiveTime,
+			token:      token,
+		})
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// KickoutAdminSession 踢出租户下指定编号的会话并吊销其刷新令牌，会话不存在或不属于该租户时返回 false
+func (m *Manager) KickoutAdminSession(ctx context.Context, tenantID, sessionID string) (bool, error) {
+	sessions, err := m.ListAdminSessions(ctx, tenantID, "")
+	if err != nil {
+		return false, err
+	}
+	for _, session := range sessions {
+		if session.ID != sessionID {
+			continue
+		}
+		if err = m.RevokeRefreshByAccessToken(ctx, session.token); err != nil {
+			return false, err
+		}
+		return true, m.Admin.GetManager().KickoutByToken(session.token)
+	}
+	return false, nil
+}
+
+// tokenTenant 查询访问令牌所属的租户，OAuth2 令牌以授权记录为准
+func (m *Manager) tokenTenant(ctx context.Context, token string) (string, bool, error) {
+	grant, err := m.GetTokenGrant(ctx, token)
+	if err != nil {
+		return "", false, err
+	}
+	if grant != nil {
+		return grant.TenantID, true, nil
+	}
+	return m.SessionTenant(ctx, token)
+}
+
+// KickoutAdmin 将用户的所有后台会话踢下线，并吊销其刷新令牌
+func (m *Manager) KickoutAdmin(ctx context.Context, loginID string) error {
+	if err := m.RevokeRefreshByLoginID(ctx, loginID); err != nil {
+		return err
+	}
+	tokens, err := m.Admin.GetTokenValueList(loginID)
+	if err != nil {
+		return err
+	}
+	for _, token := range tokens {
+		if err = m.Admin.GetManager().KickoutByToken(token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type userSessionRepo struct {
+	manager *Manager
+	log     *log.Helper
+}
+
+// NewUserSessionRepo 用户会话仓储，禁用、删除用户时用于吊销令牌
+func NewUserSessionRepo(manager *Manager, logger log.Logger) userBiz.UserSessionRepo {
+	return &userSessionRepo{
+		manager: manager,
+		log:     log.NewHelper(log.With(logger, "module", "auth/data/session")),
+	}
+}
+
+func (r *userSessionRepo) RevokeByUserID(ctx context.Context, userID string) error {
+	if err := r.manager.KickoutAdmin(ctx, userID); err != nil {
+		r.log.WithContext(ctx).Errorf("吊销用户会话失败,userID:%s,error:%v", userID, err)
+		return err
+	}
+	return nil
+}
@@ -1,27 +1,74 @@
 package auth
 
 import (
+	"quest-admin/internal/conf"
+	"time"
+
 	"github.com/click33/sa-token-go/core"
+	"github.com/click33/sa-token-go/core/adapter"
 	storage "github.com/click33/sa-token-go/storage/redis"
 	"github.com/click33/sa-token-go/stputil"
 	"github.com/redis/go-redis/v9"
 )
 
+// adminKeyPrefix 后台会话在 redis 中的键前缀
+const adminKeyPrefix = "qa:admin:"
+
+const (
+	defaultAccessTokenTTL  = 2 * time.Hour
+	defaultRefreshTokenTTL = 7 * 24 * time.Hour
+)
+
 type Manager struct {
 	Admin *stputil.StpLogic
+
+	redis      *redis.Client
+	accessTTL  time.Duration
+	refreshTTL time.Duration
 }
 
 type Admin stputil.StpLogic
 
-func NewAuthManager(redisClient *redis.Client) *Manager {
-	admin := stputil.NewStpLogic(
+func NewAuthManager(c *conf.Bootstrap, redisClient *redis.Client) *Manager {
+	accessTTL, refreshTTL := defaultAccessTokenTTL, defaultRefreshTokenTTL
+	if ttl := c.GetAuth().GetAccessTokenTtl(); ttl > 0 {
+		accessTTL = time.Duration(ttl) * time.Second
+	}
+	if ttl := c.GetAuth().GetRefreshTokenTtl(); ttl > 0 {
+		refreshTTL = time.Duration(ttl) * time.Second
+	}
+
+	return &Manager{
+		Admin:      NewAdminLogic(storage.NewStorageFromClient(redisClient), accessTTL),
+		redis:      redisClient,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+	}
+}
+
+// NewAdminLogic 创建后台登录逻辑。每次登录都签发新的令牌，同一账号同一设备的多次登录互不共用、互不挤占
+func NewAdminLogic(store adapter.Storage, accessTTL time.Duration) *stputil.StpLogic {
+	return stputil.NewStpLogic(
 		core.NewBuilder().
-			Storage(storage.NewStorageFromClient(redisClient)).
-			KeyPrefix("qa:admin:").
+			Storage(store).
+			KeyPrefix(adminKeyPrefix).
 			TokenName("Authorization").
-			Timeout(43200).
+			TimeoutDuration(accessTTL).
+			AutoRenew(false).
 			TokenStyle(core.TokenStyleTik).
+			IsShare(false).
+			IsConcurrent(true).
+			UnlimitedLogin().
 			IsPrintBanner(false).
 			Build())
-	return &Manager{Admin: admin}
+}
+
+// AccessTTL 访问令牌有效期
+func (m *Manager) AccessTTL() time.Duration {
+	return m.accessTTL
+}
+
+// RefreshTTL 刷新令牌有效期
+func (m *Manager) RefreshTTL() time.Duration {
+	return m.refreshTTL
 }
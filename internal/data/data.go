@@ -1,15 +1,24 @@
 package data
 
 import (
+	"quest-admin/internal/data/audit"
 	"quest-admin/internal/data/auth"
+	"quest-admin/internal/data/auth/guard"
 	"quest-admin/internal/data/config"
 	"quest-admin/internal/data/data"
 	"quest-admin/internal/data/dict"
 	"quest-admin/internal/data/idgen"
+	"quest-admin/internal/data/ldap"
+	"quest-admin/internal/data/mail"
+	"quest-admin/internal/data/oauth2"
+	"quest-admin/internal/data/oidc"
 	"quest-admin/internal/data/organization"
 	"quest-admin/internal/data/permission"
 	"quest-admin/internal/data/pg"
 	"quest-admin/internal/data/redis"
+	"quest-admin/internal/data/scim"
+	"quest-admin/internal/data/sms"
+	"quest-admin/internal/data/social"
 	"quest-admin/internal/data/tenant"
 	"quest-admin/internal/data/transaction"
 	"quest-admin/internal/data/user"
@@ -29,15 +38,41 @@ var ProviderSet = wire.NewSet(
 	user.NewUserRoleRepo,
 	user.NewUserPostRepo,
 	user.NewUserDeptRepo,
+	user.NewPasswordRepo,
 	organization.NewDepartmentRepo,
 	organization.NewPostRepo,
 	tenant.NewTenantRepo,
+	tenant.NewTenantExpiryRepo,
 	tenant.NewTenantPackageRepo,
 	permission.NewRoleRepo,
 	permission.NewMenuRepo,
 	permission.NewRoleMenuRepo,
 	config.NewConfigRepo,
 	auth.NewAuthManager,
+	auth.NewUserSessionRepo,
+	guard.NewLoginGuardRepo,
+	guard.NewCaptchaRepo,
+	guard.NewUserSecurityRepo,
+	guard.NewMfaTicketRepo,
+	guard.NewPasswordResetRepo,
+	guard.NewApiKeyRepo,
+	guard.NewPasskeyRepo,
+	guard.NewPasskeySessionRepo,
+	guard.NewLoginCodeRepo,
+	guard.NewIpRuleRepo,
+	mail.NewMailSender,
+	sms.NewSmsSender,
+	oauth2.NewClientRepo,
+	oauth2.NewAuthorizationCodeRepo,
+	oidc.NewKeyRepo,
+	social.NewProviderRepo,
+	social.NewUserSocialRepo,
+	social.NewStateRepo,
+	ldap.NewConfigRepo,
+	ldap.NewLinkRepo,
+	scim.NewConfigRepo,
 	dict.NewDictTypeRepo,
 	dict.NewDictDataRepo,
+	audit.NewLoginLogRepo,
+	audit.NewOperateLogRepo,
 )
@@ -0,0 +1,41 @@
+package data
+
+import (
+	"context"
+	"quest-admin/pkg/util/ctxs"
+
+	"github.com/uptrace/bun"
+)
+
+// ScopeByUser 按当前请求的数据范围过滤归属于用户的数据，column 为用户编号列，如 u.id、p.create_by
+func ScopeByUser(ctx context.Context, q *bun.SelectQuery, column string) (*bun.SelectQuery, error) {
+	scope, err := ctxs.GetDataScope(ctx)
+	if err != nil || scope == nil || scope.All {
+		return q, err
+	}
+	return q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+		if len(scope.DeptIDs) != 0 {
+			q = q.WhereOr("? IN (SELECT ud.user_id FROM qa_user_dept AS ud WHERE ud.dept_id IN (?) AND ud.delete_at IS NULL)",
+				bun.Ident(column), bun.In(scope.DeptIDs))
+		}
+		if scope.Self {
+			q = q.WhereOr("? = ?", bun.Ident(column), scope.UserID)
+		}
+		if len(scope.DeptIDs) == 0 && !scope.Self {
+			q = q.Where("1 = 0")
+		}
+		return q
+	}), nil
+}
+
+// ScopeByDept 按当前请求的数据范围过滤部门数据，column 为部门编号列
+func ScopeByDept(ctx context.Context, q *bun.SelectQuery, column string) (*bun.SelectQuery, error) {
+	scope, err := ctxs.GetDataScope(ctx)
+	if err != nil || scope == nil || scope.All {
+		return q, err
+	}
+	if len(scope.DeptIDs) == 0 {
+		return q.Where("1 = 0"), nil
+	}
+	return q.Where("? IN (?)", bun.Ident(column), bun.In(scope.DeptIDs)), nil
+}
@@ -0,0 +1,321 @@
+package ldap
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"quest-admin/internal/conf"
+	"quest-admin/internal/data/data"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/lang/crypto"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/errkey"
+	"time"
+
+	biz "quest-admin/internal/biz/ldap"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-redsync/redsync/v4"
+	"github.com/uptrace/bun"
+)
+
+const (
+	syncLockPrefix = "qa:admin:ldap:sync:"
+	// syncLockExpiry 同步锁的最长持有时间，进程异常退出后锁自动释放
+	syncLockExpiry           = time.Hour
+	defaultSyncCheckInterval = time.Minute
+	defaultTimeout           = 10 * time.Second
+)
+
+type Config struct {
+	bun.BaseModel `bun:"table:qa_ldap_config,alias:lc"`
+
+	ID                 string     `bun:"id,pk"`
+	URL                string     `bun:"url,notnull"`
+	StartTLS           bool       `bun:"start_tls,notnull"`
+	InsecureSkipVerify bool       `bun:"insecure_skip_verify,notnull"`
+	BindDN             string     `bun:"bind_dn"`
+	BindPassword       string     `bun:"bind_password"`
+	BaseDN             string     `bun:"base_dn,notnull"`
+	UserFilter         string     `bun:"user_filter,notnull"`
+	Attributes         string     `bun:"attributes"`
+	GroupBaseDN        string     `bun:"group_base_dn"`
+	GroupFilter        string     `bun:"group_filter,notnull"`
+	GroupMemberAttr    string     `bun:"group_member_attr,notnull"`
+	GroupRoles         string     `bun:"group_roles"`
+	DeptFilter         string     `bun:"dept_filter,notnull"`
+	RootDeptID         string     `bun:"root_dept_id"`
+	AuthEnabled        bool       `bun:"auth_enabled,notnull"`
+	SyncEnabled        bool       `bun:"sync_enabled,notnull"`
+	SyncInterval       int32      `bun:"sync_interval,notnull"`
+	Status             int32      `bun:"status,notnull"`
+	LastSyncAt         time.Time  `bun:"last_sync_at,nullzero"`
+	LastSyncResult     string     `bun:"last_sync_result"`
+	CreateBy           string     `bun:"create_by"`
+	CreateAt           time.Time  `bun:"create_at,notnull,default:current_timestamp()"`
+	UpdateBy           string     `bun:"update_by"`
+	UpdateAt           time.Time  `bun:"update_at,notnull,default:current_timestamp()"`
+	TenantID           string     `bun:"tenant_id,notnull"`
+	DeleteAt           *time.Time `bun:"delete_at,soft_delete,nullzero"`
+}
+
+type configRepo struct {
+	data    *data.Data
+	rsync   *redsync.Redsync
+	cipher  *crypto.Cipher
+	options *biz.Options
+	log     *log.Helper
+}
+
+// NewConfigRepo 租户 LDAP 配置存储，服务账号密码使用 auth.ldap.secret_key 加密后落库
+func NewConfigRepo(c *conf.Bootstrap, data *data.Data, rsync *redsync.Redsync, logger log.Logger) (biz.ConfigRepo, error) {
+	ldap := c.GetAuth().GetLdap()
+	cipher, err := crypto.NewCipher(ldap.GetSecretKey())
+	if err != nil {
+		return nil, fmt.Errorf("auth.ldap.secret_key: %w", err)
+	}
+	options := &biz.Options{
+		SyncCheckInterval: defaultSyncCheckInterval,
+		Timeout:           defaultTimeout,
+	}
+	if interval := ldap.GetSyncCheckInterval(); interval > 0 {
+		options.SyncCheckInterval = time.Duration(interval) * time.Second
+	}
+	if timeout := ldap.GetTimeout(); timeout > 0 {
+		options.Timeout = time.Duration(timeout) * time.Second
+	}
+	return &configRepo{
+		data:    data,
+		rsync:   rsync,
+		cipher:  cipher,
+		options: options,
+		log:     log.NewHelper(logger),
+	}, nil
+}
+
+func (r *configRepo) Options() *biz.Options {
+	return r.options
+}
+
+func (r *configRepo) Find(ctx context.Context) (*biz.Config, error) {
+	dbConfig := &Config{}
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(dbConfig).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return r.toBizConfig(dbConfig)
+}
+
+func (r *configRepo) Save(ctx context.Context, cfg *biz.Config) error {
+	dbConfig, err := r.toDBConfig(cfg)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	dbConfig.UpdateBy = ctxs.GetLoginID(ctx)
+	dbConfig.UpdateAt = now
+	dbConfig.TenantID = ctxs.GetTenantID(ctx)
+
+	exists, err := r.data.DB(ctx).NewSelect().
+		Model((*Config)(nil)).
+		Where("id = ?", cfg.ID).
+		Where("tenant_id = ?", dbConfig.TenantID).
+		Exists(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	if !exists {
+		dbConfig.CreateBy = ctxs.GetLoginID(ctx)
+		dbConfig.CreateAt = now
+		_, err = r.data.DB(ctx).NewInsert().Model(dbConfig).Exec(ctx)
+	} else {
+		columns := []string{"url", "start_tls", "insecure_skip_verify", "bind_dn", "base_dn", "user_filter",
+			"attributes", "group_base_dn", "group_filter", "group_member_attr", "group_roles", "dept_filter",
+			"root_dept_id", "auth_enabled", "sync_enabled", "sync_interval", "status", "update_by", "update_at"}
+		if cfg.BindPassword != "" {
+			columns = append(columns, "bind_password")
+		}
+		_, err = r.data.DB(ctx).NewUpdate().
+			Model(dbConfig).
+			Column(columns...).
+			WherePK().
+			Where("tenant_id = ?", dbConfig.TenantID).
+			Exec(ctx)
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	cfg.UpdateAt = now
+	return nil
+}
+
+func (r *configRepo) Delete(ctx context.Context) error {
+	_, err := r.data.DB(ctx).NewDelete().
+		Model((*Config)(nil)).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	return err
+}
+
+func (r *configRepo) ListSyncEnabled(ctx context.Context) ([]*biz.Config, error) {
+	var dbConfigs []*Config
+	err := r.data.DB(ctx).NewSelect().
+		Model(&dbConfigs).
+		Where("sync_enabled = ?", true).
+		Where("status = ?", 1).
+		Scan(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	configs := make([]*biz.Config, 0, len(dbConfigs))
+	for _, dbConfig := range dbConfigs {
+		cfg, err := r.toBizConfig(dbConfig)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, cfg)
+	}
+	return configs, nil
+}
+
+func (r *configRepo) UpdateSyncResult(ctx context.Context, result *biz.SyncResult) error {
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	_, err = r.data.DB(ctx).NewUpdate().
+		Model((*Config)(nil)).
+		Set("last_sync_at = ?", result.StartAt).
+		Set("last_sync_result = ?", string(data)).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *configRepo) Lock(ctx context.Context) (func(), error) {
+	mutex := r.rsync.NewMutex(syncLockPrefix+ctxs.GetTenantID(ctx),
+		redsync.WithExpiry(syncLockExpiry),
+		redsync.WithTries(1),
+	)
+	if err := mutex.TryLockContext(ctx); err != nil {
+		var taken *redsync.ErrTaken
+		if errors.Is(err, redsync.ErrFailed) || errors.As(err, &taken) {
+			return nil, errorx.Err(errkey.ErrLdapSyncRunning)
+		}
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return func() {
+		if _, err := mutex.Unlock(); err != nil {
+			r.log.Warnf("释放LDAP同步锁失败,name:%s,error:%v", mutex.Name(), err)
+		}
+	}, nil
+}
+
+func (r *configRepo) toDBConfig(cfg *biz.Config) (*Config, error) {
+	attributes, err := json.Marshal(cfg.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	groupRoles, err := json.Marshal(cfg.GroupRoles)
+	if err != nil {
+		return nil, err
+	}
+	var password string
+	if cfg.BindPassword != "" {
+		if password, err = r.cipher.Encrypt(cfg.BindPassword); err != nil {
+			return nil, err
+		}
+	}
+	return &Config{
+		ID:                 cfg.ID,
+		URL:                cfg.URL,
+		StartTLS:           cfg.StartTLS,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+		BindDN:             cfg.BindDN,
+		BindPassword:       password,
+		BaseDN:             cfg.BaseDN,
+		UserFilter:         cfg.UserFilter,
+		Attributes:         string(attributes),
+		GroupBaseDN:        cfg.GroupBaseDN,
+		GroupFilter:        cfg.GroupFilter,
+		GroupMemberAttr:    cfg.GroupMemberAttr,
+		GroupRoles:         string(groupRoles),
+		DeptFilter:         cfg.DeptFilter,
+		RootDeptID:         cfg.RootDeptID,
+		AuthEnabled:        cfg.AuthEnabled,
+		SyncEnabled:        cfg.SyncEnabled,
+		SyncInterval:       cfg.SyncInterval,
+		Status:             cfg.Status,
+	}, nil
+}
+
+func (r *configRepo) toBizConfig(dbConfig *Config) (*biz.Config, error) {
+	cfg := &biz.Config{
+		ID:                 dbConfig.ID,
+		URL:                dbConfig.URL,
+		StartTLS:           dbConfig.StartTLS,
+		InsecureSkipVerify: dbConfig.InsecureSkipVerify,
+		BindDN:             dbConfig.BindDN,
+		BaseDN:             dbConfig.BaseDN,
+		UserFilter:         dbConfig.UserFilter,
+		Attributes:         &biz.AttributeMapping{},
+		GroupBaseDN:        dbConfig.GroupBaseDN,
+		GroupFilter:        dbConfig.GroupFilter,
+		GroupMemberAttr:    dbConfig.GroupMemberAttr,
+		DeptFilter:         dbConfig.DeptFilter,
+		RootDeptID:         dbConfig.RootDeptID,
+		AuthEnabled:        dbConfig.AuthEnabled,
+		SyncEnabled:        dbConfig.SyncEnabled,
+		SyncInterval:       dbConfig.SyncInterval,
+		Status:             dbConfig.Status,
+		LastSyncAt:         dbConfig.LastSyncAt,
+		CreateBy:           dbConfig.CreateBy,
+		CreateAt:           dbConfig.CreateAt,
+		UpdateBy:           dbConfig.UpdateBy,
+		UpdateAt:           dbConfig.UpdateAt,
+		TenantID:           dbConfig.TenantID,
+	}
+	if dbConfig.Attributes != "" {
+		if err := json.Unmarshal([]byte(dbConfig.Attributes), cfg.Attributes); err != nil {
+			return nil, err
+		}
+	}
+	if dbConfig.GroupRoles != "" {
+		if err := json.Unmarshal([]byte(dbConfig.GroupRoles), &cfg.GroupRoles); err != nil {
+			return nil, err
+		}
+	}
+	if dbConfig.LastSyncResult != "" {
+		cfg.LastSyncResult = &biz.SyncResult{}
+		if err := json.Unmarshal([]byte(dbConfig.LastSyncResult), cfg.LastSyncResult); err != nil {
+			return nil, err
+		}
+	}
+	// 密钥口令变更后无法解密，按未配置密码处理，绑定时目录服务会拒绝
+	if dbConfig.BindPassword != "" {
+		password, err := r.cipher.Decrypt(dbConfig.BindPassword)
+		if err != nil {
+			r.log.Warnf("解密LDAP服务账号密码失败,id:%s,error:%v", dbConfig.ID, err)
+		}
+		cfg.BindPassword = password
+	}
+	return cfg, nil
+}
@@ -0,0 +1,145 @@
+package ldap
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"quest-admin/internal/data/data"
+	"quest-admin/pkg/util/ctxs"
+	"time"
+
+	biz "quest-admin/internal/biz/ldap"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/uptrace/bun"
+)
+
+type Link struct {
+	bun.BaseModel `bun:"table:qa_ldap_link,alias:ll"`
+
+	ID         string    `bun:"id,pk"`
+	Type       int32     `bun:"type,notnull"`
+	ExternalID string    `bun:"external_id,notnull"`
+	DN         string    `bun:"dn,notnull"`
+	TargetID   string    `bun:"target_id,notnull"`
+	CreateBy   string    `bun:"create_by"`
+	CreateAt   time.Time `bun:"create_at,notnull,default:current_timestamp()"`
+	UpdateBy   string    `bun:"update_by"`
+	UpdateAt   time.Time `bun:"update_at,notnull,default:current_timestamp()"`
+	TenantID   string    `bun:"tenant_id,notnull"`
+}
+
+type linkRepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+func NewLinkRepo(data *data.Data, logger log.Logger) biz.LinkRepo {
+	return &linkRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *linkRepo) ListByType(ctx context.Context, linkType int32) ([]*biz.Link, error) {
+	var dbLinks []*Link
+	err := r.data.DB(ctx).NewSelect().
+		Model(&dbLinks).
+		Where("type = ?", linkType).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Scan(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	links := make([]*biz.Link, 0, len(dbLinks))
+	for _, dbLink := range dbLinks {
+		links = append(links, r.toBizLink(dbLink))
+	}
+	return links, nil
+}
+
+func (r *linkRepo) FindByTarget(ctx context.Context, linkType int32, targetID string) (*biz.Link, error) {
+	dbLink := &Link{}
+	err := r.data.DB(ctx).NewSelect().
+		Model(dbLink).
+		Where("type = ?", linkType).
+		Where("target_id = ?", targetID).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return r.toBizLink(dbLink), nil
+}
+
+func (r *linkRepo) Create(ctx context.Context, link *biz.Link) error {
+	now := time.Now()
+	dbLink := &Link{
+		ID:         link.ID,
+		Type:       link.Type,
+		ExternalID: link.ExternalID,
+		DN:         link.DN,
+		TargetID:   link.TargetID,
+		CreateBy:   ctxs.GetLoginID(ctx),
+		CreateAt:   now,
+		UpdateBy:   ctxs.GetLoginID(ctx),
+		UpdateAt:   now,
+		TenantID:   ctxs.GetTenantID(ctx),
+	}
+	_, err := r.data.DB(ctx).NewInsert().Model(dbLink).Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *linkRepo) UpdateDN(ctx context.Context, id, dn string) error {
+	_, err := r.data.DB(ctx).NewUpdate().
+		Model((*Link)(nil)).
+		Set("dn = ?", dn).
+		Set("update_by = ?", ctxs.GetLoginID(ctx)).
+		Set("update_at = ?", time.Now()).
+		Where("id = ?", id).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *linkRepo) Delete(ctx context.Context, id string) error {
+	_, err := r.data.DB(ctx).NewDelete().
+		Model((*Link)(nil)).
+		Where("id = ?", id).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	return err
+}
+
+func (r *linkRepo) DeleteAll(ctx context.Context) error {
+	_, err := r.data.DB(ctx).NewDelete().
+		Model((*Link)(nil)).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	return err
+}
+
+func (r *linkRepo) toBizLink(dbLink *Link) *biz.Link {
+	return &biz.Link{
+		ID:         dbLink.ID,
+		Type:       dbLink.Type,
+		ExternalID: dbLink.ExternalID,
+		DN:         dbLink.DN,
+		TargetID:   dbLink.TargetID,
+		TenantID:   dbLink.TenantID,
+	}
+}
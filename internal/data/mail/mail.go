@@ -0,0 +1,54 @@
+package mail
+
+import (
+	"fmt"
+	"quest-admin/internal/conf"
+	"quest-admin/pkg/mail"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	driverSMTP = "smtp"
+	driverFile = "file"
+
+	defaultMailDir  = "logs/mail"
+	defaultMailFrom = "noreply@localhost"
+)
+
+// NewMailSender 按配置创建邮件发送器，未配置时使用 file 方式
+func NewMailSender(c *conf.Bootstrap, logger log.Logger) (mail.Sender, error) {
+	helper := log.NewHelper(log.With(logger, "module", "mail/data"))
+	cfg := c.GetMail()
+	from := cfg.GetFrom()
+	if from == "" {
+		from = defaultMailFrom
+	}
+
+	switch cfg.GetDriver() {
+	case driverSMTP:
+		smtp := cfg.GetSmtp()
+		if smtp.GetHost() == "" || smtp.GetPort() == 0 {
+			return nil, fmt.Errorf("mail: smtp host and port are required")
+		}
+		return mail.NewSMTPSender(mail.SMTPConfig{
+			Host:        smtp.GetHost(),
+			Port:        int(smtp.GetPort()),
+			Username:    smtp.GetUsername(),
+			Password:    smtp.GetPassword(),
+			From:        from,
+			ImplicitTLS: smtp.GetImplicitTls(),
+			Timeout:     time.Duration(smtp.GetTimeout()) * time.Second,
+		}), nil
+	case driverFile, "":
+		dir := cfg.GetFile().GetDir()
+		if dir == "" {
+			dir = defaultMailDir
+		}
+		helper.Infof("邮件不会真正发送,将写入目录:%s", dir)
+		return mail.NewFileSender(dir, from), nil
+	default:
+		return nil, fmt.Errorf("mail: unknown driver %q", cfg.GetDriver())
+	}
+}
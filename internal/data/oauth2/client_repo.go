@@ -0,0 +1,273 @@
+package oauth2
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"quest-admin/internal/data/data"
+	"quest-admin/pkg/util/ctxs"
+	"time"
+
+	biz "quest-admin/internal/biz/oauth2"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/uptrace/bun"
+)
+
+type Client struct {
+	bun.BaseModel `bun:"table:qa_oauth2_client,alias:oc"`
+
+	ID           string     `bun:"id,pk"`
+	ClientID     string     `bun:"client_id,notnull"`
+	Name         string     `bun:"name,notnull"`
+	ClientSecret string     `bun:"client_secret"`
+	ClientType   string     `bun:"client_type,notnull"`
+	RedirectURIs string     `bun:"redirect_uris"`
+	GrantTypes   string     `bun:"grant_types"`
+	Scopes       string     `bun:"scopes"`
+	Trusted      bool       `bun:"trusted,notnull"`
+	Status       int32      `bun:"status,notnull"`
+	Remark       string     `bun:"remark"`
+	CreateBy     string     `bun:"create_by"`
+	CreateAt     time.Time  `bun:"create_at,notnull,default:current_timestamp()"`
+	UpdateBy     string     `bun:"update_by"`
+	UpdateAt     time.Time  `bun:"update_at,notnull,default:current_timestamp()"`
+	TenantID     string     `bun:"tenant_id,notnull"`
+	DeleteAt     *time.Time `bun:"delete_at,soft_delete,nullzero"`
+}
+
+type clientRepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+// NewClientRepo OAuth2 客户端存储，只保存客户端密钥的 SHA-256 哈希
+func NewClientRepo(data *data.Data, logger log.Logger) biz.ClientRepo {
+	return &clientRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *clientRepo) Create(ctx context.Context, client *biz.Client) error {
+	dbClient, err := r.toDBClient(client)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	dbClient.ClientID = client.ClientID
+	dbClient.ClientSecret = client.ClientSecret
+	dbClient.ClientType = client.ClientType
+	dbClient.CreateBy = ctxs.GetLoginID(ctx)
+	dbClient.CreateAt = now
+	dbClient.UpdateBy = ctxs.GetLoginID(ctx)
+	dbClient.UpdateAt = now
+	dbClient.TenantID = ctxs.GetTenantID(ctx)
+
+	_, err = r.data.DB(ctx).NewInsert().Model(dbClient).Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	client.CreateAt = now
+	client.UpdateAt = now
+	return nil
+}
+
+func (r *clientRepo) FindByID(ctx context.Context, id string) (*biz.Client, error) {
+	dbClient := &Client{ID: id}
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(dbClient).
+		WherePK().
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return r.toBizClient(dbClient)
+}
+
+func (r *clientRepo) FindByClientID(ctx context.Context, clientID string) (*biz.Client, error) {
+	dbClient := &Client{}
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(dbClient).
+		Where("client_id = ?", clientID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return r.toBizClient(dbClient)
+}
+
+func (r *clientRepo) List(ctx context.Context, opt *biz.WhereClientOpt) ([]*biz.Client, error) {
+	var dbClients []*Client
+	q := r.data.DB(ctx).NewSelect().Model(&dbClients)
+	q = r.applyFilter(ctx, q, opt)
+
+	if opt.Offset != 0 {
+		q = q.Offset(int(opt.Offset))
+	}
+	if opt.Limit != 0 {
+		q = q.Limit(int(opt.Limit))
+	}
+
+	err := q.Order("create_at DESC").Scan(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+
+	clients := make([]*biz.Client, 0, len(dbClients))
+	for _, dbClient := range dbClients {
+		client, err := r.toBizClient(dbClient)
+		if err != nil {
+			return nil, err
+		}
+		clients = append(clients, client)
+	}
+	return clients, nil
+}
+
+func (r *clientRepo) Count(ctx context.Context, opt *biz.WhereClientOpt) (int64, error) {
+	q := r.data.DB(ctx).NewSelect().Model((*Client)(nil))
+	q = r.applyFilter(ctx, q, opt)
+
+	total, err := q.Count(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return 0, err
+	}
+	return int64(total), nil
+}
+
+func (r *clientRepo) Update(ctx context.Context, client *biz.Client) error {
+	dbClient, err := r.toDBClient(client)
+	if err != nil {
+		return err
+	}
+	dbClient.UpdateBy = ctxs.GetLoginID(ctx)
+	dbClient.UpdateAt = time.Now()
+
+	_, err = r.data.DB(ctx).
+		NewUpdate().
+		Model(dbClient).
+		Column("name", "redirect_uris", "grant_types", "scopes", "trusted", "status", "remark", "update_by", "update_at").
+		WherePK().
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *clientRepo) UpdateSecret(ctx context.Context, id, secret string) error {
+	_, err := r.data.DB(ctx).
+		NewUpdate().
+		Model((*Client)(nil)).
+		Set("client_secret = ?", secret).
+		Set("update_by = ?", ctxs.GetLoginID(ctx)).
+		Set("update_at = ?", time.Now()).
+		Where("id = ?", id).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *clientRepo) Delete(ctx context.Context, id string) error {
+	_, err := r.data.DB(ctx).NewDelete().
+		Model((*Client)(nil)).
+		Where("id = ?", id).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	return err
+}
+
+func (r *clientRepo) applyFilter(ctx context.Context, q *bun.SelectQuery, opt *biz.WhereClientOpt) *bun.SelectQuery {
+	if opt.Keyword != "" {
+		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.WhereOr("name LIKE ?", "%"+opt.Keyword+"%").
+				WhereOr("client_id = ?", opt.Keyword)
+		})
+	}
+	if opt.Status != nil {
+		q = q.Where("status = ?", *opt.Status)
+	}
+	return q.Where("tenant_id = ?", ctxs.GetTenantID(ctx))
+}
+
+func (r *clientRepo) toDBClient(client *biz.Client) (*Client, error) {
+	redirectURIs, err := json.Marshal(client.RedirectURIs)
+	if err != nil {
+		return nil, err
+	}
+	grantTypes, err := json.Marshal(client.GrantTypes)
+	if err != nil {
+		return nil, err
+	}
+	scopes, err := json.Marshal(client.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		ID:           client.ID,
+		Name:         client.Name,
+		RedirectURIs: string(redirectURIs),
+		GrantTypes:   string(grantTypes),
+		Scopes:       string(scopes),
+		Trusted:      client.Trusted,
+		Status:       client.Status,
+		Remark:       client.Remark,
+	}, nil
+}
+
+func (r *clientRepo) toBizClient(dbClient *Client) (*biz.Client, error) {
+	client := &biz.Client{
+		ID:           dbClient.ID,
+		ClientID:     dbClient.ClientID,
+		Name:         dbClient.Name,
+		ClientSecret: dbClient.ClientSecret,
+		ClientType:   dbClient.ClientType,
+		Trusted:      dbClient.Trusted,
+		Status:       dbClient.Status,
+		Remark:       dbClient.Remark,
+		CreateBy:     dbClient.CreateBy,
+		CreateAt:     dbClient.CreateAt,
+		UpdateBy:     dbClient.UpdateBy,
+		UpdateAt:     dbClient.UpdateAt,
+		TenantID:     dbClient.TenantID,
+	}
+	if err := unmarshalList(dbClient.RedirectURIs, &client.RedirectURIs); err != nil {
+		return nil, err
+	}
+	if err := unmarshalList(dbClient.GrantTypes, &client.GrantTypes); err != nil {
+		return nil, err
+	}
+	if err := unmarshalList(dbClient.Scopes, &client.Scopes); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func unmarshalList(column string, target *[]string) error {
+	if column == "" {
+		return nil
+	}
+	return json.Unmarshal([]byte(column), target)
+}
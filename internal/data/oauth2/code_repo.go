@@ -0,0 +1,93 @@
+package oauth2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	biz "quest-admin/internal/biz/oauth2"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	authorizationCodeKeyPrefix = "qa:admin:oauth2:code:"
+
+	// authorizationCodeTTL 授权码有效期，RFC 6749 建议不超过 10 分钟
+	authorizationCodeTTL = 5 * time.Minute
+)
+
+type authorizationCode struct {
+	ClientID            string   `json:"clientId"`
+	UserID              string   `json:"userId"`
+	RedirectURI         string   `json:"redirectUri"`
+	RedirectURIProvided bool     `json:"redirectUriProvided"`
+	Scopes              []string `json:"scopes"`
+	CodeChallenge       string   `json:"codeChallenge"`
+	CodeChallengeMethod string   `json:"codeChallengeMethod"`
+	OpenID              bool     `json:"openId"`
+	Nonce               string   `json:"nonce"`
+	TenantID            string   `json:"tenantId"`
+}
+
+type authorizationCodeRepo struct {
+	redis *redis.Client
+	log   *log.Helper
+}
+
+// NewAuthorizationCodeRepo 基于 redis 的授权码存储，只保存授权码哈希
+func NewAuthorizationCodeRepo(redisClient *redis.Client, logger log.Logger) biz.AuthorizationCodeRepo {
+	return &authorizationCodeRepo{
+		redis: redisClient,
+		log:   log.NewHelper(log.With(logger, "module", "oauth2/data/code")),
+	}
+}
+
+func (r *authorizationCodeRepo) Save(ctx context.Context, code *biz.AuthorizationCode) error {
+	data, err := json.Marshal(&authorizationCode{
+		ClientID:            code.ClientID,
+		UserID:              code.UserID,
+		RedirectURI:         code.RedirectURI,
+		RedirectURIProvided: code.RedirectURIProvided,
+		Scopes:              code.Scopes,
+		CodeChallenge:       code.CodeChallenge,
+		CodeChallengeMethod: code.CodeChallengeMethod,
+		OpenID:              code.OpenID,
+		Nonce:               code.Nonce,
+		TenantID:            code.TenantID,
+	})
+	if err != nil {
+		return err
+	}
+	return r.redis.Set(ctx, authorizationCodeKeyPrefix+code.Hash, data, authorizationCodeTTL).Err()
+}
+
+func (r *authorizationCodeRepo) Take(ctx context.Context, hash string) (*biz.AuthorizationCode, error) {
+	data, err := r.redis.GetDel(ctx, authorizationCodeKeyPrefix+hash).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("查询授权码失败,error:%v", err)
+		return nil, err
+	}
+	var code authorizationCode
+	if err = json.Unmarshal(data, &code); err != nil {
+		return nil, nil
+	}
+	return &biz.AuthorizationCode{
+		Hash:                hash,
+		ClientID:            code.ClientID,
+		UserID:              code.UserID,
+		RedirectURI:         code.RedirectURI,
+		RedirectURIProvided: code.RedirectURIProvided,
+		Scopes:              code.Scopes,
+		CodeChallenge:       code.CodeChallenge,
+		CodeChallengeMethod: code.CodeChallengeMethod,
+		OpenID:              code.OpenID,
+		Nonce:               code.Nonce,
+		TenantID:            code.TenantID,
+	}, nil
+}
@@ -0,0 +1,167 @@
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"quest-admin/internal/conf"
+	"quest-admin/internal/data/data"
+	"quest-admin/pkg/lang/crypto"
+	"quest-admin/pkg/util/jwk"
+	"strings"
+	"time"
+
+	biz "quest-admin/internal/biz/oidc"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/uptrace/bun"
+)
+
+const (
+	defaultAudience       = "quest-admin"
+	defaultIDTokenTTL     = time.Hour
+	defaultRotationPeriod = 30 * 24 * time.Hour
+)
+
+type SigningKey struct {
+	bun.BaseModel `bun:"table:qa_oidc_key,alias:ok"`
+
+	ID         string    `bun:"id,pk"`
+	Algorithm  string    `bun:"algorithm,notnull"`
+	PrivateKey string    `bun:"private_key,notnull"`
+	CreateAt   time.Time `bun:"create_at,notnull,default:current_timestamp()"`
+	ActivateAt time.Time `bun:"activate_at,notnull"`
+	ExpireAt   time.Time `bun:"expire_at,notnull"`
+	RetireAt   time.Time `bun:"retire_at,notnull"`
+}
+
+type keyRepo struct {
+	data    *data.Data
+	cipher  *crypto.Cipher
+	options *biz.Options
+	log     *log.Helper
+}
+
+// NewKeyRepo ID Token 签名密钥存储，私钥使用 auth.oidc.secret_key 加密后落库，密钥不区分租户
+func NewKeyRepo(c *conf.Bootstrap, data *data.Data, logger log.Logger) (biz.KeyRepo, error) {
+	oidc := c.GetAuth().GetOidc()
+	cipher, err := crypto.NewCipher(oidc.GetSecretKey())
+	if err != nil {
+		return nil, fmt.Errorf("auth.oidc.secret_key: %w", err)
+	}
+	options := &biz.Options{
+		Issuer:         strings.TrimSuffix(oidc.GetIssuer(), "/"),
+		AuthorizeURL:   oidc.GetAuthorizeUrl(),
+		Algorithm:      jwk.RS256,
+		Audience:       defaultAudience,
+		IDTokenTTL:     defaultIDTokenTTL,
+		RotationPeriod: defaultRotationPeriod,
+	}
+	if oidc.GetAlgorithm() != "" {
+		options.Algorithm = oidc.GetAlgorithm()
+	}
+	if _, err = jwk.SigningMethod(options.Algorithm); err != nil {
+		return nil, fmt.Errorf("auth.oidc.algorithm: %w", err)
+	}
+	if options.AuthorizeURL == "" {
+		options.AuthorizeURL = options.Issuer + "/qs/v1/oauth2/authorize"
+	}
+	if oidc.GetAudience() != "" {
+		options.Audience = oidc.GetAudience()
+	}
+	if ttl := oidc.GetIdTokenTtl(); ttl > 0 {
+		options.IDTokenTTL = time.Duration(ttl) * time.Second
+	}
+	if period := oidc.GetKeyRotationPeriod(); period > 0 {
+		options.RotationPeriod = time.Duration(period) * time.Second
+	}
+	return &keyRepo{
+		data:    data,
+		cipher:  cipher,
+		options: options,
+		log:     log.NewHelper(logger),
+	}, nil
+}
+
+func (r *keyRepo) Options() *biz.Options {
+	return r.options
+}
+
+func (r *keyRepo) ListActive(ctx context.Context) ([]*biz.SigningKey, error) {
+	var dbKeys []*SigningKey
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(&dbKeys).
+		Where("retire_at > ?", time.Now()).
+		Order("create_at DESC").
+		Scan(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	keys := make([]*biz.SigningKey, 0, len(dbKeys))
+	for _, dbKey := range dbKeys {
+		key, err := r.toBizKey(dbKey)
+		if err != nil {
+			// 密钥口令变更后旧密钥无法解密，跳过并由上层生成新密钥
+			r.log.WithContext(ctx).Errorf("解密签名密钥失败,kid:%s,error:%v", dbKey.ID, err)
+			continue
+		}
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (r *keyRepo) Create(ctx context.Context, key *biz.SigningKey) error {
+	encoded, err := jwk.MarshalPrivateKey(key.PrivateKey)
+	if err != nil {
+		return err
+	}
+	privateKey, err := r.cipher.Encrypt(encoded)
+	if err != nil {
+		return err
+	}
+	dbKey := &SigningKey{
+		ID:         key.ID,
+		Algorithm:  key.Algorithm,
+		PrivateKey: privateKey,
+		CreateAt:   key.CreateAt,
+		ActivateAt: key.ActivateAt,
+		ExpireAt:   key.ExpireAt,
+		RetireAt:   key.RetireAt,
+	}
+	_, err = r.data.DB(ctx).NewInsert().Model(dbKey).Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *keyRepo) DeleteRetired(ctx context.Context) error {
+	_, err := r.data.DB(ctx).
+		NewDelete().
+		Model((*SigningKey)(nil)).
+		Where("retire_at <= ?", time.Now()).
+		Exec(ctx)
+	return err
+}
+
+func (r *keyRepo) toBizKey(dbKey *SigningKey) (*biz.SigningKey, error) {
+	encoded, err := r.cipher.Decrypt(dbKey.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := jwk.ParsePrivateKey(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return &biz.SigningKey{
+		ID:         dbKey.ID,
+		Algorithm:  dbKey.Algorithm,
+		PrivateKey: privateKey,
+		CreateAt:   dbKey.CreateAt,
+		ActivateAt: dbKey.ActivateAt,
+		ExpireAt:   dbKey.ExpireAt,
+		RetireAt:   dbKey.RetireAt,
+	}, nil
+}
@@ -124,9 +124,11 @@ func (r *departmentRepo) FindByName(ctx context.Context, name string) (*biz.Depa
 
 func (r *departmentRepo) List(ctx context.Context) ([]*biz.Department, error) {
 	var dbDepts []*Department
-	err := r.data.DB(ctx).NewSelect().
-		Model(&dbDepts).
-		Where("status = ?", 1).
+	q, err := data.ScopeByDept(ctx, r.data.DB(ctx).NewSelect().Model(&dbDepts), "d.id")
+	if err != nil {
+		return nil, err
+	}
+	err = q.Where("status = ?", 1).
 		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
 		Order("level ASC, sort ASC").
 		Scan(ctx)
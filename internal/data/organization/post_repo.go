@@ -138,6 +138,12 @@ func (r *postRepo) List(ctx context.Context, opt *biz.WherePostOpt) ([]*biz.Post
 		q = q.Where("status = ?", *opt.Status)
 	}
 
+	// 岗位按创建人归属数据范围
+	q, err := data.ScopeByUser(ctx, q, "p.create_by")
+	if err != nil {
+		return nil, err
+	}
+
 	if opt.Offset != 0 {
 		q.Offset(int(opt.Offset))
 	}
@@ -155,7 +161,7 @@ func (r *postRepo) List(ctx context.Context, opt *biz.WherePostOpt) ([]*biz.Post
 		q = q.Order("sort ASC, create_at DESC")
 	}
 
-	err := q.Where("tenant_id = ?", ctxs.GetTenantID(ctx)).Scan(ctx)
+	err = q.Where("tenant_id = ?", ctxs.GetTenantID(ctx)).Scan(ctx)
 	if err != nil {
 		r.log.WithContext(ctx).Error(err)
 		return nil, err
@@ -184,6 +190,11 @@ func (r *postRepo) Count(ctx context.Context, opt *biz.WherePostOpt) (int64, err
 		q = q.Where("status = ?", *opt.Status)
 	}
 
+	q, err := data.ScopeByUser(ctx, q, "p.create_by")
+	if err != nil {
+		return 0, err
+	}
+
 	total, err := q.Where("tenant_id = ?", ctxs.GetTenantID(ctx)).Count(ctx)
 	if err != nil {
 		r.log.WithContext(ctx).Error(err)
@@ -134,6 +134,21 @@ func (r *roleMapMenuRepo) GetMenuIDs(ctx context.Context, roleID string) ([]stri
 	return menuIDs, nil
 }
 
+func (r *roleMapMenuRepo) List(ctx context.Context) ([]*permission.RoleMenu, error) {
+	var roleMenus []*RoleMenu
+	err := r.data.DB(ctx).NewSelect().
+		Model(&roleMenus).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Scan(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return slices.Map(roleMenus, func(item *RoleMenu, index int) *permission.RoleMenu {
+		return toBizRoleMenu(item)
+	}), nil
+}
+
 func toBizRoleMenu(menu *RoleMenu) *permission.RoleMenu {
 	return &permission.RoleMenu{
 		ID:       menu.ID,
@@ -0,0 +1,149 @@
+package scim
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"quest-admin/internal/data/data"
+	"quest-admin/pkg/util/ctxs"
+	"time"
+
+	biz "quest-admin/internal/biz/scim"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/uptrace/bun"
+)
+
+type Config struct {
+	bun.BaseModel `bun:"table:qa_scim_config,alias:sc"`
+
+	ID          string     `bun:"id,pk"`
+	TokenPrefix string     `bun:"token_prefix,notnull"`
+	TokenHash   string     `bun:"token_hash,notnull"`
+	GroupTarget int32      `bun:"group_target,notnull"`
+	RootDeptID  string     `bun:"root_dept_id"`
+	Status      int32      `bun:"status,notnull"`
+	CreateBy    string     `bun:"create_by"`
+	CreateAt    time.Time  `bun:"create_at,notnull,default:current_timestamp()"`
+	UpdateBy    string     `bun:"update_by"`
+	UpdateAt    time.Time  `bun:"update_at,notnull,default:current_timestamp()"`
+	TenantID    string     `bun:"tenant_id,notnull"`
+	DeleteAt    *time.Time `bun:"delete_at,soft_delete,nullzero"`
+}
+
+type configRepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+// NewConfigRepo 租户 SCIM 配置存储，只保存令牌的 SHA-256 哈希
+func NewConfigRepo(data *data.Data, logger log.Logger) biz.ConfigRepo {
+	return &configRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *configRepo) Find(ctx context.Context) (*biz.Config, error) {
+	dbConfig := &Config{}
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(dbConfig).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return r.toBizConfig(dbConfig), nil
+}
+
+func (r *configRepo) FindByTokenHash(ctx context.Context, hash string) (*biz.Config, error) {
+	dbConfig := &Config{}
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(dbConfig).
+		Where("token_hash = ?", hash).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return r.toBizConfig(dbConfig), nil
+}
+
+func (r *configRepo) Save(ctx context.Context, cfg *biz.Config) error {
+	now := time.Now()
+	dbConfig := &Config{
+		ID:          cfg.ID,
+		TokenPrefix: cfg.TokenPrefix,
+		TokenHash:   cfg.TokenHash,
+		GroupTarget: cfg.GroupTarget,
+		RootDeptID:  cfg.RootDeptID,
+		Status:      cfg.Status,
+		UpdateBy:    ctxs.GetLoginID(ctx),
+		UpdateAt:    now,
+		TenantID:    ctxs.GetTenantID(ctx),
+	}
+	exists, err := r.data.DB(ctx).NewSelect().
+		Model((*Config)(nil)).
+		Where("id = ?", cfg.ID).
+		Where("tenant_id = ?", dbConfig.TenantID).
+		Exists(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	if !exists {
+		dbConfig.CreateBy = ctxs.GetLoginID(ctx)
+		dbConfig.CreateAt = now
+		_, err = r.data.DB(ctx).NewInsert().Model(dbConfig).Exec(ctx)
+	} else {
+		columns := []string{"group_target", "root_dept_id", "status", "update_by", "update_at"}
+		if cfg.TokenHash != "" {
+			columns = append(columns, "token_prefix", "token_hash")
+		}
+		_, err = r.data.DB(ctx).NewUpdate().
+			Model(dbConfig).
+			Column(columns...).
+			WherePK().
+			Where("tenant_id = ?", dbConfig.TenantID).
+			Exec(ctx)
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	cfg.UpdateAt = now
+	return nil
+}
+
+func (r *configRepo) Delete(ctx context.Context) error {
+	_, err := r.data.DB(ctx).NewDelete().
+		Model((*Config)(nil)).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	return err
+}
+
+func (r *configRepo) toBizConfig(dbConfig *Config) *biz.Config {
+	return &biz.Config{
+		ID:          dbConfig.ID,
+		TokenPrefix: dbConfig.TokenPrefix,
+		TokenHash:   dbConfig.TokenHash,
+		GroupTarget: dbConfig.GroupTarget,
+		RootDeptID:  dbConfig.RootDeptID,
+		Status:      dbConfig.Status,
+		CreateBy:    dbConfig.CreateBy,
+		CreateAt:    dbConfig.CreateAt,
+		UpdateBy:    dbConfig.UpdateBy,
+		UpdateAt:    dbConfig.UpdateAt,
+		TenantID:    dbConfig.TenantID,
+	}
+}
@@ -0,0 +1,37 @@
+package sms
+
+import (
+	"fmt"
+	"quest-admin/internal/conf"
+	"quest-admin/pkg/sms"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	driverLog  = "log"
+	driverFile = "file"
+
+	defaultSmsDir = "logs/sms"
+)
+
+// NewSmsSender 按配置创建短信发送器，未配置时使用 log 方式
+func NewSmsSender(c *conf.Bootstrap, logger log.Logger) (sms.Sender, error) {
+	helper := log.NewHelper(log.With(logger, "module", "sms/data"))
+	cfg := c.GetSms()
+
+	switch cfg.GetDriver() {
+	case driverLog, "":
+		helper.Info("短信不会真正发送,将写入日志")
+		return sms.NewLogSender(logger), nil
+	case driverFile:
+		dir := cfg.GetFile().GetDir()
+		if dir == "" {
+			dir = defaultSmsDir
+		}
+		helper.Infof("短信不会真正发送,将写入目录:%s", dir)
+		return sms.NewFileSender(dir), nil
+	default:
+		return nil, fmt.Errorf("sms: unknown driver %q", cfg.GetDriver())
+	}
+}
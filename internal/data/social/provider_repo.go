@@ -0,0 +1,276 @@
+package social
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"quest-admin/internal/conf"
+	"quest-admin/internal/data/data"
+	"quest-admin/pkg/lang/crypto"
+	"quest-admin/pkg/util/ctxs"
+	"time"
+
+	biz "quest-admin/internal/biz/social"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/uptrace/bun"
+)
+
+type Provider struct {
+	bun.BaseModel `bun:"table:qa_social_provider,alias:sp"`
+
+	ID            string     `bun:"id,pk"`
+	Code          string     `bun:"code,notnull"`
+	Name          string     `bun:"name,notnull"`
+	Icon          string     `bun:"icon"`
+	Issuer        string     `bun:"issuer,notnull"`
+	ClientID      string     `bun:"client_id,notnull"`
+	ClientSecret  string     `bun:"client_secret,notnull"`
+	Scopes        string     `bun:"scopes"`
+	ClaimMapping  string     `bun:"claim_mapping"`
+	AutoCreate    bool       `bun:"auto_create,notnull"`
+	DefaultDeptID string     `bun:"default_dept_id"`
+	DefaultRoleID string     `bun:"default_role_id"`
+	Sort          int32      `bun:"sort,notnull"`
+	Status        int32      `bun:"status,notnull"`
+	Remark        string     `bun:"remark"`
+	CreateBy      string     `bun:"create_by"`
+	CreateAt      time.Time  `bun:"create_at,notnull,default:current_timestamp()"`
+	UpdateBy      string     `bun:"update_by"`
+	UpdateAt      time.Time  `bun:"update_at,notnull,default:current_timestamp()"`
+	TenantID      string     `bun:"tenant_id,notnull"`
+	DeleteAt      *time.Time `bun:"delete_at,soft_delete,nullzero"`
+}
+
+type providerRepo struct {
+	data   *data.Data
+	cipher *crypto.Cipher
+	log    *log.Helper
+}
+
+// NewProviderRepo 身份提供方存储，客户端密钥使用 auth.social.secret_key 加密后落库
+func NewProviderRepo(c *conf.Bootstrap, data *data.Data, logger log.Logger) (biz.ProviderRepo, error) {
+	cipher, err := crypto.NewCipher(c.GetAuth().GetSocial().GetSecretKey())
+	if err != nil {
+		return nil, fmt.Errorf("auth.social.secret_key: %w", err)
+	}
+	return &providerRepo{
+		data:   data,
+		cipher: cipher,
+		log:    log.NewHelper(logger),
+	}, nil
+}
+
+func (r *providerRepo) Create(ctx context.Context, provider *biz.Provider) error {
+	dbProvider, err := r.toDBProvider(provider)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	dbProvider.CreateBy = ctxs.GetLoginID(ctx)
+	dbProvider.CreateAt = now
+	dbProvider.UpdateBy = ctxs.GetLoginID(ctx)
+	dbProvider.UpdateAt = now
+	dbProvider.TenantID = ctxs.GetTenantID(ctx)
+
+	_, err = r.data.DB(ctx).NewInsert().Model(dbProvider).Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	provider.CreateAt = now
+	provider.UpdateAt = now
+	return nil
+}
+
+func (r *providerRepo) FindByID(ctx context.Context, id string) (*biz.Provider, error) {
+	return r.findOne(ctx, "id = ?", id)
+}
+
+func (r *providerRepo) FindByCode(ctx context.Context, code string) (*biz.Provider, error) {
+	return r.findOne(ctx, "code = ?", code)
+}
+
+func (r *providerRepo) List(ctx context.Context, opt *biz.WhereProviderOpt) ([]*biz.Provider, error) {
+	var dbProviders []*Provider
+	q := r.data.DB(ctx).NewSelect().Model(&dbProviders)
+	q = r.applyFilter(ctx, q, opt)
+
+	if opt.Offset != 0 {
+		q = q.Offset(int(opt.Offset))
+	}
+	if opt.Limit != 0 {
+		q = q.Limit(int(opt.Limit))
+	}
+
+	err := q.Order("sort ASC", "create_at DESC").Scan(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+
+	providers := make([]*biz.Provider, 0, len(dbProviders))
+	for _, dbProvider := range dbProviders {
+		provider, err := r.toBizProvider(dbProvider)
+		if err != nil {
+			return nil, err
+		}
+		providers = append(providers, provider)
+	}
+	return providers, nil
+}
+
+func (r *providerRepo) Count(ctx context.Context, opt *biz.WhereProviderOpt) (int64, error) {
+	q := r.data.DB(ctx).NewSelect().Model((*Provider)(nil))
+	q = r.applyFilter(ctx, q, opt)
+
+	total, err := q.Count(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return 0, err
+	}
+	return int64(total), nil
+}
+
+func (r *providerRepo) Update(ctx context.Context, provider *biz.Provider) error {
+	dbProvider, err := r.toDBProvider(provider)
+	if err != nil {
+		return err
+	}
+	dbProvider.UpdateBy = ctxs.GetLoginID(ctx)
+	dbProvider.UpdateAt = time.Now()
+
+	columns := []string{"code", "name", "icon", "issuer", "client_id", "scopes", "claim_mapping", "auto_create",
+		"default_dept_id", "default_role_id", "sort", "status", "remark", "update_by", "update_at"}
+	if provider.ClientSecret != "" {
+		columns = append(columns, "client_secret")
+	}
+	_, err = r.data.DB(ctx).
+		NewUpdate().
+		Model(dbProvider).
+		Column(columns...).
+		WherePK().
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *providerRepo) Delete(ctx context.Context, id string) error {
+	_, err := r.data.DB(ctx).NewDelete().
+		Model((*Provider)(nil)).
+		Where("id = ?", id).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	return err
+}
+
+func (r *providerRepo) findOne(ctx context.Context, query string, arg string) (*biz.Provider, error) {
+	dbProvider := &Provider{}
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(dbProvider).
+		Where(query, arg).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return r.toBizProvider(dbProvider)
+}
+
+func (r *providerRepo) applyFilter(ctx context.Context, q *bun.SelectQuery, opt *biz.WhereProviderOpt) *bun.SelectQuery {
+	if opt.Keyword != "" {
+		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.WhereOr("name LIKE ?", "%"+opt.Keyword+"%").
+				WhereOr("code = ?", opt.Keyword)
+		})
+	}
+	if opt.Status != nil {
+		q = q.Where("status = ?", *opt.Status)
+	}
+	return q.Where("tenant_id = ?", ctxs.GetTenantID(ctx))
+}
+
+func (r *providerRepo) toDBProvider(provider *biz.Provider) (*Provider, error) {
+	scopes, err := json.Marshal(provider.Scopes)
+	if err != nil {
+		return nil, err
+	}
+	mapping, err := json.Marshal(provider.ClaimMapping)
+	if err != nil {
+		return nil, err
+	}
+	var secret string
+	if provider.ClientSecret != "" {
+		if secret, err = r.cipher.Encrypt(provider.ClientSecret); err != nil {
+			return nil, err
+		}
+	}
+	return &Provider{
+		ID:            provider.ID,
+		Code:          provider.Code,
+		Name:          provider.Name,
+		Icon:          provider.Icon,
+		Issuer:        provider.Issuer,
+		ClientID:      provider.ClientID,
+		ClientSecret:  secret,
+		Scopes:        string(scopes),
+		ClaimMapping:  string(mapping),
+		AutoCreate:    provider.AutoCreate,
+		DefaultDeptID: provider.DefaultDeptID,
+		DefaultRoleID: provider.DefaultRoleID,
+		Sort:          provider.Sort,
+		Status:        provider.Status,
+		Remark:        provider.Remark,
+	}, nil
+}
+
+func (r *providerRepo) toBizProvider(dbProvider *Provider) (*biz.Provider, error) {
+	provider := &biz.Provider{
+		ID:            dbProvider.ID,
+		Code:          dbProvider.Code,
+		Name:          dbProvider.Name,
+		Icon:          dbProvider.Icon,
+		Issuer:        dbProvider.Issuer,
+		ClientID:      dbProvider.ClientID,
+		ClaimMapping:  &biz.ClaimMapping{},
+		AutoCreate:    dbProvider.AutoCreate,
+		DefaultDeptID: dbProvider.DefaultDeptID,
+		DefaultRoleID: dbProvider.DefaultRoleID,
+		Sort:          dbProvider.Sort,
+		Status:        dbProvider.Status,
+		Remark:        dbProvider.Remark,
+		CreateBy:      dbProvider.CreateBy,
+		CreateAt:      dbProvider.CreateAt,
+		UpdateBy:      dbProvider.UpdateBy,
+		UpdateAt:      dbProvider.UpdateAt,
+		TenantID:      dbProvider.TenantID,
+	}
+	if dbProvider.Scopes != "" {
+		if err := json.Unmarshal([]byte(dbProvider.Scopes), &provider.Scopes); err != nil {
+			return nil, err
+		}
+	}
+	if dbProvider.ClaimMapping != "" {
+		if err := json.Unmarshal([]byte(dbProvider.ClaimMapping), provider.ClaimMapping); err != nil {
+			return nil, err
+		}
+	}
+	// 密钥口令变更后无法解密，按未配置密钥处理，登录时上游会拒绝
+	secret, err := r.cipher.Decrypt(dbProvider.ClientSecret)
+	if err != nil {
+		r.log.Warnf("解密身份提供方客户端密钥失败,id:%s,error:%v", dbProvider.ID, err)
+	}
+	provider.ClientSecret = secret
+	return provider, nil
+}
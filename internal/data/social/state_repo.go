@@ -0,0 +1,91 @@
+package social
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"quest-admin/internal/conf"
+	"time"
+
+	biz "quest-admin/internal/biz/social"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	stateKeyPrefix  = "qa:admin:social:state:"
+	defaultStateTTL = 10 * time.Minute
+)
+
+type authState struct {
+	ProviderID   string `json:"providerId"`
+	TenantID     string `json:"tenantId"`
+	Nonce        string `json:"nonce"`
+	CodeVerifier string `json:"codeVerifier"`
+	BindUserID   string `json:"bindUserId"`
+}
+
+type stateRepo struct {
+	redis   *redis.Client
+	options *biz.Options
+	log     *log.Helper
+}
+
+// NewStateRepo 基于 redis 的授权状态存储
+func NewStateRepo(c *conf.Bootstrap, redisClient *redis.Client, logger log.Logger) biz.StateRepo {
+	social := c.GetAuth().GetSocial()
+	options := &biz.Options{
+		RedirectURL: social.GetRedirectUrl(),
+		StateTTL:    defaultStateTTL,
+	}
+	if ttl := social.GetStateTtl(); ttl > 0 {
+		options.StateTTL = time.Duration(ttl) * time.Second
+	}
+	return &stateRepo{
+		redis:   redisClient,
+		options: options,
+		log:     log.NewHelper(log.With(logger, "module", "social/data/state")),
+	}
+}
+
+func (r *stateRepo) Options() *biz.Options {
+	return r.options
+}
+
+func (r *stateRepo) Save(ctx context.Context, state *biz.AuthState) error {
+	data, err := json.Marshal(&authState{
+		ProviderID:   state.ProviderID,
+		TenantID:     state.TenantID,
+		Nonce:        state.Nonce,
+		CodeVerifier: state.CodeVerifier,
+		BindUserID:   state.BindUserID,
+	})
+	if err != nil {
+		return err
+	}
+	return r.redis.Set(ctx, stateKeyPrefix+state.State, data, r.options.StateTTL).Err()
+}
+
+func (r *stateRepo) Take(ctx context.Context, state string) (*biz.AuthState, error) {
+	data, err := r.redis.GetDel(ctx, stateKeyPrefix+state).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("查询授权状态失败,error:%v", err)
+		return nil, err
+	}
+	var value authState
+	if err = json.Unmarshal(data, &value); err != nil {
+		return nil, nil
+	}
+	return &biz.AuthState{
+		State:        state,
+		ProviderID:   value.ProviderID,
+		TenantID:     value.TenantID,
+		Nonce:        value.Nonce,
+		CodeVerifier: value.CodeVerifier,
+		BindUserID:   value.BindUserID,
+	}, nil
+}
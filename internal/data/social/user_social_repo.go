@@ -0,0 +1,170 @@
+package social
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"quest-admin/internal/data/data"
+	"quest-admin/pkg/util/ctxs"
+	"time"
+
+	biz "quest-admin/internal/biz/social"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/uptrace/bun"
+)
+
+type UserSocial struct {
+	bun.BaseModel `bun:"table:qa_user_social,alias:us"`
+
+	ID          string     `bun:"id,pk"`
+	UserID      string     `bun:"user_id,notnull"`
+	ProviderID  string     `bun:"provider_id,notnull"`
+	Subject     string     `bun:"subject,notnull"`
+	Username    string     `bun:"username"`
+	Nickname    string     `bun:"nickname"`
+	Email       string     `bun:"email"`
+	Avatar      string     `bun:"avatar"`
+	LastLoginAt time.Time  `bun:"last_login_at"`
+	CreateBy    string     `bun:"create_by"`
+	CreateAt    time.Time  `bun:"create_at,notnull,default:current_timestamp()"`
+	UpdateBy    string     `bun:"update_by"`
+	UpdateAt    time.Time  `bun:"update_at,notnull,default:current_timestamp()"`
+	TenantID    string     `bun:"tenant_id,notnull"`
+	DeleteAt    *time.Time `bun:"delete_at,soft_delete,nullzero"`
+}
+
+type userSocialRepo struct {
+	data *data.Data
+	log  *log.Helper
+}
+
+func NewUserSocialRepo(data *data.Data, logger log.Logger) biz.UserSocialRepo {
+	return &userSocialRepo{
+		data: data,
+		log:  log.NewHelper(logger),
+	}
+}
+
+func (r *userSocialRepo) Create(ctx context.Context, social *biz.UserSocial) error {
+	now := time.Now()
+	dbSocial := &UserSocial{
+		ID:          social.ID,
+		UserID:      social.UserID,
+		ProviderID:  social.ProviderID,
+		Subject:     social.Subject,
+		Username:    social.Username,
+		Nickname:    social.Nickname,
+		Email:       social.Email,
+		Avatar:      social.Avatar,
+		LastLoginAt: social.LastLoginAt,
+		CreateBy:    ctxs.GetLoginID(ctx),
+		CreateAt:    now,
+		UpdateBy:    ctxs.GetLoginID(ctx),
+		UpdateAt:    now,
+		TenantID:    ctxs.GetTenantID(ctx),
+	}
+	_, err := r.data.DB(ctx).NewInsert().Model(dbSocial).Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	social.CreateAt = now
+	return nil
+}
+
+func (r *userSocialRepo) FindBySubject(ctx context.Context, providerID, subject string) (*biz.UserSocial, error) {
+	dbSocial := &UserSocial{}
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(dbSocial).
+		Where("provider_id = ?", providerID).
+		Where("subject = ?", subject).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return r.toBizUserSocial(dbSocial), nil
+}
+
+func (r *userSocialRepo) ListByUserID(ctx context.Context, userID string) ([]*biz.UserSocial, error) {
+	var dbSocials []*UserSocial
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(&dbSocials).
+		Where("user_id = ?", userID).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Order("create_at ASC").
+		Scan(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	socials := make([]*biz.UserSocial, 0, len(dbSocials))
+	for _, dbSocial := range dbSocials {
+		socials = append(socials, r.toBizUserSocial(dbSocial))
+	}
+	return socials, nil
+}
+
+func (r *userSocialRepo) UpdateLogin(ctx context.Context, social *biz.UserSocial) error {
+	_, err := r.data.DB(ctx).
+		NewUpdate().
+		Model((*UserSocial)(nil)).
+		Set("username = ?", social.Username).
+		Set("nickname = ?", social.Nickname).
+		Set("email = ?", social.Email).
+		Set("avatar = ?", social.Avatar).
+		Set("last_login_at = ?", social.LastLoginAt).
+		Set("update_at = ?", time.Now()).
+		Where("id = ?", social.ID).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+// Delete 绑定关系直接物理删除，解绑后同一上游身份可以重新绑定
+func (r *userSocialRepo) Delete(ctx context.Context, id string) error {
+	_, err := r.data.DB(ctx).NewDelete().
+		Model((*UserSocial)(nil)).
+		Where("id = ?", id).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		ForceDelete().
+		Exec(ctx)
+	return err
+}
+
+func (r *userSocialRepo) DeleteByProviderID(ctx context.Context, providerID string) error {
+	_, err := r.data.DB(ctx).NewDelete().
+		Model((*UserSocial)(nil)).
+		Where("provider_id = ?", providerID).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		ForceDelete().
+		Exec(ctx)
+	return err
+}
+
+func (r *userSocialRepo) toBizUserSocial(dbSocial *UserSocial) *biz.UserSocial {
+	return &biz.UserSocial{
+		ID:          dbSocial.ID,
+		UserID:      dbSocial.UserID,
+		ProviderID:  dbSocial.ProviderID,
+		Subject:     dbSocial.Subject,
+		Username:    dbSocial.Username,
+		Nickname:    dbSocial.Nickname,
+		Email:       dbSocial.Email,
+		Avatar:      dbSocial.Avatar,
+		LastLoginAt: dbSocial.LastLoginAt,
+		CreateAt:    dbSocial.CreateAt,
+		TenantID:    dbSocial.TenantID,
+	}
+}
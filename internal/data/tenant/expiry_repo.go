@@ -0,0 +1,70 @@
+package tenant
+
+import (
+	"context"
+	"quest-admin/internal/conf"
+	"strconv"
+	"time"
+
+	biz "quest-admin/internal/biz/tenant"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	expiryWarnKeyPrefix = "qa:admin:tenant:expiry:warned:"
+
+	defaultExpiryCheckInterval = time.Hour
+	defaultExpiryWarnDays      = 7
+)
+
+type expiryRepo struct {
+	redis   *redis.Client
+	options *biz.Options
+	log     *log.Helper
+}
+
+// NewTenantExpiryRepo 基于 redis 记录已发送的租户到期提醒，多实例同时检查时只发送一次
+func NewTenantExpiryRepo(c *conf.Bootstrap, redisClient *redis.Client, logger log.Logger) biz.TenantExpiryRepo {
+	cfg := c.GetTenant()
+	options := &biz.Options{
+		ExpiryCheckInterval: defaultExpiryCheckInterval,
+		ExpiryWarnBefore:    defaultExpiryWarnDays * 24 * time.Hour,
+	}
+	if interval := cfg.GetExpiryCheckInterval(); interval > 0 {
+		options.ExpiryCheckInterval = time.Duration(interval) * time.Second
+	}
+	if days := cfg.GetExpiryWarnDays(); days > 0 {
+		options.ExpiryWarnBefore = time.Duration(days) * 24 * time.Hour
+	}
+	return &expiryRepo{
+		redis:   redisClient,
+		options: options,
+		log:     log.NewHelper(log.With(logger, "module", "tenant/data/expiry")),
+	}
+}
+
+func (r *expiryRepo) Options() *biz.Options {
+	return r.options
+}
+
+func (r *expiryRepo) MarkWarned(ctx context.Context, tenantID string, expireTime time.Time) (bool, error) {
+	key := expiryWarnKey(tenantID, expireTime)
+	// 记录保留到过期时间之后，续期后过期时间变化会重新提醒
+	ttl := time.Until(expireTime) + 24*time.Hour
+	ok, err := r.redis.SetNX(ctx, key, 1, ttl).Result()
+	if err != nil {
+		r.log.WithContext(ctx).Errorf("记录租户到期提醒失败,tenantID:%s,error:%v", tenantID, err)
+		return false, err
+	}
+	return ok, nil
+}
+
+func (r *expiryRepo) UnmarkWarned(ctx context.Context, tenantID string, expireTime time.Time) error {
+	return r.redis.Del(ctx, expiryWarnKey(tenantID, expireTime)).Err()
+}
+
+func expiryWarnKey(tenantID string, expireTime time.Time) string {
+	return expiryWarnKeyPrefix + tenantID + ":" + strconv.FormatInt(expireTime.Unix(), 10)
+}
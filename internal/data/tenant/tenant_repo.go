@@ -7,7 +7,6 @@ import (
 	"time"
 
 	biz "quest-admin/internal/biz/tenant"
-	"quest-admin/pkg/util/idgen"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
@@ -49,7 +48,7 @@ func NewTenantRepo(data *data.Data, logger log.Logger) biz.TenantRepo {
 func (r *tenantRepo) Create(ctx context.Context, tenant *biz.Tenant) error {
 	now := time.Now()
 	dbTenant := &Tenant{
-		ID:            idgen.GenerateID(),
+		ID:            tenant.ID,
 		Name:          tenant.Name,
 		ContactUserID: tenant.ContactUserID,
 		ContactName:   tenant.ContactName,
@@ -87,6 +86,19 @@ func (r *tenantRepo) FindByID(ctx context.Context, id string) (*biz.Tenant, erro
 	return r.toBizTenant(dbTenant), nil
 }
 
+func (r *tenantRepo) LockByID(ctx context.Context, id string) (*biz.Tenant, error) {
+	dbTenant := &Tenant{ID: id}
+	err := r.data.DB(ctx).NewSelect().Model(dbTenant).WherePK().For("UPDATE").Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return r.toBizTenant(dbTenant), nil
+}
+
 func (r *tenantRepo) FindByName(ctx context.Context, name string) (*biz.Tenant, error) {
 	dbTenant := &Tenant{}
 	err := r.data.DB(ctx).NewSelect().Model(dbTenant).Where("name = ?", name).Scan(ctx)
@@ -100,6 +112,24 @@ func (r *tenantRepo) FindByName(ctx context.Context, name string) (*biz.Tenant,
 	return r.toBizTenant(dbTenant), nil
 }
 
+func (r *tenantRepo) FindByWebsite(ctx context.Context, host string) (*biz.Tenant, error) {
+	dbTenant := &Tenant{}
+	err := r.data.DB(ctx).NewSelect().
+		Model(dbTenant).
+		Where("LOWER(REGEXP_REPLACE(REGEXP_REPLACE(website, '^[a-zA-Z][a-zA-Z0-9+.-]*://', ''), '[:/#].*$', '')) = ?", host).
+		Order("id").
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return r.toBizTenant(dbTenant), nil
+}
+
 func (r *tenantRepo) List(ctx context.Context, query *biz.ListTenantsQuery) (*biz.ListTenantsResult, error) {
 	var dbTenants []*Tenant
 
@@ -171,7 +201,10 @@ func (r *tenantRepo) Update(ctx context.Context, tenant *biz.Tenant) error {
 		UpdateAt:      time.Now(),
 	}
 
-	_, err := r.data.DB(ctx).NewUpdate().Model(dbTenant).WherePK().OmitZero().Exec(ctx)
+	// 状态为 0 表示停用，不能随其它零值字段一起被忽略
+	_, err := r.data.DB(ctx).NewUpdate().Model(dbTenant).WherePK().OmitZero().
+		Value("status", "?", tenant.Status).
+		Exec(ctx)
 	if err != nil {
 		r.log.WithContext(ctx).Error(err)
 		return err
@@ -180,6 +213,40 @@ func (r *tenantRepo) Update(ctx context.Context, tenant *biz.Tenant) error {
 	return nil
 }
 
+func (r *tenantRepo) UpdateStatus(ctx context.Context, id string, status int32) error {
+	_, err := r.data.DB(ctx).NewUpdate().
+		Model((*Tenant)(nil)).
+		Set("status = ?", status).
+		Set("update_at = ?", time.Now()).
+		Where("id = ?", id).
+		Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *tenantRepo) ListExpiring(ctx context.Context, before time.Time) ([]*biz.Tenant, error) {
+	var dbTenants []*Tenant
+	// 过期时间为 1970-01-01 及以前视为未设置
+	err := r.data.DB(ctx).NewSelect().
+		Model(&dbTenants).
+		Where("status = 1").
+		Where("expire_time > ?", time.Unix(0, 0)).
+		Where("expire_time <= ?", before).
+		Scan(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	tenants := make([]*biz.Tenant, 0, len(dbTenants))
+	for _, dbTenant := range dbTenants {
+		tenants = append(tenants, r.toBizTenant(dbTenant))
+	}
+	return tenants, nil
+}
+
 func (r *tenantRepo) Delete(ctx context.Context, id string) error {
 	_, err := r.data.DB(ctx).NewDelete().
 		Model((*Tenant)(nil)).
@@ -188,6 +255,20 @@ func (r *tenantRepo) Delete(ctx context.Context, id string) error {
 	return err
 }
 
+func (r *tenantRepo) FindIDsByPackageID(ctx context.Context, packageID string) ([]string, error) {
+	var ids []string
+	err := r.data.DB(ctx).NewSelect().
+		Model((*Tenant)(nil)).
+		Column("id").
+		Where("package_id = ?", packageID).
+		Scan(ctx, &ids)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return ids, nil
+}
+
 func (r *tenantRepo) FindIDAndNameList(ctx context.Context) ([]*biz.TenantSimple, error) {
 	var dbTenants []struct {
 		ID   string `bun:"id"`
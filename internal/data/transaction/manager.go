@@ -19,7 +19,12 @@ type manager struct {
 func NewManager(db *bun.DB) Manager {
 	return &manager{db: db}
 }
+
+// Tx 在事务中执行 fn，上下文已处于事务中时加入外层事务，由外层统一提交或回滚
 func (m *manager) Tx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(ContextTxKey{}).(bun.Tx); ok {
+		return fn(ctx)
+	}
 	err := m.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
 		ctx = context.WithValue(ctx, ContextTxKey{}, tx)
 		return fn(ctx)
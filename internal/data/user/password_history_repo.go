@@ -0,0 +1,116 @@
+package user
+
+import (
+	"context"
+	"quest-admin/internal/conf"
+	"quest-admin/internal/data/data"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/pkg/util/validator"
+	"time"
+
+	biz "quest-admin/internal/biz/user"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/uptrace/bun"
+)
+
+const (
+	defaultPasswordMinLength  = 8
+	defaultPasswordMinClasses = 3
+)
+
+type PasswordHistory struct {
+	bun.BaseModel `bun:"table:qa_password_history,alias:ph"`
+
+	ID       string    `bun:"id,pk"`
+	UserID   string    `bun:"user_id,notnull"`
+	Password string    `bun:"password,notnull"`
+	CreateAt time.Time `bun:"create_at,notnull,default:current_timestamp()"`
+	TenantID string    `bun:"tenant_id"`
+}
+
+type passwordRepo struct {
+	data   *data.Data
+	policy *validator.PasswordPolicy
+	log    *log.Helper
+}
+
+// NewPasswordRepo 密码策略取自 auth.password_policy，历史密码只保留策略要求的条数
+func NewPasswordRepo(c *conf.Bootstrap, data *data.Data, logger log.Logger) biz.PasswordRepo {
+	cfg := c.GetAuth().GetPasswordPolicy()
+	minLength := int(cfg.GetMinLength())
+	if minLength <= 0 {
+		minLength = defaultPasswordMinLength
+	}
+	minClasses := int(cfg.GetMinClasses())
+	if minClasses <= 0 {
+		minClasses = defaultPasswordMinClasses
+	}
+	return &passwordRepo{
+		data: data,
+		policy: validator.NewPasswordPolicy(
+			minLength,
+			minClasses,
+			int(cfg.GetHistoryDepth()),
+			time.Duration(cfg.GetMaxAgeDays())*24*time.Hour,
+			cfg.GetDenylist()...,
+		),
+		log: log.NewHelper(logger),
+	}
+}
+
+func (r *passwordRepo) Policy() *validator.PasswordPolicy {
+	return r.policy
+}
+
+func (r *passwordRepo) ListHistory(ctx context.Context, userID string, limit int) ([]string, error) {
+	var passwords []string
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model((*PasswordHistory)(nil)).
+		Column("password").
+		Where("user_id = ?", userID).
+		Order("create_at DESC").
+		Limit(limit).
+		Scan(ctx, &passwords)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	return passwords, nil
+}
+
+func (r *passwordRepo) CreateHistory(ctx context.Context, history *biz.PasswordHistory) error {
+	dbHistory := &PasswordHistory{
+		ID:       history.ID,
+		UserID:   history.UserID,
+		Password: history.Password,
+		CreateAt: time.Now(),
+		TenantID: ctxs.GetTenantID(ctx),
+	}
+	_, err := r.data.DB(ctx).NewInsert().Model(dbHistory).Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+
+	// 清理超出保留条数的旧记录
+	keep := r.data.DB(ctx).
+		NewSelect().
+		Model((*PasswordHistory)(nil)).
+		Column("id").
+		Where("user_id = ?", history.UserID).
+		Order("create_at DESC").
+		Limit(max(r.policy.HistoryDepth, 1))
+	_, err = r.data.DB(ctx).
+		NewDelete().
+		Model((*PasswordHistory)(nil)).
+		Where("user_id = ?", history.UserID).
+		Where("id NOT IN (?)", keep).
+		Exec(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return err
+	}
+	return nil
+}
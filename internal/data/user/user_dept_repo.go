@@ -65,6 +65,7 @@ func (r *userDeptRepo) Delete(ctx context.Context, id string) error {
 	_, err := r.data.DB(ctx).NewUpdate().
 		Model((*UserDept)(nil)).
 		Set("update_by = ?", ctxs.GetLoginID(ctx)).
+		Set("delete_at = ?", time.Now()).
 		Where("id = ?", id).
 		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
 		Exec(ctx)
@@ -93,6 +94,23 @@ func (r *userDeptRepo) GetUserDepts(ctx context.Context, userID string) ([]*biz.
 	}), nil
 }
 
+func (r *userDeptRepo) ListByDeptID(ctx context.Context, deptID string) ([]*biz.UserDept, error) {
+	var userDepts []*UserDept
+	err := r.data.DB(ctx).NewSelect().
+		Model(&userDepts).
+		Where("dept_id = ?", deptID).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Scan(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+
+	return slices.Map(userDepts, func(item *UserDept, index int) *biz.UserDept {
+		return r.toBizUserDept(item)
+	}), nil
+}
+
 func (r *userDeptRepo) toBizUserDept(item *UserDept) *biz.UserDept {
 	return &biz.UserDept{
 		ID:       item.ID,
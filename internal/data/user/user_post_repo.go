@@ -66,6 +66,7 @@ func (r *userPostRepo) Delete(ctx context.Context, id string) error {
 	_, err := r.data.DB(ctx).NewUpdate().
 		Model((*UserPost)(nil)).
 		Set("update_by = ?", ctxs.GetLoginID(ctx)).
+		Set("delete_at = ?", time.Now()).
 		Where("id = ?", id).
 		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
 		Exec(ctx)
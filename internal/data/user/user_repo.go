@@ -16,25 +16,27 @@ import (
 )
 
 type User struct {
-	bun.BaseModel `bun:"table:qa_user,alias:u"`
-	ID            string     `bun:"id,pk"`
-	Username      string     `bun:"username,notnull"`
-	Password      string     `bun:"password,notnull"`
-	Nickname      string     `bun:"nickname"`
-	Email         string     `bun:"email"`
-	Mobile        string     `bun:"mobile"`
-	Sex           int32      `bun:"sex,default:0"`
-	Avatar        string     `bun:"avatar"`
-	Status        int32      `bun:"status,default:1"`
-	Remark        string     `bun:"remark"`
-	LoginIP       string     `bun:"login_ip"`
-	LoginDate     time.Time  `bun:"login_date"`
-	CreateBy      string     `bun:"create_by"`
-	CreateAt      time.Time  `bun:"create_at,notnull,default:current_timestamp()"`
-	UpdateBy      string     `bun:"update_by"`
-	UpdateAt      time.Time  `bun:"update_at,notnull,default:current_timestamp()"`
-	TenantID      string     `bun:"tenant_id"`
-	DeleteAt      *time.Time `bun:"delete_at,soft_delete,nullzero"`
+	bun.BaseModel    `bun:"table:qa_user,alias:u"`
+	ID               string     `bun:"id,pk"`
+	Username         string     `bun:"username,notnull"`
+	Password         string     `bun:"password,notnull"`
+	Nickname         string     `bun:"nickname"`
+	Email            string     `bun:"email"`
+	Mobile           string     `bun:"mobile"`
+	Sex              int32      `bun:"sex,default:0"`
+	Avatar           string     `bun:"avatar"`
+	Status           int32      `bun:"status,default:1"`
+	Remark           string     `bun:"remark"`
+	LoginIP          string     `bun:"login_ip"`
+	LoginDate        time.Time  `bun:"login_date"`
+	PasswordUpdateAt time.Time  `bun:"password_update_at"`
+	PasswordReset    int32      `bun:"password_reset,notnull"`
+	CreateBy         string     `bun:"create_by"`
+	CreateAt         time.Time  `bun:"create_at,notnull,default:current_timestamp()"`
+	UpdateBy         string     `bun:"update_by"`
+	UpdateAt         time.Time  `bun:"update_at,notnull,default:current_timestamp()"`
+	TenantID         string     `bun:"tenant_id"`
+	DeleteAt         *time.Time `bun:"delete_at,soft_delete,nullzero"`
 }
 
 type userRepo struct {
@@ -52,23 +54,25 @@ func NewUserRepo(data *data.Data, logger log.Logger) biz.UserRepo {
 func (r *userRepo) Create(ctx context.Context, user *biz.User) error {
 	now := time.Now()
 	dbUser := &User{
-		ID:        user.ID,
-		Username:  user.Username,
-		Password:  user.Password,
-		Nickname:  user.Nickname,
-		Email:     user.Email,
-		Mobile:    user.Mobile,
-		Sex:       user.Sex,
-		Avatar:    user.Avatar,
-		Status:    user.Status,
-		Remark:    user.Remark,
-		LoginIP:   user.LoginIP,
-		LoginDate: user.LoginDate,
-		CreateBy:  user.CreateBy,
-		CreateAt:  now,
-		UpdateBy:  user.UpdateBy,
-		UpdateAt:  now,
-		TenantID:  user.TenantID,
+		ID:               user.ID,
+		Username:         user.Username,
+		Password:         user.Password,
+		Nickname:         user.Nickname,
+		Email:            user.Email,
+		Mobile:           user.Mobile,
+		Sex:              user.Sex,
+		Avatar:           user.Avatar,
+		Status:           user.Status,
+		Remark:           user.Remark,
+		LoginIP:          user.LoginIP,
+		LoginDate:        user.LoginDate,
+		PasswordUpdateAt: now,
+		PasswordReset:    boolToInt(user.PasswordReset),
+		CreateBy:         user.CreateBy,
+		CreateAt:         now,
+		UpdateBy:         user.UpdateBy,
+		UpdateAt:         now,
+		TenantID:         user.TenantID,
 	}
 
 	_, err := r.data.DB(ctx).NewInsert().Model(dbUser).Exec(ctx)
@@ -115,6 +119,46 @@ func (r *userRepo) FindByUsername(ctx context.Context, username string) (*biz.Us
 	return r.toBizUser(dbUser), nil
 }
 
+func (r *userRepo) FindByEmail(ctx context.Context, email string) (*biz.User, error) {
+	var dbUsers []*User
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(&dbUsers).
+		Where("LOWER(email) = LOWER(?)", email).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Limit(2).
+		Scan(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	// 邮箱未做唯一约束，对应多个用户时无法确定账号
+	if len(dbUsers) != 1 {
+		return nil, nil
+	}
+	return r.toBizUser(dbUsers[0]), nil
+}
+
+func (r *userRepo) FindByMobile(ctx context.Context, mobile string) (*biz.User, error) {
+	var dbUsers []*User
+	err := r.data.DB(ctx).
+		NewSelect().
+		Model(&dbUsers).
+		Where("mobile = ?", mobile).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Limit(2).
+		Scan(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+	// 手机号未做唯一约束，对应多个用户时无法确定账号
+	if len(dbUsers) != 1 {
+		return nil, nil
+	}
+	return r.toBizUser(dbUsers[0]), nil
+}
+
 func (r *userRepo) List(ctx context.Context, opt *biz.WhereUserOpt) ([]*biz.User, error) {
 	var dbUsers []*User
 	q := r.data.DB(ctx).NewSelect().Model(&dbUsers)
@@ -133,6 +177,10 @@ func (r *userRepo) List(ctx context.Context, opt *biz.WhereUserOpt) ([]*biz.User
 	if opt.Sex != nil {
 		q = q.Where("sex = ?", *opt.Sex)
 	}
+	q, err := data.ScopeByUser(ctx, q, "u.id")
+	if err != nil {
+		return nil, err
+	}
 	if opt.Offset != 0 {
 		q.Offset(int(opt.Offset))
 	}
@@ -144,7 +192,7 @@ func (r *userRepo) List(ctx context.Context, opt *biz.WhereUserOpt) ([]*biz.User
 	} else {
 		q = q.Order("id DESC")
 	}
-	err := q.Where("tenant_id = ?", ctxs.GetTenantID(ctx)).Scan(ctx)
+	err = q.Where("tenant_id = ?", ctxs.GetTenantID(ctx)).Scan(ctx)
 	if err != nil {
 		r.log.WithContext(ctx).Error(err)
 		return nil, err
@@ -175,6 +223,10 @@ func (r *userRepo) Count(ctx context.Context, opt *biz.WhereUserOpt) (int64, err
 	if opt.Sex != nil {
 		q = q.Where("sex = ?", *opt.Sex)
 	}
+	q, err := data.ScopeByUser(ctx, q, "u.id")
+	if err != nil {
+		return 0, err
+	}
 	total, err := q.Where("tenant_id = ?", ctxs.GetTenantID(ctx)).Count(ctx)
 	if err != nil {
 		r.log.WithContext(ctx).Error(err)
@@ -183,6 +235,18 @@ func (r *userRepo) Count(ctx context.Context, opt *biz.WhereUserOpt) (int64, err
 	return int64(total), nil
 }
 
+func (r *userRepo) CountByTenant(ctx context.Context) (int64, error) {
+	total, err := r.data.DB(ctx).NewSelect().
+		Model((*User)(nil)).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Count(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return 0, err
+	}
+	return int64(total), nil
+}
+
 func (r *userRepo) Update(ctx context.Context, user *biz.User) error {
 	dbUser := &User{
 		ID:       user.ID,
@@ -214,6 +278,8 @@ func (r *userRepo) UpdatePassword(ctx context.Context, bo *biz.UpdatePasswordBO)
 	_, err := r.data.DB(ctx).NewUpdate().
 		Model((*User)(nil)).
 		Set("password = ?", bo.NewPassword).
+		Set("password_update_at = ?", time.Now()).
+		Set("password_reset = ?", boolToInt(bo.PasswordReset)).
 		Set("update_at = ?", time.Now()).
 		Where("id = ?", bo.UserID).
 		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
@@ -258,22 +324,31 @@ func (r *userRepo) Delete(ctx context.Context, bo *biz.DeleteUserBO) error {
 
 func (r *userRepo) toBizUser(dbUser *User) *biz.User {
 	return &biz.User{
-		ID:        dbUser.ID,
-		Username:  dbUser.Username,
-		Password:  dbUser.Password,
-		Nickname:  dbUser.Nickname,
-		Email:     dbUser.Email,
-		Mobile:    dbUser.Mobile,
-		Sex:       dbUser.Sex,
-		Avatar:    dbUser.Avatar,
-		Status:    dbUser.Status,
-		Remark:    dbUser.Remark,
-		LoginIP:   dbUser.LoginIP,
-		LoginDate: dbUser.LoginDate,
-		CreateBy:  dbUser.CreateBy,
-		CreateAt:  dbUser.CreateAt,
-		UpdateBy:  dbUser.UpdateBy,
-		UpdateAt:  dbUser.UpdateAt,
-		TenantID:  dbUser.TenantID,
+		ID:               dbUser.ID,
+		Username:         dbUser.Username,
+		Password:         dbUser.Password,
+		Nickname:         dbUser.Nickname,
+		Email:            dbUser.Email,
+		Mobile:           dbUser.Mobile,
+		Sex:              dbUser.Sex,
+		Avatar:           dbUser.Avatar,
+		Status:           dbUser.Status,
+		Remark:           dbUser.Remark,
+		LoginIP:          dbUser.LoginIP,
+		LoginDate:        dbUser.LoginDate,
+		PasswordUpdateAt: dbUser.PasswordUpdateAt,
+		PasswordReset:    dbUser.PasswordReset == 1,
+		CreateBy:         dbUser.CreateBy,
+		CreateAt:         dbUser.CreateAt,
+		UpdateBy:         dbUser.UpdateBy,
+		UpdateAt:         dbUser.UpdateAt,
+		TenantID:         dbUser.TenantID,
+	}
+}
+
+func boolToInt(b bool) int32 {
+	if b {
+		return 1
 	}
+	return 0
 }
@@ -68,6 +68,7 @@ func (r *userRoleRepo) Delete(ctx context.Context, id string) error {
 	_, err := r.data.DB(ctx).NewUpdate().
 		Model((*UserRole)(nil)).
 		Set("update_by = ?", ctxs.GetLoginID(ctx)).
+		Set("delete_at = ?", time.Now()).
 		Where("id = ?", id).
 		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
 		Exec(ctx)
@@ -98,6 +99,23 @@ func (r *userRoleRepo) GetUserRoles(ctx context.Context, userID string) ([]*biz.
 	}), nil
 }
 
+func (r *userRoleRepo) ListByRoleID(ctx context.Context, roleID string) ([]*biz.UserRole, error) {
+	var userRoles []*UserRole
+	err := r.data.DB(ctx).NewSelect().
+		Model(&userRoles).
+		Where("role_id = ?", roleID).
+		Where("tenant_id = ?", ctxs.GetTenantID(ctx)).
+		Scan(ctx)
+	if err != nil {
+		r.log.WithContext(ctx).Error(err)
+		return nil, err
+	}
+
+	return slices.Map(userRoles, func(item *UserRole, index int) *biz.UserRole {
+		return r.toBizUserRole(item)
+	}), nil
+}
+
 func (r *userRoleRepo) addUserRoles(ctx context.Context, userID string, roleIDs []string) error {
 	now := time.Now()
 	userRoles := make([]*UserRole, 0, len(roleIDs))
@@ -2,8 +2,16 @@ package server
 
 import (
 	userv1 "quest-admin/api/gen/user/v1"
+	auditBiz "quest-admin/internal/biz/audit"
+	authBiz "quest-admin/internal/biz/auth"
+	permBiz "quest-admin/internal/biz/permission"
+	tenantBiz "quest-admin/internal/biz/tenant"
 	"quest-admin/internal/conf"
+	authManager "quest-admin/internal/data/auth"
 	"quest-admin/internal/service/user"
+	auditmiddleware "quest-admin/pkg/middleware/audit"
+	authmiddleware "quest-admin/pkg/middleware/auth"
+	"quest-admin/pkg/middleware/clientip"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -12,10 +20,30 @@ import (
 )
 
 // NewGRPCServer new a gRPC server.
-func NewGRPCServer(c *conf.Bootstrap, logger log.Logger, userService *user.UserService) *grpc.Server {
+func NewGRPCServer(
+	c *conf.Bootstrap,
+	logger log.Logger,
+	authManager *authManager.Manager,
+	userService *user.UserService,
+	operateLogUsecase *auditBiz.OperateLogUsecase,
+	apiKeyUsecase *authBiz.ApiKeyUsecase,
+	ipRuleUsecase *authBiz.IpRuleUsecase,
+	dataScopeUsecase *permBiz.DataScopeUsecase,
+	tenantUsecase *tenantBiz.TenantUsecase,
+) (*grpc.Server, error) {
+	clientIP, err := clientip.Server(c.GetServer().GetTrustedProxies())
+	if err != nil {
+		return nil, err
+	}
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
 			recovery.Recovery(),
+			clientIP,
+			authmiddleware.AdminHttpServer(authManager, apiKeyUsecase, ipRuleUsecase, tenantUsecase),
+			auditmiddleware.OperateLog(operateLogUsecase),
+			authmiddleware.Permission(authManager),
+			authmiddleware.TenantGuard(tenantUsecase),
+			authmiddleware.DataScope(dataScopeUsecase),
 		),
 	}
 	if c.Server.Grpc.Network != "" {
@@ -29,5 +57,5 @@ func NewGRPCServer(c *conf.Bootstrap, logger log.Logger, userService *user.UserS
 	}
 	srv := grpc.NewServer(opts...)
 	userv1.RegisterUserServiceServer(srv, userService)
-	return srv
+	return srv, nil
 }
@@ -1,23 +1,35 @@
 package server
 
 import (
+	auditv1 "quest-admin/api/gen/audit/v1"
 	authv1 "quest-admin/api/gen/auth/v1"
 	configv1 "quest-admin/api/gen/config/v1"
+	oauth2v1 "quest-admin/api/gen/oauth2/v1"
 	orgv1 "quest-admin/api/gen/organization/v1"
 	permissionv1 "quest-admin/api/gen/permission/v1"
 	tenantv1 "quest-admin/api/gen/tenant/v1"
 	userv1 "quest-admin/api/gen/user/v1"
+	auditBiz "quest-admin/internal/biz/audit"
+	authBiz "quest-admin/internal/biz/auth"
+	permBiz "quest-admin/internal/biz/permission"
+	tenantBiz "quest-admin/internal/biz/tenant"
 	"quest-admin/internal/conf"
 	authManager "quest-admin/internal/data/auth"
+	"quest-admin/internal/service/audit"
 	"quest-admin/internal/service/auth"
 	"quest-admin/internal/service/config"
+	"quest-admin/internal/service/oauth2"
+	"quest-admin/internal/service/oidc"
 	"quest-admin/internal/service/organization"
 	"quest-admin/internal/service/permission"
+	"quest-admin/internal/service/scim"
 	"quest-admin/internal/service/tenant"
 	"quest-admin/internal/service/user"
 	pkglogger "quest-admin/pkg/logger"
+	auditmiddleware "quest-admin/pkg/middleware/audit"
 	authmiddleware "quest-admin/pkg/middleware/auth"
-	"quest-admin/pkg/middleware/err"
+	"quest-admin/pkg/middleware/clientip"
+	errmiddleware "quest-admin/pkg/middleware/err"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -41,15 +53,37 @@ func NewHTTPServer(
 	postService *organization.PostService,
 	configService *config.ConfigService,
 	authService *auth.AuthService,
-) *http.Server {
+	socialService *auth.SocialService,
+	ldapService *auth.LdapService,
+	ipRuleService *auth.IpRuleService,
+	loginLogService *audit.LoginLogService,
+	operateLogService *audit.OperateLogService,
+	oauth2Service *oauth2.OAuth2Service,
+	oidcService *oidc.OidcService,
+	scimService *scim.ScimService,
+	operateLogUsecase *auditBiz.OperateLogUsecase,
+	apiKeyUsecase *authBiz.ApiKeyUsecase,
+	ipRuleUsecase *authBiz.IpRuleUsecase,
+	dataScopeUsecase *permBiz.DataScopeUsecase,
+	tenantUsecase *tenantBiz.TenantUsecase,
+) (*http.Server, error) {
+	clientIP, err := clientip.Server(c.GetServer().GetTrustedProxies())
+	if err != nil {
+		return nil, err
+	}
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			clientIP,
 			metadata.Server(),
 			pkglogger.SimpleTraceIdProvider(),
 			logging.Server(logger),
-			err.Server(),
-			authmiddleware.AdminHttpServer(authManager),
+			errmiddleware.Server(),
+			authmiddleware.AdminHttpServer(authManager, apiKeyUsecase, ipRuleUsecase, tenantUsecase),
+			auditmiddleware.OperateLog(operateLogUsecase),
+			authmiddleware.Permission(authManager),
+			authmiddleware.TenantGuard(tenantUsecase),
+			authmiddleware.DataScope(dataScopeUsecase),
 		),
 		http.Filter(handlers.CORS(
 			handlers.AllowedHeaders([]string{"Accept", "Accept-Language", "Content-Language", "Origin", "Content-Type", "Content-Length", "Accept-Encoding", "Authorization"}),
@@ -76,6 +110,20 @@ func NewHTTPServer(
 	permissionv1.RegisterRoleServiceHTTPServer(srv, roleService)
 	configv1.RegisterConfigServiceHTTPServer(srv, configService)
 	authv1.RegisterAuthServiceHTTPServer(srv, authService)
+	authv1.RegisterSocialServiceHTTPServer(srv, socialService)
+	authv1.RegisterLdapServiceHTTPServer(srv, ldapService)
+	authv1.RegisterIpRuleServiceHTTPServer(srv, ipRuleService)
+	authv1.RegisterScimServiceHTTPServer(srv, scimService)
+	auditv1.RegisterLoginLogServiceHTTPServer(srv, loginLogService)
+	auditv1.RegisterOperateLogServiceHTTPServer(srv, operateLogService)
+	oauth2v1.RegisterOAuth2ServiceHTTPServer(srv, oauth2Service)
+	srv.HandleFunc(oauth2.TokenPath, oauth2Service.Token)
+	srv.HandleFunc(oauth2.IntrospectPath, oauth2Service.Introspect)
+	srv.HandleFunc(oauth2.RevokePath, oauth2Service.Revoke)
+	srv.HandleFunc(oidc.DiscoveryPath, oidcService.Discovery)
+	srv.HandleFunc(oidc.JWKSPath, oidcService.JWKS)
+	srv.HandleFunc(oidc.UserInfoPath, oidcService.UserInfo)
+	srv.HandlePrefix(scim.Prefix+"/", scimService)
 
-	return srv
+	return srv, nil
 }
@@ -0,0 +1,51 @@
+package server
+
+import (
+	"context"
+	ldapBiz "quest-admin/internal/biz/ldap"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// LdapSyncServer 定时检查并执行到期的 LDAP 目录同步
+type LdapSyncServer struct {
+	ldapUc *ldapBiz.LdapUsecase
+	done   chan struct{}
+	once   sync.Once
+	log    *log.Helper
+}
+
+func NewLdapSyncServer(logger log.Logger, ldapUc *ldapBiz.LdapUsecase) *LdapSyncServer {
+	return &LdapSyncServer{
+		ldapUc: ldapUc,
+		done:   make(chan struct{}),
+		log:    log.NewHelper(log.With(logger, "module", "server/ldap")),
+	}
+}
+
+func (s *LdapSyncServer) Start(ctx context.Context) error {
+	interval := s.ldapUc.Options().SyncCheckInterval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	s.log.Infof("[LDAP] sync check interval: %s", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.done:
+			return nil
+		case <-ticker.C:
+			s.ldapUc.SyncDue(ctx)
+		}
+	}
+}
+
+func (s *LdapSyncServer) Stop(ctx context.Context) error {
+	s.once.Do(func() { close(s.done) })
+	return nil
+}
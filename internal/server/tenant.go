@@ -0,0 +1,53 @@
+package server
+
+import (
+	"context"
+	tenantBiz "quest-admin/internal/biz/tenant"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TenantExpiryServer 定时停用已过期的租户，并提醒即将到期的租户续期
+type TenantExpiryServer struct {
+	tenantUc *tenantBiz.TenantUsecase
+	done     chan struct{}
+	once     sync.Once
+	log      *log.Helper
+}
+
+func NewTenantExpiryServer(logger log.Logger, tenantUc *tenantBiz.TenantUsecase) *TenantExpiryServer {
+	return &TenantExpiryServer{
+		tenantUc: tenantUc,
+		done:     make(chan struct{}),
+		log:      log.NewHelper(log.With(logger, "module", "server/tenant")),
+	}
+}
+
+func (s *TenantExpiryServer) Start(ctx context.Context) error {
+	interval := s.tenantUc.Options().ExpiryCheckInterval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	s.log.Infof("[TENANT] expiry check interval: %s", interval)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	// 启动时先检查一次，避免重启后要等一个周期才停用过期租户
+	s.tenantUc.CheckExpiry(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.done:
+			return nil
+		case <-ticker.C:
+			s.tenantUc.CheckExpiry(ctx)
+		}
+	}
+}
+
+func (s *TenantExpiryServer) Stop(ctx context.Context) error {
+	s.once.Do(func() { close(s.done) })
+	return nil
+}
@@ -0,0 +1,78 @@
+package audit
+
+import (
+	"context"
+
+	v1 "quest-admin/api/gen/audit/v1"
+	biz "quest-admin/internal/biz/audit"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type LoginLogService struct {
+	v1.UnimplementedLoginLogServiceServer
+	uc  *biz.LoginLogUsecase
+	log *log.Helper
+}
+
+func NewLoginLogService(uc *biz.LoginLogUsecase, logger log.Logger) *LoginLogService {
+	return &LoginLogService{
+		uc:  uc,
+		log: log.NewHelper(log.With(logger, "module", "audit/service/login_log")),
+	}
+}
+
+func (s *LoginLogService) ListLoginLogs(ctx context.Context, in *v1.ListLoginLogsRequest) (*v1.ListLoginLogsReply, error) {
+	query := &biz.ListLoginLogsQuery{
+		Page:      in.GetPage(),
+		PageSize:  in.GetPageSize(),
+		Username:  in.GetUsername(),
+		UserID:    in.GetUserId(),
+		LoginIP:   in.GetLoginIp(),
+		Status:    in.Status,
+		SortField: in.GetSortField(),
+		SortOrder: in.GetSortOrder(),
+	}
+	if in.BeginTime != nil {
+		beginTime := in.BeginTime.AsTime()
+		query.BeginTime = &beginTime
+	}
+	if in.EndTime != nil {
+		endTime := in.EndTime.AsTime()
+		query.EndTime = &endTime
+	}
+
+	result, err := s.uc.ListLoginLogs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	loginLogs := make([]*v1.LoginLogInfo, 0, len(result.LoginLogs))
+	for _, loginLog := range result.LoginLogs {
+		loginLogs = append(loginLogs, s.toProtoLoginLog(loginLog))
+	}
+
+	return &v1.ListLoginLogsReply{
+		LoginLogs:  loginLogs,
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalPages: result.TotalPages,
+	}, nil
+}
+
+func (s *LoginLogService) toProtoLoginLog(loginLog *biz.LoginLog) *v1.LoginLogInfo {
+	return &v1.LoginLogInfo{
+		Id:        loginLog.ID,
+		Username:  loginLog.Username,
+		UserId:    loginLog.UserID,
+		TenantId:  loginLog.TenantID,
+		LoginIp:   loginLog.LoginIP,
+		UserAgent: loginLog.UserAgent,
+		Device:    loginLog.Device,
+		Status:    loginLog.Status,
+		Reason:    loginLog.Reason,
+		LoginAt:   timestamppb.New(loginLog.LoginAt),
+	}
+}
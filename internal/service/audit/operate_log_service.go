@@ -0,0 +1,94 @@
+package audit
+
+import (
+	"context"
+
+	v1 "quest-admin/api/gen/audit/v1"
+	biz "quest-admin/internal/biz/audit"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type OperateLogService struct {
+	v1.UnimplementedOperateLogServiceServer
+	uc  *biz.OperateLogUsecase
+	log *log.Helper
+}
+
+func NewOperateLogService(uc *biz.OperateLogUsecase, logger log.Logger) *OperateLogService {
+	return &OperateLogService{
+		uc:  uc,
+		log: log.NewHelper(log.With(logger, "module", "audit/service/operate_log")),
+	}
+}
+
+func (s *OperateLogService) GetOperateLog(ctx context.Context, in *v1.GetOperateLogRequest) (*v1.GetOperateLogReply, error) {
+	operateLog, err := s.uc.GetOperateLog(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetOperateLogReply{
+		OperateLog: s.toProtoOperateLog(operateLog),
+	}, nil
+}
+
+func (s *OperateLogService) ListOperateLogs(ctx context.Context, in *v1.ListOperateLogsRequest) (*v1.ListOperateLogsReply, error) {
+	query := &biz.ListOperateLogsQuery{
+		Page:      in.GetPage(),
+		PageSize:  in.GetPageSize(),
+		Operation: in.GetOperation(),
+		LoginID:   in.GetLoginId(),
+		TraceID:   in.GetTraceId(),
+		Success:   in.Success,
+		SortField: in.GetSortField(),
+		SortOrder: in.GetSortOrder(),
+	}
+	if in.BeginTime != nil {
+		beginTime := in.BeginTime.AsTime()
+		query.BeginTime = &beginTime
+	}
+	if in.EndTime != nil {
+		endTime := in.EndTime.AsTime()
+		query.EndTime = &endTime
+	}
+
+	result, err := s.uc.ListOperateLogs(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	operateLogs := make([]*v1.OperateLogInfo, 0, len(result.OperateLogs))
+	for _, operateLog := range result.OperateLogs {
+		operateLogs = append(operateLogs, s.toProtoOperateLog(operateLog))
+	}
+
+	return &v1.ListOperateLogsReply{
+		OperateLogs: operateLogs,
+		Total:       result.Total,
+		Page:        result.Page,
+		PageSize:    result.PageSize,
+		TotalPages:  result.TotalPages,
+	}, nil
+}
+
+func (s *OperateLogService) toProtoOperateLog(operateLog *biz.OperateLog) *v1.OperateLogInfo {
+	return &v1.OperateLogInfo{
+		Id:             operateLog.ID,
+		Operation:      operateLog.Operation,
+		Method:         operateLog.Method,
+		Path:           operateLog.Path,
+		LoginId:        operateLog.LoginID,
+		TenantId:       operateLog.TenantID,
+		TraceId:        operateLog.TraceID,
+		Request:        operateLog.Request,
+		Code:           operateLog.Code,
+		Reason:         operateLog.Reason,
+		LatencyMs:      operateLog.LatencyMs,
+		ClientIp:       operateLog.ClientIP,
+		UserAgent:      operateLog.UserAgent,
+		OperateAt:      timestamppb.New(operateLog.OperateAt),
+		SwitchTenantId: operateLog.SwitchTenantID,
+	}
+}
@@ -2,9 +2,11 @@ package auth
 
 import (
 	"context"
-	"fmt"
 	v1 "quest-admin/api/gen/auth/v1"
+	auditBiz "quest-admin/internal/biz/audit"
 	authBiz "quest-admin/internal/biz/auth"
+	ldapBiz "quest-admin/internal/biz/ldap"
+	oidcBiz "quest-admin/internal/biz/oidc"
 	permBiz "quest-admin/internal/biz/permission"
 	userBiz "quest-admin/internal/biz/user"
 	"quest-admin/pkg/errorx"
@@ -12,8 +14,11 @@ import (
 	"quest-admin/pkg/lang/slices"
 	"quest-admin/pkg/util/ctxs"
 	"quest-admin/types/errkey"
+	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -24,6 +29,13 @@ type AuthService struct {
 	userUsecase *userBiz.UserUsecase
 	roleUsecase *permBiz.RoleUsecase
 	menuUsecase *permBiz.MenuUsecase
+	loginLogUc  *auditBiz.LoginLogUsecase
+	apiKeyUc    *authBiz.ApiKeyUsecase
+	passkeyUc   *authBiz.PasskeyUsecase
+	loginCodeUc *authBiz.LoginCodeUsecase
+	ipRuleUc    *authBiz.IpRuleUsecase
+	oidcUc      *oidcBiz.OidcUsecase
+	ldapUc      *ldapBiz.LdapUsecase
 	log         *log.Helper
 }
 
@@ -34,6 +46,13 @@ func NewAuthService(
 	userUsecase *userBiz.UserUsecase,
 	roleUsecase *permBiz.RoleUsecase,
 	menuUsecase *permBiz.MenuUsecase,
+	loginLogUc *auditBiz.LoginLogUsecase,
+	apiKeyUc *authBiz.ApiKeyUsecase,
+	passkeyUc *authBiz.PasskeyUsecase,
+	loginCodeUc *authBiz.LoginCodeUsecase,
+	ipRuleUc *authBiz.IpRuleUsecase,
+	oidcUc *oidcBiz.OidcUsecase,
+	ldapUc *ldapBiz.LdapUsecase,
 ) *AuthService {
 	return &AuthService{
 		log:         log.NewHelper(log.With(logger, "module", "auth/service")),
@@ -41,17 +60,233 @@ func NewAuthService(
 		roleUsecase: roleUsecase,
 		userUsecase: userUsecase,
 		menuUsecase: menuUsecase,
+		loginLogUc:  loginLogUc,
+		apiKeyUc:    apiKeyUc,
+		passkeyUc:   passkeyUc,
+		loginCodeUc: loginCodeUc,
+		ipRuleUc:    ipRuleUc,
+		oidcUc:      oidcUc,
+		ldapUc:      ldapUc,
 	}
 }
 
 // Login 用户登录
 func (s *AuthService) Login(ctx context.Context, request *v1.LoginRequest) (*v1.LoginReply, error) {
-	token, err := s.LoginByUsernameAndPassword(ctx, request)
+	token, ticket, err := s.LoginByUsernameAndPassword(ctx, request)
 	if err != nil {
 		return nil, err
 	}
+	if ticket != nil {
+		return &v1.LoginReply{
+			MfaRequired:  true,
+			MfaTicket:    ticket.ID,
+			MfaExpiresIn: ticket.ExpiresIn,
+			MfaMethods:   ticket.Methods,
+		}, nil
+	}
 	return &v1.LoginReply{
-		Token: token,
+		Token:                  token.AccessToken,
+		RefreshToken:           token.RefreshToken,
+		ExpiresIn:              token.ExpiresIn,
+		RefreshExpiresIn:       token.RefreshExpiresIn,
+		PasswordChangeRequired: token.PasswordChangeRequired,
+		IdToken:                token.IDToken,
+	}, nil
+}
+
+// VerifyMfa MFA 二次验证，通过后签发令牌
+func (s *AuthService) VerifyMfa(ctx context.Context, in *v1.VerifyMfaRequest) (reply *v1.LoginReply, err error) {
+	ticket, err := s.authUsecase.GetMfaTicket(ctx, in.GetMfaTicket())
+	if err != nil {
+		return nil, err
+	}
+	clientIP := ctxs.GetClientIP(ctx)
+	defer func() {
+		s.recordLoginLog(ctx, ticket.Username, ticket.Device, ticket.UserID, err)
+	}()
+
+	if err = s.authUsecase.CheckLoginLock(ctx, ticket.Username, clientIP); err != nil {
+		return nil, err
+	}
+	if err = s.authUsecase.VerifyMfaCode(ctx, ticket, in.GetOtpCode()); err != nil {
+		if errors.Reason(err) == string(errkey.ErrMfaCodeInvalid) {
+			return nil, s.loginFailed(ctx, ticket.Username, clientIP, err)
+		}
+		return nil, err
+	}
+	return s.completeLogin(ctx, ticket.UserID, ticket.Username, ticket.Device)
+}
+
+// completeLogin 二次验证或通行密钥校验通过后清除失败次数并签发令牌，期间用户可能已被禁用或删除
+func (s *AuthService) completeLogin(ctx context.Context, userID, username, device string) (*v1.LoginReply, error) {
+	if err := s.authUsecase.ClearLoginFailure(ctx, username); err != nil {
+		s.log.WithContext(ctx).Errorf("清除登录失败次数失败,username:%s,error:%v", username, err)
+	}
+	user, err := s.userUsecase.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errorx.Err(errkey.ErrUserNotFound)
+	}
+	ok, err := s.userUsecase.VerifyStatus(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errorx.Err(errkey.ErrUserDisabled)
+	}
+	if err = s.checkLoginIP(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	token, err := s.issueToken(ctx, user, device)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.LoginReply{
+		Token:                  token.AccessToken,
+		RefreshToken:           token.RefreshToken,
+		ExpiresIn:              token.ExpiresIn,
+		RefreshExpiresIn:       token.RefreshExpiresIn,
+		PasswordChangeRequired: token.PasswordChangeRequired,
+		IdToken:                token.IDToken,
+	}, nil
+}
+
+// SendLoginCode 发送登录验证码
+func (s *AuthService) SendLoginCode(ctx context.Context, in *v1.SendLoginCodeRequest) (*v1.SendLoginCodeReply, error) {
+	sent, err := s.loginCodeUc.SendLoginCode(ctx, in.GetChannel(), in.GetTarget())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SendLoginCodeReply{
+		ExpiresIn:  sent.ExpiresIn,
+		RetryAfter: sent.RetryAfter,
+	}, nil
+}
+
+// LoginByCode 验证码登录，验证码错误由验证码自身的失败次数限制，不计入账号的登录失败次数
+func (s *AuthService) LoginByCode(ctx context.Context, in *v1.LoginByCodeRequest) (reply *v1.LoginReply, err error) {
+	var (
+		username = in.GetTarget()
+		userID   string
+		device   = in.GetDevice()
+		mfa      bool
+	)
+	defer func() {
+		// 等待二次验证时由 VerifyMfa 记录登录结果
+		if !mfa {
+			s.recordLoginLog(ctx, username, device, userID, err)
+		}
+	}()
+
+	user, err := s.loginCodeUc.VerifyLoginCode(ctx, in.GetChannel(), in.GetTarget(), in.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	username, userID = user.Username, user.ID
+	if err = s.authUsecase.CheckLoginLock(ctx, user.Username, ctxs.GetClientIP(ctx)); err != nil {
+		return nil, err
+	}
+	if err = s.checkLoginIP(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	status, err := s.mfaStatus(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if status.Enabled() {
+		ticket, err := s.authUsecase.CreateMfaTicket(ctx, user.ID, user.Username, device)
+		if err != nil {
+			return nil, err
+		}
+		mfa = true
+		return &v1.LoginReply{
+			MfaRequired:  true,
+			MfaTicket:    ticket.ID,
+			MfaExpiresIn: ticket.ExpiresIn,
+			MfaMethods:   status.Methods(),
+		}, nil
+	}
+	return s.completeLogin(ctx, user.ID, user.Username, device)
+}
+
+// RefreshToken 刷新令牌
+func (s *AuthService) RefreshToken(ctx context.Context, in *v1.RefreshTokenRequest) (*v1.RefreshTokenReply, error) {
+	token, err := s.authUsecase.RefreshToken(ctx, in.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+
+	// 刷新请求不携带登录态，后续操作均在会话所属的租户下进行
+	ctx = ctxs.WithTenantID(ctx, token.TenantID)
+	// 刷新期间用户可能已被禁用或删除，需重新校验并刷新会话中的角色和权限
+	user, err := s.userUsecase.GetUser(ctx, token.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		_ = s.authUsecase.KickoutUser(ctx, token.UserID)
+		return nil, errorx.Err(errkey.ErrUserNotFound)
+	}
+	ok, err := s.userUsecase.VerifyStatus(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		_ = s.authUsecase.KickoutUser(ctx, token.UserID)
+		return nil, errorx.Err(errkey.ErrUserDisabled)
+	}
+	if err = s.authUsecase.GrantUserAccess(ctx, user); err != nil {
+		return nil, err
+	}
+	token.PasswordChangeRequired = s.userUsecase.PasswordChangeRequired(user)
+	token.IDToken, err = s.oidcUc.IssueIDToken(ctx, user, &oidcBiz.IDTokenBO{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.RefreshTokenReply{
+		Token:                  token.AccessToken,
+		RefreshToken:           token.RefreshToken,
+		ExpiresIn:              token.ExpiresIn,
+		RefreshExpiresIn:       token.RefreshExpiresIn,
+		PasswordChangeRequired: token.PasswordChangeRequired,
+		IdToken:                token.IDToken,
+	}, nil
+}
+
+// RequestPasswordReset 申请找回密码，无论账号是否存在均返回成功
+func (s *AuthService) RequestPasswordReset(ctx context.Context, in *v1.RequestPasswordResetRequest) (*emptypb.Empty, error) {
+	if err := s.authUsecase.RequestPasswordReset(ctx, in.GetAccount()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ResetPasswordWithToken 使用找回密码令牌重置密码
+func (s *AuthService) ResetPasswordWithToken(ctx context.Context, in *v1.ResetPasswordWithTokenRequest) (*emptypb.Empty, error) {
+	if in.GetToken() == "" {
+		return nil, errorx.Err(errkey.ErrBadRequest, "token")
+	}
+	if err := s.authUsecase.ResetPasswordWithToken(ctx, in.GetToken(), in.GetNewPassword()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetCaptcha 获取图形验证码
+func (s *AuthService) GetCaptcha(ctx context.Context, in *v1.GetCaptchaRequest) (*v1.GetCaptchaReply, error) {
+	bo, err := s.authUsecase.GenerateCaptcha(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetCaptchaReply{
+		CaptchaId: bo.ID,
+		Image:     bo.Image,
+		ExpiresIn: bo.ExpiresIn,
 	}, nil
 }
 
@@ -73,6 +308,11 @@ func (s *AuthService) GetPermissionInfo(ctx context.Context, in *v1.GetPermissio
 		return nil, err
 	}
 	slices.Uniq(roleIDs)
+	roles, err := s.roleUsecase.ListByRoleIDs(ctx, roleIDs)
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("获取角色信息失败,roleID:%s,error:%v", roleIDs, err)
+		return nil, err
+	}
 	menuIDs, err := s.roleUsecase.GetMenusByRoleIDs(ctx, roleIDs)
 	if err != nil {
 		s.log.WithContext(ctx).Errorf("获取角色菜单失败,roleID:%s,error:%v", roleIDs, err)
@@ -95,7 +335,7 @@ func (s *AuthService) GetPermissionInfo(ctx context.Context, in *v1.GetPermissio
 
 	return &v1.GetPermissionInfoReply{
 		User:        s.toProtoUser(user),
-		Roles:       roleIDs,
+		Roles:       s.toRoleCodes(roles),
 		Permissions: permissions,
 		Menus: slices.Map(menuTree, func(item *permBiz.Menu, index int) *v1.MenuInfo {
 			return s.toProtoMenu(item)
@@ -103,59 +343,553 @@ func (s *AuthService) GetPermissionInfo(ctx context.Context, in *v1.GetPermissio
 	}, nil
 }
 
-func (s *AuthService) LoginByUsernameAndPassword(ctx context.Context, request *v1.LoginRequest) (token string, err error) {
-	user, err := s.userUsecase.GetUserByUsername(ctx, ptr.From(request.Username))
+// Logout 退出登录
+func (s *AuthService) Logout(ctx context.Context, in *v1.LogoutRequest) (*emptypb.Empty, error) {
+	err := s.authUsecase.Logout(ctx, ctxs.GetToken(ctx))
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// KickoutUser 踢出用户
+func (s *AuthService) KickoutUser(ctx context.Context, in *v1.KickoutUserRequest) (*emptypb.Empty, error) {
+	if in.GetUserId() == "" {
+		return nil, errorx.Err(errkey.ErrBadRequest, "user_id")
+	}
+	// 只能踢出当前租户下的用户
+	user, err := s.userUsecase.GetUser(ctx, in.GetUserId())
+	if err != nil {
+		return nil, err
 	}
 	if user == nil {
-		//return "", errorx.Err(errkey.ErrUserNotFound)
-		return "", fmt.Errorf("Asgsdfas")
+		return nil, errorx.Err(errkey.ErrUserNotFound)
 	}
-	ok, err := s.userUsecase.VerifyStatus(ctx, user)
+	err = s.authUsecase.KickoutUser(ctx, user.ID)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	if !ok {
-		return "", errorx.Err(errkey.ErrUserDisabled)
+	return &emptypb.Empty{}, nil
+}
+
+// KickoutSession 踢出会话
+func (s *AuthService) KickoutSession(ctx context.Context, in *v1.KickoutSessionRequest) (*emptypb.Empty, error) {
+	if in.GetSessionId() == "" {
+		return nil, errorx.Err(errkey.ErrBadRequest, "session_id")
 	}
+	err := s.authUsecase.KickoutSession(ctx, in.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
 
-	_, err = s.userUsecase.VerifyPassword(ctx, user.Password, ptr.From(request.Password))
+// ListOnlineSessions 获取在线会话列表
+func (s *AuthService) ListOnlineSessions(ctx context.Context, in *v1.ListOnlineSessionsRequest) (*v1.ListOnlineSessionsReply, error) {
+	sessions, err := s.authUsecase.ListOnlineSessions(ctx, in.GetUserId())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
+	usernames := make(map[string]string)
+	items := make([]*v1.SessionInfo, 0, len(sessions))
+	for _, item := range sessions {
+		username, ok := usernames[item.UserID]
+		if !ok {
+			user, err := s.userUsecase.GetUser(ctx, item.UserID)
+			if err == nil && user != nil {
+				username = user.Username
+			}
+			usernames[item.UserID] = username
+		}
+		items = append(items, &v1.SessionInfo{
+			SessionId: item.ID,
+			UserId:    item.UserID,
+			Username:  username,
+			Device:    item.Device,
+			LoginAt:   timestamppb.New(item.LoginAt),
+			ActiveAt:  timestamppb.New(item.ActiveAt),
+		})
+	}
+	return &v1.ListOnlineSessionsReply{
+		Sessions: items,
+		Total:    int64(len(items)),
+	}, nil
+}
+
+// LoginByUsernameAndPassword 校验用户名和密码，已启用 MFA 的用户返回待二次验证的票据而不签发令牌
+func (s *AuthService) LoginByUsernameAndPassword(ctx context.Context, request *v1.LoginRequest) (token *authBiz.TokenBO, ticket *authBiz.MfaTicket, err error) {
+	var (
+		userID   string
+		username = ptr.From(request.Username)
+		device   = ptr.From(request.Device)
+		clientIP = ctxs.GetClientIP(ctx)
+	)
+	defer func() {
+		// 等待二次验证时由 VerifyMfa 记录登录结果
+		if ticket == nil {
+			s.recordLoginLog(ctx, username, device, userID, err)
+		}
+	}()
 
-	//获取用户关联角色
-	roles, err := s.userUsecase.GetUserRoles(ctx, user.ID)
+	if err = s.authUsecase.CheckLoginLock(ctx, username, clientIP); err != nil {
+		return nil, nil, err
+	}
+	err = s.authUsecase.VerifyLoginCaptcha(ctx, username, clientIP, request.GetCaptchaId(), request.GetCaptchaCode())
 	if err != nil {
-		return "", err
+		return nil, nil, err
 	}
 
-	//获取角色管理的菜单
-	menuIDs, err := s.roleUsecase.GetMenusByRoleIDs(ctx, roles)
+	// 用户不存在和密码错误返回相同的错误，密码正确后才提示账号状态，避免探测用户名
+	user, err := s.userUsecase.GetUserByUsername(ctx, username)
 	if err != nil {
-		return "", err
+		return nil, nil, err
 	}
-	menus, err := s.menuUsecase.ListByMenuIDs(ctx, menuIDs)
+	if user == nil {
+		return nil, nil, s.loginFailed(ctx, username, clientIP, errorx.Err(errkey.ErrBadCredentials))
+	}
+	userID = user.ID
+	err = s.verifyPassword(ctx, user, ptr.From(request.Password))
+	if err != nil {
+		if errors.Reason(err) == string(errkey.ErrPasswordNotMatch) {
+			return nil, nil, s.loginFailed(ctx, username, clientIP, errorx.Err(errkey.ErrBadCredentials))
+		}
+		return nil, nil, err
+	}
+	ok, err := s.userUsecase.VerifyStatus(ctx, user)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ok {
+		return nil, nil, errorx.Err(errkey.ErrUserDisabled)
+	}
+
+	if err = s.checkLoginIP(ctx, user.ID); err != nil {
+		return nil, nil, err
+	}
+
+	// 启用 MFA 时失败计数保留到二次验证通过后再清除，验证码错误同样计入失败次数
+	mfa, err := s.mfaStatus(ctx, user.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if mfa.Enabled() {
+		ticket, err = s.authUsecase.CreateMfaTicket(ctx, user.ID, user.Username, device)
+		if err != nil {
+			return nil, nil, err
+		}
+		ticket.Methods = mfa.Methods()
+		return nil, ticket, nil
+	}
+
+	if err = s.authUsecase.ClearLoginFailure(ctx, username); err != nil {
+		s.log.WithContext(ctx).Errorf("清除登录失败次数失败,username:%s,error:%v", username, err)
+	}
+	token, err = s.issueToken(ctx, user, device)
 	if err != nil {
-		return "", err
+		return nil, nil, err
 	}
-	menus = s.menuUsecase.ProcessDisabledMenus(menus)
-	permissions := slices.Map(menus, func(item *permBiz.Menu, index int) string { return item.Permission })
+	return token, nil, nil
+}
+
+// verifyPassword 租户开启 LDAP 认证时目录用户校验 LDAP 密码，其余用户校验本地密码
+func (s *AuthService) verifyPassword(ctx context.Context, user *userBiz.User, password string) error {
+	handled, err := s.ldapUc.Authenticate(ctx, user, password)
+	if handled || err != nil {
+		return err
+	}
+	_, err = s.userUsecase.VerifyPassword(ctx, user.Password, password)
+	return err
+}
+
+// checkLoginIP 登录时校验来源 IP 是否在白名单内，拒绝原因由调用方写入登录日志
+func (s *AuthService) checkLoginIP(ctx context.Context, userID string) error {
+	return s.ipRuleUc.CheckAccess(ctx, userID, ctxs.GetClientIP(ctx))
+}
 
-	token, err = s.authUsecase.AdminGenerateToken(ctx, &authBiz.GenerateTokenBO{UserID: user.ID, Device: ptr.From(request.Device)})
+// issueToken 签发令牌并写入会话的角色和权限
+func (s *AuthService) issueToken(ctx context.Context, user *userBiz.User, device string) (*authBiz.TokenBO, error) {
+	token, err := s.authUsecase.AdminGenerateToken(ctx, &authBiz.GenerateTokenBO{UserID: user.ID, Device: device})
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	err = s.authUsecase.SetRolesAndPermission(ctx, user.ID, roles, permissions)
+	err = s.authUsecase.GrantUserAccess(ctx, user)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	token.PasswordChangeRequired = s.userUsecase.PasswordChangeRequired(user)
+	token.IDToken, err = s.oidcUc.IssueIDToken(ctx, user, &oidcBiz.IDTokenBO{})
+	if err != nil {
+		return nil, err
 	}
 	s.log.WithContext(ctx).Infof("登录成功,userID:%s", user.ID)
-	//todo 记录登录日志
 	return token, nil
 }
 
+// loginFailed 记录一次登录失败，达到阈值时改为返回锁定错误
+func (s *AuthService) loginFailed(ctx context.Context, username, clientIP string, cause error) error {
+	err := s.authUsecase.RecordLoginFailure(ctx, username, clientIP)
+	if errors.Reason(err) == string(errkey.ErrLoginLocked) {
+		return err
+	}
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("记录登录失败次数失败,username:%s,error:%v", username, err)
+	}
+	return cause
+}
+
+// UnlockUser 解除登录锁定
+func (s *AuthService) UnlockUser(ctx context.Context, in *v1.UnlockUserRequest) (*emptypb.Empty, error) {
+	user, err := s.userUsecase.GetUser(ctx, in.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errorx.Err(errkey.ErrUserNotFound)
+	}
+	err = s.authUsecase.UnlockLogin(ctx, user.Username, in.GetIp())
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetMfaStatus 获取当前用户的MFA状态
+func (s *AuthService) GetMfaStatus(ctx context.Context, in *v1.GetMfaStatusRequest) (*v1.GetMfaStatusReply, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status, err := s.mfaStatus(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetMfaStatusReply{
+		TotpEnabled:            status.TotpEnabled,
+		RecoveryCodesRemaining: status.RecoveryCodesRemaining,
+		PasskeyCount:           status.PasskeyCount,
+	}, nil
+}
+
+// mfaStatus 汇总 TOTP 和通行密钥，任一启用即需要二次验证
+func (s *AuthService) mfaStatus(ctx context.Context, userID string) (*authBiz.MfaStatus, error) {
+	status, err := s.authUsecase.GetMfaStatus(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	status.PasskeyCount, err = s.passkeyUc.CountPasskeys(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// BeginTotpEnroll 开始绑定TOTP
+func (s *AuthService) BeginTotpEnroll(ctx context.Context, in *v1.BeginTotpEnrollRequest) (*v1.BeginTotpEnrollReply, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	enroll, err := s.authUsecase.BeginTotpEnroll(ctx, user.ID, user.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.BeginTotpEnrollReply{
+		Secret:     enroll.Secret,
+		OtpauthUri: enroll.URI,
+		QrCode:     enroll.QRCode,
+	}, nil
+}
+
+// ConfirmTotpEnroll 确认绑定TOTP
+func (s *AuthService) ConfirmTotpEnroll(ctx context.Context, in *v1.ConfirmTotpEnrollRequest) (*v1.ConfirmTotpEnrollReply, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	codes, err := s.authUsecase.ConfirmTotpEnroll(ctx, user.ID, in.GetOtpCode())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ConfirmTotpEnrollReply{RecoveryCodes: codes}, nil
+}
+
+// DisableTotp 关闭TOTP
+func (s *AuthService) DisableTotp(ctx context.Context, in *v1.DisableTotpRequest) (*emptypb.Empty, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = s.authUsecase.DisableTotp(ctx, user.ID, in.GetOtpCode())
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CreateApiKey 为当前登录用户创建 API Key
+func (s *AuthService) CreateApiKey(ctx context.Context, in *v1.CreateApiKeyRequest) (*v1.CreateApiKeyReply, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bo := &authBiz.CreateApiKeyBO{
+		UserID:      user.ID,
+		Name:        in.GetName(),
+		Permissions: in.GetPermissions(),
+	}
+	if in.GetExpireAt() != nil {
+		bo.ExpireAt = in.GetExpireAt().AsTime()
+	}
+	key, secret, err := s.apiKeyUc.CreateApiKey(ctx, bo)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateApiKeyReply{
+		Key:    s.toProtoApiKey(key),
+		Secret: secret,
+	}, nil
+}
+
+// ListApiKeys 获取当前登录用户的 API Key 列表
+func (s *AuthService) ListApiKeys(ctx context.Context, in *v1.ListApiKeysRequest) (*v1.ListApiKeysReply, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := s.apiKeyUc.ListApiKeys(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ListApiKeysReply{
+		Keys: slices.Map(keys, func(item *authBiz.ApiKey, index int) *v1.ApiKeyInfo {
+			return s.toProtoApiKey(item)
+		}),
+		Total: int64(len(keys)),
+	}, nil
+}
+
+// RevokeApiKey 吊销当前登录用户的 API Key
+func (s *AuthService) RevokeApiKey(ctx context.Context, in *v1.RevokeApiKeyRequest) (*emptypb.Empty, error) {
+	if in.GetId() == "" {
+		return nil, errorx.Err(errkey.ErrBadRequest, "id")
+	}
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.apiKeyUc.RevokeApiKey(ctx, user.ID, in.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// BeginPasskeyRegistration 为当前登录用户开始注册通行密钥
+func (s *AuthService) BeginPasskeyRegistration(ctx context.Context, in *v1.BeginPasskeyRegistrationRequest) (*v1.PasskeyCeremonyReply, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ceremony, err := s.passkeyUc.BeginRegistration(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	return s.toProtoCeremony(ceremony), nil
+}
+
+// FinishPasskeyRegistration 校验注册凭证并绑定到当前登录用户
+func (s *AuthService) FinishPasskeyRegistration(ctx context.Context, in *v1.FinishPasskeyRegistrationRequest) (*v1.PasskeyInfo, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	passkey, err := s.passkeyUc.FinishRegistration(ctx, user, in.GetSessionId(), in.GetName(), in.GetCredential())
+	if err != nil {
+		return nil, err
+	}
+	return s.toProtoPasskey(passkey), nil
+}
+
+// BeginPasskeyLogin 开始通行密钥登录，带 MFA 票据时作为二次验证
+func (s *AuthService) BeginPasskeyLogin(ctx context.Context, in *v1.BeginPasskeyLoginRequest) (*v1.PasskeyCeremonyReply, error) {
+	var ticket *authBiz.MfaTicket
+	if in.MfaTicket != nil {
+		var err error
+		if ticket, err = s.authUsecase.GetMfaTicket(ctx, in.GetMfaTicket()); err != nil {
+			return nil, err
+		}
+	}
+	ceremony, err := s.passkeyUc.BeginLogin(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+	return s.toProtoCeremony(ceremony), nil
+}
+
+// FinishPasskeyLogin 校验通行密钥断言，通过后签发令牌；免密登录的失败无法归属到用户，不计入失败次数
+func (s *AuthService) FinishPasskeyLogin(ctx context.Context, in *v1.FinishPasskeyLoginRequest) (reply *v1.LoginReply, err error) {
+	session, err := s.passkeyUc.TakeLoginSession(ctx, in.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+	if session.MfaTicket != "" {
+		return s.finishPasskeyMfa(ctx, session, in.GetCredential())
+	}
+
+	userID, err := s.passkeyUc.VerifyLogin(ctx, session, in.GetCredential())
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.userUsecase.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errorx.Err(errkey.ErrUserNotFound)
+	}
+	device := in.GetDevice()
+	defer func() {
+		s.recordLoginLog(ctx, user.Username, device, user.ID, err)
+	}()
+	if err = s.authUsecase.CheckLoginLock(ctx, user.Username, ctxs.GetClientIP(ctx)); err != nil {
+		return nil, err
+	}
+	return s.completeLogin(ctx, user.ID, user.Username, device)
+}
+
+// finishPasskeyMfa 以通行密钥完成二次验证，校验失败与验证码错误一样计入登录失败次数
+func (s *AuthService) finishPasskeyMfa(ctx context.Context, session *authBiz.PasskeySession, credential string) (reply *v1.LoginReply, err error) {
+	ticket, err := s.authUsecase.GetMfaTicket(ctx, session.MfaTicket)
+	if err != nil {
+		return nil, err
+	}
+	clientIP := ctxs.GetClientIP(ctx)
+	defer func() {
+		s.recordLoginLog(ctx, ticket.Username, ticket.Device, ticket.UserID, err)
+	}()
+
+	if err = s.authUsecase.CheckLoginLock(ctx, ticket.Username, clientIP); err != nil {
+		return nil, err
+	}
+	if err = s.passkeyUc.VerifyMfaPasskey(ctx, ticket, session, credential); err != nil {
+		if errors.Reason(err) == string(errkey.ErrPasskeyInvalid) {
+			return nil, s.loginFailed(ctx, ticket.Username, clientIP, err)
+		}
+		return nil, err
+	}
+	return s.completeLogin(ctx, ticket.UserID, ticket.Username, ticket.Device)
+}
+
+// ListPasskeys 获取当前登录用户的通行密钥列表
+func (s *AuthService) ListPasskeys(ctx context.Context, in *v1.ListPasskeysRequest) (*v1.ListPasskeysReply, error) {
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	passkeys, err := s.passkeyUc.ListPasskeys(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ListPasskeysReply{
+		Passkeys: slices.Map(passkeys, func(item *authBiz.Passkey, index int) *v1.PasskeyInfo {
+			return s.toProtoPasskey(item)
+		}),
+		Total: int64(len(passkeys)),
+	}, nil
+}
+
+// RenamePasskey 重命名当前登录用户的通行密钥
+func (s *AuthService) RenamePasskey(ctx context.Context, in *v1.RenamePasskeyRequest) (*emptypb.Empty, error) {
+	if in.GetId() == "" {
+		return nil, errorx.Err(errkey.ErrBadRequest, "id")
+	}
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.passkeyUc.RenamePasskey(ctx, user.ID, in.GetId(), in.GetName()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// DeletePasskey 删除当前登录用户的通行密钥
+func (s *AuthService) DeletePasskey(ctx context.Context, in *v1.DeletePasskeyRequest) (*emptypb.Empty, error) {
+	if in.GetId() == "" {
+		return nil, errorx.Err(errkey.ErrBadRequest, "id")
+	}
+	user, err := s.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.passkeyUc.DeletePasskey(ctx, user.ID, in.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// currentUser 获取当前登录用户，未登录时返回未授权错误，账号安全相关操作不允许使用 API Key
+func (s *AuthService) currentUser(ctx context.Context) (*userBiz.User, error) {
+	if ctxs.IsScoped(ctx) {
+		return nil, errorx.Err(errkey.ErrApiKeyNotAllowed)
+	}
+	if ctxs.GetToken(ctx) == "" {
+		return nil, errorx.Err(errkey.ErrUnauthorized)
+	}
+	user, err := s.userUsecase.GetUser(ctx, ctxs.GetLoginID(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errorx.Err(errkey.ErrUnauthorized)
+	}
+	return user, nil
+}
+
+// recordLoginLog 记录登录日志，成功时同时更新用户的最后登录信息；记录失败不影响登录结果
+func (s *AuthService) recordLoginLog(ctx context.Context, username, device, userID string, loginErr error) {
+	loginLog := &auditBiz.LoginLog{
+		Username:  username,
+		UserID:    userID,
+		TenantID:  ctxs.GetTenantID(ctx),
+		LoginIP:   ctxs.GetClientIP(ctx),
+		UserAgent: ctxs.GetUserAgent(ctx),
+		Device:    device,
+		Status:    1,
+		LoginAt:   time.Now(),
+	}
+	if loginErr != nil {
+		loginLog.Status = 0
+		if e := errors.FromError(loginErr); e.Reason != "" {
+			loginLog.Reason = e.Reason
+		} else {
+			loginLog.Reason = e.Message
+		}
+	}
+	_ = s.loginLogUc.RecordLoginLog(ctx, loginLog)
+
+	if loginErr == nil {
+		err := s.userUsecase.UpdateLoginInfo(ctx, &userBiz.UpdateLoginInfoBO{
+			UserID:    userID,
+			LoginIP:   loginLog.LoginIP,
+			LoginDate: loginLog.LoginAt,
+		})
+		if err != nil {
+			s.log.WithContext(ctx).Errorf("更新用户登录信息失败,userID:%s,error:%v", userID, err)
+		}
+	}
+}
+
+// toRoleCodes 角色编码用于接口的角色校验，停用的角色不生效
+func (s *AuthService) toRoleCodes(roles []*permBiz.Role) []string {
+	codes := make([]string, 0, len(roles))
+	for _, role := range roles {
+		if role.Status == 1 {
+			codes = append(codes, role.Code)
+		}
+	}
+	return codes
+}
+
 func (s *AuthService) toProtoUser(user *userBiz.User) *v1.UserInfo {
 	return &v1.UserInfo{
 		Id:       user.ID,
@@ -171,6 +905,43 @@ func (s *AuthService) toProtoUser(user *userBiz.User) *v1.UserInfo {
 	}
 }
 
+func (s *AuthService) toProtoApiKey(key *authBiz.ApiKey) *v1.ApiKeyInfo {
+	info := &v1.ApiKeyInfo{
+		Id:          key.ID,
+		Name:        key.Name,
+		Prefix:      key.Prefix,
+		Permissions: key.Permissions,
+		ExpireAt:    timestamppb.New(key.ExpireAt),
+		CreateAt:    timestamppb.New(key.CreateAt),
+	}
+	if !key.LastUsedAt.IsZero() {
+		info.LastUsedAt = timestamppb.New(key.LastUsedAt)
+	}
+	return info
+}
+
+func (s *AuthService) toProtoPasskey(passkey *authBiz.Passkey) *v1.PasskeyInfo {
+	info := &v1.PasskeyInfo{
+		Id:         passkey.ID,
+		Name:       passkey.Name,
+		Transports: passkey.Transports,
+		BackedUp:   passkey.BackupState,
+		CreateAt:   timestamppb.New(passkey.CreateAt),
+	}
+	if !passkey.LastUsedAt.IsZero() {
+		info.LastUsedAt = timestamppb.New(passkey.LastUsedAt)
+	}
+	return info
+}
+
+func (s *AuthService) toProtoCeremony(ceremony *authBiz.PasskeyCeremony) *v1.PasskeyCeremonyReply {
+	return &v1.PasskeyCeremonyReply{
+		SessionId: ceremony.SessionID,
+		Options:   ceremony.Options,
+		ExpiresIn: ceremony.ExpiresIn,
+	}
+}
+
 func (s *AuthService) toProtoMenu(menu *permBiz.Menu) *v1.MenuInfo {
 	return &v1.MenuInfo{
 		Id:            menu.ID,
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"context"
+	v1 "quest-admin/api/gen/auth/v1"
+	authBiz "quest-admin/internal/biz/auth"
+	"quest-admin/pkg/lang/slices"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// IpRuleService 管理端访问 IP 白名单服务
+type IpRuleService struct {
+	v1.UnimplementedIpRuleServiceServer
+	ipRuleUc *authBiz.IpRuleUsecase
+	log      *log.Helper
+}
+
+func NewIpRuleService(logger log.Logger, ipRuleUc *authBiz.IpRuleUsecase) *IpRuleService {
+	return &IpRuleService{
+		ipRuleUc: ipRuleUc,
+		log:      log.NewHelper(log.With(logger, "module", "auth/service/ip_rule")),
+	}
+}
+
+func (s *IpRuleService) CreateIpRule(ctx context.Context, in *v1.CreateIpRuleRequest) (*v1.IpRuleInfo, error) {
+	rule := &authBiz.IpRule{
+		Scope:    in.GetScope(),
+		TargetID: in.GetTargetId(),
+		Cidr:     in.GetCidr(),
+		Remark:   in.GetRemark(),
+		Status:   1,
+	}
+	if in.Status != nil {
+		rule.Status = in.GetStatus()
+	}
+	rule, err := s.ipRuleUc.CreateIpRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	return s.toProtoIpRule(rule), nil
+}
+
+func (s *IpRuleService) ListIpRules(ctx context.Context, in *v1.ListIpRulesRequest) (*v1.ListIpRulesReply, error) {
+	rules, err := s.ipRuleUc.ListIpRules(ctx, &authBiz.ListIpRulesQuery{
+		Scope:    in.GetScope(),
+		TargetID: in.GetTargetId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ListIpRulesReply{
+		Rules: slices.Map(rules, func(item *authBiz.IpRule, index int) *v1.IpRuleInfo {
+			return s.toProtoIpRule(item)
+		}),
+		Total: int64(len(rules)),
+	}, nil
+}
+
+// UpdateIpRule 未传状态时视为启用
+func (s *IpRuleService) UpdateIpRule(ctx context.Context, in *v1.UpdateIpRuleRequest) (*v1.IpRuleInfo, error) {
+	rule := &authBiz.IpRule{
+		ID:     in.GetId(),
+		Cidr:   in.GetCidr(),
+		Remark: in.GetRemark(),
+		Status: 1,
+	}
+	if in.Status != nil {
+		rule.Status = in.GetStatus()
+	}
+	rule, err := s.ipRuleUc.UpdateIpRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	return s.toProtoIpRule(rule), nil
+}
+
+func (s *IpRuleService) DeleteIpRule(ctx context.Context, in *v1.DeleteIpRuleRequest) (*emptypb.Empty, error) {
+	if err := s.ipRuleUc.DeleteIpRule(ctx, in.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *IpRuleService) toProtoIpRule(rule *authBiz.IpRule) *v1.IpRuleInfo {
+	return &v1.IpRuleInfo{
+		Id:       rule.ID,
+		Scope:    rule.Scope,
+		TargetId: rule.TargetID,
+		Cidr:     rule.Cidr,
+		Remark:   rule.Remark,
+		Status:   rule.Status,
+		CreateAt: timestamppb.New(rule.CreateAt),
+	}
+}
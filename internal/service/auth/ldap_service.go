@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"context"
+	v1 "quest-admin/api/gen/auth/v1"
+	ldapBiz "quest-admin/internal/biz/ldap"
+	"quest-admin/pkg/lang/slices"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// LdapService LDAP 配置与目录同步服务
+type LdapService struct {
+	v1.UnimplementedLdapServiceServer
+	ldapUc *ldapBiz.LdapUsecase
+	log    *log.Helper
+}
+
+func NewLdapService(logger log.Logger, ldapUc *ldapBiz.LdapUsecase) *LdapService {
+	return &LdapService{
+		ldapUc: ldapUc,
+		log:    log.NewHelper(log.With(logger, "module", "auth/service/ldap")),
+	}
+}
+
+func (s *LdapService) GetLdapConfig(ctx context.Context, in *v1.GetLdapConfigRequest) (*v1.GetLdapConfigReply, error) {
+	cfg, err := s.ldapUc.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetLdapConfigReply{Config: s.toProtoConfig(cfg)}, nil
+}
+
+// SaveLdapConfig 整体保存配置，仅服务账号密码为空时保留原值
+func (s *LdapService) SaveLdapConfig(ctx context.Context, in *v1.SaveLdapConfigRequest) (*emptypb.Empty, error) {
+	cfg := &ldapBiz.Config{
+		URL:                in.GetUrl(),
+		StartTLS:           in.GetStartTls(),
+		InsecureSkipVerify: in.GetInsecureSkipVerify(),
+		BindDN:             in.GetBindDn(),
+		BindPassword:       in.GetBindPassword(),
+		BaseDN:             in.GetBaseDn(),
+		UserFilter:         in.GetUserFilter(),
+		GroupBaseDN:        in.GetGroupBaseDn(),
+		GroupFilter:        in.GetGroupFilter(),
+		GroupMemberAttr:    in.GetGroupMemberAttr(),
+		GroupRoles: slices.Map(in.GetGroupRoles(), func(item *v1.LdapGroupRole, index int) *ldapBiz.GroupRole {
+			return &ldapBiz.GroupRole{GroupDN: item.GetGroupDn(), RoleID: item.GetRoleId()}
+		}),
+		DeptFilter:   in.GetDeptFilter(),
+		RootDeptID:   in.GetRootDeptId(),
+		AuthEnabled:  in.GetAuthEnabled(),
+		SyncEnabled:  in.GetSyncEnabled(),
+		SyncInterval: in.GetSyncInterval(),
+		Status:       1,
+	}
+	if in.Status != nil {
+		cfg.Status = in.GetStatus()
+	}
+	if mapping := in.GetAttributes(); mapping != nil {
+		cfg.Attributes = &ldapBiz.AttributeMapping{
+			Username: mapping.GetUsername(),
+			Nickname: mapping.GetNickname(),
+			Email:    mapping.GetEmail(),
+			Mobile:   mapping.GetMobile(),
+		}
+	}
+	if err := s.ldapUc.SaveConfig(ctx, cfg); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *LdapService) DeleteLdapConfig(ctx context.Context, in *v1.DeleteLdapConfigRequest) (*emptypb.Empty, error) {
+	if err := s.ldapUc.DeleteConfig(ctx); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *LdapService) TestLdapConnection(ctx context.Context, in *v1.TestLdapConnectionRequest) (*v1.TestLdapConnectionReply, error) {
+	users, err := s.ldapUc.TestConnection(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TestLdapConnectionReply{Users: int32(users)}, nil
+}
+
+func (s *LdapService) SyncLdap(ctx context.Context, in *v1.SyncLdapRequest) (*v1.SyncLdapReply, error) {
+	result, err := s.ldapUc.Sync(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SyncLdapReply{Result: s.toProtoSyncResult(result)}, nil
+}
+
+// toProtoConfig 不返回服务账号密码
+func (s *LdapService) toProtoConfig(cfg *ldapBiz.Config) *v1.LdapConfig {
+	config := &v1.LdapConfig{
+		Id:                 cfg.ID,
+		Url:                cfg.URL,
+		StartTls:           cfg.StartTLS,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+		BindDn:             cfg.BindDN,
+		BaseDn:             cfg.BaseDN,
+		UserFilter:         cfg.UserFilter,
+		GroupBaseDn:        cfg.GroupBaseDN,
+		GroupFilter:        cfg.GroupFilter,
+		GroupMemberAttr:    cfg.GroupMemberAttr,
+		GroupRoles: slices.Map(cfg.GroupRoles, func(item *ldapBiz.GroupRole, index int) *v1.LdapGroupRole {
+			return &v1.LdapGroupRole{GroupDn: item.GroupDN, RoleId: item.RoleID}
+		}),
+		DeptFilter:     cfg.DeptFilter,
+		RootDeptId:     cfg.RootDeptID,
+		AuthEnabled:    cfg.AuthEnabled,
+		SyncEnabled:    cfg.SyncEnabled,
+		SyncInterval:   cfg.SyncInterval,
+		Status:         cfg.Status,
+		LastSyncResult: s.toProtoSyncResult(cfg.LastSyncResult),
+		CreateAt:       timestamppb.New(cfg.CreateAt),
+		UpdateAt:       timestamppb.New(cfg.UpdateAt),
+	}
+	if mapping := cfg.Attributes; mapping != nil {
+		config.Attributes = &v1.LdapAttributeMapping{
+			Username: mapping.Username,
+			Nickname: mapping.Nickname,
+			Email:    mapping.Email,
+			Mobile:   mapping.Mobile,
+		}
+	}
+	if !cfg.LastSyncAt.IsZero() {
+		config.LastSyncAt = timestamppb.New(cfg.LastSyncAt)
+	}
+	return config
+}
+
+func (s *LdapService) toProtoSyncResult(result *ldapBiz.SyncResult) *v1.LdapSyncResult {
+	if result == nil {
+		return nil
+	}
+	return &v1.LdapSyncResult{
+		Created:      result.Created,
+		Updated:      result.Updated,
+		Disabled:     result.Disabled,
+		Skipped:      result.Skipped,
+		DeptsCreated: result.DeptsCreated,
+		DeptsUpdated: result.DeptsUpdated,
+		StartAt:      timestamppb.New(result.StartAt),
+		FinishAt:     timestamppb.New(result.FinishAt),
+		Error:        result.Error,
+	}
+}
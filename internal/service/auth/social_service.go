@@ -0,0 +1,324 @@
+package auth
+
+import (
+	"context"
+	v1 "quest-admin/api/gen/auth/v1"
+	socialBiz "quest-admin/internal/biz/social"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/lang/ptr"
+	"quest-admin/pkg/lang/slices"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SocialService 第三方登录服务，登录成功后的令牌签发与账号密码登录一致
+type SocialService struct {
+	v1.UnimplementedSocialServiceServer
+	socialUc    *socialBiz.SocialUsecase
+	authService *AuthService
+	log         *log.Helper
+}
+
+func NewSocialService(logger log.Logger, socialUc *socialBiz.SocialUsecase, authService *AuthService) *SocialService {
+	return &SocialService{
+		socialUc:    socialUc,
+		authService: authService,
+		log:         log.NewHelper(log.With(logger, "module", "auth/service/social")),
+	}
+}
+
+// ListSocialProviders 登录页可用的身份提供方
+func (s *SocialService) ListSocialProviders(ctx context.Context, in *v1.ListSocialProvidersRequest) (*v1.ListSocialProvidersReply, error) {
+	providers, err := s.socialUc.ListEnabledProviders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ListSocialProvidersReply{
+		Providers: slices.Map(providers, func(item *socialBiz.Provider, index int) *v1.SocialProviderInfo {
+			return &v1.SocialProviderInfo{Code: item.Code, Name: item.Name, Icon: item.Icon}
+		}),
+	}, nil
+}
+
+// GetAuthorizeURL 获取上游授权地址，绑定身份时需要登录
+func (s *SocialService) GetAuthorizeURL(ctx context.Context, in *v1.GetAuthorizeURLRequest) (*v1.GetAuthorizeURLReply, error) {
+	if in.GetProvider() == "" {
+		return nil, errorx.Err(errkey.ErrBadRequest, "provider")
+	}
+	var bindUserID string
+	if in.GetBind() {
+		user, err := s.authService.currentUser(ctx)
+		if err != nil {
+			return nil, err
+		}
+		bindUserID = user.ID
+	}
+	authorizeURL, err := s.socialUc.AuthorizeURL(ctx, in.GetProvider(), bindUserID)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetAuthorizeURLReply{AuthorizeUrl: authorizeURL}, nil
+}
+
+// SocialCallback 第三方登录回调，已启用 MFA 的用户同样需要二次验证
+func (s *SocialService) SocialCallback(ctx context.Context, in *v1.SocialCallbackRequest) (reply *v1.SocialCallbackReply, err error) {
+	var (
+		username string
+		userID   string
+		device   = ptr.From(in.Device)
+		ticket   bool
+	)
+	defer func() {
+		// 未识别出本地用户的失败不记录登录日志，等待二次验证时由 VerifyMfa 记录
+		if username != "" && !ticket {
+			s.authService.recordLoginLog(ctx, username, device, userID, err)
+		}
+	}()
+
+	result, err := s.socialUc.Callback(ctx, in.GetState(), in.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	if result.Bound {
+		return &v1.SocialCallbackReply{Bound: true}, nil
+	}
+	user := result.User
+	username, userID = user.Username, user.ID
+	ok, err := s.authService.userUsecase.VerifyStatus(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errorx.Err(errkey.ErrUserDisabled)
+	}
+	if err = s.authService.checkLoginIP(ctx, user.ID); err != nil {
+		return nil, err
+	}
+
+	mfa, err := s.authService.mfaStatus(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if mfa.Enabled() {
+		mfaTicket, err := s.authService.authUsecase.CreateMfaTicket(ctx, user.ID, user.Username, device)
+		if err != nil {
+			return nil, err
+		}
+		ticket = true
+		return &v1.SocialCallbackReply{
+			MfaRequired:  true,
+			MfaTicket:    mfaTicket.ID,
+			MfaExpiresIn: mfaTicket.ExpiresIn,
+		}, nil
+	}
+
+	token, err := s.authService.issueToken(ctx, user, device)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SocialCallbackReply{
+		Token:                  token.AccessToken,
+		RefreshToken:           token.RefreshToken,
+		ExpiresIn:              token.ExpiresIn,
+		RefreshExpiresIn:       token.RefreshExpiresIn,
+		PasswordChangeRequired: token.PasswordChangeRequired,
+		IdToken:                token.IDToken,
+	}, nil
+}
+
+// ListUserSocials 获取当前用户绑定的上游身份
+func (s *SocialService) ListUserSocials(ctx context.Context, in *v1.ListUserSocialsRequest) (*v1.ListUserSocialsReply, error) {
+	user, err := s.authService.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	socials, err := s.socialUc.ListUserSocials(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	providers := make(map[string]*socialBiz.Provider)
+	items := make([]*v1.UserSocialInfo, 0, len(socials))
+	for _, social := range socials {
+		provider, ok := providers[social.ProviderID]
+		if !ok {
+			provider, err = s.socialUc.GetProvider(ctx, social.ProviderID)
+			if err != nil {
+				provider = &socialBiz.Provider{}
+			}
+			providers[social.ProviderID] = provider
+		}
+		item := &v1.UserSocialInfo{
+			Id:           social.ID,
+			ProviderCode: provider.Code,
+			ProviderName: provider.Name,
+			Username:     social.Username,
+			Nickname:     social.Nickname,
+			Email:        social.Email,
+			Avatar:       social.Avatar,
+			CreateAt:     timestamppb.New(social.CreateAt),
+		}
+		if !social.LastLoginAt.IsZero() {
+			item.LastLoginAt = timestamppb.New(social.LastLoginAt)
+		}
+		items = append(items, item)
+	}
+	return &v1.ListUserSocialsReply{Socials: items}, nil
+}
+
+// UnbindSocial 解除当前用户绑定的上游身份
+func (s *SocialService) UnbindSocial(ctx context.Context, in *v1.UnbindSocialRequest) (*emptypb.Empty, error) {
+	if in.GetId() == "" {
+		return nil, errorx.Err(errkey.ErrBadRequest, "id")
+	}
+	user, err := s.authService.currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.socialUc.Unbind(ctx, user.ID, in.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *SocialService) CreateSocialProvider(ctx context.Context, in *v1.CreateSocialProviderRequest) (*v1.CreateSocialProviderReply, error) {
+	provider := &socialBiz.Provider{
+		Code:          in.GetCode(),
+		Name:          in.GetName(),
+		Icon:          in.GetIcon(),
+		Issuer:        in.GetIssuer(),
+		ClientID:      in.GetClientId(),
+		ClientSecret:  in.GetClientSecret(),
+		Scopes:        in.GetScopes(),
+		ClaimMapping:  s.toBizClaimMapping(in.GetClaimMapping()),
+		AutoCreate:    in.GetAutoCreate(),
+		DefaultDeptID: in.GetDefaultDeptId(),
+		DefaultRoleID: in.GetDefaultRoleId(),
+		Sort:          in.GetSort(),
+		Status:        1,
+		Remark:        in.GetRemark(),
+	}
+	if in.Status != nil {
+		provider.Status = in.GetStatus()
+	}
+	if err := s.socialUc.CreateProvider(ctx, provider); err != nil {
+		return nil, err
+	}
+	return &v1.CreateSocialProviderReply{Provider: s.toProtoProvider(provider)}, nil
+}
+
+func (s *SocialService) GetSocialProvider(ctx context.Context, in *v1.GetSocialProviderRequest) (*v1.GetSocialProviderReply, error) {
+	if in.GetId() == "" {
+		return nil, errorx.Err(errkey.ErrBadRequest, "id")
+	}
+	provider, err := s.socialUc.GetProvider(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetSocialProviderReply{Provider: s.toProtoProvider(provider)}, nil
+}
+
+func (s *SocialService) ListSocialProviderConfigs(ctx context.Context, in *v1.ListSocialProviderConfigsRequest) (*v1.ListSocialProviderConfigsReply, error) {
+	query := &socialBiz.ListProvidersQuery{
+		Page:     in.GetPage(),
+		PageSize: in.GetPageSize(),
+		Keyword:  in.GetKeyword(),
+		Status:   in.Status,
+	}
+	result, err := s.socialUc.ListProviders(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ListSocialProviderConfigsReply{
+		Providers: slices.Map(result.Providers, func(item *socialBiz.Provider, index int) *v1.SocialProviderConfig {
+			return s.toProtoProvider(item)
+		}),
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalPages: result.TotalPages,
+	}, nil
+}
+
+func (s *SocialService) UpdateSocialProvider(ctx context.Context, in *v1.UpdateSocialProviderRequest) (*emptypb.Empty, error) {
+	if in.GetId() == "" {
+		return nil, errorx.Err(errkey.ErrBadRequest, "id")
+	}
+	provider := &socialBiz.Provider{
+		ID:            in.GetId(),
+		Code:          in.GetCode(),
+		Name:          in.GetName(),
+		Icon:          in.GetIcon(),
+		Issuer:        in.GetIssuer(),
+		ClientID:      in.GetClientId(),
+		ClientSecret:  in.GetClientSecret(),
+		Scopes:        in.GetScopes(),
+		ClaimMapping:  s.toBizClaimMapping(in.GetClaimMapping()),
+		AutoCreate:    in.GetAutoCreate(),
+		DefaultDeptID: in.GetDefaultDeptId(),
+		DefaultRoleID: in.GetDefaultRoleId(),
+		Sort:          in.GetSort(),
+		Status:        in.GetStatus(),
+		Remark:        in.GetRemark(),
+	}
+	if err := s.socialUc.UpdateProvider(ctx, provider); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *SocialService) DeleteSocialProvider(ctx context.Context, in *v1.DeleteSocialProviderRequest) (*emptypb.Empty, error) {
+	if in.GetId() == "" {
+		return nil, errorx.Err(errkey.ErrBadRequest, "id")
+	}
+	if err := s.socialUc.DeleteProvider(ctx, in.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *SocialService) toBizClaimMapping(mapping *v1.ClaimMapping) *socialBiz.ClaimMapping {
+	if mapping == nil {
+		return nil
+	}
+	return &socialBiz.ClaimMapping{
+		Username: mapping.GetUsername(),
+		Nickname: mapping.GetNickname(),
+		Email:    mapping.GetEmail(),
+		Mobile:   mapping.GetMobile(),
+		Avatar:   mapping.GetAvatar(),
+	}
+}
+
+// toProtoProvider 不返回客户端密钥
+func (s *SocialService) toProtoProvider(provider *socialBiz.Provider) *v1.SocialProviderConfig {
+	config := &v1.SocialProviderConfig{
+		Id:            provider.ID,
+		Code:          provider.Code,
+		Name:          provider.Name,
+		Icon:          provider.Icon,
+		Issuer:        provider.Issuer,
+		ClientId:      provider.ClientID,
+		Scopes:        provider.Scopes,
+		AutoCreate:    provider.AutoCreate,
+		DefaultDeptId: provider.DefaultDeptID,
+		DefaultRoleId: provider.DefaultRoleID,
+		Sort:          provider.Sort,
+		Status:        provider.Status,
+		Remark:        provider.Remark,
+		CreateAt:      timestamppb.New(provider.CreateAt),
+		UpdateAt:      timestamppb.New(provider.UpdateAt),
+	}
+	if mapping := provider.ClaimMapping; mapping != nil {
+		config.ClaimMapping = &v1.ClaimMapping{
+			Username: mapping.Username,
+			Nickname: mapping.Nickname,
+			Email:    mapping.Email,
+			Mobile:   mapping.Mobile,
+			Avatar:   mapping.Avatar,
+		}
+	}
+	return config
+}
@@ -0,0 +1,201 @@
+package oauth2
+
+import (
+	"context"
+
+	v1 "quest-admin/api/gen/oauth2/v1"
+	biz "quest-admin/internal/biz/oauth2"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type OAuth2Service struct {
+	v1.UnimplementedOAuth2ServiceServer
+	clientUsecase *biz.ClientUsecase
+	oauth2Usecase *biz.OAuth2Usecase
+	log           *log.Helper
+}
+
+func NewOAuth2Service(clientUsecase *biz.ClientUsecase, oauth2Usecase *biz.OAuth2Usecase, logger log.Logger) *OAuth2Service {
+	return &OAuth2Service{
+		clientUsecase: clientUsecase,
+		oauth2Usecase: oauth2Usecase,
+		log:           log.NewHelper(log.With(logger, "module", "oauth2/service")),
+	}
+}
+
+func (s *OAuth2Service) CreateClient(ctx context.Context, in *v1.CreateClientRequest) (*v1.CreateClientReply, error) {
+	client := &biz.Client{
+		Name:         in.GetName(),
+		ClientType:   in.GetClientType(),
+		RedirectURIs: in.GetRedirectUris(),
+		GrantTypes:   in.GetGrantTypes(),
+		Scopes:       in.GetScopes(),
+		Trusted:      in.GetTrusted(),
+		Remark:       in.GetRemark(),
+		Status:       1,
+	}
+	secret, err := s.clientUsecase.CreateClient(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreateClientReply{
+		Client:       s.toProtoClient(client),
+		ClientSecret: secret,
+	}, nil
+}
+
+func (s *OAuth2Service) GetClient(ctx context.Context, in *v1.GetClientRequest) (*v1.GetClientReply, error) {
+	client, err := s.clientUsecase.GetClient(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetClientReply{
+		Client: s.toProtoClient(client),
+	}, nil
+}
+
+func (s *OAuth2Service) ListClients(ctx context.Context, in *v1.ListClientsRequest) (*v1.ListClientsReply, error) {
+	query := &biz.ListClientsQuery{
+		Page:     in.GetPage(),
+		PageSize: in.GetPageSize(),
+		Keyword:  in.GetKeyword(),
+		Status:   in.Status,
+	}
+	result, err := s.clientUsecase.ListClients(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	clients := make([]*v1.ClientInfo, 0, len(result.Clients))
+	for _, client := range result.Clients {
+		clients = append(clients, s.toProtoClient(client))
+	}
+	return &v1.ListClientsReply{
+		Clients:    clients,
+		Total:      result.Total,
+		Page:       result.Page,
+		PageSize:   result.PageSize,
+		TotalPages: result.TotalPages,
+	}, nil
+}
+
+func (s *OAuth2Service) UpdateClient(ctx context.Context, in *v1.UpdateClientRequest) (*emptypb.Empty, error) {
+	client := &biz.Client{
+		ID:           in.GetId(),
+		Name:         in.GetName(),
+		RedirectURIs: in.GetRedirectUris(),
+		GrantTypes:   in.GetGrantTypes(),
+		Scopes:       in.GetScopes(),
+		Trusted:      in.GetTrusted(),
+		Status:       in.GetStatus(),
+		Remark:       in.GetRemark(),
+	}
+	if err := s.clientUsecase.UpdateClient(ctx, client); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *OAuth2Service) ResetClientSecret(ctx context.Context, in *v1.ResetClientSecretRequest) (*v1.ResetClientSecretReply, error) {
+	secret, err := s.clientUsecase.ResetClientSecret(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ResetClientSecretReply{ClientSecret: secret}, nil
+}
+
+func (s *OAuth2Service) DeleteClient(ctx context.Context, in *v1.DeleteClientRequest) (*emptypb.Empty, error) {
+	if err := s.clientUsecase.DeleteClient(ctx, in.GetId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *OAuth2Service) GetAuthorizeInfo(ctx context.Context, in *v1.GetAuthorizeInfoRequest) (*v1.GetAuthorizeInfoReply, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info, err := s.oauth2Usecase.GetAuthorizeInfo(ctx, userID, &biz.AuthorizeRequest{
+		ClientID:            in.GetClientId(),
+		RedirectURI:         in.GetRedirectUri(),
+		ResponseType:        in.GetResponseType(),
+		Scope:               in.GetScope(),
+		State:               in.GetState(),
+		CodeChallenge:       in.GetCodeChallenge(),
+		CodeChallengeMethod: in.GetCodeChallengeMethod(),
+		Nonce:               in.GetNonce(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]*v1.ScopeInfo, 0, len(info.Scopes))
+	for _, scope := range info.Scopes {
+		scopes = append(scopes, &v1.ScopeInfo{Code: scope.Code, Name: scope.Name})
+	}
+	return &v1.GetAuthorizeInfoReply{
+		ClientId:        info.Client.ClientID,
+		ClientName:      info.Client.Name,
+		RedirectUri:     info.RedirectURI,
+		Scopes:          scopes,
+		ConsentRequired: info.ConsentRequired,
+		Openid:          info.OpenID,
+	}, nil
+}
+
+func (s *OAuth2Service) Authorize(ctx context.Context, in *v1.AuthorizeRequest) (*v1.AuthorizeReply, error) {
+	userID, err := s.currentUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	redirectURI, err := s.oauth2Usecase.Authorize(ctx, userID, &biz.AuthorizeRequest{
+		ClientID:            in.GetClientId(),
+		RedirectURI:         in.GetRedirectUri(),
+		ResponseType:        in.GetResponseType(),
+		Scope:               in.GetScope(),
+		State:               in.GetState(),
+		CodeChallenge:       in.GetCodeChallenge(),
+		CodeChallengeMethod: in.GetCodeChallengeMethod(),
+		Nonce:               in.GetNonce(),
+	}, in.GetApproved())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AuthorizeReply{RedirectUri: redirectURI}, nil
+}
+
+// currentUserID 授权确认只能由登录用户本人操作，不接受 API Key 和 OAuth2 令牌
+func (s *OAuth2Service) currentUserID(ctx context.Context) (string, error) {
+	if ctxs.IsScoped(ctx) {
+		return "", errorx.Err(errkey.ErrApiKeyNotAllowed)
+	}
+	if ctxs.GetToken(ctx) == "" {
+		return "", errorx.Err(errkey.ErrUnauthorized)
+	}
+	return ctxs.GetLoginID(ctx), nil
+}
+
+func (s *OAuth2Service) toProtoClient(client *biz.Client) *v1.ClientInfo {
+	return &v1.ClientInfo{
+		Id:           client.ID,
+		ClientId:     client.ClientID,
+		Name:         client.Name,
+		ClientType:   client.ClientType,
+		RedirectUris: client.RedirectURIs,
+		GrantTypes:   client.GrantTypes,
+		Scopes:       client.Scopes,
+		Trusted:      client.Trusted,
+		Status:       client.Status,
+		Remark:       client.Remark,
+		CreateAt:     timestamppb.New(client.CreateAt),
+		UpdateAt:     timestamppb.New(client.UpdateAt),
+		TenantId:     client.TenantID,
+	}
+}
@@ -0,0 +1,165 @@
+package oauth2
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	biz "quest-admin/internal/biz/oauth2"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// 令牌、自省和吊销端点按 RFC 6749/7662/7009 以表单提交并返回标准 JSON，
+// 不经过 proto 路由和中间件，由 server 直接注册。
+const (
+	TokenPath      = "/qs/v1/oauth2/token"
+	IntrospectPath = "/qs/v1/oauth2/introspect"
+	RevokePath     = "/qs/v1/oauth2/revoke"
+
+	oauth2ErrorPrefix = "OAUTH2_"
+)
+
+type tokenReply struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+}
+
+type introspectReply struct {
+	Active    bool   `json:"active"`
+	ClientID  string `json:"client_id,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Scope     string `json:"scope,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+}
+
+type errorReply struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// Token 令牌端点
+func (s *OAuth2Service) Token(w http.ResponseWriter, r *http.Request) {
+	if !s.parseForm(w, r) {
+		return
+	}
+	clientID, clientSecret := clientCredentials(r)
+	token, err := s.oauth2Usecase.Token(r.Context(), &biz.TokenRequest{
+		GrantType:    r.PostForm.Get("grant_type"),
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+		Code:         r.PostForm.Get("code"),
+		RedirectURI:  r.PostForm.Get("redirect_uri"),
+		CodeVerifier: r.PostForm.Get("code_verifier"),
+		RefreshToken: r.PostForm.Get("refresh_token"),
+		Scope:        r.PostForm.Get("scope"),
+	})
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, &tokenReply{
+		AccessToken:  token.AccessToken,
+		TokenType:    token.TokenType,
+		ExpiresIn:    token.ExpiresIn,
+		RefreshToken: token.RefreshToken,
+		Scope:        token.Scope,
+		IDToken:      token.IDToken,
+	})
+}
+
+// Introspect 令牌自省端点，仅机密客户端可调用
+func (s *OAuth2Service) Introspect(w http.ResponseWriter, r *http.Request) {
+	if !s.parseForm(w, r) {
+		return
+	}
+	clientID, clientSecret := clientCredentials(r)
+	result, err := s.oauth2Usecase.Introspect(r.Context(), clientID, clientSecret, r.PostForm.Get("token"))
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, &introspectReply{
+		Active:    result.Active,
+		ClientID:  result.ClientID,
+		Sub:       result.Subject,
+		Scope:     result.Scope,
+		TokenType: result.TokenType,
+		Exp:       result.ExpiresAt,
+	})
+}
+
+// Revoke 令牌吊销端点，令牌无效时同样返回 200
+func (s *OAuth2Service) Revoke(w http.ResponseWriter, r *http.Request) {
+	if !s.parseForm(w, r) {
+		return
+	}
+	clientID, clientSecret := clientCredentials(r)
+	if err := s.oauth2Usecase.Revoke(r.Context(), clientID, clientSecret, r.PostForm.Get("token")); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *OAuth2Service) parseForm(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeJSON(w, http.StatusMethodNotAllowed, &errorReply{Error: "invalid_request", ErrorDescription: "method must be POST"})
+		return false
+	}
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, &errorReply{Error: "invalid_request", ErrorDescription: err.Error()})
+		return false
+	}
+	return true
+}
+
+// writeError OAuth2 错误按 RFC 错误码返回，其它业务错误（如用户被停用）视为授权无效
+func (s *OAuth2Service) writeError(w http.ResponseWriter, err error) {
+	e := errors.FromError(err)
+	reply := &errorReply{Error: strings.ToLower(strings.TrimPrefix(e.Reason, oauth2ErrorPrefix)), ErrorDescription: e.Message}
+	status := int(e.Code)
+	switch {
+	case e.Reason == string(errkey.ErrOAuth2InvalidClient):
+		w.Header().Set("WWW-Authenticate", `Basic realm="oauth2"`)
+	case strings.HasPrefix(e.Reason, oauth2ErrorPrefix):
+	case status >= http.StatusInternalServerError:
+		s.log.Errorf("OAuth2令牌端点出现错误,error:%v", err)
+		reply = &errorReply{Error: "server_error"}
+	default:
+		reply.Error = "invalid_grant"
+		status = http.StatusBadRequest
+	}
+	writeJSON(w, status, reply)
+}
+
+// clientCredentials 客户端认证优先使用 HTTP Basic（凭证按表单编码），其次是表单参数
+func clientCredentials(r *http.Request) (string, string) {
+	if clientID, clientSecret, ok := r.BasicAuth(); ok {
+		if id, err := url.QueryUnescape(clientID); err == nil {
+			clientID = id
+		}
+		if secret, err := url.QueryUnescape(clientSecret); err == nil {
+			clientSecret = secret
+		}
+		return clientID, clientSecret
+	}
+	return r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,137 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	biz "quest-admin/internal/biz/oidc"
+	"quest-admin/internal/conf"
+	"quest-admin/internal/service/oauth2"
+	"quest-admin/pkg/middleware/clientip"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+// 发现文档、JWKS 和 userinfo 端点按 OpenID Connect 规范返回标准 JSON，
+// 不经过 proto 路由和中间件，由 server 直接注册。
+const (
+	DiscoveryPath = "/.well-known/openid-configuration"
+	JWKSPath      = "/qs/v1/oidc/jwks"
+	UserInfoPath  = "/qs/v1/oidc/userinfo"
+)
+
+// jwksMaxAge 新密钥先发布再签名，发布时长覆盖了这里的缓存时间，客户端可放心缓存
+var jwksMaxAge = "public, max-age=" + strconv.Itoa(int(biz.JWKSMaxAge.Seconds()))
+
+type discoveryReply struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JwksURI                           string   `json:"jwks_uri"`
+	IntrospectionEndpoint             string   `json:"introspection_endpoint"`
+	RevocationEndpoint                string   `json:"revocation_endpoint"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+type errorReply struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+type OidcService struct {
+	oidcUsecase *biz.OidcUsecase
+	// clientIP userinfo 端点不经过 server 中间件，按受信代理单独解析请求方 IP
+	clientIP middleware.Middleware
+	log      *log.Helper
+}
+
+func NewOidcService(c *conf.Bootstrap, oidcUsecase *biz.OidcUsecase, logger log.Logger) (*OidcService, error) {
+	clientIP, err := clientip.Server(c.GetServer().GetTrustedProxies())
+	if err != nil {
+		return nil, err
+	}
+	return &OidcService{
+		oidcUsecase: oidcUsecase,
+		clientIP:    clientIP,
+		log:         log.NewHelper(log.With(logger, "module", "oidc/service")),
+	}, nil
+}
+
+// Discovery OpenID Provider 发现文档
+func (s *OidcService) Discovery(w http.ResponseWriter, r *http.Request) {
+	options := s.oidcUsecase.Options()
+	writeJSON(w, http.StatusOK, "public, max-age=3600", &discoveryReply{
+		Issuer:                            options.Issuer,
+		AuthorizationEndpoint:             options.AuthorizeURL,
+		TokenEndpoint:                     options.Issuer + oauth2.TokenPath,
+		UserinfoEndpoint:                  options.Issuer + UserInfoPath,
+		JwksURI:                           options.Issuer + JWKSPath,
+		IntrospectionEndpoint:             options.Issuer + oauth2.IntrospectPath,
+		RevocationEndpoint:                options.Issuer + oauth2.RevokePath,
+		ScopesSupported:                   []string{"openid"},
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{"authorization_code", "refresh_token", "client_credentials"},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{options.Algorithm},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post", "none"},
+		CodeChallengeMethodsSupported:     []string{"S256"},
+		ClaimsSupported:                   []string{"sub", "iss", "aud", "exp", "iat", "nonce", "preferred_username", "name", "email", "picture", "tenant_id", "roles"},
+	})
+}
+
+// JWKS 验签公钥集合
+func (s *OidcService) JWKS(w http.ResponseWriter, r *http.Request) {
+	set, err := s.oidcUsecase.JWKS(r.Context())
+	if err != nil {
+		s.log.WithContext(r.Context()).Errorf("查询OIDC公钥失败,error:%v", err)
+		writeJSON(w, http.StatusInternalServerError, "no-store", &errorReply{Error: "server_error"})
+		return
+	}
+	writeJSON(w, http.StatusOK, jwksMaxAge, set)
+}
+
+// UserInfo 以访问令牌查询当前用户的身份声明，普通登录令牌按 Tenant 请求头确定租户
+func (s *OidcService) UserInfo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		w.Header().Set("Allow", "GET, POST")
+		writeJSON(w, http.StatusMethodNotAllowed, "no-store", &errorReply{Error: "invalid_request", ErrorDescription: "method must be GET or POST"})
+		return
+	}
+	accessToken := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	ctx := r.Context()
+	reply, err := s.clientIP(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return s.oidcUsecase.UserInfo(ctx, accessToken)
+	})(ctx, nil)
+	if err != nil {
+		e := errors.FromError(err)
+		if e.Code >= http.StatusInternalServerError {
+			s.log.WithContext(ctx).Errorf("查询OIDC用户信息失败,error:%v", err)
+			writeJSON(w, http.StatusInternalServerError, "no-store", &errorReply{Error: "server_error"})
+			return
+		}
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		writeJSON(w, http.StatusUnauthorized, "no-store", &errorReply{Error: "invalid_token", ErrorDescription: e.Message})
+		return
+	}
+	writeJSON(w, http.StatusOK, "no-store", reply)
+}
+
+func writeJSON(w http.ResponseWriter, status int, cacheControl string, v any) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.Header().Set("Cache-Control", cacheControl)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
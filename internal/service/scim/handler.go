@@ -0,0 +1,408 @@
+package scim
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	biz "quest-admin/internal/biz/scim"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/pkg/util/scimfilter"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// SCIM 2.0 协议端点按 RFC 7643/7644 收发 application/scim+json，使用租户的 SCIM 令牌认证，
+// 不经过 proto 路由和中间件，由 server 直接注册。
+const (
+	Prefix = "/scim/v2"
+
+	contentType  = "application/scim+json"
+	maxBodyBytes = 1 << 20
+	defaultCount = 100
+
+	// scimLoginID 作为 SCIM 写入数据的操作人
+	scimLoginID = "scim"
+)
+
+// ServeHTTP 认证后按资源路径分发请求
+func (s *ScimService) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx, err := s.authenticate(r)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	r = r.WithContext(ctx)
+	r.Body = http.MaxBytesReader(w, r.Body, maxBodyBytes)
+
+	resource, id, _ := strings.Cut(strings.Trim(strings.TrimPrefix(r.URL.Path, Prefix), "/"), "/")
+	switch {
+	case resource == "ServiceProviderConfig" && id == "" && r.Method == http.MethodGet:
+		s.serviceProviderConfig(w, r)
+	case resource == "Users" && id == "":
+		switch r.Method {
+		case http.MethodGet:
+			s.listUsers(w, r)
+		case http.MethodPost:
+			s.createUser(w, r)
+		default:
+			s.methodNotAllowed(w, http.MethodGet, http.MethodPost)
+		}
+	case resource == "Users":
+		switch r.Method {
+		case http.MethodGet:
+			s.getUser(w, r, id)
+		case http.MethodPut:
+			s.replaceUser(w, r, id)
+		case http.MethodPatch:
+			s.patchUser(w, r, id)
+		case http.MethodDelete:
+			s.deleteUser(w, r, id)
+		default:
+			s.methodNotAllowed(w, http.MethodGet, http.MethodPut, http.MethodPatch, http.MethodDelete)
+		}
+	case resource == "Groups" && id == "":
+		switch r.Method {
+		case http.MethodGet:
+			s.listGroups(w, r)
+		case http.MethodPost:
+			s.createGroup(w, r)
+		default:
+			s.methodNotAllowed(w, http.MethodGet, http.MethodPost)
+		}
+	case resource == "Groups":
+		switch r.Method {
+		case http.MethodGet:
+			s.getGroup(w, r, id)
+		case http.MethodPut:
+			s.replaceGroup(w, r, id)
+		case http.MethodPatch:
+			s.patchGroup(w, r, id)
+		case http.MethodDelete:
+			s.deleteGroup(w, r, id)
+		default:
+			s.methodNotAllowed(w, http.MethodGet, http.MethodPut, http.MethodPatch, http.MethodDelete)
+		}
+	default:
+		s.writeError(w, errorx.Err(errkey.ErrNotFound, r.URL.Path))
+	}
+}
+
+// authenticate 校验 Bearer 令牌，并将请求切换到令牌所属租户
+func (s *ScimService) authenticate(r *http.Request) (context.Context, error) {
+	scheme, token, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !strings.EqualFold(scheme, "Bearer") {
+		return nil, errorx.Err(errkey.ErrScimUnauthorized)
+	}
+	cfg, err := s.scimUc.ResolveToken(r.Context(), strings.TrimSpace(token))
+	if err != nil {
+		return nil, err
+	}
+	ctx := ctxs.WithTenantID(r.Context(), cfg.TenantID)
+	return context.WithValue(ctx, ctxs.LoginIDKey, scimLoginID), nil
+}
+
+func (s *ScimService) serviceProviderConfig(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, &serviceProviderConfig{
+		Schemas:        []string{schemaServiceProviderConfig},
+		Patch:          supported{Supported: true},
+		Bulk:           bulkSupported{},
+		Filter:         filterSupported{Supported: true, MaxResults: biz.MaxResults},
+		ChangePassword: supported{},
+		Sort:           supported{},
+		Etag:           supported{},
+		AuthenticationSchemes: []authenticationScheme{{
+			Type:        "oauthbearertoken",
+			Name:        "OAuth Bearer Token",
+			Description: "Authentication scheme using the SCIM token of the tenant",
+			Primary:     true,
+		}},
+	})
+}
+
+func (s *ScimService) listUsers(w http.ResponseWriter, r *http.Request) {
+	query, err := parseListQuery(r)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	result, err := s.scimUc.ListUsers(r.Context(), query)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	base := baseURL(r)
+	resources := make([]*userResource, 0, len(result.Users))
+	for _, user := range result.Users {
+		resources = append(resources, toUserResource(user, base))
+	}
+	writeJSON(w, http.StatusOK, &listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: result.Total,
+		StartIndex:   result.StartIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func (s *ScimService) getUser(w http.ResponseWriter, r *http.Request, id string) {
+	user, err := s.scimUc.GetUser(r.Context(), id)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toUserResource(user, baseURL(r)))
+}
+
+func (s *ScimService) createUser(w http.ResponseWriter, r *http.Request) {
+	in := &userResource{}
+	if err := decodeBody(r, in); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	user, err := s.scimUc.CreateUser(r.Context(), in.toBizUser())
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	resource := toUserResource(user, baseURL(r))
+	w.Header().Set("Location", resource.Meta.Location)
+	writeJSON(w, http.StatusCreated, resource)
+}
+
+func (s *ScimService) replaceUser(w http.ResponseWriter, r *http.Request, id string) {
+	in := &userResource{}
+	if err := decodeBody(r, in); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	item := in.toBizUser()
+	item.ID = id
+	user, err := s.scimUc.ReplaceUser(r.Context(), item)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toUserResource(user, baseURL(r)))
+}
+
+// patchUser 在当前用户数据上依次应用操作，再整体更新
+func (s *ScimService) patchUser(w http.ResponseWriter, r *http.Request, id string) {
+	in := &patchRequest{}
+	if err := decodeBody(r, in); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	user, err := s.scimUc.GetUser(r.Context(), id)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	if err = applyUserPatch(user, in.Operations); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	if user, err = s.scimUc.ReplaceUser(r.Context(), user); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toUserResource(user, baseURL(r)))
+}
+
+func (s *ScimService) deleteUser(w http.ResponseWriter, r *http.Request, id string) {
+	if err := s.scimUc.DeprovisionUser(r.Context(), id); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *ScimService) listGroups(w http.ResponseWriter, r *http.Request) {
+	query, err := parseListQuery(r)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	excludeMembers := false
+	for _, attr := range strings.Split(r.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(attr), "members") {
+			excludeMembers = true
+		}
+	}
+	result, err := s.scimUc.ListGroups(r.Context(), query, excludeMembers)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	base := baseURL(r)
+	resources := make([]*groupResource, 0, len(result.Groups))
+	for _, group := range result.Groups {
+		resources = append(resources, toGroupResource(group, base))
+	}
+	writeJSON(w, http.StatusOK, &listResponse{
+		Schemas:      []string{schemaListResponse},
+		TotalResults: result.Total,
+		StartIndex:   result.StartIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	})
+}
+
+func (s *ScimService) getGroup(w http.ResponseWriter, r *http.Request, id string) {
+	group, err := s.scimUc.GetGroup(r.Context(), id)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toGroupResource(group, baseURL(r)))
+}
+
+func (s *ScimService) createGroup(w http.ResponseWriter, r *http.Request) {
+	in := &groupResource{}
+	if err := decodeBody(r, in); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	group, err := s.scimUc.CreateGroup(r.Context(), in.toBizGroup())
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	resource := toGroupResource(group, baseURL(r))
+	w.Header().Set("Location", resource.Meta.Location)
+	writeJSON(w, http.StatusCreated, resource)
+}
+
+func (s *ScimService) replaceGroup(w http.ResponseWriter, r *http.Request, id string) {
+	in := &groupResource{}
+	if err := decodeBody(r, in); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	item := in.toBizGroup()
+	item.ID = id
+	group, err := s.scimUc.ReplaceGroup(r.Context(), item)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toGroupResource(group, baseURL(r)))
+}
+
+func (s *ScimService) patchGroup(w http.ResponseWriter, r *http.Request, id string) {
+	in := &patchRequest{}
+	if err := decodeBody(r, in); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	group, err := s.scimUc.GetGroup(r.Context(), id)
+	if err != nil {
+		s.writeError(w, err)
+		return
+	}
+	if err = applyGroupPatch(group, in.Operations); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	if group, err = s.scimUc.ReplaceGroup(r.Context(), group); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, toGroupResource(group, baseURL(r)))
+}
+
+func (s *ScimService) deleteGroup(w http.ResponseWriter, r *http.Request, id string) {
+	if err := s.scimUc.DeleteGroup(r.Context(), id); err != nil {
+		s.writeError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *ScimService) methodNotAllowed(w http.ResponseWriter, methods ...string) {
+	w.Header().Set("Allow", strings.Join(methods, ", "))
+	writeJSON(w, http.StatusMethodNotAllowed, &errorResponse{
+		Schemas: []string{schemaError},
+		Status:  strconv.Itoa(http.StatusMethodNotAllowed),
+	})
+}
+
+// writeError 按 RFC 7644 3.12 返回错误，scimType 由错误原因映射
+func (s *ScimService) writeError(w http.ResponseWriter, err error) {
+	e := errors.FromError(err)
+	status := int(e.Code)
+	reply := &errorResponse{Schemas: []string{schemaError}, Detail: e.Message}
+	switch {
+	case e.Reason == string(errkey.ErrScimUnauthorized):
+		w.Header().Set("WWW-Authenticate", `Bearer realm="scim"`)
+	case e.Reason == string(errkey.ErrScimInvalidFilter):
+		reply.ScimType = "invalidFilter"
+	case e.Reason == string(errkey.ErrScimInvalidValue):
+		reply.ScimType = "invalidValue"
+	case e.Reason == string(errkey.ErrScimInvalidPath):
+		reply.ScimType = "invalidPath"
+	case e.Reason == string(errkey.ErrScimMutability):
+		reply.ScimType = "mutability"
+	case status == http.StatusConflict:
+		reply.ScimType = "uniqueness"
+	case status >= http.StatusInternalServerError:
+		s.log.Errorf("SCIM端点出现错误,error:%v", err)
+		reply.Detail = ""
+	}
+	reply.Status = strconv.Itoa(status)
+	writeJSON(w, status, reply)
+}
+
+func parseListQuery(r *http.Request) (*biz.ListQuery, error) {
+	values := r.URL.Query()
+	query := &biz.ListQuery{StartIndex: 1, Count: defaultCount}
+	if v := values.Get("startIndex"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, errorx.Err(errkey.ErrScimInvalidValue, "startIndex")
+		}
+		query.StartIndex = int32(n)
+	}
+	if v := values.Get("count"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, errorx.Err(errkey.ErrScimInvalidValue, "count")
+		}
+		query.Count = int32(n)
+	}
+	filter, err := scimfilter.Parse(values.Get("filter"))
+	if err != nil {
+		return nil, errorx.Err(errkey.ErrScimInvalidFilter, err.Error())
+	}
+	query.Filter = filter
+	return query, nil
+}
+
+func decodeBody(r *http.Request, v any) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return errorx.Err(errkey.ErrScimInvalidValue, "malformed request body")
+	}
+	return nil
+}
+
+// baseURL 资源 location 的前缀，反向代理后以 X-Forwarded-Proto 为准
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if proto := r.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	return scheme + "://" + r.Host + Prefix
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,222 @@
+package scim
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	biz "quest-admin/internal/biz/scim"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/lang/slices"
+	"quest-admin/pkg/util/scimfilter"
+	"quest-admin/types/errkey"
+)
+
+const (
+	opAdd     = "add"
+	opReplace = "replace"
+	opRemove  = "remove"
+)
+
+type patchRequest struct {
+	Schemas    []string          `json:"schemas"`
+	Operations []*patchOperation `json:"Operations"`
+}
+
+type patchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// applyUserPatch 应用用户 PATCH 操作，未支持的属性（如 externalId、企业扩展）忽略
+func applyUserPatch(user *biz.User, ops []*patchOperation) error {
+	return applyPatch(ops, func(kind, path string, value json.RawMessage) error {
+		return patchUserAttr(user, kind, path, value)
+	})
+}
+
+// applyGroupPatch 应用组 PATCH 操作，成员支持 members[value eq "id"] 形式的删除
+func applyGroupPatch(group *biz.Group, ops []*patchOperation) error {
+	return applyPatch(ops, func(kind, path string, value json.RawMessage) error {
+		return patchGroupAttr(group, kind, path, value)
+	})
+}
+
+// applyPatch 操作名不区分大小写；未指定 path 时 value 为属性到值的映射
+func applyPatch(ops []*patchOperation, apply func(kind, path string, value json.RawMessage) error) error {
+	for _, op := range ops {
+		kind := strings.ToLower(op.Op)
+		if kind != opAdd && kind != opReplace && kind != opRemove {
+			return errorx.Err(errkey.ErrScimInvalidValue, "unsupported op "+op.Op)
+		}
+		if op.Path != "" {
+			if err := apply(kind, op.Path, op.Value); err != nil {
+				return err
+			}
+			continue
+		}
+		if kind == opRemove {
+			return errorx.Err(errkey.ErrScimInvalidPath, "path is required for remove")
+		}
+		attrs := map[string]json.RawMessage{}
+		if err := json.Unmarshal(op.Value, &attrs); err != nil {
+			return errorx.Err(errkey.ErrScimInvalidValue, "value must be an object when path is omitted")
+		}
+		paths := make([]string, 0, len(attrs))
+		for path := range attrs {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		for _, path := range paths {
+			if err := apply(kind, path, attrs[path]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func patchUserAttr(user *biz.User, kind, path string, value json.RawMessage) error {
+	attr := strings.TrimPrefix(strings.ToLower(path), strings.ToLower(schemaUser)+":")
+	var err error
+	switch {
+	case attr == "active":
+		if kind == opRemove {
+			return errorx.Err(errkey.ErrScimInvalidValue, "active can not be removed")
+		}
+		user.Active, err = decodeBool(value)
+	case attr == "username":
+		if kind == opRemove {
+			return errorx.Err(errkey.ErrScimMutability, "userName")
+		}
+		user.UserName, err = decodeString(value)
+	case attr == "displayname", attr == "name.formatted":
+		if kind == opRemove {
+			user.DisplayName = ""
+			return nil
+		}
+		user.DisplayName, err = decodeString(value)
+	case attr == "name":
+		if kind == opRemove {
+			user.DisplayName = ""
+			return nil
+		}
+		n := &name{}
+		if err = json.Unmarshal(value, n); err != nil {
+			return errorx.Err(errkey.ErrScimInvalidValue, path)
+		}
+		if v := n.displayName(); v != "" {
+			user.DisplayName = v
+		}
+	case strings.HasPrefix(attr, "emails"):
+		user.Email, err = patchMultiValue(kind, attr, value, "work")
+	case strings.HasPrefix(attr, "phonenumbers"):
+		user.Mobile, err = patchMultiValue(kind, attr, value, "mobile")
+	}
+	if err != nil {
+		return errorx.Err(errkey.ErrScimInvalidValue, path)
+	}
+	return nil
+}
+
+// patchMultiValue 用户只保存一个邮箱和手机号，emails[...].value 形式的 path 直接取字符串值
+func patchMultiValue(kind, attr string, value json.RawMessage, typ string) (string, error) {
+	if kind == opRemove {
+		return "", nil
+	}
+	if strings.HasSuffix(attr, ".value") {
+		return decodeString(value)
+	}
+	var values []*multiValue
+	if err := json.Unmarshal(value, &values); err != nil {
+		item := &multiValue{}
+		if err = json.Unmarshal(value, item); err != nil {
+			return "", err
+		}
+		values = []*multiValue{item}
+	}
+	return primaryValue(values, typ), nil
+}
+
+func patchGroupAttr(group *biz.Group, kind, path string, value json.RawMessage) error {
+	attr, filter, hasFilter := strings.Cut(path, "[")
+	switch strings.TrimPrefix(strings.ToLower(attr), strings.ToLower(schemaGroup)+":") {
+	case "displayname":
+		if kind == opRemove {
+			return errorx.Err(errkey.ErrScimInvalidValue, "displayName can not be removed")
+		}
+		v, err := decodeString(value)
+		if err != nil {
+			return errorx.Err(errkey.ErrScimInvalidValue, path)
+		}
+		group.DisplayName = v
+	case "members":
+		if hasFilter {
+			return removeFilteredMember(group, kind, path, filter)
+		}
+		if kind == opRemove && isNull(value) {
+			group.Members = nil
+			return nil
+		}
+		var members []*multiValue
+		if err := json.Unmarshal(value, &members); err != nil {
+			return errorx.Err(errkey.ErrScimInvalidValue, path)
+		}
+		ids := slices.Map(members, func(item *multiValue, index int) string { return item.Value })
+		switch kind {
+		case opAdd:
+			group.Members = slices.Uniq(append(group.Members, ids...))
+		case opReplace:
+			group.Members = slices.Uniq(ids)
+		case opRemove:
+			group.Members = slices.Filter(group.Members, func(item string, index int) bool {
+				return !slices.Contains(ids, item)
+			})
+		}
+	}
+	return nil
+}
+
+// removeFilteredMember 处理 members[value eq "id"]，只支持删除
+func removeFilteredMember(group *biz.Group, kind, path, filter string) error {
+	filter, ok := strings.CutSuffix(filter, "]")
+	if !ok || kind != opRemove {
+		return errorx.Err(errkey.ErrScimInvalidPath, path)
+	}
+	conds, err := scimfilter.Parse(filter)
+	if err != nil || len(conds) != 1 || !strings.EqualFold(conds[0].Attr, "value") {
+		return errorx.Err(errkey.ErrScimInvalidPath, path)
+	}
+	group.Members = slices.Filter(group.Members, func(item string, index int) bool {
+		return item != conds[0].Value
+	})
+	return nil
+}
+
+// decodeBool 兼容部分身份提供方以字符串 "True"/"False" 传递布尔值
+func decodeBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	s, err := decodeString(value)
+	if err != nil {
+		return false, err
+	}
+	return strconv.ParseBool(strings.ToLower(s))
+}
+
+func decodeString(value json.RawMessage) (string, error) {
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+func isNull(value json.RawMessage) bool {
+	v := strings.TrimSpace(string(value))
+	return v == "" || v == "null"
+}
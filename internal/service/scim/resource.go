@@ -0,0 +1,200 @@
+package scim
+
+import (
+	"strings"
+	"time"
+
+	biz "quest-admin/internal/biz/scim"
+)
+
+const (
+	schemaUser                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	schemaGroup                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	schemaListResponse          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	schemaError                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	schemaServiceProviderConfig = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+type meta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location"`
+}
+
+type name struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// multiValue 多值属性的元素，emails、phoneNumbers 和 members 共用
+type multiValue struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+	Display string `json:"display,omitempty"`
+	Ref     string `json:"$ref,omitempty"`
+}
+
+type userResource struct {
+	Schemas      []string      `json:"schemas"`
+	ID           string        `json:"id,omitempty"`
+	UserName     string        `json:"userName"`
+	Name         *name         `json:"name,omitempty"`
+	DisplayName  string        `json:"displayName,omitempty"`
+	Emails       []*multiValue `json:"emails,omitempty"`
+	PhoneNumbers []*multiValue `json:"phoneNumbers,omitempty"`
+	Active       *bool         `json:"active,omitempty"`
+	Meta         *meta         `json:"meta,omitempty"`
+}
+
+type groupResource struct {
+	Schemas     []string      `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	DisplayName string        `json:"displayName"`
+	Members     []*multiValue `json:"members,omitempty"`
+	Meta        *meta         `json:"meta,omitempty"`
+}
+
+type listResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int64    `json:"totalResults"`
+	StartIndex   int32    `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    any      `json:"Resources"`
+}
+
+type errorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+type supported struct {
+	Supported bool `json:"supported"`
+}
+
+type bulkSupported struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+type filterSupported struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+type authenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Primary     bool   `json:"primary"`
+}
+
+type serviceProviderConfig struct {
+	Schemas               []string               `json:"schemas"`
+	Patch                 supported              `json:"patch"`
+	Bulk                  bulkSupported          `json:"bulk"`
+	Filter                filterSupported        `json:"filter"`
+	ChangePassword        supported              `json:"changePassword"`
+	Sort                  supported              `json:"sort"`
+	Etag                  supported              `json:"etag"`
+	AuthenticationSchemes []authenticationScheme `json:"authenticationSchemes"`
+}
+
+// toBizUser 未传 active 时视为启用；邮箱和手机号优先取 primary 的值
+func (u *userResource) toBizUser() *biz.User {
+	user := &biz.User{
+		UserName:    u.UserName,
+		DisplayName: u.DisplayName,
+		Email:       primaryValue(u.Emails, "work"),
+		Mobile:      primaryValue(u.PhoneNumbers, "mobile"),
+		Active:      u.Active == nil || *u.Active,
+	}
+	if user.DisplayName == "" && u.Name != nil {
+		user.DisplayName = u.Name.displayName()
+	}
+	return user
+}
+
+func (n *name) displayName() string {
+	if n.Formatted != "" {
+		return n.Formatted
+	}
+	return strings.TrimSpace(n.GivenName + " " + n.FamilyName)
+}
+
+func (g *groupResource) toBizGroup() *biz.Group {
+	group := &biz.Group{DisplayName: g.DisplayName, Members: make([]string, 0, len(g.Members))}
+	for _, member := range g.Members {
+		group.Members = append(group.Members, member.Value)
+	}
+	return group
+}
+
+func toUserResource(user *biz.User, base string) *userResource {
+	active := user.Active
+	resource := &userResource{
+		Schemas:     []string{schemaUser},
+		ID:          user.ID,
+		UserName:    user.UserName,
+		DisplayName: user.DisplayName,
+		Active:      &active,
+		Meta: &meta{
+			ResourceType: "User",
+			Created:      user.CreateAt,
+			LastModified: user.UpdateAt,
+			Location:     base + "/Users/" + user.ID,
+		},
+	}
+	if user.DisplayName != "" {
+		resource.Name = &name{Formatted: user.DisplayName}
+	}
+	if user.Email != "" {
+		resource.Emails = []*multiValue{{Value: user.Email, Type: "work", Primary: true}}
+	}
+	if user.Mobile != "" {
+		resource.PhoneNumbers = []*multiValue{{Value: user.Mobile, Type: "mobile", Primary: true}}
+	}
+	return resource
+}
+
+func toGroupResource(group *biz.Group, base string) *groupResource {
+	resource := &groupResource{
+		Schemas:     []string{schemaGroup},
+		ID:          group.ID,
+		DisplayName: group.DisplayName,
+		Members:     make([]*multiValue, 0, len(group.Members)),
+		Meta: &meta{
+			ResourceType: "Group",
+			Created:      group.CreateAt,
+			LastModified: group.UpdateAt,
+			Location:     base + "/Groups/" + group.ID,
+		},
+	}
+	for _, userID := range group.Members {
+		resource.Members = append(resource.Members, &multiValue{Value: userID, Ref: base + "/Users/" + userID})
+	}
+	return resource
+}
+
+// primaryValue 依次取 primary、指定 type、第一个元素的值
+func primaryValue(values []*multiValue, typ string) string {
+	for _, v := range values {
+		if v.Primary {
+			return v.Value
+		}
+	}
+	for _, v := range values {
+		if strings.EqualFold(v.Type, typ) {
+			return v.Value
+		}
+	}
+	if len(values) > 0 {
+		return values[0].Value
+	}
+	return ""
+}
@@ -0,0 +1,77 @@
+package scim
+
+import (
+	"context"
+
+	v1 "quest-admin/api/gen/auth/v1"
+	biz "quest-admin/internal/biz/scim"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ScimService SCIM 配置管理接口，以及由 server 直接注册的 SCIM 2.0 协议端点
+type ScimService struct {
+	v1.UnimplementedScimServiceServer
+	scimUc *biz.ScimUsecase
+	log    *log.Helper
+}
+
+func NewScimService(scimUc *biz.ScimUsecase, logger log.Logger) *ScimService {
+	return &ScimService{
+		scimUc: scimUc,
+		log:    log.NewHelper(log.With(logger, "module", "scim/service")),
+	}
+}
+
+func (s *ScimService) GetScimConfig(ctx context.Context, in *v1.GetScimConfigRequest) (*v1.GetScimConfigReply, error) {
+	cfg, err := s.scimUc.GetConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GetScimConfigReply{Config: &v1.ScimConfig{
+		Id:          cfg.ID,
+		TokenPrefix: cfg.TokenPrefix,
+		GroupTarget: cfg.GroupTarget,
+		RootDeptId:  cfg.RootDeptID,
+		Status:      cfg.Status,
+		Endpoint:    Prefix,
+		CreateAt:    timestamppb.New(cfg.CreateAt),
+		UpdateAt:    timestamppb.New(cfg.UpdateAt),
+	}}, nil
+}
+
+func (s *ScimService) SaveScimConfig(ctx context.Context, in *v1.SaveScimConfigRequest) (*v1.SaveScimConfigReply, error) {
+	cfg := &biz.Config{
+		GroupTarget: biz.GroupTargetDept,
+		RootDeptID:  in.GetRootDeptId(),
+		Status:      1,
+	}
+	if in.GroupTarget != nil {
+		cfg.GroupTarget = in.GetGroupTarget()
+	}
+	if in.Status != nil {
+		cfg.Status = in.GetStatus()
+	}
+	token, err := s.scimUc.SaveConfig(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SaveScimConfigReply{Token: token}, nil
+}
+
+func (s *ScimService) RotateScimToken(ctx context.Context, in *v1.RotateScimTokenRequest) (*v1.RotateScimTokenReply, error) {
+	token, err := s.scimUc.RotateToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.RotateScimTokenReply{Token: token}, nil
+}
+
+func (s *ScimService) DeleteScimConfig(ctx context.Context, in *v1.DeleteScimConfigRequest) (*emptypb.Empty, error) {
+	if err := s.scimUc.DeleteConfig(ctx); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
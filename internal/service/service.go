@@ -1,11 +1,15 @@
 package service
 
 import (
+	"quest-admin/internal/service/audit"
 	"quest-admin/internal/service/auth"
 	"quest-admin/internal/service/config"
 	"quest-admin/internal/service/dict"
+	"quest-admin/internal/service/oauth2"
+	"quest-admin/internal/service/oidc"
 	"quest-admin/internal/service/organization"
 	"quest-admin/internal/service/permission"
+	"quest-admin/internal/service/scim"
 	"quest-admin/internal/service/tenant"
 	"quest-admin/internal/service/user"
 
@@ -23,5 +27,13 @@ var ProviderSet = wire.NewSet(
 	organization.NewPostService,
 	config.NewConfigService,
 	auth.NewAuthService,
+	auth.NewSocialService,
+	auth.NewLdapService,
+	auth.NewIpRuleService,
+	oauth2.NewOAuth2Service,
+	oidc.NewOidcService,
+	scim.NewScimService,
 	dict.NewDictService,
+	audit.NewLoginLogService,
+	audit.NewOperateLogService,
 )
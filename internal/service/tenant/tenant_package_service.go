@@ -4,6 +4,7 @@ import (
 	"context"
 
 	v1 "quest-admin/api/gen/tenant/v1"
+	permBiz "quest-admin/internal/biz/permission"
 	biz "quest-admin/internal/biz/tenant"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -14,12 +15,14 @@ import (
 type TenantPackageService struct {
 	v1.UnimplementedTenantPackageServiceServer
 	tpc *biz.TenantPackageUsecase
+	rc  *permBiz.RoleUsecase
 	log *log.Helper
 }
 
-func NewTenantPackageService(tpc *biz.TenantPackageUsecase, logger log.Logger) *TenantPackageService {
+func NewTenantPackageService(tpc *biz.TenantPackageUsecase, rc *permBiz.RoleUsecase, logger log.Logger) *TenantPackageService {
 	return &TenantPackageService{
 		tpc: tpc,
+		rc:  rc,
 		log: log.NewHelper(log.With(logger, "module", "tenant/service/package")),
 	}
 }
@@ -96,6 +99,10 @@ func (s *TenantPackageService) UpdateTenantPackage(ctx context.Context, in *v1.U
 	if err != nil {
 		return nil, err
 	}
+	// 套餐收缩后清理各租户角色上超出套餐的菜单
+	if err = s.rc.PrunePackageMenus(ctx, pkg.ID); err != nil {
+		return nil, err
+	}
 
 	return &emptypb.Empty{}, nil
 }
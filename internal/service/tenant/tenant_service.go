@@ -4,7 +4,12 @@ import (
 	"context"
 
 	v1 "quest-admin/api/gen/tenant/v1"
+	onboardingBiz "quest-admin/internal/biz/onboarding"
+	permBiz "quest-admin/internal/biz/permission"
 	biz "quest-admin/internal/biz/tenant"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/errkey"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -15,36 +20,49 @@ type TenantService struct {
 	v1.UnimplementedTenantServiceServer
 	tc  *biz.TenantUsecase
 	tpc *biz.TenantPackageUsecase
+	rc  *permBiz.RoleUsecase
+	oc  *onboardingBiz.TenantOnboardingUsecase
 	log *log.Helper
 }
 
-func NewTenantService(tc *biz.TenantUsecase, tpc *biz.TenantPackageUsecase, logger log.Logger) *TenantService {
+func NewTenantService(tc *biz.TenantUsecase, tpc *biz.TenantPackageUsecase, rc *permBiz.RoleUsecase, oc *onboardingBiz.TenantOnboardingUsecase, logger log.Logger) *TenantService {
 	return &TenantService{
 		tc:  tc,
 		tpc: tpc,
+		rc:  rc,
+		oc:  oc,
 		log: log.NewHelper(log.With(logger, "module", "tenant/service")),
 	}
 }
 
-func (s *TenantService) CreateTenant(ctx context.Context, in *v1.CreateTenantRequest) (*emptypb.Empty, error) {
+func (s *TenantService) CreateTenant(ctx context.Context, in *v1.CreateTenantRequest) (*v1.CreateTenantReply, error) {
 	tenant := &biz.Tenant{
 		Name:          in.GetName(),
-		ContactUserID: in.GetContactUserId(),
 		ContactName:   in.GetContactName(),
 		ContactMobile: in.GetContactMobile(),
 		Website:       in.GetWebsite(),
 		PackageID:     in.GetPackageId(),
-		ExpireTime:    in.GetExpireTime().AsTime(),
 		AccountCount:  in.GetAccountCount(),
 		Status:        1,
 	}
+	if in.ExpireTime != nil {
+		tenant.ExpireTime = in.GetExpireTime().AsTime()
+	}
 
-	err := s.tc.CreateTenant(ctx, tenant)
+	credentials, err := s.oc.OnboardTenant(ctx, &onboardingBiz.OnboardTenantBO{
+		Tenant:        tenant,
+		AdminUsername: in.GetAdminUsername(),
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &emptypb.Empty{}, nil
+	return &v1.CreateTenantReply{
+		TenantId: credentials.TenantID,
+		UserId:   credentials.UserID,
+		Username: credentials.Username,
+		Password: credentials.Password,
+	}, nil
 }
 
 func (s *TenantService) GetTenant(ctx context.Context, in *v1.GetTenantRequest) (*v1.GetTenantReply, error) {
@@ -100,14 +118,32 @@ func (s *TenantService) UpdateTenant(ctx context.Context, in *v1.UpdateTenantReq
 		Status:        in.GetStatus(),
 		Website:       in.GetWebsite(),
 		PackageID:     in.GetPackageId(),
-		ExpireTime:    in.GetExpireTime().AsTime(),
 		AccountCount:  in.GetAccountCount(),
 	}
+	// 未传过期时间时保持不变
+	if in.ExpireTime != nil {
+		tenant.ExpireTime = in.GetExpireTime().AsTime()
+	}
+	// 未传状态时保持不变，状态总会写入，0 表示停用
+	if in.Status == nil {
+		current, err := s.tc.GetTenant(ctx, tenant.ID)
+		if err != nil {
+			return nil, err
+		}
+		if current == nil {
+			return nil, errorx.Err(errkey.ErrTenantNotFound)
+		}
+		tenant.Status = current.Status
+	}
 
 	err := s.tc.UpdateTenant(ctx, tenant)
 	if err != nil {
 		return nil, err
 	}
+	// 更换套餐后清理该租户角色上超出新套餐的菜单
+	if err = s.rc.PruneMenus(ctxs.WithTenantID(ctx, tenant.ID)); err != nil {
+		return nil, err
+	}
 
 	return &emptypb.Empty{}, nil
 }
@@ -2,12 +2,17 @@ package user
 
 import (
 	"context"
+	authBiz "quest-admin/internal/biz/auth"
 	"quest-admin/internal/biz/organization"
 	"quest-admin/internal/biz/permission"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/errkey"
 
 	v1 "quest-admin/api/gen/user/v1"
 	biz "quest-admin/internal/biz/user"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -15,20 +20,22 @@ import (
 
 type UserService struct {
 	v1.UnimplementedUserServiceServer
-	uc   *biz.UserUsecase
-	role *permission.RoleUsecase
-	dept *organization.DepartmentUsecase
-	post *organization.PostUsecase
-	log  *log.Helper
+	uc     *biz.UserUsecase
+	role   *permission.RoleUsecase
+	dept   *organization.DepartmentUsecase
+	post   *organization.PostUsecase
+	authUc *authBiz.AuthUsecase
+	log    *log.Helper
 }
 
-func NewUserService(uc *biz.UserUsecase, role *permission.RoleUsecase, dept *organization.DepartmentUsecase, post *organization.PostUsecase, logger log.Logger) *UserService {
+func NewUserService(uc *biz.UserUsecase, role *permission.RoleUsecase, dept *organization.DepartmentUsecase, post *organization.PostUsecase, authUc *authBiz.AuthUsecase, logger log.Logger) *UserService {
 	return &UserService{
-		uc:   uc,
-		role: role,
-		dept: dept,
-		post: post,
-		log:  log.NewHelper(log.With(logger, "module", "user/service")),
+		uc:     uc,
+		role:   role,
+		dept:   dept,
+		post:   post,
+		authUc: authUc,
+		log:    log.NewHelper(log.With(logger, "module", "user/service")),
 	}
 }
 
@@ -122,12 +129,58 @@ func (s *UserService) UpdateUser(ctx context.Context, in *v1.UpdateUserRequest)
 }
 
 func (s *UserService) ChangePassword(ctx context.Context, in *v1.ChangePasswordRequest) (*emptypb.Empty, error) {
+	if in.ConfirmPassword != nil && in.GetConfirmPassword() != in.GetNewPassword() {
+		return nil, errorx.Err(errkey.ErrPasswordConfirmMismatch)
+	}
+	// 只能修改当前登录用户的密码，旧密码错误与登录失败共用锁定计数
+	userID := ctxs.GetLoginID(ctx)
+	if userID == "" || userID == "unknown" {
+		return nil, errorx.Err(errkey.ErrUnauthorized)
+	}
+	user, err := s.uc.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, errorx.Err(errkey.ErrUserNotFound)
+	}
+	clientIP := ctxs.GetClientIP(ctx)
+	if err = s.authUc.CheckLoginLock(ctx, user.Username, clientIP); err != nil {
+		return nil, err
+	}
 	bo := &biz.UpdatePasswordBO{
-		UserID:      in.GetId(),
+		UserID:      userID,
 		OldPassword: in.GetOldPassword(),
 		NewPassword: in.GetNewPassword(),
 	}
-	err := s.uc.ChangePassword(ctx, bo)
+	err = s.uc.ChangePassword(ctx, bo)
+	if errors.Reason(err) == string(errkey.ErrPasswordNotMatch) {
+		return nil, s.passwordFailed(ctx, user.Username, clientIP, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// passwordFailed 记录一次旧密码错误，达到阈值时改为返回锁定错误
+func (s *UserService) passwordFailed(ctx context.Context, username, clientIP string, cause error) error {
+	err := s.authUc.RecordLoginFailure(ctx, username, clientIP)
+	if errors.Reason(err) == string(errkey.ErrLoginLocked) {
+		return err
+	}
+	if err != nil {
+		s.log.WithContext(ctx).Errorf("记录密码错误次数失败,username:%s,error:%v", username, err)
+	}
+	return cause
+}
+
+func (s *UserService) ResetPassword(ctx context.Context, in *v1.ResetPasswordRequest) (*emptypb.Empty, error) {
+	err := s.uc.ResetPassword(ctx, &biz.ResetPasswordBO{
+		UserID:   in.GetId(),
+		Password: in.GetPassword(),
+	})
 	if err != nil {
 		return nil, err
 	}
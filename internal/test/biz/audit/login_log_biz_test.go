@@ -0,0 +1,87 @@
+package audit_test
+
+import (
+	"context"
+	"testing"
+
+	"quest-admin/internal/biz/audit"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLoginLogRepo struct {
+	mock.Mock
+}
+
+func (m *MockLoginLogRepo) Create(ctx context.Context, loginLog *audit.LoginLog) error {
+	args := m.Called(ctx, loginLog)
+	return args.Error(0)
+}
+
+func (m *MockLoginLogRepo) List(ctx context.Context, opt *audit.WhereLoginLogOpt) ([]*audit.LoginLog, error) {
+	args := m.Called(ctx, opt)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*audit.LoginLog), args.Error(1)
+}
+
+func (m *MockLoginLogRepo) Count(ctx context.Context, opt *audit.WhereLoginLogOpt) (int64, error) {
+	args := m.Called(ctx, opt)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func TestLoginLogUsecase_ListLoginLogs(t *testing.T) {
+	ctx := context.Background()
+	status := int32(0)
+	tests := []struct {
+		name        string
+		setupMock   func(*MockLoginLogRepo)
+		query       *audit.ListLoginLogsQuery
+		expectError bool
+		expectTotal int64
+		expectPages int32
+	}{
+		{
+			name: "success",
+			setupMock: func(m *MockLoginLogRepo) {
+				m.On("List", ctx, mock.MatchedBy(func(opt *audit.WhereLoginLogOpt) bool {
+					return opt.Offset == 10 && opt.Limit == 10 && *opt.Status == 0 && opt.Username == "admin"
+				})).Return([]*audit.LoginLog{{ID: "LLOG1", Username: "admin"}}, nil)
+				m.On("Count", ctx, mock.AnythingOfType("*audit.WhereLoginLogOpt")).Return(int64(11), nil)
+			},
+			query:       &audit.ListLoginLogsQuery{Page: 2, PageSize: 10, Username: "admin", Status: &status},
+			expectTotal: 11,
+			expectPages: 2,
+		},
+		{
+			name: "list error",
+			setupMock: func(m *MockLoginLogRepo) {
+				m.On("List", ctx, mock.AnythingOfType("*audit.WhereLoginLogOpt")).Return(nil, assert.AnError)
+			},
+			query:       &audit.ListLoginLogsQuery{Page: 1, PageSize: 10},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockLoginLogRepo)
+			tt.setupMock(mockRepo)
+			uc := audit.NewLoginLogUsecase(log.DefaultLogger, mockRepo, nil)
+
+			result, err := uc.ListLoginLogs(ctx, tt.query)
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectTotal, result.Total)
+				assert.Equal(t, tt.expectPages, result.TotalPages)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package audit_test
+
+import (
+	"context"
+	"testing"
+
+	"quest-admin/internal/biz/audit"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockOperateLogRepo struct {
+	audit.OperateLogRepo
+}
+
+func TestOperateLogUsecase_RecordAfterCleanup(t *testing.T) {
+	uc, cleanup := audit.NewOperateLogUsecase(log.DefaultLogger, &MockOperateLogRepo{}, nil)
+	cleanup()
+
+	// 关闭后记录的日志直接丢弃，重复关闭同样安全
+	assert.NotPanics(t, func() {
+		uc.RecordOperateLog(context.Background(), &audit.OperateLog{Operation: "op"})
+		cleanup()
+	})
+}
@@ -0,0 +1,594 @@
+package auth_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"quest-admin/internal/biz/auth"
+	configBiz "quest-admin/internal/biz/config"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/pkg/util/totp"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockLoginGuardRepo struct {
+	mock.Mock
+}
+
+func (m *MockLoginGuardRepo) LockedFor(ctx context.Context, username, ip string) (time.Duration, error) {
+	args := m.Called(ctx, username, ip)
+	return args.Get(0).(time.Duration), args.Error(1)
+}
+
+func (m *MockLoginGuardRepo) RecordFailure(ctx context.Context, username, ip string) (time.Duration, error) {
+	args := m.Called(ctx, username, ip)
+	return args.Get(0).(time.Duration), args.Error(1)
+}
+
+func (m *MockLoginGuardRepo) NeedCaptcha(ctx context.Context, username, ip string) (bool, error) {
+	args := m.Called(ctx, username, ip)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockLoginGuardRepo) ClearFailure(ctx context.Context, username string) error {
+	args := m.Called(ctx, username)
+	return args.Error(0)
+}
+
+func (m *MockLoginGuardRepo) Unlock(ctx context.Context, username, ip string) error {
+	args := m.Called(ctx, username, ip)
+	return args.Error(0)
+}
+
+type MockCaptchaRepo struct {
+	mock.Mock
+}
+
+func (m *MockCaptchaRepo) TTL() time.Duration {
+	return 2 * time.Minute
+}
+
+func (m *MockCaptchaRepo) Save(ctx context.Context, id, code string) error {
+	args := m.Called(ctx, id, code)
+	return args.Error(0)
+}
+
+func (m *MockCaptchaRepo) Take(ctx context.Context, id string) (string, error) {
+	args := m.Called(ctx, id)
+	return args.String(0), args.Error(1)
+}
+
+type MockConfigRepo struct {
+	mock.Mock
+}
+
+func (m *MockConfigRepo) Create(ctx context.Context, config *configBiz.Config) error {
+	args := m.Called(ctx, config)
+	return args.Error(0)
+}
+
+func (m *MockConfigRepo) FindByID(ctx context.Context, id string) (*configBiz.Config, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*configBiz.Config), args.Error(1)
+}
+
+func (m *MockConfigRepo) FindByKey(ctx context.Context, key string) (*configBiz.Config, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*configBiz.Config), args.Error(1)
+}
+
+func (m *MockConfigRepo) List(ctx context.Context, opt *configBiz.WhereConfigOpt) ([]*configBiz.Config, error) {
+	args := m.Called(ctx, opt)
+	return args.Get(0).([]*configBiz.Config), args.Error(1)
+}
+
+func (m *MockConfigRepo) Count(ctx context.Context, opt *configBiz.WhereConfigOpt) (int64, error) {
+	args := m.Called(ctx, opt)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockConfigRepo) Update(ctx context.Context, config *configBiz.Config) error {
+	args := m.Called(ctx, config)
+	return args.Error(0)
+}
+
+func (m *MockConfigRepo) UpdateStatus(ctx context.Context, bo *configBiz.UpdateStatusBO) error {
+	args := m.Called(ctx, bo)
+	return args.Error(0)
+}
+
+func (m *MockConfigRepo) Delete(ctx context.Context, bo *configBiz.DeleteConfigBO) error {
+	args := m.Called(ctx, bo)
+	return args.Error(0)
+}
+
+type MockUserSecurityRepo struct {
+	mock.Mock
+}
+
+func (m *MockUserSecurityRepo) Issuer() string {
+	return "Quest Admin"
+}
+
+func (m *MockUserSecurityRepo) FindByUserID(ctx context.Context, userID string) (*auth.UserSecurity, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*auth.UserSecurity), args.Error(1)
+}
+
+func (m *MockUserSecurityRepo) Save(ctx context.Context, security *auth.UserSecurity) error {
+	args := m.Called(ctx, security)
+	return args.Error(0)
+}
+
+func (m *MockUserSecurityRepo) UseTotpStep(ctx context.Context, userID string, step int64) (bool, error) {
+	args := m.Called(ctx, userID, step)
+	return args.Bool(0), args.Error(1)
+}
+
+type MockMfaTicketRepo struct {
+	mock.Mock
+}
+
+func (m *MockMfaTicketRepo) TTL() time.Duration {
+	return 5 * time.Minute
+}
+
+func (m *MockMfaTicketRepo) Save(ctx context.Context, ticket *auth.MfaTicket) error {
+	args := m.Called(ctx, ticket)
+	return args.Error(0)
+}
+
+func (m *MockMfaTicketRepo) Find(ctx context.Context, id string) (*auth.MfaTicket, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*auth.MfaTicket), args.Error(1)
+}
+
+func (m *MockMfaTicketRepo) Fail(ctx context.Context, id string) (int64, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockMfaTicketRepo) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+type MockPasswordResetRepo struct {
+	mock.Mock
+}
+
+func (m *MockPasswordResetRepo) TTL() time.Duration {
+	return 30 * time.Minute
+}
+
+func (m *MockPasswordResetRepo) ResetURL() string {
+	return "http://127.0.0.1:3000/reset-password"
+}
+
+func (m *MockPasswordResetRepo) Save(ctx context.Context, token *auth.PasswordResetToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockPasswordResetRepo) Find(ctx context.Context, hash string) (*auth.PasswordResetToken, error) {
+	args := m.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*auth.PasswordResetToken), args.Error(1)
+}
+
+func (m *MockPasswordResetRepo) Take(ctx context.Context, hash string) (*auth.PasswordResetToken, error) {
+	args := m.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*auth.PasswordResetToken), args.Error(1)
+}
+
+func newTestUsecase(repo auth.LoginGuardRepo) *auth.AuthUsecase {
+	return auth.NewAuthUsecase(nil, log.DefaultLogger, nil, nil, nil, nil, repo, nil, nil, nil, nil, nil, nil)
+}
+
+func newTestMfaUsecase(securityRepo auth.UserSecurityRepo, ticketRepo auth.MfaTicketRepo) *auth.AuthUsecase {
+	return auth.NewAuthUsecase(nil, log.DefaultLogger, nil, nil, nil, nil, nil, nil, securityRepo, ticketRepo, nil, nil, nil)
+}
+
+func tenantIs(tenantID string) interface{} {
+	return mock.MatchedBy(func(ctx context.Context) bool { return ctxs.GetTenantID(ctx) == tenantID })
+}
+
+func TestAuthUsecase_CheckLoginLock(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name        string
+		remaining   time.Duration
+		repoErr     error
+		expectError bool
+		expectLock  bool
+	}{
+		{name: "not locked", remaining: 0},
+		{name: "locked", remaining: 90*time.Second + 200*time.Millisecond, expectError: true, expectLock: true},
+		{name: "repo error", repoErr: assert.AnError, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockLoginGuardRepo)
+			repo.On("LockedFor", ctx, "admin", "127.0.0.1").Return(tt.remaining, tt.repoErr)
+			uc := newTestUsecase(repo)
+
+			err := uc.CheckLoginLock(ctx, "admin", "127.0.0.1")
+
+			if !tt.expectError {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Error(t, err)
+			if tt.expectLock {
+				se := errors.FromError(err)
+				assert.Equal(t, string(errkey.ErrLoginLocked), se.Reason)
+				assert.Equal(t, "91", se.Metadata["remaining_seconds"])
+				assert.Contains(t, se.Message, "91")
+			}
+			repo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestAuthUsecase_RecordLoginFailure(t *testing.T) {
+	ctx := context.Background()
+
+	repo := new(MockLoginGuardRepo)
+	repo.On("RecordFailure", ctx, "admin", "127.0.0.1").Return(time.Duration(0), nil).Once()
+	uc := newTestUsecase(repo)
+	assert.NoError(t, uc.RecordLoginFailure(ctx, "admin", "127.0.0.1"))
+
+	repo.On("RecordFailure", ctx, "admin", "127.0.0.1").Return(15*time.Minute, nil).Once()
+	err := uc.RecordLoginFailure(ctx, "admin", "127.0.0.1")
+	assert.Equal(t, string(errkey.ErrLoginLocked), errors.Reason(err))
+	repo.AssertExpectations(t)
+}
+
+func TestAuthUsecase_VerifyLoginCaptcha(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "t1")
+	tests := []struct {
+		name         string
+		tenantConfig *configBiz.Config
+		globalConfig *configBiz.Config
+		needCaptcha  bool
+		captchaID    string
+		captchaCode  string
+		storedCode   string
+		expectReason errorx.ErrorKey
+	}{
+		{name: "not required"},
+		{
+			name:         "global enabled without code",
+			globalConfig: &configBiz.Config{Value: "true", Status: 1},
+			expectReason: errkey.ErrCaptchaRequired,
+		},
+		{
+			name:         "tenant disables global",
+			tenantConfig: &configBiz.Config{Value: "false", Status: 1},
+		},
+		{
+			name:         "disabled config ignored",
+			tenantConfig: &configBiz.Config{Value: "true", Status: 0},
+			globalConfig: &configBiz.Config{Value: "true", Status: 1},
+			captchaID:    "c1",
+			captchaCode:  "1234",
+			storedCode:   "1234",
+		},
+		{
+			name:        "failure threshold reached with valid code",
+			needCaptcha: true,
+			captchaID:   "c1",
+			captchaCode: " 1234 ",
+			storedCode:  "1234",
+		},
+		{
+			name:         "wrong code",
+			needCaptcha:  true,
+			captchaID:    "c1",
+			captchaCode:  "0000",
+			storedCode:   "1234",
+			expectReason: errkey.ErrCaptchaInvalid,
+		},
+		{
+			name:         "expired code",
+			needCaptcha:  true,
+			captchaID:    "c1",
+			captchaCode:  "1234",
+			expectReason: errkey.ErrCaptchaInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configRepo := new(MockConfigRepo)
+			configRepo.On("FindByKey", tenantIs("t1"), auth.CaptchaEnabledKey).Return(tt.tenantConfig, nil).Maybe()
+			configRepo.On("FindByKey", tenantIs(""), auth.CaptchaEnabledKey).Return(tt.globalConfig, nil).Maybe()
+			guardRepo := new(MockLoginGuardRepo)
+			guardRepo.On("NeedCaptcha", ctx, "admin", "127.0.0.1").Return(tt.needCaptcha, nil).Maybe()
+			captchaRepo := new(MockCaptchaRepo)
+			captchaRepo.On("Take", ctx, tt.captchaID).Return(tt.storedCode, nil).Maybe()
+			uc := auth.NewAuthUsecase(nil, log.DefaultLogger, nil, nil, nil,
+				configBiz.NewConfigUsecase(log.DefaultLogger, configRepo, nil), guardRepo, captchaRepo, nil, nil, nil, nil, nil)
+
+			err := uc.VerifyLoginCaptcha(ctx, "admin", "127.0.0.1", tt.captchaID, tt.captchaCode)
+
+			if tt.expectReason == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, string(tt.expectReason), errors.Reason(err))
+		})
+	}
+}
+
+func TestAuthUsecase_ConfirmTotpEnroll(t *testing.T) {
+	ctx := context.Background()
+	secret, err := totp.GenerateSecret()
+	assert.NoError(t, err)
+	code, err := totp.GenerateCode(secret, time.Now())
+	assert.NoError(t, err)
+
+	t.Run("not enrolling", func(t *testing.T) {
+		repo := new(MockUserSecurityRepo)
+		repo.On("FindByUserID", ctx, "u1").Return(nil, nil)
+		_, err := newTestMfaUsecase(repo, nil).ConfirmTotpEnroll(ctx, "u1", code)
+		assert.Equal(t, string(errkey.ErrMfaNotEnrolling), errors.Reason(err))
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		repo := new(MockUserSecurityRepo)
+		repo.On("FindByUserID", ctx, "u1").Return(&auth.UserSecurity{UserID: "u1", TotpSecret: secret}, nil)
+		_, err := newTestMfaUsecase(repo, nil).ConfirmTotpEnroll(ctx, "u1", "000000x")
+		assert.Equal(t, string(errkey.ErrMfaCodeInvalid), errors.Reason(err))
+		repo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+	})
+
+	t.Run("enabled with recovery codes", func(t *testing.T) {
+		repo := new(MockUserSecurityRepo)
+		repo.On("FindByUserID", ctx, "u1").Return(&auth.UserSecurity{UserID: "u1", TotpSecret: secret}, nil)
+		repo.On("Save", ctx, mock.MatchedBy(func(s *auth.UserSecurity) bool {
+			return s.TotpEnabled && s.TotpLastStep > 0 && len(s.RecoveryCodes) == 10
+		})).Return(nil)
+
+		codes, err := newTestMfaUsecase(repo, nil).ConfirmTotpEnroll(ctx, "u1", code)
+		assert.NoError(t, err)
+		assert.Len(t, codes, 10)
+		assert.Regexp(t, `^[a-z2-7]{5}-[a-z2-7]{5}$`, codes[0])
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestAuthUsecase_VerifyMfaCode(t *testing.T) {
+	ctx := context.Background()
+	secret, err := totp.GenerateSecret()
+	assert.NoError(t, err)
+	code, err := totp.GenerateCode(secret, time.Now())
+	assert.NoError(t, err)
+	ticket := &auth.MfaTicket{ID: "t1", UserID: "u1"}
+
+	t.Run("totp code", func(t *testing.T) {
+		security := &auth.UserSecurity{UserID: "u1", TotpSecret: secret, TotpEnabled: true}
+		repo := new(MockUserSecurityRepo)
+		repo.On("FindByUserID", ctx, "u1").Return(security, nil)
+		repo.On("UseTotpStep", ctx, "u1", totp.Step(time.Now())).Return(true, nil).Once()
+		tickets := new(MockMfaTicketRepo)
+		tickets.On("Delete", ctx, "t1").Return(nil)
+		uc := newTestMfaUsecase(repo, tickets)
+
+		assert.NoError(t, uc.VerifyMfaCode(ctx, ticket, code))
+		assert.Equal(t, totp.Step(time.Now()), security.TotpLastStep)
+
+		// 同一时间步的验证码不能重复使用
+		tickets.On("Fail", ctx, "t1").Return(int64(1), nil)
+		err := uc.VerifyMfaCode(ctx, ticket, code)
+		assert.Equal(t, string(errkey.ErrMfaCodeInvalid), errors.Reason(err))
+	})
+
+	t.Run("totp code used concurrently", func(t *testing.T) {
+		// 读取时时间步尚未使用，写入时已被并发请求抢先
+		repo := new(MockUserSecurityRepo)
+		repo.On("FindByUserID", ctx, "u1").Return(&auth.UserSecurity{UserID: "u1", TotpSecret: secret, TotpEnabled: true}, nil)
+		repo.On("UseTotpStep", ctx, "u1", totp.Step(time.Now())).Return(false, nil)
+		tickets := new(MockMfaTicketRepo)
+		tickets.On("Fail", ctx, "t1").Return(int64(1), nil)
+
+		err := newTestMfaUsecase(repo, tickets).VerifyMfaCode(ctx, ticket, code)
+		assert.Equal(t, string(errkey.ErrMfaCodeInvalid), errors.Reason(err))
+		tickets.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("recovery code consumed", func(t *testing.T) {
+		enroll := new(MockUserSecurityRepo)
+		enroll.On("FindByUserID", ctx, "u1").Return(&auth.UserSecurity{UserID: "u1", TotpSecret: secret}, nil)
+		var saved *auth.UserSecurity
+		enroll.On("Save", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			saved = args.Get(1).(*auth.UserSecurity)
+		}).Return(nil)
+		codes, err := newTestMfaUsecase(enroll, nil).ConfirmTotpEnroll(ctx, "u1", code)
+		assert.NoError(t, err)
+
+		repo := new(MockUserSecurityRepo)
+		repo.On("FindByUserID", ctx, "u1").Return(saved, nil)
+		repo.On("Save", ctx, saved).Return(nil)
+		tickets := new(MockMfaTicketRepo)
+		tickets.On("Delete", ctx, "t1").Return(nil)
+		tickets.On("Fail", ctx, "t1").Return(int64(mfaAttemptsForTest), nil)
+		uc := newTestMfaUsecase(repo, tickets)
+
+		assert.NoError(t, uc.VerifyMfaCode(ctx, ticket, " "+strings.ToUpper(codes[0])+" "))
+		assert.Len(t, saved.RecoveryCodes, 9)
+
+		err = uc.VerifyMfaCode(ctx, ticket, codes[0])
+		assert.Equal(t, string(errkey.ErrMfaCodeInvalid), errors.Reason(err))
+		tickets.AssertNumberOfCalls(t, "Delete", 2)
+	})
+
+	t.Run("totp disabled", func(t *testing.T) {
+		repo := new(MockUserSecurityRepo)
+		repo.On("FindByUserID", ctx, "u1").Return(&auth.UserSecurity{UserID: "u1"}, nil)
+		tickets := new(MockMfaTicketRepo)
+		tickets.On("Delete", ctx, "t1").Return(nil)
+
+		err := newTestMfaUsecase(repo, tickets).VerifyMfaCode(ctx, ticket, code)
+		assert.Equal(t, string(errkey.ErrMfaTicketInvalid), errors.Reason(err))
+	})
+}
+
+// mfaAttemptsForTest 达到单票据最大失败次数，票据应被删除
+const mfaAttemptsForTest = 5
+
+func TestAuthUsecase_RequestPasswordReset_EmptyAccount(t *testing.T) {
+	resetRepo := new(MockPasswordResetRepo)
+	uc := auth.NewAuthUsecase(nil, log.DefaultLogger, nil, nil, nil, nil, nil, nil, nil, nil, resetRepo, nil, nil)
+
+	err := uc.RequestPasswordReset(context.Background(), "  ")
+
+	assert.Equal(t, string(errkey.ErrBadRequest), errors.Reason(err))
+	resetRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestAuthUsecase_ResetPasswordWithToken_Invalid(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "t1")
+	tests := []struct {
+		name   string
+		stored *auth.PasswordResetToken
+	}{
+		{name: "not found"},
+		{name: "other tenant", stored: &auth.PasswordResetToken{UserID: "user-1", TenantID: "t2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resetRepo := new(MockPasswordResetRepo)
+			resetRepo.On("Find", ctx, mock.MatchedBy(func(hash string) bool {
+				// 只按哈希查询，不使用明文令牌
+				return len(hash) == 64 && hash != "raw-token"
+			})).Return(tt.stored, nil)
+			uc := auth.NewAuthUsecase(nil, log.DefaultLogger, nil, nil, nil, nil, nil, nil, nil, nil, resetRepo, nil, nil)
+
+			err := uc.ResetPasswordWithToken(ctx, "raw-token", "Welcome@2024")
+
+			assert.Equal(t, string(errkey.ErrPasswordResetTokenInvalid), errors.Reason(err))
+			resetRepo.AssertExpectations(t)
+			resetRepo.AssertNotCalled(t, "Take", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+type MockApiKeyRepo struct {
+	mock.Mock
+}
+
+func (m *MockApiKeyRepo) Create(ctx context.Context, key *auth.ApiKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockApiKeyRepo) FindByHash(ctx context.Context, hash string) (*auth.ApiKey, error) {
+	args := m.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*auth.ApiKey), args.Error(1)
+}
+
+func (m *MockApiKeyRepo) ListByUserID(ctx context.Context, userID string) ([]*auth.ApiKey, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*auth.ApiKey), args.Error(1)
+}
+
+func (m *MockApiKeyRepo) CountByUserID(ctx context.Context, userID string) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockApiKeyRepo) Delete(ctx context.Context, userID, id string) (bool, error) {
+	args := m.Called(ctx, userID, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockApiKeyRepo) UpdateLastUsed(ctx context.Context, id string, at time.Time) error {
+	args := m.Called(ctx, id, at)
+	return args.Error(0)
+}
+
+func TestApiKeyUsecase_CreateApiKey_Invalid(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		bo   *auth.CreateApiKeyBO
+	}{
+		{name: "empty name", bo: &auth.CreateApiKeyBO{UserID: "user-1", Name: " ", Permissions: []string{"system:user:list"}}},
+		{name: "no permissions", bo: &auth.CreateApiKeyBO{UserID: "user-1", Name: "ci", Permissions: []string{""}}},
+		{name: "expired", bo: &auth.CreateApiKeyBO{UserID: "user-1", Name: "ci", Permissions: []string{"system:user:list"}, ExpireAt: time.Now().Add(-time.Hour)}},
+		{name: "too long", bo: &auth.CreateApiKeyBO{UserID: "user-1", Name: "ci", Permissions: []string{"system:user:list"}, ExpireAt: time.Now().AddDate(2, 0, 0)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockApiKeyRepo)
+			uc := auth.NewApiKeyUsecase(log.DefaultLogger, repo, nil, nil)
+
+			_, secret, err := uc.CreateApiKey(ctx, tt.bo)
+
+			assert.Equal(t, string(errkey.ErrBadRequest), errors.Reason(err))
+			assert.Empty(t, secret)
+			repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestApiKeyUsecase_ResolveApiKey_Invalid(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name   string
+		secret string
+		stored *auth.ApiKey
+	}{
+		{name: "not an api key", secret: "session-token"},
+		{name: "not found", secret: auth.ApiKeyPrefix + "unknown"},
+		{name: "expired", secret: auth.ApiKeyPrefix + "expired", stored: &auth.ApiKey{ID: "key-1", UserID: "user-1", ExpireAt: time.Now().Add(-time.Minute)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockApiKeyRepo)
+			repo.On("FindByHash", ctx, mock.MatchedBy(func(hash string) bool {
+				return len(hash) == 64 && !strings.HasPrefix(hash, auth.ApiKeyPrefix)
+			})).Return(tt.stored, nil).Maybe()
+			uc := auth.NewApiKeyUsecase(log.DefaultLogger, repo, nil, nil)
+
+			principal, err := uc.ResolveApiKey(ctx, tt.secret)
+
+			assert.Nil(t, principal)
+			assert.Equal(t, string(errkey.ErrApiKeyInvalid), errors.Reason(err))
+			repo.AssertNotCalled(t, "UpdateLastUsed", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
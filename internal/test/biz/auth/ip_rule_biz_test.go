@@ -0,0 +1,177 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"quest-admin/internal/biz/auth"
+	"quest-admin/internal/biz/user"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockIpRuleRepo 内存实现
+type MockIpRuleRepo struct {
+	rules []*auth.IpRule
+}
+
+func (m *MockIpRuleRepo) Create(ctx context.Context, rule *auth.IpRule) error {
+	m.rules = append(m.rules, rule)
+	return nil
+}
+
+func (m *MockIpRuleRepo) Update(ctx context.Context, rule *auth.IpRule) error {
+	for i, item := range m.rules {
+		if item.ID == rule.ID {
+			m.rules[i] = rule
+		}
+	}
+	return nil
+}
+
+func (m *MockIpRuleRepo) Delete(ctx context.Context, id string) error {
+	for i, item := range m.rules {
+		if item.ID == id {
+			m.rules = append(m.rules[:i], m.rules[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+func (m *MockIpRuleRepo) FindByID(ctx context.Context, id string) (*auth.IpRule, error) {
+	for _, item := range m.rules {
+		if item.ID == id {
+			copied := *item
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockIpRuleRepo) List(ctx context.Context, query *auth.ListIpRulesQuery) ([]*auth.IpRule, error) {
+	var rules []*auth.IpRule
+	for _, item := range m.rules {
+		if query.Status != nil && item.Status != *query.Status {
+			continue
+		}
+		rules = append(rules, item)
+	}
+	return rules, nil
+}
+
+type MockUserRoleRepo struct {
+	user.UserRoleRepo
+	mock.Mock
+}
+
+func (m *MockUserRoleRepo) GetUserRoles(ctx context.Context, userID string) ([]*user.UserRole, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*user.UserRole), args.Error(1)
+}
+
+func newIpRuleUsecase(repo auth.IpRuleRepo) *auth.IpRuleUsecase {
+	roleRepo := new(MockUserRoleRepo)
+	roleRepo.On("GetUserRoles", mock.Anything, "U1").Return([]*user.UserRole{{UserID: "U1", RoleID: "R1"}}, nil)
+	roleRepo.On("GetUserRoles", mock.Anything, mock.Anything).Return([]*user.UserRole{}, nil)
+	userUc := user.NewUserUsecase(log.DefaultLogger, nil, nil, nil, nil, nil, roleRepo, nil, nil, nil)
+	authUc := auth.NewAuthUsecase(nil, log.DefaultLogger, userUc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	return auth.NewIpRuleUsecase(log.DefaultLogger, repo, nil, authUc, nil)
+}
+
+func TestIpRuleUsecase_CheckAccess(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "T1")
+	tenant := &auth.IpRule{ID: "IP1", Scope: auth.IpRuleScopeTenant, Cidr: "10.0.0.0/8", Status: 1}
+	role := &auth.IpRule{ID: "IP2", Scope: auth.IpRuleScopeRole, TargetID: "R1", Cidr: "10.1.0.0/16", Status: 1}
+	user1 := &auth.IpRule{ID: "IP3", Scope: auth.IpRuleScopeUser, TargetID: "U1", Cidr: "10.1.2.3/32", Status: 1}
+	user1Alt := &auth.IpRule{ID: "IP4", Scope: auth.IpRuleScopeUser, TargetID: "U1", Cidr: "192.168.0.0/24", Status: 1}
+	disabled := &auth.IpRule{ID: "IP5", Scope: auth.IpRuleScopeTenant, Cidr: "172.16.0.0/12", Status: 0}
+
+	tests := []struct {
+		name    string
+		rules   []*auth.IpRule
+		userID  string
+		ip      string
+		allowed bool
+	}{
+		{name: "无规则", userID: "U1", ip: "203.0.113.1", allowed: true},
+		{name: "命中租户规则", rules: []*auth.IpRule{tenant}, userID: "U2", ip: "10.9.9.9", allowed: true},
+		{name: "未命中租户规则", rules: []*auth.IpRule{tenant}, userID: "U2", ip: "203.0.113.1"},
+		{name: "停用规则不生效", rules: []*auth.IpRule{disabled}, userID: "U2", ip: "203.0.113.1", allowed: true},
+		{name: "角色规则只约束拥有角色的用户", rules: []*auth.IpRule{tenant, role}, userID: "U2", ip: "10.9.9.9", allowed: true},
+		{name: "未命中角色规则", rules: []*auth.IpRule{tenant, role}, userID: "U1", ip: "10.9.9.9"},
+		{name: "各层级均命中", rules: []*auth.IpRule{tenant, role, user1}, userID: "U1", ip: "10.1.2.3", allowed: true},
+		{name: "同一层级命中任一规则", rules: []*auth.IpRule{user1, user1Alt}, userID: "U1", ip: "192.168.0.8", allowed: true},
+		{name: "未命中用户规则", rules: []*auth.IpRule{tenant, role, user1}, userID: "U1", ip: "10.1.2.4"},
+		{name: "IPv4映射地址", rules: []*auth.IpRule{tenant}, userID: "U2", ip: "::ffff:10.0.0.1", allowed: true},
+		{name: "无效IP", rules: []*auth.IpRule{tenant}, userID: "U2", ip: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := newIpRuleUsecase(&MockIpRuleRepo{rules: tt.rules})
+			err := uc.CheckAccess(ctx, tt.userID, tt.ip)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, string(errkey.ErrIpNotAllowed), errors.Reason(err))
+			}
+		})
+	}
+}
+
+func TestIpRuleUsecase_SelfLockout(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "T1")
+	ctx = context.WithValue(ctx, ctxs.LoginIDKey, "U1")
+	ctx = ctxs.WithClientIP(ctx, "10.1.2.3")
+
+	t.Run("收紧网段后排除当前IP", func(t *testing.T) {
+		repo := &MockIpRuleRepo{rules: []*auth.IpRule{
+			{ID: "IP1", Scope: auth.IpRuleScopeTenant, Cidr: "10.0.0.0/8", Status: 1},
+		}}
+		uc := newIpRuleUsecase(repo)
+		_, err := uc.UpdateIpRule(ctx, &auth.IpRule{ID: "IP1", Cidr: "192.168.0.0/16", Status: 1})
+		assert.Equal(t, string(errkey.ErrIpRuleSelfLockout), errors.Reason(err))
+		assert.Equal(t, "10.0.0.0/8", repo.rules[0].Cidr)
+
+		rule, err := uc.UpdateIpRule(ctx, &auth.IpRule{ID: "IP1", Cidr: "10.1.9.9/16", Status: 1})
+		assert.NoError(t, err)
+		assert.Equal(t, "10.1.0.0/16", rule.Cidr)
+		assert.Equal(t, auth.IpRuleScopeTenant, rule.Scope)
+	})
+
+	t.Run("删除同层级唯一命中的规则", func(t *testing.T) {
+		repo := &MockIpRuleRepo{rules: []*auth.IpRule{
+			{ID: "IP1", Scope: auth.IpRuleScopeRole, TargetID: "R1", Cidr: "10.1.0.0/16", Status: 1},
+			{ID: "IP2", Scope: auth.IpRuleScopeRole, TargetID: "R1", Cidr: "192.168.0.0/16", Status: 1},
+		}}
+		uc := newIpRuleUsecase(repo)
+		err := uc.DeleteIpRule(ctx, "IP1")
+		assert.Equal(t, string(errkey.ErrIpRuleSelfLockout), errors.Reason(err))
+
+		assert.NoError(t, uc.DeleteIpRule(ctx, "IP2"))
+		assert.Len(t, repo.rules, 1)
+	})
+
+	t.Run("规则不存在", func(t *testing.T) {
+		uc := newIpRuleUsecase(&MockIpRuleRepo{})
+		err := uc.DeleteIpRule(ctx, "IP9")
+		assert.Equal(t, string(errkey.ErrIpRuleNotFound), errors.Reason(err))
+
+		_, err = uc.UpdateIpRule(ctx, &auth.IpRule{ID: "IP9", Cidr: "10.0.0.0/8", Status: 1})
+		assert.Equal(t, string(errkey.ErrIpRuleNotFound), errors.Reason(err))
+	})
+
+	t.Run("网段无效", func(t *testing.T) {
+		repo := &MockIpRuleRepo{rules: []*auth.IpRule{
+			{ID: "IP1", Scope: auth.IpRuleScopeTenant, Cidr: "10.0.0.0/8", Status: 1},
+		}}
+		uc := newIpRuleUsecase(repo)
+		_, err := uc.UpdateIpRule(ctx, &auth.IpRule{ID: "IP1", Cidr: "10.0.0.0/40", Status: 1})
+		assert.Equal(t, string(errkey.ErrIpRuleInvalid), errors.Reason(err))
+	})
+}
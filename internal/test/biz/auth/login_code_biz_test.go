@@ -0,0 +1,229 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"quest-admin/internal/biz/auth"
+	"quest-admin/internal/biz/user"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/sms"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockLoginCodeRepo 内存实现，发送额度由测试用例指定
+type MockLoginCodeRepo struct {
+	quota *auth.LoginCodeQuota
+	codes map[string]*auth.LoginCode
+	fails map[string]int64
+}
+
+func newMockLoginCodeRepo() *MockLoginCodeRepo {
+	return &MockLoginCodeRepo{
+		quota: &auth.LoginCodeQuota{TargetCount: 1, IPCount: 1},
+		codes: map[string]*auth.LoginCode{},
+		fails: map[string]int64{},
+	}
+}
+
+func (m *MockLoginCodeRepo) TTL() time.Duration {
+	return 5 * time.Minute
+}
+
+func (m *MockLoginCodeRepo) Policy() *auth.LoginCodePolicy {
+	return &auth.LoginCodePolicy{Length: 6, SendInterval: time.Minute, DailyLimit: 10, IPDailyLimit: 50}
+}
+
+func (m *MockLoginCodeRepo) Reserve(ctx context.Context, channel, target, ip string) (*auth.LoginCodeQuota, error) {
+	return m.quota, nil
+}
+
+func (m *MockLoginCodeRepo) Save(ctx context.Context, code *auth.LoginCode) error {
+	m.codes[code.Channel+":"+code.Target] = code
+	m.fails[code.Channel+":"+code.Target] = 0
+	return nil
+}
+
+func (m *MockLoginCodeRepo) Find(ctx context.Context, channel, target string) (*auth.LoginCode, error) {
+	return m.codes[channel+":"+target], nil
+}
+
+func (m *MockLoginCodeRepo) Fail(ctx context.Context, channel, target string) (int64, error) {
+	m.fails[channel+":"+target]++
+	return m.fails[channel+":"+target], nil
+}
+
+func (m *MockLoginCodeRepo) Delete(ctx context.Context, channel, target string) error {
+	delete(m.codes, channel+":"+target)
+	delete(m.fails, channel+":"+target)
+	return nil
+}
+
+// MockSmsSender 记录发送的短信
+type MockSmsSender struct {
+	sent chan *sms.Message
+}
+
+func (m *MockSmsSender) Send(ctx context.Context, msg *sms.Message) error {
+	m.sent <- msg
+	return nil
+}
+
+// MockLoginCodeUserRepo 只实现验证码登录用到的查询
+type MockLoginCodeUserRepo struct {
+	user.UserRepo
+	mock.Mock
+}
+
+func (m *MockLoginCodeUserRepo) FindByID(ctx context.Context, id string) (*user.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockLoginCodeUserRepo) FindByMobile(ctx context.Context, mobile string) (*user.User, error) {
+	args := m.Called(ctx, mobile)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockLoginCodeUserRepo) FindByEmail(ctx context.Context, email string) (*user.User, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func newLoginCodeUsecase(repo auth.LoginCodeRepo, userRepo user.UserRepo, sender sms.Sender) *auth.LoginCodeUsecase {
+	userUc := user.NewUserUsecase(log.DefaultLogger, userRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+	authUc := auth.NewAuthUsecase(nil, log.DefaultLogger, userUc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	return auth.NewLoginCodeUsecase(log.DefaultLogger, repo, sender, authUc)
+}
+
+func receiveCode(t *testing.T, sender *MockSmsSender) string {
+	select {
+	case msg := <-sender.sent:
+		assert.Equal(t, "13800138000", msg.Mobile)
+		return msg.Content[len("您的登录验证码为 ") : len("您的登录验证码为 ")+6]
+	case <-time.After(time.Second):
+		t.Fatal("验证码未发送")
+		return ""
+	}
+}
+
+func TestLoginCodeUsecase_SendLoginCode(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "T1")
+	alice := &user.User{ID: "U1", Username: "alice", Mobile: "13800138000", Status: 1}
+
+	tests := []struct {
+		name      string
+		channel   string
+		target    string
+		quota     *auth.LoginCodeQuota
+		user      *user.User
+		wantErr   errorx.ErrorKey
+		wantSaved bool
+	}{
+		{name: "发送成功", channel: auth.LoginCodeChannelSms, target: " 13800138000 ", user: alice, wantSaved: true},
+		{name: "不支持的渠道", channel: "wechat", target: "13800138000", wantErr: errkey.ErrLoginCodeChannelInvalid},
+		{name: "手机号格式错误", channel: auth.LoginCodeChannelSms, target: "123", wantErr: errkey.ErrBadRequest},
+		{name: "发送间隔内", channel: auth.LoginCodeChannelSms, target: "13800138000", quota: &auth.LoginCodeQuota{Wait: 30 * time.Second}, wantErr: errkey.ErrLoginCodeTooFrequent},
+		{name: "超过当天次数", channel: auth.LoginCodeChannelSms, target: "13800138000", quota: &auth.LoginCodeQuota{TargetCount: 11, IPCount: 11}, wantErr: errkey.ErrLoginCodeLimitExceeded},
+		{name: "账号不存在时同样成功", channel: auth.LoginCodeChannelSms, target: "13800138000"},
+		{name: "禁用账号不发送", channel: auth.LoginCodeChannelSms, target: "13800138000", user: &user.User{ID: "U2", Status: 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockLoginCodeRepo()
+			if tt.quota != nil {
+				repo.quota = tt.quota
+			}
+			userRepo := new(MockLoginCodeUserRepo)
+			if tt.user != nil {
+				userRepo.On("FindByMobile", mock.Anything, "13800138000").Return(tt.user, nil)
+			} else {
+				userRepo.On("FindByMobile", mock.Anything, "13800138000").Return(nil, nil)
+			}
+			sender := &MockSmsSender{sent: make(chan *sms.Message, 1)}
+			uc := newLoginCodeUsecase(repo, userRepo, sender)
+
+			sent, err := uc.SendLoginCode(ctx, tt.channel, tt.target)
+			if tt.wantErr != "" {
+				assert.Equal(t, string(tt.wantErr), errors.Reason(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, int64(300), sent.ExpiresIn)
+			assert.Equal(t, int64(60), sent.RetryAfter)
+			assert.Equal(t, tt.wantSaved, len(repo.codes) == 1)
+			if tt.wantSaved {
+				code := receiveCode(t, sender)
+				assert.Len(t, code, 6)
+				assert.NotContains(t, repo.codes["sms:13800138000"].Hash, code)
+			}
+		})
+	}
+}
+
+func TestLoginCodeUsecase_VerifyLoginCode(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "T1")
+	alice := &user.User{ID: "U1", Username: "alice", Mobile: "13800138000", Status: 1}
+
+	setup := func(t *testing.T) (*auth.LoginCodeUsecase, *MockLoginCodeRepo, string) {
+		repo := newMockLoginCodeRepo()
+		userRepo := new(MockLoginCodeUserRepo)
+		userRepo.On("FindByMobile", mock.Anything, "13800138000").Return(alice, nil)
+		userRepo.On("FindByID", mock.Anything, "U1").Return(alice, nil)
+		sender := &MockSmsSender{sent: make(chan *sms.Message, 1)}
+		uc := newLoginCodeUsecase(repo, userRepo, sender)
+		_, err := uc.SendLoginCode(ctx, auth.LoginCodeChannelSms, "13800138000")
+		assert.NoError(t, err)
+		return uc, repo, receiveCode(t, sender)
+	}
+
+	t.Run("验证码正确", func(t *testing.T) {
+		uc, repo, code := setup(t)
+		u, err := uc.VerifyLoginCode(ctx, auth.LoginCodeChannelSms, "13800138000", code)
+		assert.NoError(t, err)
+		assert.Equal(t, "U1", u.ID)
+		assert.Empty(t, repo.codes)
+
+		// 验证码只能使用一次
+		_, err = uc.VerifyLoginCode(ctx, auth.LoginCodeChannelSms, "13800138000", code)
+		assert.Equal(t, string(errkey.ErrLoginCodeInvalid), errors.Reason(err))
+	})
+
+	t.Run("其他租户不可用", func(t *testing.T) {
+		uc, _, code := setup(t)
+		_, err := uc.VerifyLoginCode(ctxs.WithTenantID(context.Background(), "T2"), auth.LoginCodeChannelSms, "13800138000", code)
+		assert.Equal(t, string(errkey.ErrLoginCodeInvalid), errors.Reason(err))
+	})
+
+	t.Run("错误次数达到上限后作废", func(t *testing.T) {
+		uc, repo, code := setup(t)
+		wrong := "000000"
+		if code == wrong {
+			wrong = "111111"
+		}
+		for i := 0; i < 5; i++ {
+			_, err := uc.VerifyLoginCode(ctx, auth.LoginCodeChannelSms, "13800138000", wrong)
+			assert.Equal(t, string(errkey.ErrLoginCodeInvalid), errors.Reason(err))
+		}
+		assert.Empty(t, repo.codes)
+
+		_, err := uc.VerifyLoginCode(ctx, auth.LoginCodeChannelSms, "13800138000", code)
+		assert.Equal(t, string(errkey.ErrLoginCodeInvalid), errors.Reason(err))
+	})
+}
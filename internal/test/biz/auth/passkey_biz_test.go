@@ -0,0 +1,384 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"quest-admin/internal/biz/auth"
+	"quest-admin/internal/biz/user"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testRPID   = "localhost"
+	testOrigin = "http://localhost:3000"
+)
+
+type MockPasskeyRepo struct {
+	mock.Mock
+}
+
+func (m *MockPasskeyRepo) RelyingParty() *auth.RelyingParty {
+	return &auth.RelyingParty{ID: testRPID, Name: "Quest Admin", Origins: []string{testOrigin}}
+}
+
+func (m *MockPasskeyRepo) Create(ctx context.Context, passkey *auth.Passkey) error {
+	args := m.Called(ctx, passkey)
+	return args.Error(0)
+}
+
+func (m *MockPasskeyRepo) ListByUserID(ctx context.Context, userID string) ([]*auth.Passkey, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*auth.Passkey), args.Error(1)
+}
+
+func (m *MockPasskeyRepo) FindByCredentialID(ctx context.Context, credentialID []byte) (*auth.Passkey, error) {
+	args := m.Called(ctx, credentialID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*auth.Passkey), args.Error(1)
+}
+
+func (m *MockPasskeyRepo) Rename(ctx context.Context, userID, id, name string) (bool, error) {
+	args := m.Called(ctx, userID, id, name)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockPasskeyRepo) Delete(ctx context.Context, userID, id string) (bool, error) {
+	args := m.Called(ctx, userID, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockPasskeyRepo) UpdateUsage(ctx context.Context, passkey *auth.Passkey) error {
+	args := m.Called(ctx, passkey)
+	return args.Error(0)
+}
+
+// MockPasskeySessionRepo 内存实现，Take 后挑战即失效
+type MockPasskeySessionRepo struct {
+	sessions map[string]*auth.PasskeySession
+}
+
+func (m *MockPasskeySessionRepo) TTL() time.Duration {
+	return 5 * time.Minute
+}
+
+func (m *MockPasskeySessionRepo) Save(ctx context.Context, session *auth.PasskeySession) error {
+	m.sessions[session.ID] = session
+	return nil
+}
+
+func (m *MockPasskeySessionRepo) Take(ctx context.Context, id string) (*auth.PasskeySession, error) {
+	session := m.sessions[id]
+	delete(m.sessions, id)
+	return session, nil
+}
+
+// softAuthenticator 软件认证器，生成 none 证明和 ES256 断言
+type softAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialID []byte
+	signCount    uint32
+}
+
+func newSoftAuthenticator(t *testing.T) *softAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	credentialID := make([]byte, 16)
+	_, _ = rand.Read(credentialID)
+	return &softAuthenticator{key: key, credentialID: credentialID}
+}
+
+func (a *softAuthenticator) publicKey(t *testing.T) []byte {
+	raw, err := webauthncbor.Marshal(&webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  1,
+		XCoord: a.key.X.FillBytes(make([]byte, 32)),
+		YCoord: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	assert.NoError(t, err)
+	return raw
+}
+
+func (a *softAuthenticator) passkey(t *testing.T, userID string) *auth.Passkey {
+	return &auth.Passkey{ID: "PKEY1", UserID: userID, CredentialID: a.credentialID, PublicKey: a.publicKey(t), TenantID: "T1"}
+}
+
+func (a *softAuthenticator) authData(flags byte, attested []byte) []byte {
+	rpHash := sha256.Sum256([]byte(testRPID))
+	data := append(rpHash[:], flags)
+	data = binary.BigEndian.AppendUint32(data, a.signCount)
+	return append(data, attested...)
+}
+
+func (a *softAuthenticator) create(t *testing.T, options string) string {
+	clientData := clientDataJSON(t, "webauthn.create", options)
+	attested := make([]byte, 16)
+	attested = binary.BigEndian.AppendUint16(attested, uint16(len(a.credentialID)))
+	attested = append(attested, a.credentialID...)
+	attested = append(attested, a.publicKey(t)...)
+	attestation, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      "none",
+		"attStmt":  map[string]any{},
+		"authData": a.authData(0x45, attested),
+	})
+	assert.NoError(t, err)
+	return credentialJSON(t, a.credentialID, map[string]string{
+		"clientDataJSON":    b64(clientData),
+		"attestationObject": b64(attestation),
+	})
+}
+
+func (a *softAuthenticator) get(t *testing.T, options, userID string) string {
+	a.signCount++
+	clientData := clientDataJSON(t, "webauthn.get", options)
+	authData := a.authData(0x05, nil)
+	hash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), hash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	assert.NoError(t, err)
+	return credentialJSON(t, a.credentialID, map[string]string{
+		"clientDataJSON":    b64(clientData),
+		"authenticatorData": b64(authData),
+		"signature":         b64(signature),
+		"userHandle":        b64([]byte(userID)),
+	})
+}
+
+func clientDataJSON(t *testing.T, typ, options string) []byte {
+	var parsed struct {
+		PublicKey struct {
+			Challenge string `json:"challenge"`
+		} `json:"publicKey"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(options), &parsed))
+	raw, err := json.Marshal(map[string]string{"type": typ, "challenge": parsed.PublicKey.Challenge, "origin": testOrigin})
+	assert.NoError(t, err)
+	return raw
+}
+
+func credentialJSON(t *testing.T, credentialID []byte, response map[string]string) string {
+	raw, err := json.Marshal(map[string]any{
+		"id":       b64(credentialID),
+		"rawId":    b64(credentialID),
+		"type":     "public-key",
+		"response": response,
+	})
+	assert.NoError(t, err)
+	return string(raw)
+}
+
+func b64(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func newTestPasskeyUsecase(repo *MockPasskeyRepo, ticketRepo auth.MfaTicketRepo) *auth.PasskeyUsecase {
+	authUc := newTestMfaUsecase(nil, ticketRepo)
+	sessionRepo := &MockPasskeySessionRepo{sessions: map[string]*auth.PasskeySession{}}
+	return auth.NewPasskeyUsecase(log.DefaultLogger, repo, sessionRepo, nil, authUc)
+}
+
+func TestPasskeyUsecase_Registration(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "T1")
+	alice := &user.User{ID: "U1", Username: "alice"}
+	authenticator := newSoftAuthenticator(t)
+
+	t.Run("凭证已注册", func(t *testing.T) {
+		repo := &MockPasskeyRepo{}
+		repo.On("ListByUserID", mock.Anything, "U1").Return([]*auth.Passkey{}, nil)
+		repo.On("FindByCredentialID", mock.Anything, authenticator.credentialID).Return(authenticator.passkey(t, "U1"), nil)
+		uc := newTestPasskeyUsecase(repo, nil)
+
+		ceremony, err := uc.BeginRegistration(ctx, alice)
+		assert.NoError(t, err)
+		_, err = uc.FinishRegistration(ctx, alice, ceremony.SessionID, "", authenticator.create(t, ceremony.Options))
+		assert.Equal(t, string(errkey.ErrPasskeyExists), errors.Reason(err))
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("挑战只能使用一次", func(t *testing.T) {
+		repo := &MockPasskeyRepo{}
+		repo.On("ListByUserID", mock.Anything, "U1").Return([]*auth.Passkey{}, nil)
+		uc := newTestPasskeyUsecase(repo, nil)
+
+		ceremony, err := uc.BeginRegistration(ctx, alice)
+		assert.NoError(t, err)
+		_, err = uc.FinishRegistration(ctx, alice, ceremony.SessionID, "", `{}`)
+		assert.Equal(t, string(errkey.ErrPasskeyInvalid), errors.Reason(err))
+		_, err = uc.FinishRegistration(ctx, alice, ceremony.SessionID, "", authenticator.create(t, ceremony.Options))
+		assert.Equal(t, string(errkey.ErrPasskeySessionInvalid), errors.Reason(err))
+	})
+
+	t.Run("其他用户的挑战", func(t *testing.T) {
+		repo := &MockPasskeyRepo{}
+		repo.On("ListByUserID", mock.Anything, "U1").Return([]*auth.Passkey{}, nil)
+		uc := newTestPasskeyUsecase(repo, nil)
+
+		ceremony, err := uc.BeginRegistration(ctx, alice)
+		assert.NoError(t, err)
+		_, err = uc.FinishRegistration(ctx, &user.User{ID: "U2", Username: "bob"}, ceremony.SessionID, "", authenticator.create(t, ceremony.Options))
+		assert.Equal(t, string(errkey.ErrPasskeySessionInvalid), errors.Reason(err))
+	})
+
+	t.Run("超过数量上限", func(t *testing.T) {
+		passkeys := make([]*auth.Passkey, 10)
+		for i := range passkeys {
+			passkeys[i] = newSoftAuthenticator(t).passkey(t, "U1")
+		}
+		repo := &MockPasskeyRepo{}
+		repo.On("ListByUserID", mock.Anything, "U1").Return(passkeys, nil)
+		uc := newTestPasskeyUsecase(repo, nil)
+
+		_, err := uc.BeginRegistration(ctx, alice)
+		assert.Equal(t, string(errkey.ErrPasskeyLimitExceeded), errors.Reason(err))
+	})
+}
+
+func TestPasskeyUsecase_PasswordlessLogin(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "T1")
+	authenticator := newSoftAuthenticator(t)
+	stored := authenticator.passkey(t, "U1")
+
+	repo := &MockPasskeyRepo{}
+	repo.On("FindByCredentialID", mock.Anything, authenticator.credentialID).Return(stored, nil)
+	repo.On("UpdateUsage", mock.Anything, stored).Return(nil)
+	uc := newTestPasskeyUsecase(repo, nil)
+
+	t.Run("登录成功", func(t *testing.T) {
+		ceremony, err := uc.BeginLogin(ctx, nil)
+		assert.NoError(t, err)
+		session, err := uc.TakeLoginSession(ctx, ceremony.SessionID)
+		assert.NoError(t, err)
+		userID, err := uc.VerifyLogin(ctx, session, authenticator.get(t, ceremony.Options, "U1"))
+		assert.NoError(t, err)
+		assert.Equal(t, "U1", userID)
+		assert.Equal(t, authenticator.signCount, stored.SignCount)
+		assert.False(t, stored.LastUsedAt.IsZero())
+
+		_, err = uc.TakeLoginSession(ctx, ceremony.SessionID)
+		assert.Equal(t, string(errkey.ErrPasskeySessionInvalid), errors.Reason(err))
+	})
+
+	t.Run("用户句柄不匹配", func(t *testing.T) {
+		ceremony, err := uc.BeginLogin(ctx, nil)
+		assert.NoError(t, err)
+		session, err := uc.TakeLoginSession(ctx, ceremony.SessionID)
+		assert.NoError(t, err)
+		_, err = uc.VerifyLogin(ctx, session, authenticator.get(t, ceremony.Options, "U2"))
+		assert.Equal(t, string(errkey.ErrPasskeyInvalid), errors.Reason(err))
+	})
+
+	t.Run("签名计数回退", func(t *testing.T) {
+		ceremony, err := uc.BeginLogin(ctx, nil)
+		assert.NoError(t, err)
+		session, err := uc.TakeLoginSession(ctx, ceremony.SessionID)
+		assert.NoError(t, err)
+		stored.SignCount = authenticator.signCount + 10
+		_, err = uc.VerifyLogin(ctx, session, authenticator.get(t, ceremony.Options, "U1"))
+		assert.Equal(t, string(errkey.ErrPasskeyInvalid), errors.Reason(err))
+	})
+
+	t.Run("其他租户的挑战", func(t *testing.T) {
+		ceremony, err := uc.BeginLogin(ctx, nil)
+		assert.NoError(t, err)
+		_, err = uc.TakeLoginSession(ctxs.WithTenantID(context.Background(), "T2"), ceremony.SessionID)
+		assert.Equal(t, string(errkey.ErrPasskeySessionInvalid), errors.Reason(err))
+	})
+}
+
+func TestPasskeyUsecase_SecondFactor(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "T1")
+	ticket := &auth.MfaTicket{ID: "ticket", UserID: "U1", Username: "alice", TenantID: "T1"}
+	authenticator := newSoftAuthenticator(t)
+
+	newUsecase := func(ticketRepo *MockMfaTicketRepo) (*auth.PasskeyUsecase, *MockPasskeyRepo) {
+		repo := &MockPasskeyRepo{}
+		repo.On("ListByUserID", mock.Anything, "U1").Return([]*auth.Passkey{authenticator.passkey(t, "U1")}, nil)
+		repo.On("UpdateUsage", mock.Anything, mock.Anything).Return(nil)
+		return newTestPasskeyUsecase(repo, ticketRepo), repo
+	}
+
+	t.Run("验证通过后票据失效", func(t *testing.T) {
+		ticketRepo := &MockMfaTicketRepo{}
+		ticketRepo.On("Delete", mock.Anything, "ticket").Return(nil)
+		uc, repo := newUsecase(ticketRepo)
+
+		ceremony, err := uc.BeginLogin(ctx, ticket)
+		assert.NoError(t, err)
+		session, err := uc.TakeLoginSession(ctx, ceremony.SessionID)
+		assert.NoError(t, err)
+		err = uc.VerifyMfaPasskey(ctx, ticket, session, authenticator.get(t, ceremony.Options, "U1"))
+		assert.NoError(t, err)
+		ticketRepo.AssertExpectations(t)
+		repo.AssertCalled(t, "UpdateUsage", mock.Anything, mock.Anything)
+	})
+
+	t.Run("验证失败计入票据失败次数", func(t *testing.T) {
+		ticketRepo := &MockMfaTicketRepo{}
+		ticketRepo.On("Fail", mock.Anything, "ticket").Return(int64(1), nil)
+		uc, _ := newUsecase(ticketRepo)
+
+		ceremony, err := uc.BeginLogin(ctx, ticket)
+		assert.NoError(t, err)
+		session, err := uc.TakeLoginSession(ctx, ceremony.SessionID)
+		assert.NoError(t, err)
+		other := newSoftAuthenticator(t)
+		other.credentialID = authenticator.credentialID
+		err = uc.VerifyMfaPasskey(ctx, ticket, session, other.get(t, ceremony.Options, "U1"))
+		assert.Equal(t, string(errkey.ErrPasskeyInvalid), errors.Reason(err))
+		ticketRepo.AssertExpectations(t)
+		ticketRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("未绑定通行密钥", func(t *testing.T) {
+		repo := &MockPasskeyRepo{}
+		repo.On("ListByUserID", mock.Anything, "U1").Return([]*auth.Passkey{}, nil)
+		uc := newTestPasskeyUsecase(repo, &MockMfaTicketRepo{})
+
+		_, err := uc.BeginLogin(ctx, ticket)
+		assert.Equal(t, string(errkey.ErrPasskeyNotFound), errors.Reason(err))
+	})
+
+	t.Run("免密登录的挑战不能用于二次验证", func(t *testing.T) {
+		uc, _ := newUsecase(&MockMfaTicketRepo{})
+		ceremony, err := uc.BeginLogin(ctx, nil)
+		assert.NoError(t, err)
+		session, err := uc.TakeLoginSession(ctx, ceremony.SessionID)
+		assert.NoError(t, err)
+		err = uc.VerifyMfaPasskey(ctx, ticket, session, authenticator.get(t, ceremony.Options, "U1"))
+		assert.Equal(t, string(errkey.ErrPasskeySessionInvalid), errors.Reason(err))
+	})
+}
+
+func TestMfaStatus_Methods(t *testing.T) {
+	status := &auth.MfaStatus{}
+	assert.False(t, status.Enabled())
+	assert.Empty(t, status.Methods())
+
+	status.PasskeyCount = 1
+	assert.True(t, status.Enabled())
+	assert.Equal(t, []string{auth.MfaMethodPasskey}, status.Methods())
+
+	status.TotpEnabled = true
+	assert.Equal(t, []string{auth.MfaMethodTotp, auth.MfaMethodPasskey}, status.Methods())
+}
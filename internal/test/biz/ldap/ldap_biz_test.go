@@ -0,0 +1,380 @@
+package ldap_test
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"quest-admin/internal/biz/ldap"
+	"quest-admin/internal/biz/user"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/errkey"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testBindDN   = "cn=admin,dc=example,dc=com"
+	testBindPwd  = "admin-secret"
+	testAliceDN  = "uid=alice,ou=dev,dc=example,dc=com"
+	testAlicePwd = "alice-secret"
+)
+
+type MockConfigRepo struct {
+	mock.Mock
+}
+
+func (m *MockConfigRepo) Options() *ldap.Options {
+	return &ldap.Options{}
+}
+
+func (m *MockConfigRepo) Find(ctx context.Context) (*ldap.Config, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(*ldap.Config), args.Error(1)
+}
+
+func (m *MockConfigRepo) Save(ctx context.Context, cfg *ldap.Config) error {
+	args := m.Called(ctx, cfg)
+	return args.Error(0)
+}
+
+func (m *MockConfigRepo) Delete(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockConfigRepo) ListSyncEnabled(ctx context.Context) ([]*ldap.Config, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*ldap.Config), args.Error(1)
+}
+
+func (m *MockConfigRepo) UpdateSyncResult(ctx context.Context, result *ldap.SyncResult) error {
+	args := m.Called(ctx, result)
+	return args.Error(0)
+}
+
+func (m *MockConfigRepo) Lock(ctx context.Context) (func(), error) {
+	args := m.Called(ctx)
+	return args.Get(0).(func()), args.Error(1)
+}
+
+type MockLinkRepo struct {
+	mock.Mock
+}
+
+func (m *MockLinkRepo) ListByType(ctx context.Context, linkType int32) ([]*ldap.Link, error) {
+	args := m.Called(ctx, linkType)
+	return args.Get(0).([]*ldap.Link), args.Error(1)
+}
+
+func (m *MockLinkRepo) FindByTarget(ctx context.Context, linkType int32, targetID string) (*ldap.Link, error) {
+	args := m.Called(ctx, linkType, targetID)
+	return args.Get(0).(*ldap.Link), args.Error(1)
+}
+
+func (m *MockLinkRepo) Create(ctx context.Context, link *ldap.Link) error {
+	args := m.Called(ctx, link)
+	return args.Error(0)
+}
+
+func (m *MockLinkRepo) UpdateDN(ctx context.Context, id, dn string) error {
+	args := m.Called(ctx, id, dn)
+	return args.Error(0)
+}
+
+func (m *MockLinkRepo) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockLinkRepo) DeleteAll(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+// stubEntry 模拟目录中的条目
+type stubEntry struct {
+	dn       string
+	password string
+	attrs    map[string][]string
+}
+
+// stubServer 进程内的最小 LDAP 服务，支持简单绑定、子树搜索和解绑
+type stubServer struct {
+	listener net.Listener
+	entries  []*stubEntry
+}
+
+func newStubServer(t *testing.T, entries ...*stubEntry) *stubServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	s := &stubServer{listener: listener, entries: entries}
+	go s.serve()
+	t.Cleanup(func() { _ = listener.Close() })
+	return s
+}
+
+func (s *stubServer) URL() string {
+	return "ldap://" + s.listener.Addr().String()
+}
+
+func (s *stubServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *stubServer) handle(conn net.Conn) {
+	defer conn.Close()
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		messageID := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+		switch op.Tag {
+		case 0: // BindRequest
+			dn := op.Children[1].Data.String()
+			password := op.Children[2].Data.String()
+			code := int64(49)
+			if entry := s.find(dn); entry != nil && entry.password == password {
+				code = 0
+			}
+			_, _ = conn.Write(s.result(messageID, 1, code).Bytes())
+		case 2: // UnbindRequest
+			return
+		case 3: // SearchRequest
+			baseDN := strings.ToLower(op.Children[0].Data.String())
+			for _, entry := range s.entries {
+				if strings.HasSuffix(strings.ToLower(entry.dn), baseDN) && match(op.Children[6], entry) {
+					_, _ = conn.Write(s.searchEntry(messageID, entry).Bytes())
+				}
+			}
+			_, _ = conn.Write(s.result(messageID, 5, 0).Bytes())
+		default:
+			return
+		}
+	}
+}
+
+func (s *stubServer) find(dn string) *stubEntry {
+	for _, entry := range s.entries {
+		if strings.EqualFold(entry.dn, dn) {
+			return entry
+		}
+	}
+	return nil
+}
+
+func (s *stubServer) result(messageID int64, tag ber.Tag, code int64) *ber.Packet {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "MessageID"))
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Response")
+	op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "resultCode"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "diagnosticMessage"))
+	packet.AppendChild(op)
+	return packet
+}
+
+func (s *stubServer) searchEntry(messageID int64, entry *stubEntry) *ber.Packet {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "MessageID"))
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, 4, nil, "SearchResultEntry")
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.dn, "objectName"))
+	attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attributes")
+	for name, values := range entry.attrs {
+		attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attribute")
+		attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "vals")
+		for _, value := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "value"))
+		}
+		attr.AppendChild(set)
+		attrs.AppendChild(attr)
+	}
+	op.AppendChild(attrs)
+	packet.AppendChild(op)
+	return packet
+}
+
+// match 支持 and、or、not、等值和存在过滤器
+func match(filter *ber.Packet, entry *stubEntry) bool {
+	switch filter.Tag {
+	case 0:
+		for _, child := range filter.Children {
+			if !match(child, entry) {
+				return false
+			}
+		}
+		return true
+	case 1:
+		for _, child := range filter.Children {
+			if match(child, entry) {
+				return true
+			}
+		}
+		return false
+	case 2:
+		return !match(filter.Children[0], entry)
+	case 3:
+		name, value := filter.Children[0].Data.String(), filter.Children[1].Data.String()
+		for attr, values := range entry.attrs {
+			if !strings.EqualFold(attr, name) {
+				continue
+			}
+			for _, v := range values {
+				if strings.EqualFold(v, value) {
+					return true
+				}
+			}
+		}
+		return false
+	case 7:
+		name := filter.Data.String()
+		for attr := range entry.attrs {
+			if strings.EqualFold(attr, name) {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
+func newDirectory(t *testing.T) *stubServer {
+	return newStubServer(t,
+		&stubEntry{dn: testBindDN, password: testBindPwd, attrs: map[string][]string{
+			"objectClass": {"organizationalRole"},
+			"cn":          {"admin"},
+		}},
+		&stubEntry{dn: testAliceDN, password: testAlicePwd, attrs: map[string][]string{
+			"objectClass": {"person", "inetOrgPerson"},
+			"uid":         {"alice"},
+			"cn":          {"Alice"},
+		}},
+		&stubEntry{dn: "uid=bob,ou=dev,dc=example,dc=com", password: "bob-secret", attrs: map[string][]string{
+			"objectClass": {"person", "inetOrgPerson"},
+			"uid":         {"bob"},
+		}},
+	)
+}
+
+func newConfig(server *stubServer) *ldap.Config {
+	return &ldap.Config{
+		ID:           "LDAC1",
+		URL:          server.URL(),
+		BindDN:       testBindDN,
+		BindPassword: testBindPwd,
+		BaseDN:       "dc=example,dc=com",
+		UserFilter:   ldap.DefaultUserFilter,
+		Attributes:   &ldap.AttributeMapping{Username: ldap.DefaultUsernameAttr},
+		AuthEnabled:  true,
+		Status:       1,
+		TenantID:     "T1",
+	}
+}
+
+func newLdapUsecase(repo *MockConfigRepo, linkRepo *MockLinkRepo) *ldap.LdapUsecase {
+	return ldap.NewLdapUsecase(log.DefaultLogger, repo, linkRepo, nil, nil, nil, nil, nil)
+}
+
+func TestLdapUsecase_Authenticate(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "T1")
+	server := newDirectory(t)
+	alice := &user.User{ID: "U1", Username: "alice"}
+	aliceLink := &ldap.Link{ID: "LDAL1", Type: ldap.LinkUser, ExternalID: "alice", DN: testAliceDN, TargetID: "U1"}
+
+	t.Run("目录用户密码正确", func(t *testing.T) {
+		repo, linkRepo := &MockConfigRepo{}, &MockLinkRepo{}
+		repo.On("Find", mock.Anything).Return(newConfig(server), nil)
+		linkRepo.On("FindByTarget", mock.Anything, ldap.LinkUser, "U1").Return(aliceLink, nil)
+
+		handled, err := newLdapUsecase(repo, linkRepo).Authenticate(ctx, alice, testAlicePwd)
+		assert.NoError(t, err)
+		assert.True(t, handled)
+	})
+
+	t.Run("目录用户密码错误", func(t *testing.T) {
+		repo, linkRepo := &MockConfigRepo{}, &MockLinkRepo{}
+		repo.On("Find", mock.Anything).Return(newConfig(server), nil)
+		linkRepo.On("FindByTarget", mock.Anything, ldap.LinkUser, "U1").Return(aliceLink, nil)
+
+		uc := newLdapUsecase(repo, linkRepo)
+		handled, err := uc.Authenticate(ctx, alice, "wrong")
+		assert.True(t, handled)
+		assert.Equal(t, string(errkey.ErrPasswordNotMatch), errors.Reason(err))
+
+		// 空密码是匿名绑定，不能视为校验通过
+		handled, err = uc.Authenticate(ctx, alice, "")
+		assert.True(t, handled)
+		assert.Equal(t, string(errkey.ErrPasswordNotMatch), errors.Reason(err))
+	})
+
+	t.Run("目录中已不存在的用户", func(t *testing.T) {
+		repo, linkRepo := &MockConfigRepo{}, &MockLinkRepo{}
+		repo.On("Find", mock.Anything).Return(newConfig(server), nil)
+		carol := &user.User{ID: "U3", Username: "carol"}
+		linkRepo.On("FindByTarget", mock.Anything, ldap.LinkUser, "U3").
+			Return(&ldap.Link{ID: "LDAL3", Type: ldap.LinkUser, ExternalID: "carol", TargetID: "U3"}, nil)
+
+		handled, err := newLdapUsecase(repo, linkRepo).Authenticate(ctx, carol, "carol-secret")
+		assert.True(t, handled)
+		assert.Equal(t, string(errkey.ErrPasswordNotMatch), errors.Reason(err))
+	})
+
+	t.Run("本地用户不使用LDAP认证", func(t *testing.T) {
+		repo, linkRepo := &MockConfigRepo{}, &MockLinkRepo{}
+		repo.On("Find", mock.Anything).Return(newConfig(server), nil)
+		linkRepo.On("FindByTarget", mock.Anything, ldap.LinkUser, "U9").Return((*ldap.Link)(nil), nil)
+
+		handled, err := newLdapUsecase(repo, linkRepo).Authenticate(ctx, &user.User{ID: "U9", Username: "admin"}, "x")
+		assert.NoError(t, err)
+		assert.False(t, handled)
+	})
+
+	t.Run("未开启LDAP认证", func(t *testing.T) {
+		repo, linkRepo := &MockConfigRepo{}, &MockLinkRepo{}
+		cfg := newConfig(server)
+		cfg.AuthEnabled = false
+		repo.On("Find", mock.Anything).Return(cfg, nil)
+
+		handled, err := newLdapUsecase(repo, linkRepo).Authenticate(ctx, alice, testAlicePwd)
+		assert.NoError(t, err)
+		assert.False(t, handled)
+		linkRepo.AssertNotCalled(t, "FindByTarget", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("目录服务不可用", func(t *testing.T) {
+		repo, linkRepo := &MockConfigRepo{}, &MockLinkRepo{}
+		cfg := newConfig(server)
+		cfg.BindPassword = "wrong"
+		repo.On("Find", mock.Anything).Return(cfg, nil)
+		linkRepo.On("FindByTarget", mock.Anything, ldap.LinkUser, "U1").Return(aliceLink, nil)
+
+		handled, err := newLdapUsecase(repo, linkRepo).Authenticate(ctx, alice, testAlicePwd)
+		assert.True(t, handled)
+		assert.Equal(t, string(errkey.ErrLdapUnavailable), errors.Reason(err))
+	})
+}
+
+func TestLdapUsecase_TestConnection(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "T1")
+	server := newDirectory(t)
+	repo := &MockConfigRepo{}
+	repo.On("Find", mock.Anything).Return(newConfig(server), nil)
+
+	users, err := newLdapUsecase(repo, &MockLinkRepo{}).TestConnection(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, users)
+}
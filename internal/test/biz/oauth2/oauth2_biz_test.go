@@ -0,0 +1,274 @@
+package oauth2_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"quest-admin/internal/biz/auth"
+	"quest-admin/internal/biz/oauth2"
+	"quest-admin/internal/biz/permission"
+	"quest-admin/internal/biz/user"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockClientRepo struct {
+	mock.Mock
+}
+
+func (m *MockClientRepo) Create(ctx context.Context, client *oauth2.Client) error {
+	args := m.Called(ctx, client)
+	return args.Error(0)
+}
+
+func (m *MockClientRepo) FindByID(ctx context.Context, id string) (*oauth2.Client, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*oauth2.Client), args.Error(1)
+}
+
+func (m *MockClientRepo) FindByClientID(ctx context.Context, clientID string) (*oauth2.Client, error) {
+	args := m.Called(ctx, clientID)
+	return args.Get(0).(*oauth2.Client), args.Error(1)
+}
+
+func (m *MockClientRepo) List(ctx context.Context, opt *oauth2.WhereClientOpt) ([]*oauth2.Client, error) {
+	args := m.Called(ctx, opt)
+	return args.Get(0).([]*oauth2.Client), args.Error(1)
+}
+
+func (m *MockClientRepo) Count(ctx context.Context, opt *oauth2.WhereClientOpt) (int64, error) {
+	args := m.Called(ctx, opt)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockClientRepo) Update(ctx context.Context, client *oauth2.Client) error {
+	args := m.Called(ctx, client)
+	return args.Error(0)
+}
+
+func (m *MockClientRepo) UpdateSecret(ctx context.Context, id, secret string) error {
+	args := m.Called(ctx, id, secret)
+	return args.Error(0)
+}
+
+func (m *MockClientRepo) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+type MockAuthorizationCodeRepo struct {
+	mock.Mock
+}
+
+func (m *MockAuthorizationCodeRepo) Save(ctx context.Context, code *oauth2.AuthorizationCode) error {
+	args := m.Called(ctx, code)
+	return args.Error(0)
+}
+
+func (m *MockAuthorizationCodeRepo) Take(ctx context.Context, hash string) (*oauth2.AuthorizationCode, error) {
+	args := m.Called(ctx, hash)
+	return args.Get(0).(*oauth2.AuthorizationCode), args.Error(1)
+}
+
+type MockMenuRepo struct {
+	mock.Mock
+}
+
+func (m *MockMenuRepo) Create(ctx context.Context, menu *permission.Menu) error {
+	args := m.Called(ctx, menu)
+	return args.Error(0)
+}
+
+func (m *MockMenuRepo) FindByID(ctx context.Context, id string) (*permission.Menu, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*permission.Menu), args.Error(1)
+}
+
+func (m *MockMenuRepo) FindByName(ctx context.Context, name string) (*permission.Menu, error) {
+	args := m.Called(ctx, name)
+	return args.Get(0).(*permission.Menu), args.Error(1)
+}
+
+func (m *MockMenuRepo) List(ctx context.Context) ([]*permission.Menu, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*permission.Menu), args.Error(1)
+}
+
+func (m *MockMenuRepo) FindByParentID(ctx context.Context, parentID string) ([]*permission.Menu, error) {
+	args := m.Called(ctx, parentID)
+	return args.Get(0).([]*permission.Menu), args.Error(1)
+}
+
+func (m *MockMenuRepo) Update(ctx context.Context, menu *permission.Menu) error {
+	args := m.Called(ctx, menu)
+	return args.Error(0)
+}
+
+func (m *MockMenuRepo) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockMenuRepo) FindByMenuIDs(ctx context.Context, menuIDs []string) ([]*permission.Menu, error) {
+	args := m.Called(ctx, menuIDs)
+	return args.Get(0).([]*permission.Menu), args.Error(1)
+}
+
+// MockUserRepo 只实现按 ID 查询用户
+type MockUserRepo struct {
+	user.UserRepo
+	users map[string]*user.User
+}
+
+func (m *MockUserRepo) FindByID(ctx context.Context, id string) (*user.User, error) {
+	return m.users[id], nil
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestClientUsecase_CreateClient_Invalid(t *testing.T) {
+	ctx := context.Background()
+	valid := func(modify func(client *oauth2.Client)) *oauth2.Client {
+		client := &oauth2.Client{
+			Name:         "ticket",
+			ClientType:   oauth2.ClientTypeConfidential,
+			RedirectURIs: []string{"https://ticket.example.com/callback"},
+			GrantTypes:   []string{oauth2.GrantAuthorizationCode},
+			Scopes:       []string{"system:user:list"},
+		}
+		modify(client)
+		return client
+	}
+	tests := []struct {
+		name   string
+		client *oauth2.Client
+		reason errorx.ErrorKey
+	}{
+		{name: "empty name", client: valid(func(c *oauth2.Client) { c.Name = " " }), reason: errkey.ErrBadRequest},
+		{name: "unknown client type", client: valid(func(c *oauth2.Client) { c.ClientType = "native" }), reason: errkey.ErrOAuth2ClientInvalid},
+		{name: "public client credentials", client: valid(func(c *oauth2.Client) {
+			c.ClientType = oauth2.ClientTypePublic
+			c.GrantTypes = []string{oauth2.GrantClientCredentials}
+		}), reason: errkey.ErrOAuth2ClientInvalid},
+		{name: "unsupported grant", client: valid(func(c *oauth2.Client) { c.GrantTypes = []string{"password"} }), reason: errkey.ErrOAuth2ClientInvalid},
+		{name: "missing redirect uri", client: valid(func(c *oauth2.Client) { c.RedirectURIs = nil }), reason: errkey.ErrOAuth2ClientInvalid},
+		{name: "plain http redirect", client: valid(func(c *oauth2.Client) { c.RedirectURIs = []string{"http://ticket.example.com/callback"} }), reason: errkey.ErrOAuth2ClientInvalid},
+		{name: "redirect with fragment", client: valid(func(c *oauth2.Client) { c.RedirectURIs = []string{"https://ticket.example.com/#/callback"} }), reason: errkey.ErrOAuth2ClientInvalid},
+		{name: "unknown scope", client: valid(func(c *oauth2.Client) { c.Scopes = []string{"system:user:delete"} }), reason: errkey.ErrOAuth2InvalidScope},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := new(MockClientRepo)
+			menuRepo := new(MockMenuRepo)
+			menuRepo.On("List", ctx).Return([]*permission.Menu{
+				{ID: "menu-1", Permission: "system:user:list", Status: 1},
+				{ID: "menu-2", Permission: "system:user:delete", Status: 0},
+			}, nil).Maybe()
+			uc := oauth2.NewClientUsecase(log.DefaultLogger, repo, nil, permission.NewMenuUsecase(nil, menuRepo, nil, nil, log.DefaultLogger), nil, nil)
+
+			secret, err := uc.CreateClient(ctx, tt.client)
+
+			assert.Equal(t, string(tt.reason), errors.Reason(err))
+			assert.Empty(t, secret)
+			repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestClientUsecase_CreateClient_ScopeNotGranted(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxs.LoginIDKey, "user-1")
+	repo := new(MockClientRepo)
+	menuRepo := new(MockMenuRepo)
+	menuRepo.On("List", ctx).Return([]*permission.Menu{
+		{ID: "menu-1", Permission: "system:user:list", Status: 1},
+	}, nil)
+	menuUc := permission.NewMenuUsecase(nil, menuRepo, nil, nil, log.DefaultLogger)
+	// 需要修改密码的用户没有任何权限
+	userUc := user.NewUserUsecase(log.DefaultLogger, &MockUserRepo{users: map[string]*user.User{
+		"user-1": {ID: "user-1", PasswordReset: true},
+	}}, nil, nil, nil, nil, nil, nil, nil, nil)
+	authUc := auth.NewAuthUsecase(nil, log.DefaultLogger, userUc, nil, menuUc, nil, nil, nil, nil, nil, nil, nil, nil)
+	uc := oauth2.NewClientUsecase(log.DefaultLogger, repo, nil, menuUc, authUc, userUc)
+
+	secret, err := uc.CreateClient(ctx, &oauth2.Client{
+		Name:         "ticket",
+		ClientType:   oauth2.ClientTypeConfidential,
+		RedirectURIs: []string{"https://ticket.example.com/callback"},
+		GrantTypes:   []string{oauth2.GrantAuthorizationCode},
+		Scopes:       []string{"system:user:list"},
+	})
+
+	assert.Equal(t, string(errkey.ErrOAuth2ScopeDenied), errors.Reason(err))
+	assert.Empty(t, secret)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestOAuth2Usecase_Token_Invalid(t *testing.T) {
+	ctx := context.Background()
+	verifier := strings.Repeat("v", 43)
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+	confidential := &oauth2.Client{
+		ClientID:     "ticket",
+		ClientSecret: sha256Hex("secret"),
+		ClientType:   oauth2.ClientTypeConfidential,
+		RedirectURIs: []string{"https://ticket.example.com/callback"},
+		GrantTypes:   []string{oauth2.GrantAuthorizationCode},
+		Scopes:       []string{"system:user:list"},
+		Status:       1,
+		TenantID:     "tenant-1",
+	}
+	code := &oauth2.AuthorizationCode{
+		ClientID:            "ticket",
+		UserID:              "user-1",
+		RedirectURI:         "https://ticket.example.com/callback",
+		CodeChallenge:       challenge,
+		CodeChallengeMethod: oauth2.CodeChallengeS256,
+		TenantID:            "tenant-1",
+	}
+	tests := []struct {
+		name   string
+		req    *oauth2.TokenRequest
+		code   *oauth2.AuthorizationCode
+		reason errorx.ErrorKey
+	}{
+		{name: "unknown client", req: &oauth2.TokenRequest{GrantType: oauth2.GrantAuthorizationCode, ClientID: "unknown"}, reason: errkey.ErrOAuth2InvalidClient},
+		{name: "wrong secret", req: &oauth2.TokenRequest{GrantType: oauth2.GrantAuthorizationCode, ClientID: "ticket", ClientSecret: "wrong"}, reason: errkey.ErrOAuth2InvalidClient},
+		{name: "unsupported grant", req: &oauth2.TokenRequest{GrantType: "password", ClientID: "ticket", ClientSecret: "secret"}, reason: errkey.ErrOAuth2UnsupportedGrantType},
+		{name: "grant not registered", req: &oauth2.TokenRequest{GrantType: oauth2.GrantClientCredentials, ClientID: "ticket", ClientSecret: "secret"}, reason: errkey.ErrOAuth2UnauthorizedClient},
+		{name: "unknown code", req: &oauth2.TokenRequest{GrantType: oauth2.GrantAuthorizationCode, ClientID: "ticket", ClientSecret: "secret", Code: "code", RedirectURI: code.RedirectURI, CodeVerifier: verifier}, reason: errkey.ErrOAuth2InvalidGrant},
+		{name: "redirect uri mismatch", req: &oauth2.TokenRequest{GrantType: oauth2.GrantAuthorizationCode, ClientID: "ticket", ClientSecret: "secret", Code: "code", RedirectURI: "https://evil.example.com/callback", CodeVerifier: verifier}, code: code, reason: errkey.ErrOAuth2InvalidGrant},
+		{name: "missing code verifier", req: &oauth2.TokenRequest{GrantType: oauth2.GrantAuthorizationCode, ClientID: "ticket", ClientSecret: "secret", Code: "code", RedirectURI: code.RedirectURI}, code: code, reason: errkey.ErrOAuth2InvalidGrant},
+		{name: "wrong code verifier", req: &oauth2.TokenRequest{GrantType: oauth2.GrantAuthorizationCode, ClientID: "ticket", ClientSecret: "secret", Code: "code", RedirectURI: code.RedirectURI, CodeVerifier: strings.Repeat("w", 43)}, code: code, reason: errkey.ErrOAuth2InvalidGrant},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientRepo := new(MockClientRepo)
+			clientRepo.On("FindByClientID", ctx, "ticket").Return(confidential, nil).Maybe()
+			clientRepo.On("FindByClientID", ctx, "unknown").Return((*oauth2.Client)(nil), nil).Maybe()
+			codeRepo := new(MockAuthorizationCodeRepo)
+			codeRepo.On("Take", mock.Anything, sha256Hex("code")).Return(tt.code, nil).Maybe()
+			uc := oauth2.NewOAuth2Usecase(log.DefaultLogger, clientRepo, codeRepo, nil, nil, nil, nil, nil)
+
+			token, err := uc.Token(ctx, tt.req)
+
+			assert.Nil(t, token)
+			assert.Equal(t, string(tt.reason), errors.Reason(err))
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package oidc_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"quest-admin/internal/biz/auth"
+	"quest-admin/internal/biz/oidc"
+	"quest-admin/internal/biz/user"
+	"quest-admin/pkg/util/jwk"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockKeyRepo struct {
+	mock.Mock
+	options *oidc.Options
+}
+
+func (m *MockKeyRepo) Options() *oidc.Options {
+	return m.options
+}
+
+func (m *MockKeyRepo) ListActive(ctx context.Context) ([]*oidc.SigningKey, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*oidc.SigningKey), args.Error(1)
+}
+
+func (m *MockKeyRepo) Create(ctx context.Context, key *oidc.SigningKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockKeyRepo) DeleteRetired(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func newSigningKey(t *testing.T, id string, expireAt, retireAt time.Time) *oidc.SigningKey {
+	privateKey, err := jwk.GenerateKey(jwk.RS256)
+	assert.NoError(t, err)
+	return &oidc.SigningKey{
+		ID:         id,
+		Algorithm:  jwk.RS256,
+		PrivateKey: privateKey,
+		CreateAt:   expireAt.Add(-24 * time.Hour),
+		ExpireAt:   expireAt,
+		RetireAt:   retireAt,
+	}
+}
+
+func newOidcUsecase(repo *MockKeyRepo) *oidc.OidcUsecase {
+	// 需要修改密码的用户不会查询角色，无需 mock 角色相关仓储
+	userUc := user.NewUserUsecase(log.DefaultLogger, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	authUc := auth.NewAuthUsecase(nil, log.DefaultLogger, userUc, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	return oidc.NewOidcUsecase(log.DefaultLogger, repo, nil, nil, authUc, userUc, nil, nil)
+}
+
+func TestOidcUsecase_IssueIDToken(t *testing.T) {
+	now := time.Now()
+	repo := &MockKeyRepo{options: &oidc.Options{
+		Issuer:     "https://id.example.com",
+		Algorithm:  jwk.RS256,
+		Audience:   "quest-admin",
+		IDTokenTTL: time.Hour,
+	}}
+	key := newSigningKey(t, "OIDK1", now.Add(time.Hour), now.Add(2*time.Hour))
+	repo.On("ListActive", mock.Anything).Return([]*oidc.SigningKey{key}, nil)
+	uc := newOidcUsecase(repo)
+
+	u := &user.User{ID: "U1", TenantID: "T1", Username: "alice", Email: "alice@example.com", PasswordReset: true}
+	tests := []struct {
+		name         string
+		bo           *oidc.IDTokenBO
+		wantAudience string
+	}{
+		{name: "默认受众", bo: &oidc.IDTokenBO{}, wantAudience: "quest-admin"},
+		{name: "OAuth2 客户端", bo: &oidc.IDTokenBO{Audience: "client-1", Nonce: "n-0S6_WzA2Mj"}, wantAudience: "client-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signed, err := uc.IssueIDToken(context.Background(), u, tt.bo)
+			assert.NoError(t, err)
+
+			set, err := uc.JWKS(context.Background())
+			assert.NoError(t, err)
+			claims := &oidc.Claims{}
+			token, err := jwt.ParseWithClaims(signed, claims, set.Keyfunc,
+				jwt.WithIssuer("https://id.example.com"), jwt.WithAudience(tt.wantAudience))
+			assert.NoError(t, err)
+			assert.Equal(t, "OIDK1", token.Header["kid"])
+			assert.Equal(t, "U1", claims.Subject)
+			assert.Equal(t, "alice", claims.PreferredUsername)
+			assert.Equal(t, "alice@example.com", claims.Email)
+			assert.Equal(t, "T1", claims.TenantID)
+			assert.Equal(t, tt.bo.Nonce, claims.Nonce)
+			assert.Empty(t, claims.Roles)
+		})
+	}
+}
+
+func TestOidcUsecase_JWKS(t *testing.T) {
+	now := time.Now()
+	repo := &MockKeyRepo{options: &oidc.Options{Algorithm: jwk.RS256, IDTokenTTL: time.Hour}}
+	current := newSigningKey(t, "OIDK3", now.Add(time.Hour), now.Add(2*time.Hour))
+	// 已停止签名但仍需发布，用于校验此前签发的令牌
+	previous := newSigningKey(t, "OIDK2", now.Add(-time.Minute), now.Add(time.Hour))
+	retired := newSigningKey(t, "OIDK1", now.Add(-2*time.Hour), now.Add(-time.Hour))
+	repo.On("ListActive", mock.Anything).Return([]*oidc.SigningKey{current, previous, retired}, nil)
+	uc := newOidcUsecase(repo)
+
+	set, err := uc.JWKS(context.Background())
+	assert.NoError(t, err)
+	var kids []string
+	for _, key := range set.Keys {
+		kids = append(kids, key.Kid)
+	}
+	assert.Equal(t, []string{"OIDK3", "OIDK2"}, kids)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestOidcUsecase_IssueIDToken_PendingKey(t *testing.T) {
+	now := time.Now()
+	repo := &MockKeyRepo{options: &oidc.Options{Algorithm: jwk.RS256, Audience: "quest-admin", IDTokenTTL: time.Hour}}
+	current := newSigningKey(t, "OIDK1", now.Add(3*time.Minute), now.Add(time.Hour))
+	// 已发布但未到生效时间的下一个密钥
+	pending := newSigningKey(t, "OIDK2", now.Add(24*time.Hour), now.Add(25*time.Hour))
+	pending.ActivateAt = now.Add(3 * time.Minute)
+	repo.On("ListActive", mock.Anything).Return([]*oidc.SigningKey{pending, current}, nil)
+	uc := newOidcUsecase(repo)
+
+	u := &user.User{ID: "U1", TenantID: "T1", PasswordReset: true}
+	signed, err := uc.IssueIDToken(context.Background(), u, &oidc.IDTokenBO{})
+	assert.NoError(t, err)
+	token, _, err := jwt.NewParser().ParseUnverified(signed, &oidc.Claims{})
+	assert.NoError(t, err)
+	assert.Equal(t, "OIDK1", token.Header["kid"])
+
+	set, err := uc.JWKS(context.Background())
+	assert.NoError(t, err)
+	var kids []string
+	for _, key := range set.Keys {
+		kids = append(kids, key.Kid)
+	}
+	assert.Equal(t, []string{"OIDK2", "OIDK1"}, kids)
+	repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
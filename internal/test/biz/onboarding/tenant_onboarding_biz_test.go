@@ -0,0 +1,122 @@
+package onboarding_test
+
+import (
+	"context"
+	"testing"
+
+	"quest-admin/internal/biz/onboarding"
+	permission "quest-admin/internal/biz/permission"
+	"quest-admin/internal/biz/tenant"
+	"quest-admin/internal/biz/user"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/validator"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+)
+
+// MockTenantRepo 只实现开通租户用到的查询
+type MockTenantRepo struct {
+	tenant.TenantRepo
+	names   map[string]bool
+	created []*tenant.Tenant
+}
+
+func (m *MockTenantRepo) FindByName(ctx context.Context, name string) (*tenant.Tenant, error) {
+	if m.names[name] {
+		return &tenant.Tenant{Name: name}, nil
+	}
+	return nil, nil
+}
+
+func (m *MockTenantRepo) Create(ctx context.Context, item *tenant.Tenant) error {
+	m.created = append(m.created, item)
+	return nil
+}
+
+type MockTenantPackageRepo struct {
+	tenant.TenantPackageRepo
+	packages map[string]*tenant.TenantPackage
+}
+
+func (m *MockTenantPackageRepo) FindByID(ctx context.Context, id string) (*tenant.TenantPackage, error) {
+	return m.packages[id], nil
+}
+
+type MockPasswordRepo struct {
+	user.PasswordRepo
+}
+
+func (m *MockPasswordRepo) Policy() *validator.PasswordPolicy {
+	return validator.NewPasswordPolicy(12, 3, 0, 0)
+}
+
+// MockTxManager 记录事务是否执行及结果
+type MockTxManager struct {
+	calls int
+	err   error
+}
+
+func (m *MockTxManager) Tx(ctx context.Context, fn func(context.Context) error) error {
+	m.calls++
+	m.err = fn(ctx)
+	return m.err
+}
+
+func newOnboardingUsecase(tm *MockTxManager, tenantRepo *MockTenantRepo) *onboarding.TenantOnboardingUsecase {
+	packageRepo := &MockTenantPackageRepo{packages: map[string]*tenant.TenantPackage{
+		"P1": {ID: "P1", MenuIDs: "M1", Status: 1},
+		"P2": {ID: "P2", MenuIDs: "M1", Status: 0},
+	}}
+	tenantUc := tenant.NewTenantUsecase(nil, tenantRepo, nil, nil, log.DefaultLogger)
+	packageUc := tenant.NewTenantPackageUsecase(packageRepo, log.DefaultLogger)
+	userUc := user.NewUserUsecase(log.DefaultLogger, nil, tm, nil, nil, nil, nil, nil, &MockPasswordRepo{}, tenantUc)
+	menuUc := permission.NewMenuUsecase(nil, nil, tenantUc, packageUc, log.DefaultLogger)
+	roleUc := permission.NewRoleUsecase(tm, nil, nil, nil, menuUc, log.DefaultLogger)
+	return onboarding.NewTenantOnboardingUsecase(log.DefaultLogger, tm, tenantUc, packageUc, userUc, roleUc, menuUc)
+}
+
+func TestTenantOnboardingUsecase_OnboardTenant_Package(t *testing.T) {
+	tests := []struct {
+		name      string
+		packageID string
+		want      errorx.ErrorKey
+	}{
+		{name: "未指定套餐", packageID: "", want: errkey.ErrTenantPackageRequired},
+		{name: "套餐不存在", packageID: "P9", want: errkey.ErrTenantPackageNotFound},
+		{name: "套餐已停用", packageID: "P2", want: errkey.ErrInvalidTenantPackageStatus},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tm := &MockTxManager{}
+			tenantRepo := &MockTenantRepo{}
+			uc := newOnboardingUsecase(tm, tenantRepo)
+
+			credentials, err := uc.OnboardTenant(context.Background(), &onboarding.OnboardTenantBO{
+				Tenant: &tenant.Tenant{Name: "Company A", PackageID: tt.packageID},
+			})
+			assert.Nil(t, credentials)
+			assert.Equal(t, string(tt.want), errors.Reason(err))
+			assert.Equal(t, 0, tm.calls)
+			assert.Empty(t, tenantRepo.created)
+		})
+	}
+}
+
+func TestTenantOnboardingUsecase_OnboardTenant_Rollback(t *testing.T) {
+	tm := &MockTxManager{}
+	tenantRepo := &MockTenantRepo{names: map[string]bool{"Company A": true}}
+	uc := newOnboardingUsecase(tm, tenantRepo)
+
+	credentials, err := uc.OnboardTenant(context.Background(), &onboarding.OnboardTenantBO{
+		Tenant: &tenant.Tenant{Name: "Company A", PackageID: "P1"},
+	})
+	assert.Nil(t, credentials)
+	assert.Equal(t, string(errkey.ErrTenantNameExists), errors.Reason(err))
+	// 错误由事务函数返回，事务整体回滚
+	assert.Equal(t, 1, tm.calls)
+	assert.Equal(t, err, tm.err)
+	assert.Empty(t, tenantRepo.created)
+}
@@ -0,0 +1,127 @@
+package permission_test
+
+import (
+	"context"
+	"testing"
+
+	"quest-admin/internal/biz/organization"
+	permission "quest-admin/internal/biz/permission"
+	"quest-admin/internal/biz/user"
+	"quest-admin/pkg/util/ctxs"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockScopeUserRoleRepo 内存实现，只实现数据范围解析用到的查询
+type MockScopeUserRoleRepo struct {
+	user.UserRoleRepo
+	roles map[string][]string
+}
+
+func (m *MockScopeUserRoleRepo) GetUserRoles(ctx context.Context, userID string) ([]*user.UserRole, error) {
+	var items []*user.UserRole
+	for _, roleID := range m.roles[userID] {
+		items = append(items, &user.UserRole{UserID: userID, RoleID: roleID})
+	}
+	return items, nil
+}
+
+type MockScopeUserDeptRepo struct {
+	user.UserDeptRepo
+	depts map[string][]string
+}
+
+func (m *MockScopeUserDeptRepo) GetUserDepts(ctx context.Context, userID string) ([]*user.UserDept, error) {
+	var items []*user.UserDept
+	for _, deptID := range m.depts[userID] {
+		items = append(items, &user.UserDept{UserID: userID, DeptID: deptID})
+	}
+	return items, nil
+}
+
+type MockScopeDepartmentRepo struct {
+	organization.DepartmentRepo
+	depts []*organization.Department
+}
+
+func (m *MockScopeDepartmentRepo) List(ctx context.Context) ([]*organization.Department, error) {
+	return m.depts, nil
+}
+
+func TestDataScopeUsecase_Resolve(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "T1")
+	roles := map[string]*permission.Role{
+		"ALL":    {ID: "ALL", DataScope: permission.DataScopeAll, Status: 1},
+		"CUSTOM": {ID: "CUSTOM", DataScope: permission.DataScopeCustom, DataScopeDeptIDs: "D4, D1", Status: 1},
+		"DEPT":   {ID: "DEPT", DataScope: permission.DataScopeDept, Status: 1},
+		"CHILD":  {ID: "CHILD", DataScope: permission.DataScopeDeptAndChild, Status: 1},
+		"SELF":   {ID: "SELF", DataScope: permission.DataScopeSelf, Status: 1},
+		"OFF":    {ID: "OFF", DataScope: permission.DataScopeAll, Status: 0},
+	}
+	// D1 -> D2 -> D3，D4 为独立部门
+	depts := []*organization.Department{
+		{ID: "D1"},
+		{ID: "D2", ParentID: "D1"},
+		{ID: "D3", ParentID: "D2"},
+		{ID: "D4"},
+	}
+
+	tests := []struct {
+		name  string
+		roles []string
+		want  *ctxs.DataScope
+	}{
+		{name: "没有角色仅本人", want: &ctxs.DataScope{UserID: "U1", Self: true}},
+		{name: "全部数据", roles: []string{"DEPT", "ALL"}, want: &ctxs.DataScope{All: true, UserID: "U1"}},
+		{name: "本部门", roles: []string{"DEPT"}, want: &ctxs.DataScope{UserID: "U1", DeptIDs: []string{"D2"}}},
+		{name: "本部门及以下", roles: []string{"CHILD"}, want: &ctxs.DataScope{UserID: "U1", DeptIDs: []string{"D2", "D3"}}},
+		{name: "自定义部门", roles: []string{"CUSTOM"}, want: &ctxs.DataScope{UserID: "U1", DeptIDs: []string{"D4", "D1"}}},
+		{name: "仅本人", roles: []string{"SELF"}, want: &ctxs.DataScope{UserID: "U1", Self: true}},
+		{name: "多个角色取并集", roles: []string{"SELF", "DEPT", "CHILD"}, want: &ctxs.DataScope{UserID: "U1", Self: true, DeptIDs: []string{"D2", "D3"}}},
+		{name: "停用角色不生效", roles: []string{"OFF"}, want: &ctxs.DataScope{UserID: "U1", Self: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roleRepo := new(MockRoleRepo)
+			var userRoles []*permission.Role
+			for _, id := range tt.roles {
+				userRoles = append(userRoles, roles[id])
+			}
+			roleRepo.On("FindListByIDs", mock.Anything, tt.roles).Return(userRoles, nil)
+
+			userUc := user.NewUserUsecase(log.DefaultLogger, nil, nil, nil,
+				&MockScopeUserDeptRepo{depts: map[string][]string{"U1": {"D2"}}}, nil,
+				&MockScopeUserRoleRepo{roles: map[string][]string{"U1": tt.roles}}, nil, nil, nil)
+			deptUc := organization.NewDepartmentUsecase(nil, &MockScopeDepartmentRepo{depts: depts}, log.DefaultLogger)
+			roleUc := permission.NewRoleUsecase(nil, nil, roleRepo, nil, nil, log.DefaultLogger)
+			uc := permission.NewDataScopeUsecase(log.DefaultLogger, roleUc, userUc, deptUc)
+
+			scope, err := uc.Resolve(ctx, "U1")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.All, scope.All)
+			assert.Equal(t, tt.want.Self, scope.Self)
+			assert.Equal(t, "U1", scope.UserID)
+			assert.ElementsMatch(t, tt.want.DeptIDs, scope.DeptIDs)
+		})
+	}
+}
+
+func TestDataScopeUsecase_Resolve_SwitchedTenant(t *testing.T) {
+	ctx := ctxs.WithTenantSwitch(ctxs.WithTenantID(context.Background(), "T2"), "")
+	userRoleRepo := &MockScopeUserRoleRepo{roles: map[string][]string{}}
+	userUc := user.NewUserUsecase(log.DefaultLogger, nil, nil, nil, nil, nil, userRoleRepo, nil, nil, nil)
+	uc := permission.NewDataScopeUsecase(log.DefaultLogger, nil, userUc, nil)
+
+	scope, err := uc.Resolve(ctx, "U1")
+	assert.NoError(t, err)
+	assert.True(t, scope.All)
+	assert.Equal(t, "U1", scope.UserID)
+}
+
+func TestParseDataScopeDeptIDs(t *testing.T) {
+	assert.Equal(t, []string{"D1", "D2"}, permission.ParseDataScopeDeptIDs("D1,D2"))
+	assert.Equal(t, []string{"D1", "D2"}, permission.ParseDataScopeDeptIDs(`["D1", "D2"]`))
+	assert.Nil(t, permission.ParseDataScopeDeptIDs(""))
+}
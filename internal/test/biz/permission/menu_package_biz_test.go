@@ -0,0 +1,155 @@
+package permission_test
+
+import (
+	"context"
+	"testing"
+
+	permission "quest-admin/internal/biz/permission"
+	"quest-admin/internal/biz/tenant"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockPackageTenantRepo 只实现套餐校验用到的查询
+type MockPackageTenantRepo struct {
+	tenant.TenantRepo
+	tenants map[string]*tenant.Tenant
+}
+
+func (m *MockPackageTenantRepo) FindByID(ctx context.Context, id string) (*tenant.Tenant, error) {
+	return m.tenants[id], nil
+}
+
+func (m *MockPackageTenantRepo) FindIDsByPackageID(ctx context.Context, packageID string) ([]string, error) {
+	var ids []string
+	for _, item := range m.tenants {
+		if item.PackageID == packageID {
+			ids = append(ids, item.ID)
+		}
+	}
+	return ids, nil
+}
+
+type MockTenantPackageRepo struct {
+	tenant.TenantPackageRepo
+	packages map[string]*tenant.TenantPackage
+}
+
+func (m *MockTenantPackageRepo) FindByID(ctx context.Context, id string) (*tenant.TenantPackage, error) {
+	return m.packages[id], nil
+}
+
+// passTx 直接执行事务函数
+type passTx struct{}
+
+func (passTx) Tx(ctx context.Context, fn func(context.Context) error) error {
+	return fn(ctx)
+}
+
+func newPackageUsecases() (*permission.MenuUsecase, *permission.RoleUsecase, *MockRoleRepo, *MockRoleMenuRepo) {
+	menuRepo := new(MockMenuRepo)
+	// M1 -> M2 -> M3，M4 为独立菜单
+	menuRepo.On("List", mock.Anything).Return([]*permission.Menu{
+		{ID: "M1", Status: 1},
+		{ID: "M2", ParentID: "M1", Status: 1},
+		{ID: "M3", ParentID: "M2", Permission: "system:user:list", Status: 1},
+		{ID: "M4", Permission: "system:tenant:list", Status: 1},
+	}, nil)
+	tenantRepo := &MockPackageTenantRepo{tenants: map[string]*tenant.Tenant{
+		"T1": {ID: "T1", PackageID: "P1"},
+		"T2": {ID: "T2"},
+		"T3": {ID: "T3", PackageID: "P2"},
+	}}
+	packageRepo := &MockTenantPackageRepo{packages: map[string]*tenant.TenantPackage{
+		"P1": {ID: "P1", MenuIDs: "M3", Status: 1},
+		"P2": {ID: "P2", MenuIDs: "M3,M4", Status: 0},
+	}}
+	menuUc := permission.NewMenuUsecase(nil, menuRepo,
+		tenant.NewTenantUsecase(nil, tenantRepo, nil, nil, log.DefaultLogger),
+		tenant.NewTenantPackageUsecase(packageRepo, log.DefaultLogger), log.DefaultLogger)
+	roleRepo := new(MockRoleRepo)
+	roleMenuRepo := new(MockRoleMenuRepo)
+	roleUc := permission.NewRoleUsecase(passTx{}, nil, roleRepo, roleMenuRepo, menuUc, log.DefaultLogger)
+	return menuUc, roleUc, roleRepo, roleMenuRepo
+}
+
+func TestMenuUsecase_PackageMenuIDs(t *testing.T) {
+	menuUc, _, _, _ := newPackageUsecases()
+
+	tests := []struct {
+		name     string
+		tenantID string
+		want     map[string]bool
+	}{
+		{name: "全局租户不限制", tenantID: ""},
+		{name: "未绑定套餐没有可用菜单", tenantID: "T2", want: map[string]bool{}},
+		{name: "未登记的租户没有可用菜单", tenantID: "T9", want: map[string]bool{}},
+		{name: "套餐菜单包含上级菜单", tenantID: "T1", want: map[string]bool{"M1": true, "M2": true, "M3": true}},
+		{name: "停用套餐没有可用菜单", tenantID: "T3", want: map[string]bool{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, err := menuUc.PackageMenuIDs(ctxs.WithTenantID(context.Background(), tt.tenantID))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, allowed)
+		})
+	}
+}
+
+func TestMenuUsecase_GetMenuTreeByPackage(t *testing.T) {
+	menuUc, _, _, _ := newPackageUsecases()
+
+	tree, err := menuUc.GetMenuTree(ctxs.WithTenantID(context.Background(), "T1"))
+	assert.NoError(t, err)
+	assert.Len(t, tree, 1)
+	assert.Equal(t, "M1", tree[0].ID)
+	assert.Equal(t, "M3", tree[0].Children[0].Children[0].ID)
+
+	menus, err := menuUc.ListPermissionMenus(ctxs.WithTenantID(context.Background(), "T1"))
+	assert.NoError(t, err)
+	assert.Len(t, menus, 1)
+	assert.Equal(t, "system:user:list", menus[0].Permission)
+}
+
+func TestRoleUsecase_PackageMenus(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "T1")
+
+	t.Run("分配套餐外的菜单", func(t *testing.T) {
+		_, roleUc, roleRepo, _ := newPackageUsecases()
+		roleRepo.On("FindByID", mock.Anything, "R1").Return(&permission.Role{ID: "R1"}, nil)
+
+		err := roleUc.AssignRoleMenu(ctx, &permission.AssignRoleMenuBO{RoleID: "R1", MenuIDs: []string{"M3", "M4"}})
+		assert.Equal(t, string(errkey.ErrMenuNotInPackage), errors.Reason(err))
+	})
+
+	t.Run("登录权限只包含套餐内菜单", func(t *testing.T) {
+		_, roleUc, _, roleMenuRepo := newPackageUsecases()
+		roleMenuRepo.On("FindListByRoleIDs", mock.Anything, []string{"R1"}).Return([]*permission.RoleMenu{
+			{ID: "RM1", RoleID: "R1", MenuID: "M3"},
+			{ID: "RM2", RoleID: "R1", MenuID: "M4"},
+		}, nil)
+
+		menuIDs, err := roleUc.GetMenusByRoleIDs(ctx, []string{"R1"})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"M3"}, menuIDs)
+	})
+
+	t.Run("套餐收缩后清理角色菜单", func(t *testing.T) {
+		_, roleUc, _, roleMenuRepo := newPackageUsecases()
+		roleMenuRepo.On("List", mock.Anything).Return([]*permission.RoleMenu{
+			{ID: "RM1", RoleID: "R1", MenuID: "M3"},
+			{ID: "RM2", RoleID: "R1", MenuID: "M4"},
+			{ID: "RM3", RoleID: "R2", MenuID: "M1"},
+		}, nil)
+		roleMenuRepo.On("Delete", mock.Anything, "RM2").Return(nil)
+
+		assert.NoError(t, roleUc.PrunePackageMenus(context.Background(), "P1"))
+		roleMenuRepo.AssertNumberOfCalls(t, "Delete", 1)
+		roleMenuRepo.AssertCalled(t, "Delete", mock.Anything, "RM2")
+	})
+}
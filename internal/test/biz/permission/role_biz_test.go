@@ -125,6 +125,14 @@ func (m *MockRoleMenuRepo) FindListByRoleIDs(ctx context.Context, roles []string
 	return args.Get(0).([]*permission.RoleMenu), args.Error(1)
 }
 
+func (m *MockRoleMenuRepo) List(ctx context.Context) ([]*permission.RoleMenu, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*permission.RoleMenu), args.Error(1)
+}
+
 type MockTransactionManager struct {
 	mock.Mock
 }
@@ -141,7 +149,8 @@ func newTestRoleUsecase(t *testing.T) (*permission.RoleUsecase, *MockRoleRepo, *
 	idg := idgen.NewIDGenerator()
 	logger := log.DefaultLogger
 
-	uc := permission.NewRoleUsecase(mockTm, idg, mockRepo, mockRoleMenuRepo, logger)
+	menuUc := permission.NewMenuUsecase(idg, new(MockMenuRepo), nil, nil, logger)
+	uc := permission.NewRoleUsecase(mockTm, idg, mockRepo, mockRoleMenuRepo, menuUc, logger)
 	return uc, mockRepo, mockRoleMenuRepo
 }
 
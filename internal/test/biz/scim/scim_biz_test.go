@@ -0,0 +1,228 @@
+package scim_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"quest-admin/internal/biz/scim"
+	"quest-admin/internal/biz/user"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/pkg/util/scimfilter"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockConfigRepo struct {
+	mock.Mock
+}
+
+func (m *MockConfigRepo) Find(ctx context.Context) (*scim.Config, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scim.Config), args.Error(1)
+}
+
+func (m *MockConfigRepo) FindByTokenHash(ctx context.Context, hash string) (*scim.Config, error) {
+	args := m.Called(ctx, hash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*scim.Config), args.Error(1)
+}
+
+func (m *MockConfigRepo) Save(ctx context.Context, cfg *scim.Config) error {
+	args := m.Called(ctx, cfg)
+	return args.Error(0)
+}
+
+func (m *MockConfigRepo) Delete(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+// MockUserRepo 只实现 SCIM 用到的方法
+type MockUserRepo struct {
+	user.UserRepo
+	mock.Mock
+}
+
+func (m *MockUserRepo) FindByID(ctx context.Context, id string) (*user.User, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserRepo) FindByUsername(ctx context.Context, username string) (*user.User, error) {
+	args := m.Called(ctx, username)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserRepo) Update(ctx context.Context, item *user.User) error {
+	args := m.Called(ctx, item)
+	return args.Error(0)
+}
+
+func (m *MockUserRepo) UpdateStatus(ctx context.Context, bo *user.UpdateStatusBO) error {
+	args := m.Called(ctx, bo)
+	return args.Error(0)
+}
+
+type MockUserSessionRepo struct {
+	mock.Mock
+}
+
+func (m *MockUserSessionRepo) RevokeByUserID(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func newScimUsecase(repo *MockConfigRepo, userRepo *MockUserRepo, sessionRepo *MockUserSessionRepo) *scim.ScimUsecase {
+	userUsecase := user.NewUserUsecase(log.DefaultLogger, userRepo, nil, nil, nil, nil, nil, sessionRepo, nil, nil)
+	return scim.NewScimUsecase(log.DefaultLogger, repo, nil, userUsecase, nil, nil)
+}
+
+func TestScimUsecase_ResolveToken(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "T1")
+	existing := &scim.Config{ID: "SCIC1", GroupTarget: scim.GroupTargetRole, Status: 1, TenantID: "T1"}
+
+	repo := &MockConfigRepo{}
+	var saved *scim.Config
+	repo.On("Find", mock.Anything).Return(existing, nil)
+	repo.On("Save", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		saved = args.Get(1).(*scim.Config)
+	}).Return(nil)
+	uc := newScimUsecase(repo, &MockUserRepo{}, &MockUserSessionRepo{})
+
+	token, err := uc.RotateToken(ctx)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(token, scim.TokenPrefix))
+	assert.True(t, strings.HasPrefix(token, saved.TokenPrefix))
+	assert.NotContains(t, saved.TokenHash, token)
+
+	t.Run("令牌有效", func(t *testing.T) {
+		repo.On("FindByTokenHash", mock.Anything, saved.TokenHash).Return(saved, nil).Once()
+		cfg, err := uc.ResolveToken(context.Background(), token)
+		assert.NoError(t, err)
+		assert.Equal(t, "T1", cfg.TenantID)
+	})
+
+	t.Run("前缀不匹配", func(t *testing.T) {
+		_, err := uc.ResolveToken(context.Background(), "qak_"+token)
+		assert.Equal(t, string(errkey.ErrScimUnauthorized), errors.Reason(err))
+	})
+
+	t.Run("配置已停用", func(t *testing.T) {
+		disabled := *saved
+		disabled.Status = 0
+		repo.On("FindByTokenHash", mock.Anything, saved.TokenHash).Return(&disabled, nil).Once()
+		_, err := uc.ResolveToken(context.Background(), token)
+		assert.Equal(t, string(errkey.ErrScimUnauthorized), errors.Reason(err))
+	})
+}
+
+func TestScimUsecase_ReplaceUser(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "T1")
+	newAlice := func() *user.User {
+		return &user.User{ID: "U1", Username: "alice", Nickname: "Alice", Email: "alice@example.com", Status: 1}
+	}
+
+	t.Run("停用用户并吊销会话", func(t *testing.T) {
+		userRepo, sessionRepo := &MockUserRepo{}, &MockUserSessionRepo{}
+		userRepo.On("FindByID", mock.Anything, "U1").Return(newAlice(), nil)
+		userRepo.On("UpdateStatus", mock.Anything, &user.UpdateStatusBO{UserID: "U1", Status: 0}).Return(nil)
+		sessionRepo.On("RevokeByUserID", mock.Anything, "U1").Return(nil)
+		uc := newScimUsecase(&MockConfigRepo{}, userRepo, sessionRepo)
+
+		_, err := uc.ReplaceUser(ctx, &scim.User{ID: "U1", UserName: "alice", DisplayName: "Alice", Email: "alice@example.com", Active: false})
+		assert.NoError(t, err)
+		userRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+		sessionRepo.AssertExpectations(t)
+	})
+
+	t.Run("更新资料", func(t *testing.T) {
+		userRepo := &MockUserRepo{}
+		userRepo.On("FindByID", mock.Anything, "U1").Return(newAlice(), nil)
+		userRepo.On("Update", mock.Anything, mock.MatchedBy(func(item *user.User) bool {
+			return item.Nickname == "Alice Liddell" && item.Mobile == "13800000000"
+		})).Return(nil)
+		uc := newScimUsecase(&MockConfigRepo{}, userRepo, &MockUserSessionRepo{})
+
+		_, err := uc.ReplaceUser(ctx, &scim.User{ID: "U1", UserName: "ALICE", DisplayName: "Alice Liddell", Email: "alice@example.com", Mobile: "13800000000", Active: true})
+		assert.NoError(t, err)
+		userRepo.AssertExpectations(t)
+		userRepo.AssertNotCalled(t, "UpdateStatus", mock.Anything, mock.Anything)
+	})
+
+	t.Run("用户名不可修改", func(t *testing.T) {
+		userRepo := &MockUserRepo{}
+		userRepo.On("FindByID", mock.Anything, "U1").Return(newAlice(), nil)
+		uc := newScimUsecase(&MockConfigRepo{}, userRepo, &MockUserSessionRepo{})
+
+		_, err := uc.ReplaceUser(ctx, &scim.User{ID: "U1", UserName: "bob", Active: true})
+		assert.Equal(t, string(errkey.ErrScimMutability), errors.Reason(err))
+	})
+
+	t.Run("用户不存在", func(t *testing.T) {
+		userRepo := &MockUserRepo{}
+		userRepo.On("FindByID", mock.Anything, "U404").Return(nil, nil)
+		uc := newScimUsecase(&MockConfigRepo{}, userRepo, &MockUserSessionRepo{})
+
+		_, err := uc.ReplaceUser(ctx, &scim.User{ID: "U404", Active: true})
+		assert.Equal(t, string(errkey.ErrNotFound), errors.Reason(err))
+	})
+}
+
+func TestScimUsecase_DeprovisionUser(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "T1")
+	userRepo, sessionRepo := &MockUserRepo{}, &MockUserSessionRepo{}
+	userRepo.On("FindByID", mock.Anything, "U1").Return(&user.User{ID: "U1", Username: "alice", Status: 1}, nil)
+	userRepo.On("UpdateStatus", mock.Anything, &user.UpdateStatusBO{UserID: "U1", Status: 0}).Return(nil)
+	sessionRepo.On("RevokeByUserID", mock.Anything, "U1").Return(nil)
+	uc := newScimUsecase(&MockConfigRepo{}, userRepo, sessionRepo)
+
+	assert.NoError(t, uc.DeprovisionUser(ctx, "U1"))
+	userRepo.AssertExpectations(t)
+	sessionRepo.AssertExpectations(t)
+}
+
+func TestScimUsecase_ListUsers(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "T1")
+	userRepo := &MockUserRepo{}
+	userRepo.On("FindByUsername", mock.Anything, "alice").Return(&user.User{ID: "U1", Username: "alice", Status: 1}, nil)
+	userRepo.On("FindByUsername", mock.Anything, "nobody").Return(nil, nil)
+	uc := newScimUsecase(&MockConfigRepo{}, userRepo, &MockUserSessionRepo{})
+
+	list := func(filter string) (*scim.ListUsersResult, error) {
+		conds, err := scimfilter.Parse(filter)
+		assert.NoError(t, err)
+		return uc.ListUsers(ctx, &scim.ListQuery{Filter: conds, StartIndex: 1, Count: 100})
+	}
+
+	result, err := list(`userName eq "alice"`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), result.Total)
+	assert.Equal(t, "U1", result.Users[0].ID)
+
+	result, err = list(`userName eq "alice" and active eq false`)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), result.Total)
+
+	result, err = list(`userName eq "nobody"`)
+	assert.NoError(t, err)
+	assert.Empty(t, result.Users)
+
+	_, err = list(`displayName eq "Alice"`)
+	assert.Equal(t, string(errkey.ErrScimInvalidFilter), errors.Reason(err))
+}
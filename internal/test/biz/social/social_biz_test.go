@@ -0,0 +1,323 @@
+package social_test
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"quest-admin/internal/biz/social"
+	"quest-admin/internal/biz/user"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/pkg/util/jwk"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testClientID = "quest-admin"
+	testCode     = "upstream-code"
+	testSubject  = "upstream-user-1"
+)
+
+type MockProviderRepo struct {
+	mock.Mock
+}
+
+func (m *MockProviderRepo) Create(ctx context.Context, provider *social.Provider) error {
+	args := m.Called(ctx, provider)
+	return args.Error(0)
+}
+
+func (m *MockProviderRepo) FindByID(ctx context.Context, id string) (*social.Provider, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*social.Provider), args.Error(1)
+}
+
+func (m *MockProviderRepo) FindByCode(ctx context.Context, code string) (*social.Provider, error) {
+	args := m.Called(ctx, code)
+	return args.Get(0).(*social.Provider), args.Error(1)
+}
+
+func (m *MockProviderRepo) List(ctx context.Context, opt *social.WhereProviderOpt) ([]*social.Provider, error) {
+	args := m.Called(ctx, opt)
+	return args.Get(0).([]*social.Provider), args.Error(1)
+}
+
+func (m *MockProviderRepo) Count(ctx context.Context, opt *social.WhereProviderOpt) (int64, error) {
+	args := m.Called(ctx, opt)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockProviderRepo) Update(ctx context.Context, provider *social.Provider) error {
+	args := m.Called(ctx, provider)
+	return args.Error(0)
+}
+
+func (m *MockProviderRepo) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+type MockUserSocialRepo struct {
+	mock.Mock
+}
+
+func (m *MockUserSocialRepo) Create(ctx context.Context, item *social.UserSocial) error {
+	args := m.Called(ctx, item)
+	return args.Error(0)
+}
+
+func (m *MockUserSocialRepo) FindBySubject(ctx context.Context, providerID, subject string) (*social.UserSocial, error) {
+	args := m.Called(ctx, providerID, subject)
+	return args.Get(0).(*social.UserSocial), args.Error(1)
+}
+
+func (m *MockUserSocialRepo) ListByUserID(ctx context.Context, userID string) ([]*social.UserSocial, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*social.UserSocial), args.Error(1)
+}
+
+func (m *MockUserSocialRepo) UpdateLogin(ctx context.Context, item *social.UserSocial) error {
+	args := m.Called(ctx, item)
+	return args.Error(0)
+}
+
+func (m *MockUserSocialRepo) Delete(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockUserSocialRepo) DeleteByProviderID(ctx context.Context, providerID string) error {
+	args := m.Called(ctx, providerID)
+	return args.Error(0)
+}
+
+// MockStateRepo 保存授权状态，Take 时取回，模拟一次性使用
+type MockStateRepo struct {
+	options *social.Options
+	states  map[string]*social.AuthState
+}
+
+func (m *MockStateRepo) Options() *social.Options {
+	return m.options
+}
+
+func (m *MockStateRepo) Save(ctx context.Context, state *social.AuthState) error {
+	m.states[state.State] = state
+	return nil
+}
+
+func (m *MockStateRepo) Take(ctx context.Context, state string) (*social.AuthState, error) {
+	item := m.states[state]
+	delete(m.states, state)
+	return item, nil
+}
+
+// MockUserRepo 只实现回调中查询用户的方法
+type MockUserRepo struct {
+	user.UserRepo
+	mock.Mock
+}
+
+func (m *MockUserRepo) FindByID(ctx context.Context, id string) (*user.User, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+// fakeIssuer 本地模拟的上游 OIDC 身份提供方
+type fakeIssuer struct {
+	server  *httptest.Server
+	key     crypto.Signer
+	kid     string
+	subject string
+	// nonce 由测试从授权地址中取出后设置
+	nonce string
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	key, err := jwk.GenerateKey(jwk.RS256)
+	assert.NoError(t, err)
+	f := &fakeIssuer{key: key, kid: "upstream-key-1", subject: testSubject}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]string{
+			"issuer":                 f.server.URL,
+			"authorization_endpoint": f.server.URL + "/authorize",
+			"token_endpoint":         f.server.URL + "/token",
+			"userinfo_endpoint":      f.server.URL + "/userinfo",
+			"jwks_uri":               f.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		publicKey, err := jwk.PublicKey(f.kid, jwk.RS256, f.key.Public())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, &jwk.Set{Keys: []*jwk.Key{publicKey}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		clientID, _, ok := r.BasicAuth()
+		if !ok || clientID != testClientID || r.PostFormValue("code") != testCode || r.PostFormValue("code_verifier") == "" {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		now := time.Now()
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":                f.server.URL,
+			"aud":                testClientID,
+			"sub":                f.subject,
+			"nonce":              f.nonce,
+			"iat":                now.Unix(),
+			"exp":                now.Add(time.Minute).Unix(),
+			"preferred_username": "alice",
+		})
+		token.Header["kid"] = f.kid
+		idToken, err := token.SignedString(f.key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, map[string]string{"access_token": "upstream-access", "token_type": "Bearer", "id_token": idToken})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]string{"sub": f.subject, "name": "Alice", "email": "alice@example.com"})
+	})
+	f.server = httptest.NewServer(mux)
+	t.Cleanup(f.server.Close)
+	return f
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+type socialFixture struct {
+	uc           *social.SocialUsecase
+	issuer       *fakeIssuer
+	provider     *social.Provider
+	providerRepo *MockProviderRepo
+	socialRepo   *MockUserSocialRepo
+	userRepo     *MockUserRepo
+}
+
+func newSocialFixture(t *testing.T) *socialFixture {
+	issuer := newFakeIssuer(t)
+	provider := &social.Provider{
+		ID:           "SOCP1",
+		Code:         "corp",
+		Name:         "Corp SSO",
+		Issuer:       issuer.server.URL,
+		ClientID:     testClientID,
+		ClientSecret: "upstream-secret",
+		Status:       1,
+		TenantID:     "T1",
+	}
+	providerRepo := &MockProviderRepo{}
+	providerRepo.On("FindByCode", mock.Anything, provider.Code).Return(provider, nil)
+	providerRepo.On("FindByID", mock.Anything, provider.ID).Return(provider, nil)
+	stateRepo := &MockStateRepo{
+		options: &social.Options{RedirectURL: "http://127.0.0.1:3000/social/callback", StateTTL: time.Minute},
+		states:  make(map[string]*social.AuthState),
+	}
+	socialRepo := &MockUserSocialRepo{}
+	userRepo := &MockUserRepo{}
+	userUc := user.NewUserUsecase(log.DefaultLogger, userRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+	uc := social.NewSocialUsecase(log.DefaultLogger, providerRepo, socialRepo, stateRepo, nil, nil, userUc, nil, nil)
+	return &socialFixture{
+		uc:           uc,
+		issuer:       issuer,
+		provider:     provider,
+		providerRepo: providerRepo,
+		socialRepo:   socialRepo,
+		userRepo:     userRepo,
+	}
+}
+
+// authorize 获取授权地址并返回其中的 state，同时让模拟上游签发带对应 nonce 的 ID Token
+func (f *socialFixture) authorize(t *testing.T, ctx context.Context, bindUserID string) string {
+	authorizeURL, err := f.uc.AuthorizeURL(ctx, f.provider.Code, bindUserID)
+	assert.NoError(t, err)
+	u, err := url.Parse(authorizeURL)
+	assert.NoError(t, err)
+	query := u.Query()
+	assert.Equal(t, f.issuer.server.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+	assert.Equal(t, testClientID, query.Get("client_id"))
+	assert.Equal(t, "openid", query.Get("scope"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	f.issuer.nonce = query.Get("nonce")
+	return query.Get("state")
+}
+
+func TestSocialUsecase_Callback(t *testing.T) {
+	ctx := ctxs.WithTenantID(context.Background(), "T1")
+
+	t.Run("已绑定用户登录", func(t *testing.T) {
+		f := newSocialFixture(t)
+		link := &social.UserSocial{ID: "USOC1", UserID: "U1", ProviderID: f.provider.ID, Subject: testSubject}
+		f.socialRepo.On("FindBySubject", mock.Anything, f.provider.ID, testSubject).Return(link, nil)
+		f.socialRepo.On("UpdateLogin", mock.Anything, link).Return(nil)
+		f.userRepo.On("FindByID", mock.Anything, "U1").Return(&user.User{ID: "U1", Username: "alice"}, nil)
+
+		state := f.authorize(t, ctx, "")
+		result, err := f.uc.Callback(ctx, state, testCode)
+		assert.NoError(t, err)
+		assert.Equal(t, "U1", result.User.ID)
+		assert.False(t, result.Bound)
+		assert.False(t, result.Created)
+		// userinfo 中的声明补充到身份快照
+		assert.Equal(t, "alice", link.Username)
+		assert.Equal(t, "Alice", link.Nickname)
+		assert.Equal(t, "alice@example.com", link.Email)
+
+		// state 只能使用一次
+		_, err = f.uc.Callback(ctx, state, testCode)
+		assert.Equal(t, string(errkey.ErrSocialStateInvalid), errors.Reason(err))
+	})
+
+	t.Run("未绑定且未开启自动创建", func(t *testing.T) {
+		f := newSocialFixture(t)
+		f.socialRepo.On("FindBySubject", mock.Anything, f.provider.ID, testSubject).Return((*social.UserSocial)(nil), nil)
+
+		state := f.authorize(t, ctx, "")
+		_, err := f.uc.Callback(ctx, state, testCode)
+		assert.Equal(t, string(errkey.ErrSocialNotBound), errors.Reason(err))
+	})
+
+	t.Run("身份已绑定其他用户", func(t *testing.T) {
+		f := newSocialFixture(t)
+		link := &social.UserSocial{ID: "USOC1", UserID: "U2", ProviderID: f.provider.ID, Subject: testSubject}
+		f.socialRepo.On("FindBySubject", mock.Anything, f.provider.ID, testSubject).Return(link, nil)
+
+		state := f.authorize(t, ctx, "U1")
+		_, err := f.uc.Callback(ctx, state, testCode)
+		assert.Equal(t, string(errkey.ErrSocialAlreadyBound), errors.Reason(err))
+	})
+
+	t.Run("回调租户与发起授权的租户不一致", func(t *testing.T) {
+		f := newSocialFixture(t)
+		state := f.authorize(t, ctx, "")
+		_, err := f.uc.Callback(ctxs.WithTenantID(context.Background(), "T2"), state, testCode)
+		assert.Equal(t, string(errkey.ErrSocialStateInvalid), errors.Reason(err))
+	})
+
+	t.Run("nonce 不一致", func(t *testing.T) {
+		f := newSocialFixture(t)
+		state := f.authorize(t, ctx, "")
+		f.issuer.nonce = "forged"
+		_, err := f.uc.Callback(ctx, state, testCode)
+		assert.Equal(t, string(errkey.ErrSocialLoginFailed), errors.Reason(err))
+	})
+}
@@ -3,9 +3,14 @@ package tenant_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	tenant "quest-admin/internal/biz/tenant"
+	"quest-admin/internal/data/idgen"
+	"quest-admin/pkg/sms"
+	"quest-admin/types/errkey"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -28,6 +33,14 @@ func (m *MockTenantRepo) FindByID(ctx context.Context, id string) (*tenant.Tenan
 	return args.Get(0).(*tenant.Tenant), args.Error(1)
 }
 
+func (m *MockTenantRepo) LockByID(ctx context.Context, id string) (*tenant.Tenant, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tenant.Tenant), args.Error(1)
+}
+
 func (m *MockTenantRepo) FindByName(ctx context.Context, name string) (*tenant.Tenant, error) {
 	args := m.Called(ctx, name)
 	if args.Get(0) == nil {
@@ -36,6 +49,14 @@ func (m *MockTenantRepo) FindByName(ctx context.Context, name string) (*tenant.T
 	return args.Get(0).(*tenant.Tenant), args.Error(1)
 }
 
+func (m *MockTenantRepo) FindByWebsite(ctx context.Context, host string) (*tenant.Tenant, error) {
+	args := m.Called(ctx, host)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*tenant.Tenant), args.Error(1)
+}
+
 func (m *MockTenantRepo) List(ctx context.Context, query *tenant.ListTenantsQuery) (*tenant.ListTenantsResult, error) {
 	args := m.Called(ctx, query)
 	if args.Get(0) == nil {
@@ -44,6 +65,35 @@ func (m *MockTenantRepo) List(ctx context.Context, query *tenant.ListTenantsQuer
 	return args.Get(0).(*tenant.ListTenantsResult), args.Error(1)
 }
 
+func (m *MockTenantRepo) FindIDAndNameList(ctx context.Context) ([]*tenant.TenantSimple, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*tenant.TenantSimple), args.Error(1)
+}
+
+func (m *MockTenantRepo) FindIDsByPackageID(ctx context.Context, packageID string) ([]string, error) {
+	args := m.Called(ctx, packageID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockTenantRepo) ListExpiring(ctx context.Context, before time.Time) ([]*tenant.Tenant, error) {
+	args := m.Called(ctx, before)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*tenant.Tenant), args.Error(1)
+}
+
+func (m *MockTenantRepo) UpdateStatus(ctx context.Context, id string, status int32) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
 func (m *MockTenantRepo) Update(ctx context.Context, tenant *tenant.Tenant) error {
 	args := m.Called(ctx, tenant)
 	return args.Error(0)
@@ -259,7 +309,7 @@ func TestTenantUsecase_CreateTenant(t *testing.T) {
 	mockRepo := new(MockTenantRepo)
 	logger := log.DefaultLogger
 
-	uc := tenant.NewTenantUsecase(mockRepo, logger)
+	uc := tenant.NewTenantUsecase(idgen.NewIDGenerator(), mockRepo, nil, nil, logger)
 
 	tn := &tenant.Tenant{
 		Name: "Company A",
@@ -279,7 +329,7 @@ func TestTenantUsecase_CreateTenant_FindByNameError(t *testing.T) {
 	mockRepo := new(MockTenantRepo)
 	logger := log.DefaultLogger
 
-	uc := tenant.NewTenantUsecase(mockRepo, logger)
+	uc := tenant.NewTenantUsecase(idgen.NewIDGenerator(), mockRepo, nil, nil, logger)
 
 	tn := &tenant.Tenant{
 		Name: "Company A",
@@ -298,7 +348,7 @@ func TestTenantUsecase_CreateTenant_DuplicateName(t *testing.T) {
 	mockRepo := new(MockTenantRepo)
 	logger := log.DefaultLogger
 
-	uc := tenant.NewTenantUsecase(mockRepo, logger)
+	uc := tenant.NewTenantUsecase(idgen.NewIDGenerator(), mockRepo, nil, nil, logger)
 
 	tn := &tenant.Tenant{
 		Name: "Company A",
@@ -318,7 +368,7 @@ func TestTenantUsecase_CreateTenant_CreateError(t *testing.T) {
 	mockRepo := new(MockTenantRepo)
 	logger := log.DefaultLogger
 
-	uc := tenant.NewTenantUsecase(mockRepo, logger)
+	uc := tenant.NewTenantUsecase(idgen.NewIDGenerator(), mockRepo, nil, nil, logger)
 
 	tn := &tenant.Tenant{
 		Name: "Company A",
@@ -338,7 +388,7 @@ func TestTenantUsecase_GetTenant(t *testing.T) {
 	mockRepo := new(MockTenantRepo)
 	logger := log.DefaultLogger
 
-	uc := tenant.NewTenantUsecase(mockRepo, logger)
+	uc := tenant.NewTenantUsecase(idgen.NewIDGenerator(), mockRepo, nil, nil, logger)
 
 	expected := &tenant.Tenant{
 		ID:   "tenant-1",
@@ -359,7 +409,7 @@ func TestTenantUsecase_GetTenant_Error(t *testing.T) {
 	mockRepo := new(MockTenantRepo)
 	logger := log.DefaultLogger
 
-	uc := tenant.NewTenantUsecase(mockRepo, logger)
+	uc := tenant.NewTenantUsecase(idgen.NewIDGenerator(), mockRepo, nil, nil, logger)
 
 	mockRepo.On("FindByID", ctx, "tenant-1").Return(nil, assert.AnError)
 
@@ -375,7 +425,7 @@ func TestTenantUsecase_UpdateTenant(t *testing.T) {
 	mockRepo := new(MockTenantRepo)
 	logger := log.DefaultLogger
 
-	uc := tenant.NewTenantUsecase(mockRepo, logger)
+	uc := tenant.NewTenantUsecase(idgen.NewIDGenerator(), mockRepo, nil, nil, logger)
 
 	tn := &tenant.Tenant{
 		ID:   "tenant-1",
@@ -396,7 +446,7 @@ func TestTenantUsecase_UpdateTenant_NotFound(t *testing.T) {
 	mockRepo := new(MockTenantRepo)
 	logger := log.DefaultLogger
 
-	uc := tenant.NewTenantUsecase(mockRepo, logger)
+	uc := tenant.NewTenantUsecase(idgen.NewIDGenerator(), mockRepo, nil, nil, logger)
 
 	tn := &tenant.Tenant{
 		ID:   "tenant-1",
@@ -416,7 +466,7 @@ func TestTenantUsecase_UpdateTenant_UpdateError(t *testing.T) {
 	mockRepo := new(MockTenantRepo)
 	logger := log.DefaultLogger
 
-	uc := tenant.NewTenantUsecase(mockRepo, logger)
+	uc := tenant.NewTenantUsecase(idgen.NewIDGenerator(), mockRepo, nil, nil, logger)
 
 	tn := &tenant.Tenant{
 		ID:   "tenant-1",
@@ -437,7 +487,7 @@ func TestTenantUsecase_DeleteTenant(t *testing.T) {
 	mockRepo := new(MockTenantRepo)
 	logger := log.DefaultLogger
 
-	uc := tenant.NewTenantUsecase(mockRepo, logger)
+	uc := tenant.NewTenantUsecase(idgen.NewIDGenerator(), mockRepo, nil, nil, logger)
 
 	tn := &tenant.Tenant{
 		ID:   "tenant-1",
@@ -458,7 +508,7 @@ func TestTenantUsecase_DeleteTenant_NotFound(t *testing.T) {
 	mockRepo := new(MockTenantRepo)
 	logger := log.DefaultLogger
 
-	uc := tenant.NewTenantUsecase(mockRepo, logger)
+	uc := tenant.NewTenantUsecase(idgen.NewIDGenerator(), mockRepo, nil, nil, logger)
 
 	mockRepo.On("FindByID", ctx, "tenant-1").Return(nil, assert.AnError)
 
@@ -473,7 +523,7 @@ func TestTenantUsecase_DeleteTenant_DeleteError(t *testing.T) {
 	mockRepo := new(MockTenantRepo)
 	logger := log.DefaultLogger
 
-	uc := tenant.NewTenantUsecase(mockRepo, logger)
+	uc := tenant.NewTenantUsecase(idgen.NewIDGenerator(), mockRepo, nil, nil, logger)
 
 	tn := &tenant.Tenant{
 		ID:   "tenant-1",
@@ -488,3 +538,135 @@ func TestTenantUsecase_DeleteTenant_DeleteError(t *testing.T) {
 	assert.Error(t, err)
 	mockRepo.AssertExpectations(t)
 }
+
+type MockTenantExpiryRepo struct {
+	warned map[string]bool
+}
+
+func (m *MockTenantExpiryRepo) Options() *tenant.Options {
+	return &tenant.Options{ExpiryCheckInterval: time.Hour, ExpiryWarnBefore: 7 * 24 * time.Hour}
+}
+
+func (m *MockTenantExpiryRepo) MarkWarned(ctx context.Context, tenantID string, expireTime time.Time) (bool, error) {
+	key := tenantID + expireTime.String()
+	if m.warned[key] {
+		return false, nil
+	}
+	m.warned[key] = true
+	return true, nil
+}
+
+func (m *MockTenantExpiryRepo) UnmarkWarned(ctx context.Context, tenantID string, expireTime time.Time) error {
+	delete(m.warned, tenantID+expireTime.String())
+	return nil
+}
+
+// MockSmsSender 记录发送的短信，err 不为空时发送失败
+type MockSmsSender struct {
+	messages []*sms.Message
+	err      error
+}
+
+func (m *MockSmsSender) Send(ctx context.Context, msg *sms.Message) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+func TestTenantUsecase_CheckAvailable(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	tests := []struct {
+		name   string
+		tenant *tenant.Tenant
+		want   string
+	}{
+		{name: "正常租户", tenant: &tenant.Tenant{ID: "T1", Status: 1, ExpireTime: now.Add(time.Hour)}},
+		{name: "未设置过期时间", tenant: &tenant.Tenant{ID: "T1", Status: 1, ExpireTime: time.Unix(0, 0)}},
+		{name: "未登记的租户", tenant: nil},
+		{name: "已停用", tenant: &tenant.Tenant{ID: "T1", Status: 0}, want: string(errkey.ErrTenantDisabled)},
+		{name: "已过期", tenant: &tenant.Tenant{ID: "T1", Status: 1, ExpireTime: now.Add(-time.Hour)}, want: string(errkey.ErrTenantExpired)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockTenantRepo)
+			mockRepo.On("FindByID", ctx, "T1").Return(tt.tenant, nil)
+			uc := tenant.NewTenantUsecase(nil, mockRepo, nil, nil, log.DefaultLogger)
+
+			err := uc.CheckAvailable(ctx, "T1")
+			assert.Equal(t, tt.want, errors.Reason(err))
+		})
+	}
+
+	t.Run("全局租户不校验", func(t *testing.T) {
+		mockRepo := new(MockTenantRepo)
+		uc := tenant.NewTenantUsecase(nil, mockRepo, nil, nil, log.DefaultLogger)
+
+		assert.NoError(t, uc.CheckAvailable(ctx, ""))
+		mockRepo.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	})
+}
+
+func TestTenantUsecase_ResolveByHost(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockTenantRepo)
+	mockRepo.On("FindByWebsite", ctx, "a.example.com").Return(&tenant.Tenant{ID: "T1"}, nil)
+	mockRepo.On("FindByWebsite", ctx, "b.example.com").Return(nil, nil)
+	uc := tenant.NewTenantUsecase(nil, mockRepo, nil, nil, log.DefaultLogger)
+
+	tenantID, err := uc.ResolveByHost(ctx, "A.Example.com:8080")
+	assert.NoError(t, err)
+	assert.Equal(t, "T1", tenantID)
+
+	tenantID, err = uc.ResolveByHost(ctx, "b.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "", tenantID)
+
+	tenantID, err = uc.ResolveByHost(ctx, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "", tenantID)
+	mockRepo.AssertNumberOfCalls(t, "FindByWebsite", 2)
+}
+
+func TestTenantUsecase_CheckExpiry(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	mockRepo := new(MockTenantRepo)
+	mockRepo.On("ListExpiring", ctx, mock.AnythingOfType("time.Time")).Return([]*tenant.Tenant{
+		{ID: "T1", Name: "已过期", Status: 1, ContactMobile: "13800000001", ExpireTime: now.Add(-time.Minute)},
+		{ID: "T2", Name: "即将到期", Status: 1, ContactMobile: "13800000002", ExpireTime: now.Add(48 * time.Hour)},
+		{ID: "T3", Name: "没有联系手机", Status: 1, ExpireTime: now.Add(48 * time.Hour)},
+	}, nil)
+	mockRepo.On("UpdateStatus", ctx, "T1", int32(0)).Return(nil)
+	sender := &MockSmsSender{}
+	uc := tenant.NewTenantUsecase(nil, mockRepo, &MockTenantExpiryRepo{warned: map[string]bool{}}, sender, log.DefaultLogger)
+
+	uc.CheckExpiry(ctx)
+	uc.CheckExpiry(ctx)
+
+	mockRepo.AssertCalled(t, "UpdateStatus", ctx, "T1", int32(0))
+	mockRepo.AssertNotCalled(t, "UpdateStatus", ctx, "T2", mock.Anything)
+	// 同一过期时间只提醒一次
+	assert.Len(t, sender.messages, 1)
+	assert.Equal(t, "13800000002", sender.messages[0].Mobile)
+}
+
+func TestTenantUsecase_CheckExpiry_SendFailed(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockTenantRepo)
+	mockRepo.On("ListExpiring", ctx, mock.AnythingOfType("time.Time")).Return([]*tenant.Tenant{
+		{ID: "T2", Name: "即将到期", Status: 1, ContactMobile: "13800000002", ExpireTime: time.Now().Add(48 * time.Hour)},
+	}, nil)
+	sender := &MockSmsSender{err: assert.AnError}
+	uc := tenant.NewTenantUsecase(nil, mockRepo, &MockTenantExpiryRepo{warned: map[string]bool{}}, sender, log.DefaultLogger)
+
+	uc.CheckExpiry(ctx)
+	assert.Empty(t, sender.messages)
+
+	// 发送失败不记录已提醒，下次检查重新发送
+	sender.err = nil
+	uc.CheckExpiry(ctx)
+	assert.Len(t, sender.messages, 1)
+}
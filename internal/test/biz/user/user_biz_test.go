@@ -5,9 +5,15 @@ import (
 	"testing"
 	"time"
 
+	"quest-admin/internal/biz/tenant"
 	user "quest-admin/internal/biz/user"
 	"quest-admin/internal/data/idgen"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/pkg/util/pswd"
+	"quest-admin/pkg/util/validator"
+	"quest-admin/types/errkey"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -38,6 +44,22 @@ func (m *MockUserRepo) FindByUsername(ctx context.Context, username string) (*us
 	return args.Get(0).(*user.User), args.Error(1)
 }
 
+func (m *MockUserRepo) FindByEmail(ctx context.Context, email string) (*user.User, error) {
+	args := m.Called(ctx, email)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
+func (m *MockUserRepo) FindByMobile(ctx context.Context, mobile string) (*user.User, error) {
+	args := m.Called(ctx, mobile)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.User), args.Error(1)
+}
+
 func (m *MockUserRepo) List(ctx context.Context, opt *user.WhereUserOpt) ([]*user.User, error) {
 	args := m.Called(ctx, opt)
 	if args.Get(0) == nil {
@@ -51,6 +73,11 @@ func (m *MockUserRepo) Count(ctx context.Context, opt *user.WhereUserOpt) (int64
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *MockUserRepo) CountByTenant(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *MockUserRepo) Update(ctx context.Context, user *user.User) error {
 	args := m.Called(ctx, user)
 	return args.Error(0)
@@ -88,6 +115,14 @@ func (m *MockUserDeptRepo) GetUserDepts(ctx context.Context, userID string) ([]*
 	return args.Get(0).([]*user.UserDept), args.Error(1)
 }
 
+func (m *MockUserDeptRepo) ListByDeptID(ctx context.Context, deptID string) ([]*user.UserDept, error) {
+	args := m.Called(ctx, deptID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*user.UserDept), args.Error(1)
+}
+
 func (m *MockUserDeptRepo) Delete(ctx context.Context, id string) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -132,6 +167,14 @@ func (m *MockUserRoleRepo) GetUserRoles(ctx context.Context, userID string) ([]*
 	return args.Get(0).([]*user.UserRole), args.Error(1)
 }
 
+func (m *MockUserRoleRepo) ListByRoleID(ctx context.Context, roleID string) ([]*user.UserRole, error) {
+	args := m.Called(ctx, roleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*user.UserRole), args.Error(1)
+}
+
 func (m *MockUserRoleRepo) Delete(ctx context.Context, id string) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -142,25 +185,61 @@ func (m *MockUserRoleRepo) Create(ctx context.Context, item *user.UserRole) erro
 	return args.Error(0)
 }
 
+type MockUserSessionRepo struct {
+	mock.Mock
+}
+
+func (m *MockUserSessionRepo) RevokeByUserID(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+type MockPasswordRepo struct {
+	mock.Mock
+}
+
+func (m *MockPasswordRepo) Policy() *validator.PasswordPolicy {
+	return validator.NewPasswordPolicy(8, 3, 5, 90*24*time.Hour)
+}
+
+func (m *MockPasswordRepo) ListHistory(ctx context.Context, userID string, limit int) ([]string, error) {
+	args := m.Called(ctx, userID, limit)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockPasswordRepo) CreateHistory(ctx context.Context, history *user.PasswordHistory) error {
+	args := m.Called(ctx, history)
+	return args.Error(0)
+}
+
 type MockTransactionManager struct {
 	mock.Mock
 }
 
+// Tx 直接执行 fn，不开启真实事务
 func (m *MockTransactionManager) Tx(ctx context.Context, fn func(context.Context) error) error {
-	args := m.Called(ctx, fn)
-	return args.Error(0)
+	return fn(ctx)
 }
 
 func newTestUsecase(t *testing.T) (*user.UserUsecase, *MockUserRepo) {
+	mockSessionRepo := new(MockUserSessionRepo)
+	mockSessionRepo.On("RevokeByUserID", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return newTestUsecaseWithSession(t, mockSessionRepo)
+}
+
+func newTestUsecaseWithSession(t *testing.T, mockSessionRepo *MockUserSessionRepo) (*user.UserUsecase, *MockUserRepo) {
 	mockRepo := new(MockUserRepo)
 	mockDeptRepo := new(MockUserDeptRepo)
 	mockPostRepo := new(MockUserPostRepo)
 	mockRoleRepo := new(MockUserRoleRepo)
 	mockTm := new(MockTransactionManager)
+	mockPasswordRepo := new(MockPasswordRepo)
+	mockPasswordRepo.On("ListHistory", mock.Anything, mock.Anything, mock.Anything).Return([]string{}, nil).Maybe()
+	mockPasswordRepo.On("CreateHistory", mock.Anything, mock.Anything).Return(nil).Maybe()
 	idg := idgen.NewIDGenerator()
 	logger := log.DefaultLogger
 
-	uc := user.NewUserUsecase(logger, mockRepo, mockTm, idg, mockDeptRepo, mockPostRepo, mockRoleRepo)
+	uc := user.NewUserUsecase(logger, mockRepo, mockTm, idg, mockDeptRepo, mockPostRepo, mockRoleRepo, mockSessionRepo, mockPasswordRepo, nil)
 	return uc, mockRepo
 }
 
@@ -181,7 +260,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 			},
 			inputUser: &user.User{
 				Username: "testuser",
-				Password: "password",
+				Password: "Welcome@2024",
 				Nickname: "Test User",
 			},
 			expectError:     false,
@@ -194,7 +273,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 			},
 			inputUser: &user.User{
 				Username: "testuser",
-				Password: "password",
+				Password: "Welcome@2024",
 				Nickname: "Test User",
 			},
 			expectError:     true,
@@ -208,7 +287,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 			},
 			inputUser: &user.User{
 				Username: "testuser",
-				Password: "password",
+				Password: "Welcome@2024",
 				Nickname: "Test User",
 			},
 			expectError:     true,
@@ -222,7 +301,7 @@ func TestUserUsecase_CreateUser(t *testing.T) {
 			},
 			inputUser: &user.User{
 				Username: "testuser",
-				Password: "password",
+				Password: "Welcome@2024",
 				Nickname: "Test User",
 			},
 			expectError:     true,
@@ -484,6 +563,46 @@ func TestUserUsecase_ChangeUserStatus(t *testing.T) {
 	}
 }
 
+func TestUserUsecase_ChangeUserStatus_RevokeSession(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name         string
+		status       int32
+		revokeErr    error
+		expectRevoke bool
+		expectError  bool
+	}{
+		{name: "disable revokes session", status: 0, expectRevoke: true},
+		{name: "enable keeps session", status: 1, expectRevoke: false},
+		{name: "revoke error", status: 0, revokeErr: assert.AnError, expectRevoke: true, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSessionRepo := new(MockUserSessionRepo)
+			if tt.expectRevoke {
+				mockSessionRepo.On("RevokeByUserID", ctx, "user-1").Return(tt.revokeErr)
+			}
+			uc, mockRepo := newTestUsecaseWithSession(t, mockSessionRepo)
+			mockRepo.On("FindByID", ctx, "user-1").Return(&user.User{ID: "user-1"}, nil)
+			mockRepo.On("UpdateStatus", ctx, mock.AnythingOfType("*user.UpdateStatusBO")).Return(nil)
+
+			err := uc.ChangeUserStatus(ctx, &user.UpdateStatusBO{UserID: "user-1", Status: tt.status})
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRepo.AssertExpectations(t)
+			mockSessionRepo.AssertExpectations(t)
+			if !tt.expectRevoke {
+				mockSessionRepo.AssertNotCalled(t, "RevokeByUserID", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
 func TestUserUsecase_DeleteUser(t *testing.T) {
 	ctx := context.Background()
 	tests := []struct {
@@ -557,3 +676,70 @@ func TestUserUsecase_UpdateLoginInfo(t *testing.T) {
 	assert.NoError(t, err)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestUserUsecase_ResetPassword(t *testing.T) {
+	ctx := context.Background()
+	current, err := pswd.HashPassword("Current@2024")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		password    string
+		expectError bool
+	}{
+		{name: "success", password: "Welcome@2024"},
+		{name: "too weak", password: "abc", expectError: true},
+		{name: "contains username", password: "Alice@2024", expectError: true},
+		{name: "reuse current", password: "Current@2024", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSessionRepo := new(MockUserSessionRepo)
+			uc, mockRepo := newTestUsecaseWithSession(t, mockSessionRepo)
+			mockRepo.On("FindByID", ctx, "user-1").Return(&user.User{ID: "user-1", Username: "alice", Password: current}, nil)
+			if !tt.expectError {
+				mockRepo.On("UpdatePassword", ctx, mock.MatchedBy(func(bo *user.UpdatePasswordBO) bool {
+					return bo.UserID == "user-1" && bo.PasswordReset
+				})).Return(nil)
+				mockSessionRepo.On("RevokeByUserID", ctx, "user-1").Return(nil)
+			}
+
+			err := uc.ResetPassword(ctx, &user.ResetPasswordBO{UserID: "user-1", Password: tt.password})
+
+			if tt.expectError {
+				assert.Error(t, err)
+				mockRepo.AssertNotCalled(t, "UpdatePassword", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRepo.AssertExpectations(t)
+			mockSessionRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// MockQuotaTenantRepo 只实现账号数量校验用到的查询
+type MockQuotaTenantRepo struct {
+	tenant.TenantRepo
+	tenants map[string]*tenant.Tenant
+}
+
+func (m *MockQuotaTenantRepo) LockByID(ctx context.Context, id string) (*tenant.Tenant, error) {
+	return m.tenants[id], nil
+}
+
+func TestUserUsecase_CreateUser_AccountQuota(t *testing.T) {
+	tenantUc := tenant.NewTenantUsecase(nil, &MockQuotaTenantRepo{tenants: map[string]*tenant.Tenant{
+		"T1": {ID: "T1", AccountCount: 2},
+	}}, nil, nil, log.DefaultLogger)
+
+	mockRepo := new(MockUserRepo)
+	mockRepo.On("FindByUsername", mock.Anything, "testuser").Return(nil, nil)
+	mockRepo.On("CountByTenant", mock.Anything).Return(int64(2), nil)
+	uc := user.NewUserUsecase(log.DefaultLogger, mockRepo, new(MockTransactionManager), nil, nil, nil, nil, nil, new(MockPasswordRepo), tenantUc)
+
+	err := uc.CreateUser(ctxs.WithTenantID(context.Background(), "T1"), &user.User{Username: "testuser", Password: "Welcome@2024"})
+	assert.Equal(t, string(errkey.ErrTenantAccountLimit), errors.Reason(err))
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
@@ -82,6 +82,14 @@ func (m *MockUserDeptRepoForRole) GetUserDepts(ctx context.Context, userID strin
 	return args.Get(0).([]*user.UserDept), args.Error(1)
 }
 
+func (m *MockUserDeptRepoForRole) ListByDeptID(ctx context.Context, deptID string) ([]*user.UserDept, error) {
+	args := m.Called(ctx, deptID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*user.UserDept), args.Error(1)
+}
+
 func (m *MockUserDeptRepoForRole) Delete(ctx context.Context, id string) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
@@ -126,6 +134,14 @@ func (m *MockUserRoleRepoForRole) GetUserRoles(ctx context.Context, userID strin
 	return args.Get(0).([]*user.UserRole), args.Error(1)
 }
 
+func (m *MockUserRoleRepoForRole) ListByRoleID(ctx context.Context, roleID string) ([]*user.UserRole, error) {
+	args := m.Called(ctx, roleID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*user.UserRole), args.Error(1)
+}
+
 func (m *MockUserRoleRepoForRole) Delete(ctx context.Context, id string) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
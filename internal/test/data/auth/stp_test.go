@@ -0,0 +1,28 @@
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"quest-admin/internal/data/auth"
+
+	"github.com/click33/sa-token-go/storage/memory"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAdminLogic_LoginSameDevice(t *testing.T) {
+	admin := auth.NewAdminLogic(memory.NewStorage(), time.Hour)
+
+	first, err := admin.Login("U1", "pc")
+	assert.NoError(t, err)
+	second, err := admin.Login("U1", "pc")
+	assert.NoError(t, err)
+
+	// 同一设备的两次登录各自持有令牌，后一次登录不影响前一次
+	assert.NotEqual(t, first, second)
+	for _, token := range []string{first, second} {
+		loginID, err := admin.GetLoginID(token)
+		assert.NoError(t, err)
+		assert.Equal(t, "U1", loginID)
+	}
+}
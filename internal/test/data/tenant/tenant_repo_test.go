@@ -2,13 +2,21 @@ package tenant_test
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"testing"
 	"time"
 
 	tenant "quest-admin/internal/biz/tenant"
+	"quest-admin/internal/data/data"
+	tenantData "quest-admin/internal/data/tenant"
 
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
 )
 
 type MockTenantRepo struct {
@@ -169,3 +177,38 @@ func TestTenantRepo_Delete(t *testing.T) {
 	assert.NoError(t, err)
 	mockRepo.AssertExpectations(t)
 }
+
+// offlineConnector 不连接数据库，只用于捕获生成的 SQL
+type offlineConnector struct{}
+
+func (offlineConnector) Connect(context.Context) (driver.Conn, error) {
+	return nil, errors.New("offline")
+}
+
+func (offlineConnector) Driver() driver.Driver {
+	return nil
+}
+
+// queryRecorder 记录执行的 SQL
+type queryRecorder struct {
+	queries []string
+}
+
+func (r *queryRecorder) BeforeQuery(ctx context.Context, event *bun.QueryEvent) context.Context {
+	r.queries = append(r.queries, event.Query)
+	return ctx
+}
+
+func (r *queryRecorder) AfterQuery(context.Context, *bun.QueryEvent) {}
+
+func TestTenantRepo_UpdateDisable(t *testing.T) {
+	db := bun.NewDB(sql.OpenDB(offlineConnector{}), pgdialect.New())
+	recorder := &queryRecorder{}
+	db.AddQueryHook(recorder)
+	repo := tenantData.NewTenantRepo(data.NewData(db), log.DefaultLogger)
+
+	_ = repo.Update(context.Background(), &tenant.Tenant{ID: "tenant-1", Name: "Company A", Status: 0})
+
+	assert.Len(t, recorder.queries, 1)
+	assert.Contains(t, recorder.queries[0], `"status" = 0`)
+}
@@ -47,7 +47,7 @@ func Err(key ErrorKey, args ...interface{}) *errors.Error {
 	}
 
 	if len(args) > 0 {
-		return errors.Newf(template.Code, template.Reason, template.Message, args)
+		return errors.Newf(template.Code, template.Reason, template.Message, args...)
 	}
 	return errors.New(template.Code, template.Reason, template.Message)
 }
@@ -0,0 +1,61 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+)
+
+var ErrCiphertext = errors.New("crypto: malformed ciphertext")
+
+// Cipher AES-256-GCM 加解密，密文格式为 base64(nonce || ciphertext)
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher 由任意长度的密钥口令经 SHA-256 派生 256 位密钥
+func NewCipher(key string) (*Cipher, error) {
+	if key == "" {
+		return nil, errors.New("crypto: empty key")
+	}
+	sum := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(sum[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// Encrypt 加密明文，每次使用随机 nonce
+func (c *Cipher) Encrypt(plaintext string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt 解密 Encrypt 生成的密文
+func (c *Cipher) Decrypt(ciphertext string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return "", ErrCiphertext
+	}
+	size := c.aead.NonceSize()
+	if len(data) < size {
+		return "", ErrCiphertext
+	}
+	plaintext, err := c.aead.Open(nil, data[:size], data[size:], nil)
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
@@ -0,0 +1,37 @@
+package mail
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+type fileSender struct {
+	dir  string
+	from string
+}
+
+// NewFileSender 将邮件以 .eml 文件写入目录而不真正发送，用于本地开发和测试
+func NewFileSender(dir, from string) Sender {
+	return &fileSender{dir: dir, from: from}
+}
+
+func (s *fileSender) Send(ctx context.Context, msg *Message) error {
+	data, err := build(s.from, msg)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(s.dir, 0o700); err != nil {
+		return err
+	}
+	b := make([]byte, 4)
+	if _, err = rand.Read(b); err != nil {
+		return err
+	}
+	name := strconv.FormatInt(time.Now().UnixNano(), 10) + "-" + hex.EncodeToString(b) + ".eml"
+	return os.WriteFile(filepath.Join(s.dir, name), data, 0o600)
+}
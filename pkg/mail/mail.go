@@ -0,0 +1,73 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"time"
+)
+
+// Message 邮件内容，正文为纯文本
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Sender 邮件发送器
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// build 按 RFC 5322 组装邮件，主题使用 B 编码，正文使用 quoted-printable 编码
+func build(from string, msg *Message) ([]byte, error) {
+	if len(msg.To) == 0 {
+		return nil, fmt.Errorf("mail: no recipient")
+	}
+	for _, addr := range append([]string{from}, msg.To...) {
+		if _, err := mail.ParseAddress(addr); err != nil {
+			return nil, fmt.Errorf("mail: invalid address %q: %w", addr, err)
+		}
+	}
+
+	var buf bytes.Buffer
+	header := func(key, value string) {
+		// 防止通过换行注入额外的邮件头
+		value = strings.NewReplacer("\r", "", "\n", "").Replace(value)
+		buf.WriteString(key + ": " + value + "\r\n")
+	}
+	header("From", from)
+	header("To", strings.Join(msg.To, ", "))
+	header("Subject", mime.BEncoding.Encode("UTF-8", msg.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", messageID(from))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=UTF-8")
+	header("Content-Transfer-Encoding", "quoted-printable")
+	buf.WriteString("\r\n")
+
+	w := quotedprintable.NewWriter(&buf)
+	if _, err := w.Write([]byte(msg.Body)); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func messageID(from string) string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	domain := "localhost"
+	if i := strings.LastIndex(from, "@"); i >= 0 {
+		domain = strings.TrimRight(from[i+1:], ">")
+	}
+	return "<" + hex.EncodeToString(b) + "@" + domain + ">"
+}
@@ -0,0 +1,44 @@
+package mail
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSender(t *testing.T) {
+	dir := t.TempDir()
+	sender := NewFileSender(dir, "Quest Admin <noreply@example.com>")
+
+	err := sender.Send(context.Background(), &Message{
+		To:      []string{"alice@example.com"},
+		Subject: "重置密码",
+		Body:    "点击链接重置密码",
+	})
+	assert.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	assert.NoError(t, err)
+	if !assert.Len(t, files, 1) {
+		return
+	}
+	data, err := os.ReadFile(files[0])
+	assert.NoError(t, err)
+	content := string(data)
+	assert.Contains(t, content, "To: alice@example.com\r\n")
+	assert.Contains(t, content, "Subject: =?UTF-8?b?")
+	assert.Contains(t, content, "Message-ID: <")
+	assert.True(t, strings.Contains(content, "\r\n\r\n"))
+}
+
+func TestBuild_InvalidAddress(t *testing.T) {
+	_, err := build("noreply@example.com", &Message{To: []string{"alice@example.com\r\nBcc: eve@example.com"}})
+	assert.Error(t, err)
+
+	_, err = build("noreply@example.com", &Message{})
+	assert.Error(t, err)
+}
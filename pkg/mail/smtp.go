@@ -0,0 +1,101 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+)
+
+// SMTPConfig SMTP 服务器配置
+type SMTPConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+	// ImplicitTLS 为 true 时直接建立 TLS 连接（通常为 465 端口），否则在服务器支持时使用 STARTTLS
+	ImplicitTLS bool
+	Timeout     time.Duration
+}
+
+type smtpSender struct {
+	conf SMTPConfig
+}
+
+// NewSMTPSender 通过 SMTP 服务器发送邮件
+func NewSMTPSender(conf SMTPConfig) Sender {
+	if conf.Timeout <= 0 {
+		conf.Timeout = 10 * time.Second
+	}
+	return &smtpSender{conf: conf}
+}
+
+func (s *smtpSender) Send(ctx context.Context, msg *Message) error {
+	data, err := build(s.conf.From, msg)
+	if err != nil {
+		return err
+	}
+
+	addr := net.JoinHostPort(s.conf.Host, strconv.Itoa(s.conf.Port))
+	dialer := &net.Dialer{Timeout: s.conf.Timeout}
+	var conn net.Conn
+	if s.conf.ImplicitTLS {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: s.conf.Host}}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	deadline := time.Now().Add(s.conf.Timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	_ = conn.SetDeadline(deadline)
+
+	client, err := smtp.NewClient(conn, s.conf.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if !s.conf.ImplicitTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err = client.StartTLS(&tls.Config{ServerName: s.conf.Host}); err != nil {
+				return err
+			}
+		}
+	}
+	if s.conf.Username != "" {
+		if err = client.Auth(smtp.PlainAuth("", s.conf.Username, s.conf.Password, s.conf.Host)); err != nil {
+			return err
+		}
+	}
+
+	from, _ := mail.ParseAddress(s.conf.From)
+	if err = client.Mail(from.Address); err != nil {
+		return err
+	}
+	for _, to := range msg.To {
+		addr, _ := mail.ParseAddress(to)
+		if err = client.Rcpt(addr.Address); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err = w.Write(data); err != nil {
+		return err
+	}
+	if err = w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
@@ -0,0 +1,171 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"quest-admin/internal/biz/audit"
+	"quest-admin/pkg/lang/slices"
+	pkglogger "quest-admin/pkg/logger"
+	"quest-admin/pkg/util/ctxs"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	redacted = "******"
+	// maxRequestLen 请求参数最大记录长度，超出部分截断
+	maxRequestLen = 4096
+)
+
+// sensitiveKeys 字段名（忽略大小写）包含以下关键字时脱敏
+var sensitiveKeys = []string{"password", "token", "secret", "credential", "captcha", "otp", "ticket", "verifier"}
+
+// sensitiveExactKeys 字段名（忽略大小写）等于以下值时脱敏，验证码和授权码都使用 code，
+// 不按包含匹配以免把 codeChallenge 等公开字段也脱敏
+var sensitiveExactKeys = []string{"code"}
+
+// LoadReadOperations 读取已注册 proto 中以 GET 方式暴露的方法，视为只读操作
+func LoadReadOperations() map[string]struct{} {
+	operations := make(map[string]struct{})
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			service := services.Get(i)
+			methods := service.Methods()
+			for j := 0; j < methods.Len(); j++ {
+				method := methods.Get(j)
+				rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+				if ok && rule.GetGet() != "" {
+					operations[fmt.Sprintf("/%s/%s", service.FullName(), method.Name())] = struct{}{}
+				}
+			}
+		}
+		return true
+	})
+	return operations
+}
+
+// OperateLog 记录所有非只读操作的审计日志，超级管理员切换租户后的请求不论读写都会记录，
+// 记在其登录时所属的租户下。日志异步落库，需放在 AdminHttpServer 之后
+func OperateLog(uc *audit.OperateLogUsecase) middleware.Middleware {
+	readOperations := LoadReadOperations()
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			switchFrom, switched := ctxs.GetTenantSwitch(ctx)
+			if _, ok := readOperations[tr.Operation()]; ok && !switched {
+				return handler(ctx, req)
+			}
+
+			start := time.Now()
+			reply, err = handler(ctx, req)
+
+			operateLog := &audit.OperateLog{
+				Operation: tr.Operation(),
+				LoginID:   ctxs.GetLoginID(ctx),
+				TenantID:  ctxs.GetTenantID(ctx),
+				Request:   RedactRequest(req),
+				Code:      200,
+				LatencyMs: time.Since(start).Milliseconds(),
+				ClientIP:  ctxs.GetClientIP(ctx),
+				UserAgent: ctxs.GetUserAgent(ctx),
+				OperateAt: start,
+			}
+			if switched {
+				operateLog.TenantID = switchFrom
+				operateLog.SwitchTenantID = ctxs.GetTenantID(ctx)
+			}
+			if traceID, ok := ctx.Value(pkglogger.TraceIdKey).(string); ok {
+				operateLog.TraceID = traceID
+			}
+			if ht, ok := tr.(http.Transporter); ok {
+				operateLog.Method = ht.Request().Method
+				operateLog.Path = ht.Request().URL.Path
+			}
+			if err != nil {
+				se := errors.FromError(err)
+				operateLog.Code = se.Code
+				operateLog.Reason = se.Reason
+			}
+			uc.RecordOperateLog(ctx, operateLog)
+			return reply, err
+		}
+	}
+}
+
+// RedactRequest 将请求序列化为 JSON，并对敏感字段脱敏
+func RedactRequest(req interface{}) string {
+	var (
+		data []byte
+		err  error
+	)
+	if msg, ok := req.(proto.Message); ok {
+		data, err = protojson.Marshal(msg)
+	} else {
+		data, err = json.Marshal(req)
+	}
+	if err != nil {
+		return ""
+	}
+
+	var value interface{}
+	if err = json.Unmarshal(data, &value); err != nil {
+		return ""
+	}
+	data, err = json.Marshal(redact(value))
+	if err != nil {
+		return ""
+	}
+	if len(data) > maxRequestLen {
+		return strings.ToValidUTF8(string(data[:maxRequestLen]), "")
+	}
+	return string(data)
+}
+
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if isSensitive(key) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redact(item)
+		}
+		return v
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redact(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func isSensitive(key string) bool {
+	key = strings.ToLower(key)
+	if slices.Contains(sensitiveExactKeys, key) {
+		return true
+	}
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,41 @@
+package audit
+
+import (
+	"testing"
+
+	authv1 "quest-admin/api/gen/auth/v1"
+	userv1 "quest-admin/api/gen/user/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadReadOperations(t *testing.T) {
+	operations := LoadReadOperations()
+
+	_, ok := operations[userv1.OperationUserServiceListUsers]
+	assert.True(t, ok)
+
+	_, ok = operations[userv1.OperationUserServiceDeleteUser]
+	assert.False(t, ok)
+}
+
+func TestRedactRequest(t *testing.T) {
+	username, password := "admin", "123456"
+	got := RedactRequest(&authv1.LoginRequest{Username: &username, Password: &password})
+	assert.JSONEq(t, `{"username":"admin","password":"******"}`, got)
+
+	refreshToken := "abc"
+	got = RedactRequest(&authv1.RefreshTokenRequest{RefreshToken: &refreshToken})
+	assert.JSONEq(t, `{"refreshToken":"******"}`, got)
+
+	channel, target, code := "sms", "13800138000", "123456"
+	got = RedactRequest(&authv1.LoginByCodeRequest{Channel: &channel, Target: &target, Code: &code})
+	assert.JSONEq(t, `{"channel":"sms","target":"13800138000","code":"******"}`, got)
+
+	state := "xyz"
+	got = RedactRequest(&authv1.SocialCallbackRequest{State: &state, Code: &code})
+	assert.JSONEq(t, `{"state":"xyz","code":"******"}`, got)
+
+	got = RedactRequest(map[string]string{"grant_type": "authorization_code", "code": "abc", "code_verifier": "def"})
+	assert.JSONEq(t, `{"grant_type":"authorization_code","code":"******","code_verifier":"******"}`, got)
+}
@@ -3,46 +3,206 @@ package auth
 import (
 	"context"
 	v1 "quest-admin/api/gen/auth/v1"
+	userv1 "quest-admin/api/gen/user/v1"
+	authBiz "quest-admin/internal/biz/auth"
+	tenantBiz "quest-admin/internal/biz/tenant"
 	"quest-admin/internal/data/auth"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/errkey"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
 )
 
 var whitList = []string{
 	v1.OperationAuthServiceLogin,
+	v1.OperationAuthServiceRefreshToken,
+	v1.OperationAuthServiceGetCaptcha,
+	v1.OperationAuthServiceVerifyMfa,
+	v1.OperationAuthServiceBeginPasskeyLogin,
+	v1.OperationAuthServiceFinishPasskeyLogin,
+	v1.OperationAuthServiceSendLoginCode,
+	v1.OperationAuthServiceLoginByCode,
+	v1.OperationAuthServiceRequestPasswordReset,
+	v1.OperationAuthServiceResetPasswordWithToken,
+	v1.OperationSocialServiceListSocialProviders,
+	v1.OperationSocialServiceSocialCallback,
 }
 
-func AdminHttpServer(manager *auth.Manager) middleware.Middleware {
+// optionalLoginList 未携带令牌时也可以访问的接口，携带令牌时仍会解析登录用户（如第三方账号绑定跳转）
+var optionalLoginList = []string{
+	v1.OperationSocialServiceGetAuthorizeURL,
+}
+
+// passwordChangeAllowList 需要先修改密码的会话只能访问的接口
+var passwordChangeAllowList = []string{
+	userv1.OperationUserServiceChangePassword,
+	v1.OperationAuthServiceLogout,
+}
+
+// SuperAdminRole 平台超级管理员的角色编码，只有全局租户下持有该角色的用户可以通过 Tenant 请求头切换租户
+const SuperAdminRole = "super_admin"
+
+// AdminHttpServer 解析登录令牌或 API Key，API Key 以 Bearer 方式携带，所属租户以 Key 为准；
+// OAuth2 签发的令牌按授权记录限定范围，所属租户以客户端为准；登录令牌的租户以登录时绑定的为准，
+// Tenant 请求头只对平台超级管理员生效。除免登录接口和可选登录接口外都必须携带令牌，免登录接口和未携带令牌的请求按请求 Host 匹配租户网站。解析出用户后校验来源 IP 是否在白名单内，
+// 需要先修改密码的会话只能修改密码或退出登录
+func AdminHttpServer(manager *auth.Manager, apiKeyUc *authBiz.ApiKeyUsecase, ipRuleUc *authBiz.IpRuleUsecase, tenantUc *tenantBiz.TenantUsecase) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
 			var (
 				loginID  = "unknown"
 				tenantId = ""
+				token    = ""
 			)
 			if tr, ok := transport.FromServerContext(ctx); ok {
 				operation := tr.Operation()
 				for _, v := range whitList {
 					if v == operation {
-						return handler(ctx, req)
+						tenantId, err = tenantUc.ResolveByHost(ctx, requestHost(tr))
+						if err != nil {
+							return nil, err
+						}
+						return handler(context.WithValue(ctx, "tenant_id", tenantId), req)
 					}
 				}
 
-				token := tr.RequestHeader().Get("Authorization")
-				if token != "" {
+				token = strings.TrimPrefix(tr.RequestHeader().Get("Authorization"), "Bearer ")
+				if authBiz.IsApiKey(token) {
+					principal, err := apiKeyUc.ResolveApiKey(ctx, token)
+					if err != nil {
+						return nil, err
+					}
+					ctx = ctxs.WithApiKey(ctx, principal.KeyID, principal.Permissions)
+					ctx = context.WithValue(ctx, "login_id", principal.UserID)
+					ctx = context.WithValue(ctx, "tenant_id", principal.TenantID)
+					if err = ipRuleUc.Enforce(ctx, principal.UserID); err != nil {
+						return nil, err
+					}
+					return handler(ctx, req)
+				}
+				if token == "" {
+					if err = RequireToken(operation); err != nil {
+						return nil, err
+					}
+					// 可选登录接口未登录时同样按 Host 确定租户
+					if tenantId, err = tenantUc.ResolveByHost(ctx, requestHost(tr)); err != nil {
+						return nil, err
+					}
+				} else {
 					loginID, err = manager.Admin.GetLoginID(token)
 					if err != nil {
 						return nil, errors.New(401, "UNAUTHORIZED", "Token is invalid")
 					}
-				}
-				if tmpTenantId := tr.RequestHeader().Get("Tenant"); tmpTenantId != "" {
-					tenantId = tmpTenantId
+					err = CheckPasswordChange(operation, func() (bool, error) {
+						return manager.PasswordChangeRequired(ctx, loginID)
+					})
+					if err != nil {
+						return nil, err
+					}
+					grant, err := manager.GetTokenGrant(ctx, token)
+					if err != nil {
+						return nil, err
+					}
+					if grant != nil {
+						ctx = ctxs.WithOAuth2Client(ctx, grant.ClientID, grant.Scopes)
+						tenantId = grant.TenantID
+					} else {
+						sessionTenant, ok, err := manager.SessionTenant(ctx, token)
+						if err != nil {
+							return nil, err
+						}
+						if !ok {
+							return nil, errors.New(401, "UNAUTHORIZED", "Token is invalid")
+						}
+						target, switched, err := SwitchTenant(sessionTenant, tr.RequestHeader().Get("Tenant"), func() bool {
+							return manager.Admin.HasRole(loginID, SuperAdminRole)
+						})
+						if err != nil {
+							return nil, err
+						}
+						if switched {
+							if err = checkSwitchTarget(ctx, tenantUc, target); err != nil {
+								return nil, err
+							}
+							ctx = ctxs.WithTenantSwitch(ctx, sessionTenant)
+						}
+						tenantId = target
+					}
 				}
 			}
 			ctx = context.WithValue(ctx, "login_id", loginID)
 			ctx = context.WithValue(ctx, "tenant_id", tenantId)
+			ctx = context.WithValue(ctx, "token", token)
+			if token != "" {
+				if err = ipRuleUc.Enforce(ctx, loginID); err != nil {
+					return nil, err
+				}
+			}
 			return handler(ctx, req)
 		}
 	}
 }
+
+// SwitchTenant 按 Tenant 请求头决定本次请求访问的租户。请求头为空或与会话租户一致时使用会话租户；
+// 否则只有全局租户下的超级管理员可以切换，其余情况拒绝访问
+func SwitchTenant(sessionTenant, header string, isSuperAdmin func() bool) (tenantID string, switched bool, err error) {
+	if header == "" || header == sessionTenant {
+		return sessionTenant, false, nil
+	}
+	if sessionTenant != "" || !isSuperAdmin() {
+		return "", false, errorx.Err(errkey.ErrTenantSwitchDenied)
+	}
+	return header, true, nil
+}
+
+// RequireToken 未携带令牌时只放行可选登录接口，其余接口返回未授权
+func RequireToken(operation string) error {
+	for _, v := range optionalLoginList {
+		if v == operation {
+			return nil
+		}
+	}
+	return errorx.Err(errkey.ErrUnauthorized)
+}
+
+// CheckPasswordChange 会话需要先修改密码时，只放行修改密码和退出登录
+func CheckPasswordChange(operation string, required func() (bool, error)) error {
+	for _, v := range passwordChangeAllowList {
+		if v == operation {
+			return nil
+		}
+	}
+	ok, err := required()
+	if err != nil {
+		return err
+	}
+	if ok {
+		return errorx.Err(errkey.ErrPasswordChangeRequired)
+	}
+	return nil
+}
+
+// checkSwitchTarget 只允许切换到已登记的租户
+func checkSwitchTarget(ctx context.Context, tenantUc *tenantBiz.TenantUsecase, tenantID string) error {
+	tenant, err := tenantUc.GetTenant(ctx, tenantID)
+	if err != nil {
+		return err
+	}
+	if tenant == nil {
+		return errorx.Err(errkey.ErrTenantNotFound)
+	}
+	return nil
+}
+
+// requestHost 获取请求的 Host，gRPC 请求取 :authority
+func requestHost(tr transport.Transporter) string {
+	if ht, ok := tr.(http.Transporter); ok {
+		return ht.Request().Host
+	}
+	return tr.RequestHeader().Get(":authority")
+}
@@ -0,0 +1,102 @@
+package auth
+
+import (
+	"testing"
+
+	v1 "quest-admin/api/gen/auth/v1"
+	tenantv1 "quest-admin/api/gen/tenant/v1"
+	userv1 "quest-admin/api/gen/user/v1"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwitchTenant(t *testing.T) {
+	superAdmin := func() bool { return true }
+	normalUser := func() bool { return false }
+
+	tests := []struct {
+		name          string
+		sessionTenant string
+		header        string
+		isSuperAdmin  func() bool
+		wantTenant    string
+		wantSwitched  bool
+		wantReason    string
+	}{
+		{name: "未携带请求头", sessionTenant: "T1", isSuperAdmin: normalUser, wantTenant: "T1"},
+		{name: "请求头与会话一致", sessionTenant: "T1", header: "T1", isSuperAdmin: normalUser, wantTenant: "T1"},
+		{name: "普通用户切换租户", sessionTenant: "T1", header: "T2", isSuperAdmin: normalUser, wantReason: string(errkey.ErrTenantSwitchDenied)},
+		{name: "租户内超级管理员角色不能切换", sessionTenant: "T1", header: "T2", isSuperAdmin: superAdmin, wantReason: string(errkey.ErrTenantSwitchDenied)},
+		{name: "全局租户非超级管理员", sessionTenant: "", header: "T2", isSuperAdmin: normalUser, wantReason: string(errkey.ErrTenantSwitchDenied)},
+		{name: "平台超级管理员切换租户", sessionTenant: "", header: "T2", isSuperAdmin: superAdmin, wantTenant: "T2", wantSwitched: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tenantID, switched, err := SwitchTenant(tt.sessionTenant, tt.header, tt.isSuperAdmin)
+			assert.Equal(t, tt.wantReason, errors.Reason(err))
+			assert.Equal(t, tt.wantTenant, tenantID)
+			assert.Equal(t, tt.wantSwitched, switched)
+		})
+	}
+}
+
+func TestCheckPasswordChange(t *testing.T) {
+	required := func() (bool, error) { return true, nil }
+	notRequired := func() (bool, error) { return false, nil }
+
+	tests := []struct {
+		name       string
+		operation  string
+		required   func() (bool, error)
+		wantReason string
+	}{
+		{name: "无需修改密码", operation: userv1.OperationUserServiceListUsers, required: notRequired},
+		{name: "需修改密码时访问其它接口", operation: userv1.OperationUserServiceListUsers, required: required, wantReason: string(errkey.ErrPasswordChangeRequired)},
+		{name: "需修改密码时修改密码", operation: userv1.OperationUserServiceChangePassword, required: required},
+		{name: "需修改密码时退出登录", operation: v1.OperationAuthServiceLogout, required: required},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckPasswordChange(tt.operation, tt.required)
+			assert.Equal(t, tt.wantReason, errors.Reason(err))
+		})
+	}
+}
+
+func TestRequireToken(t *testing.T) {
+	tests := []struct {
+		name       string
+		operation  string
+		wantReason string
+	}{
+		{name: "可选登录接口", operation: v1.OperationSocialServiceGetAuthorizeURL},
+		{name: "未配置权限规则的接口", operation: userv1.OperationUserServiceChangePassword, wantReason: string(errkey.ErrUnauthorized)},
+		{name: "需要权限的接口", operation: userv1.OperationUserServiceListUsers, wantReason: string(errkey.ErrUnauthorized)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantReason, errors.Reason(RequireToken(tt.operation)))
+		})
+	}
+}
+
+func TestCheckPlatformOperation(t *testing.T) {
+	tests := []struct {
+		name       string
+		operation  string
+		tenantID   string
+		wantReason string
+	}{
+		{name: "全局租户管理租户", operation: tenantv1.OperationTenantServiceCreateTenant},
+		{name: "租户内管理租户", operation: tenantv1.OperationTenantServiceCreateTenant, tenantID: "T1", wantReason: string(errkey.ErrForbidden)},
+		{name: "租户内管理套餐", operation: tenantv1.OperationTenantPackageServiceListTenantPackages, tenantID: "T1", wantReason: string(errkey.ErrForbidden)},
+		{name: "租户内访问其它接口", operation: userv1.OperationUserServiceListUsers, tenantID: "T1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantReason, errors.Reason(CheckPlatformOperation(tt.operation, tt.tenantID)))
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"context"
+	permBiz "quest-admin/internal/biz/permission"
+	"quest-admin/pkg/util/ctxs"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+)
+
+// DataScope 为已登录的请求设置按角色解析的数据范围，只在查询用到时解析，需放在 AdminHttpServer 之后
+func DataScope(uc *permBiz.DataScopeUsecase) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			if loginID := ctxs.GetLoginID(ctx); loginID != "" {
+				ctx = ctxs.WithDataScope(ctx, func(ctx context.Context) (*ctxs.DataScope, error) {
+					return uc.Resolve(ctx, loginID)
+				})
+			}
+			return handler(ctx, req)
+		}
+	}
+}
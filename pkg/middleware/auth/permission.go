@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"quest-admin/api/gen/quest"
+	"quest-admin/internal/data/auth"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/lang/slices"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/errkey"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// LoadRules 读取已注册 proto 中声明的 (quest.auth) 方法选项，key 为 kratos operation
+func LoadRules() map[string]*quest.AuthRule {
+	rules := make(map[string]*quest.AuthRule)
+	protoregistry.GlobalFiles.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			service := services.Get(i)
+			methods := service.Methods()
+			for j := 0; j < methods.Len(); j++ {
+				method := methods.Get(j)
+				if !proto.HasExtension(method.Options(), quest.E_Auth) {
+					continue
+				}
+				rule := proto.GetExtension(method.Options(), quest.E_Auth).(*quest.AuthRule)
+				rules[fmt.Sprintf("/%s/%s", service.FullName(), method.Name())] = rule
+			}
+		}
+		return true
+	})
+	return rules
+}
+
+// Permission 按接口声明的权限码和角色校验当前登录用户，需放在 AdminHttpServer 之后
+func Permission(manager *auth.Manager) middleware.Middleware {
+	rules := LoadRules()
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if ctxs.IsScoped(ctx) {
+					if !ScopeAllowed(rules[tr.Operation()], ctxs.GetScopes(ctx)) {
+						return nil, errorx.Err(errkey.ErrForbidden)
+					}
+					// API Key 的权限在解析时已与所属用户的当前权限取交集，OAuth2 令牌继续校验会话权限
+					if ctxs.GetApiKeyID(ctx) != "" {
+						return handler(ctx, req)
+					}
+				}
+				if rule, ok := rules[tr.Operation()]; ok {
+					loginID := ctxs.GetLoginID(ctx)
+					if rule.GetPermission() != "" && !manager.Admin.HasPermission(loginID, rule.GetPermission()) {
+						return nil, errorx.Err(errkey.ErrForbidden)
+					}
+					if len(rule.GetRoles()) != 0 && !manager.Admin.HasRolesOr(loginID, rule.GetRoles()) {
+						return nil, errorx.Err(errkey.ErrForbidden)
+					}
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// ScopeAllowed API Key 和 OAuth2 令牌只能调用声明了权限码且不限定角色的接口，且权限码需在授权范围内
+func ScopeAllowed(rule *quest.AuthRule, scopes []string) bool {
+	if rule.GetPermission() == "" || len(rule.GetRoles()) != 0 {
+		return false
+	}
+	return slices.Contains(scopes, rule.GetPermission())
+}
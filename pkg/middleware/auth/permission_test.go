@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"testing"
+
+	authv1 "quest-admin/api/gen/auth/v1"
+	"quest-admin/api/gen/quest"
+	userv1 "quest-admin/api/gen/user/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadRules(t *testing.T) {
+	rules := LoadRules()
+
+	rule, ok := rules[userv1.OperationUserServiceDeleteUser]
+	assert.True(t, ok)
+	assert.Equal(t, "system:user:delete", rule.GetPermission())
+
+	_, ok = rules[authv1.OperationAuthServiceLogin]
+	assert.False(t, ok)
+}
+
+func TestScopeAllowed(t *testing.T) {
+	scopes := []string{"system:user:list"}
+
+	assert.True(t, ScopeAllowed(&quest.AuthRule{Permission: "system:user:list"}, scopes))
+	assert.False(t, ScopeAllowed(&quest.AuthRule{Permission: "system:user:delete"}, scopes))
+	assert.False(t, ScopeAllowed(&quest.AuthRule{Permission: "system:user:list", Roles: []string{"admin"}}, scopes))
+	// 未声明权限码的接口不允许 API Key 和 OAuth2 令牌调用
+	assert.False(t, ScopeAllowed(nil, scopes))
+}
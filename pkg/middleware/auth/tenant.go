@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	tenantv1 "quest-admin/api/gen/tenant/v1"
+	tenantBiz "quest-admin/internal/biz/tenant"
+	"quest-admin/pkg/errorx"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/types/errkey"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// platformServices 管理租户和套餐的服务，只能在全局租户下访问
+var platformServices = []string{
+	tenantv1.TenantService_ServiceDesc.ServiceName,
+	tenantv1.TenantPackageService_ServiceDesc.ServiceName,
+}
+
+// TenantGuard 拒绝已停用或已过期租户的已登录请求，租户内的请求不能访问平台服务，需放在 AdminHttpServer 之后
+func TenantGuard(uc *tenantBiz.TenantUsecase) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if err = CheckPlatformOperation(tr.Operation(), ctxs.GetTenantID(ctx)); err != nil {
+					return nil, err
+				}
+			}
+			if ctxs.GetLoginID(ctx) != "" {
+				if err = uc.CheckAvailable(ctx, ctxs.GetTenantID(ctx)); err != nil {
+					return nil, err
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// CheckPlatformOperation 平台服务的接口只允许全局租户访问
+func CheckPlatformOperation(operation, tenantID string) error {
+	if tenantID == "" {
+		return nil
+	}
+	for _, service := range platformServices {
+		if strings.HasPrefix(operation, "/"+service+"/") {
+			return errorx.Err(errkey.ErrForbidden)
+		}
+	}
+	return nil
+}
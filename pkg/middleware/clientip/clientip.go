@@ -0,0 +1,37 @@
+package clientip
+
+import (
+	"context"
+	"quest-admin/pkg/util/ctxs"
+	"quest-admin/pkg/util/ipx"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+)
+
+// Server 解析请求方 IP 写入上下文，只有直连地址属于 trustedProxies 时才采信 X-Forwarded-For 和 X-Real-IP，
+// 需放在读取 IP 的中间件之前
+func Server(trustedProxies []string) (middleware.Middleware, error) {
+	trusted, err := ipx.ParsePrefixes(trustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			var remoteAddr string
+			if r, ok := http.RequestFromServerContext(ctx); ok {
+				remoteAddr = r.RemoteAddr
+			} else if p, ok := peer.FromContext(ctx); ok {
+				remoteAddr = p.Addr.String()
+			}
+			ip := ipx.ClientIP(remoteAddr, tr.RequestHeader().Get("X-Forwarded-For"), tr.RequestHeader().Get("X-Real-IP"), trusted)
+			return handler(ctxs.WithClientIP(ctx, ip), req)
+		}
+	}, nil
+}
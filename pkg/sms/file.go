@@ -0,0 +1,37 @@
+package sms
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+type fileSender struct {
+	dir string
+}
+
+// NewFileSender 将短信以 .txt 文件写入目录而不真正发送，用于本地开发和测试
+func NewFileSender(dir string) Sender {
+	return &fileSender{dir: dir}
+}
+
+func (s *fileSender) Send(ctx context.Context, msg *Message) error {
+	if msg.Mobile == "" {
+		return fmt.Errorf("sms: no recipient")
+	}
+	if err := os.MkdirAll(s.dir, 0o700); err != nil {
+		return err
+	}
+	b := make([]byte, 4)
+	if _, err := rand.Read(b); err != nil {
+		return err
+	}
+	name := strconv.FormatInt(time.Now().UnixNano(), 10) + "-" + hex.EncodeToString(b) + ".txt"
+	data := "To: " + msg.Mobile + "\n\n" + msg.Content + "\n"
+	return os.WriteFile(filepath.Join(s.dir, name), []byte(data), 0o600)
+}
@@ -0,0 +1,21 @@
+package sms
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+type logSender struct {
+	log *log.Helper
+}
+
+// NewLogSender 将短信内容写入日志而不真正发送，用于本地开发
+func NewLogSender(logger log.Logger) Sender {
+	return &logSender{log: log.NewHelper(log.With(logger, "module", "sms"))}
+}
+
+func (s *logSender) Send(ctx context.Context, msg *Message) error {
+	s.log.WithContext(ctx).Infof("短信未真正发送,mobile:%s,content:%s", msg.Mobile, msg.Content)
+	return nil
+}
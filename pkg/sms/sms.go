@@ -0,0 +1,14 @@
+package sms
+
+import "context"
+
+// Message 短信内容
+type Message struct {
+	Mobile  string
+	Content string
+}
+
+// Sender 短信发送器，接入短信服务商时实现该接口
+type Sender interface {
+	Send(ctx context.Context, msg *Message) error
+}
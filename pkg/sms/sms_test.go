@@ -0,0 +1,29 @@
+package sms
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSender(t *testing.T) {
+	dir := t.TempDir()
+	sender := NewFileSender(dir)
+
+	err := sender.Send(context.Background(), &Message{Mobile: "13800000000", Content: "验证码 123456"})
+	assert.NoError(t, err)
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.txt"))
+	assert.NoError(t, err)
+	if !assert.Len(t, files, 1) {
+		return
+	}
+	data, err := os.ReadFile(files[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "To: 13800000000\n\n验证码 123456\n", string(data))
+
+	assert.Error(t, sender.Send(context.Background(), &Message{Content: "验证码 123456"}))
+}
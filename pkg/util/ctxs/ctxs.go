@@ -5,6 +5,11 @@ import "context"
 var (
 	LoginIDKey = "login_id"
 	TenantKey  = "tenant_id"
+	TokenKey   = "token"
+	ApiKeyKey  = "api_key_id"
+	ClientKey  = "oauth2_client_id"
+	ScopesKey  = "scopes"
+	SwitchKey  = "tenant_switch_from"
 )
 
 func GetLoginID(ctx context.Context) string {
@@ -20,3 +25,67 @@ func GetTenantID(ctx context.Context) string {
 	}
 	return ""
 }
+
+func GetToken(ctx context.Context) string {
+	if val, ok := ctx.Value(TokenKey).(string); ok {
+		return val
+	}
+	return ""
+}
+
+// WithTenantID 返回切换到指定租户的上下文，空字符串表示全局
+func WithTenantID(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, TenantKey, tenantID)
+}
+
+// WithApiKey 标记请求使用 API Key 认证，scopes 为该 Key 当前生效的权限码
+func WithApiKey(ctx context.Context, keyID string, scopes []string) context.Context {
+	ctx = context.WithValue(ctx, ApiKeyKey, keyID)
+	return context.WithValue(ctx, ScopesKey, scopes)
+}
+
+// GetApiKeyID 获取请求使用的 API Key 编号，非 API Key 认证时为空
+func GetApiKeyID(ctx context.Context) string {
+	if val, ok := ctx.Value(ApiKeyKey).(string); ok {
+		return val
+	}
+	return ""
+}
+
+// WithOAuth2Client 标记请求使用 OAuth2 令牌认证，scopes 为令牌授权的范围
+func WithOAuth2Client(ctx context.Context, clientID string, scopes []string) context.Context {
+	ctx = context.WithValue(ctx, ClientKey, clientID)
+	return context.WithValue(ctx, ScopesKey, scopes)
+}
+
+// GetOAuth2ClientID 获取签发令牌的 OAuth2 客户端，非 OAuth2 令牌时为空
+func GetOAuth2ClientID(ctx context.Context) string {
+	if val, ok := ctx.Value(ClientKey).(string); ok {
+		return val
+	}
+	return ""
+}
+
+// IsScoped 请求是否使用受授权范围限制的凭证（API Key 或 OAuth2 令牌）
+func IsScoped(ctx context.Context) bool {
+	return GetApiKeyID(ctx) != "" || GetOAuth2ClientID(ctx) != ""
+}
+
+// GetScopes 获取 API Key 或 OAuth2 令牌当前生效的权限码
+func GetScopes(ctx context.Context) []string {
+	if val, ok := ctx.Value(ScopesKey).([]string); ok {
+		return val
+	}
+	return nil
+}
+
+// WithTenantSwitch 标记请求由超级管理员切换租户访问，from 为其登录时所属的租户
+func WithTenantSwitch(ctx context.Context, from string) context.Context {
+	return context.WithValue(ctx, SwitchKey, from)
+}
+
+// GetTenantSwitch 获取切换租户前所属的租户，未切换时 ok 为 false
+func GetTenantSwitch(ctx context.Context) (from string, ok bool) {
+	from, ok = ctx.Value(SwitchKey).(string)
+	return from, ok
+}
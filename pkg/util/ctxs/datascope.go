@@ -0,0 +1,42 @@
+package ctxs
+
+import (
+	"context"
+	"sync"
+)
+
+var DataScopeKey = "data_scope"
+
+// DataScope 当前登录用户可访问的数据范围。All 为 true 时不限制；
+// 否则可访问 DeptIDs 内部门关联的数据，Self 为 true 时还可访问本人的数据
+type DataScope struct {
+	All     bool
+	UserID  string
+	Self    bool
+	DeptIDs []string
+}
+
+type dataScopeLoader struct {
+	once    sync.Once
+	resolve func(ctx context.Context) (*DataScope, error)
+	scope   *DataScope
+	err     error
+}
+
+// WithDataScope 设置数据范围的解析函数，首次读取时才解析，同一请求内只解析一次
+func WithDataScope(ctx context.Context, resolve func(ctx context.Context) (*DataScope, error)) context.Context {
+	return context.WithValue(ctx, DataScopeKey, &dataScopeLoader{resolve: resolve})
+}
+
+// GetDataScope 获取当前请求的数据范围，未设置时返回 nil 表示不限制（如系统任务、SCIM 同步）
+func GetDataScope(ctx context.Context) (*DataScope, error) {
+	loader, ok := ctx.Value(DataScopeKey).(*dataScopeLoader)
+	if !ok || loader == nil {
+		return nil, nil
+	}
+	loader.once.Do(func() {
+		// 解析过程本身的查询不受数据范围限制
+		loader.scope, loader.err = loader.resolve(context.WithValue(ctx, DataScopeKey, (*dataScopeLoader)(nil)))
+	})
+	return loader.scope, loader.err
+}
@@ -0,0 +1,39 @@
+package ctxs
+
+import (
+	"context"
+	"net"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+var ClientIPKey = "client_ip"
+
+// WithClientIP 写入按受信代理解析后的请求方 IP
+func WithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, ClientIPKey, ip)
+}
+
+// GetClientIP 获取请求方 IP，未经 clientip 中间件解析时只取直连地址，不信任代理头
+func GetClientIP(ctx context.Context) string {
+	if val, ok := ctx.Value(ClientIPKey).(string); ok {
+		return val
+	}
+	if req, ok := http.RequestFromServerContext(ctx); ok {
+		host, _, err := net.SplitHostPort(req.RemoteAddr)
+		if err != nil {
+			return req.RemoteAddr
+		}
+		return host
+	}
+	return ""
+}
+
+// GetUserAgent 获取请求的 User-Agent
+func GetUserAgent(ctx context.Context) string {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		return tr.RequestHeader().Get("User-Agent")
+	}
+	return ""
+}
@@ -0,0 +1,89 @@
+package ipx
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+)
+
+// ParsePrefix 解析 CIDR，单个 IP 视为只包含自身的网段，结果按掩码归一化
+func ParsePrefix(s string) (netip.Prefix, error) {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, "/") {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	if prefix.Addr().Is4In6() {
+		return netip.Prefix{}, fmt.Errorf("ipx: IPv4-mapped prefix %q not supported", s)
+	}
+	return prefix.Masked(), nil
+}
+
+// ParsePrefixes 批量解析 CIDR，任一无效时返回错误
+func ParsePrefixes(list []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(list))
+	for _, s := range list {
+		prefix, err := ParsePrefix(s)
+		if err != nil {
+			return nil, err
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	return prefixes, nil
+}
+
+// Contains 判断 IP 是否落在任一网段内，IP 无效时返回 false
+func Contains(prefixes []netip.Prefix, ip string) bool {
+	addr, err := netip.ParseAddr(strings.TrimSpace(ip))
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP 根据直连地址和代理头解析请求方 IP。只有直连地址是受信代理时才读取代理头：
+// X-Forwarded-For 从右向左跳过受信代理，取第一个不受信的地址；全部受信时取最左侧地址
+func ClientIP(remoteAddr, forwardedFor, realIP string, trusted []netip.Prefix) string {
+	remote := remoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		remote = host
+	}
+	if !Contains(trusted, remote) {
+		return remote
+	}
+	if forwardedFor != "" {
+		hops := strings.Split(forwardedFor, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := strings.TrimSpace(hops[i])
+			if _, err := netip.ParseAddr(hop); err != nil {
+				// 无法解析的地址及其左侧内容都不可信，以最近的受信代理为准
+				return remote
+			}
+			if !Contains(trusted, hop) || i == 0 {
+				return hop
+			}
+			remote = hop
+		}
+	}
+	if realIP = strings.TrimSpace(realIP); realIP != "" {
+		if _, err := netip.ParseAddr(realIP); err == nil {
+			return realIP
+		}
+	}
+	return remote
+}
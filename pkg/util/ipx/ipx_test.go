@@ -0,0 +1,69 @@
+package ipx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePrefix(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "10.1.2.3/8", want: "10.0.0.0/8"},
+		{in: " 192.168.1.10 ", want: "192.168.1.10/32"},
+		{in: "2001:db8::1/32", want: "2001:db8::/32"},
+		{in: "::ffff:10.0.0.1", want: "10.0.0.1/32"},
+		{in: "::ffff:10.0.0.0/104", wantErr: true},
+		{in: "10.0.0.0/33", wantErr: true},
+		{in: "example.com", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParsePrefix(tt.in)
+		if tt.wantErr {
+			assert.Error(t, err, tt.in)
+			continue
+		}
+		assert.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, got.String())
+	}
+}
+
+func TestContains(t *testing.T) {
+	prefixes, err := ParsePrefixes([]string{"10.0.0.0/8", "2001:db8::/32"})
+	assert.NoError(t, err)
+	assert.True(t, Contains(prefixes, "10.20.30.40"))
+	assert.True(t, Contains(prefixes, "::ffff:10.20.30.40"))
+	assert.True(t, Contains(prefixes, "2001:db8::8"))
+	assert.False(t, Contains(prefixes, "192.168.0.1"))
+	assert.False(t, Contains(prefixes, ""))
+}
+
+func TestClientIP(t *testing.T) {
+	trusted, err := ParsePrefixes([]string{"10.0.0.0/8"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		remote string
+		xff    string
+		realIP string
+		want   string
+	}{
+		{name: "直连忽略代理头", remote: "203.0.113.9:5000", xff: "1.1.1.1", realIP: "2.2.2.2", want: "203.0.113.9"},
+		{name: "受信代理", remote: "10.0.0.2:5000", xff: "198.51.100.7", want: "198.51.100.7"},
+		{name: "跳过多级受信代理", remote: "10.0.0.2:5000", xff: "1.1.1.1, 198.51.100.7, 10.0.0.3", want: "198.51.100.7"},
+		{name: "全部受信取最左侧", remote: "10.0.0.2:5000", xff: "10.0.0.5, 10.0.0.3", want: "10.0.0.5"},
+		{name: "伪造地址", remote: "10.0.0.2:5000", xff: "evil, 10.0.0.3", want: "10.0.0.3"},
+		{name: "X-Real-IP", remote: "10.0.0.2:5000", realIP: "198.51.100.7", want: "198.51.100.7"},
+		{name: "无代理头", remote: "10.0.0.2:5000", want: "10.0.0.2"},
+		{name: "无端口", remote: "203.0.113.9", want: "203.0.113.9"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ClientIP(tt.remote, tt.xff, tt.realIP, trusted))
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package jwk
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	RS256 = "RS256"
+	ES256 = "ES256"
+
+	rsaKeyBits = 2048
+	pemType    = "PRIVATE KEY"
+)
+
+var ErrUnsupportedAlgorithm = errors.New("jwk: unsupported algorithm")
+
+// keyTypeAlgPrefix 未声明 alg 的密钥可用的算法前缀
+var keyTypeAlgPrefix = map[string]string{"RSA": "RS", "EC": "ES"}
+
+// Key JSON Web Key（RFC 7517），只包含公钥参数
+type Key struct {
+	Kty string `json:"kty"`
+	Use string `json:"use,omitempty"`
+	Kid string `json:"kid"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// Set JWKS 文档
+type Set struct {
+	Keys []*Key `json:"keys"`
+}
+
+// SigningMethod 返回算法对应的 JWT 签名方式
+func SigningMethod(alg string) (jwt.SigningMethod, error) {
+	switch alg {
+	case RS256:
+		return jwt.SigningMethodRS256, nil
+	case ES256:
+		return jwt.SigningMethodES256, nil
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+}
+
+// GenerateKey 生成签名私钥，RS256 使用 2048 位 RSA，ES256 使用 P-256
+func GenerateKey(alg string) (crypto.Signer, error) {
+	switch alg {
+	case RS256:
+		return rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case ES256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+}
+
+// MarshalPrivateKey 以 PKCS#8 PEM 格式编码私钥
+func MarshalPrivateKey(key crypto.Signer) (string, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: pemType, Bytes: der})), nil
+}
+
+// ParsePrivateKey 解析 MarshalPrivateKey 编码的私钥
+func ParsePrivateKey(data string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil || block.Type != pemType {
+		return nil, errors.New("jwk: invalid private key pem")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("jwk: unsupported private key type %T", key)
+	}
+	return signer, nil
+}
+
+// PublicKey 由公钥生成 JWK
+func PublicKey(kid, alg string, pub crypto.PublicKey) (*Key, error) {
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		return &Key{
+			Kty: "RSA",
+			Use: "sig",
+			Kid: kid,
+			Alg: alg,
+			N:   encode(pub.N.Bytes()),
+			E:   encode(big.NewInt(int64(pub.E)).Bytes()),
+		}, nil
+	case *ecdsa.PublicKey:
+		if pub.Curve != elliptic.P256() {
+			return nil, ErrUnsupportedAlgorithm
+		}
+		ecdh, err := pub.ECDH()
+		if err != nil {
+			return nil, err
+		}
+		// 未压缩点格式：0x04 || X || Y
+		point := ecdh.Bytes()[1:]
+		size := len(point) / 2
+		return &Key{
+			Kty: "EC",
+			Use: "sig",
+			Kid: kid,
+			Alg: alg,
+			Crv: "P-256",
+			X:   encode(point[:size]),
+			Y:   encode(point[size:]),
+		}, nil
+	default:
+		return nil, fmt.Errorf("jwk: unsupported public key type %T", pub)
+	}
+}
+
+// Public 解析 JWK 中的公钥
+func (k *Key) Public() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decode(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, ErrUnsupportedAlgorithm
+		}
+		x, err := decode(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		point := append(append([]byte{4}, x...), y...)
+		return ecdsa.ParseUncompressedPublicKey(elliptic.P256(), point)
+	default:
+		return nil, fmt.Errorf("jwk: unsupported key type %s", k.Kty)
+	}
+}
+
+// Keyfunc 按令牌头部的 kid 查找验签公钥，可直接用于 jwt.Parse；
+// 密钥声明了 alg 时令牌算法必须一致，未声明时按密钥类型匹配，令牌未携带 kid 时只接受唯一的签名密钥
+func (s *Set) Keyfunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	var candidates []*Key
+	for _, key := range s.Keys {
+		if key.Use != "" && key.Use != "sig" {
+			continue
+		}
+		if kid == "" || key.Kid == kid {
+			candidates = append(candidates, key)
+		}
+	}
+	if len(candidates) != 1 {
+		return nil, fmt.Errorf("jwk: key %q not found", kid)
+	}
+	key := candidates[0]
+	if key.Alg != "" && key.Alg != token.Method.Alg() {
+		return nil, ErrUnsupportedAlgorithm
+	}
+	if key.Alg == "" && !strings.HasPrefix(token.Method.Alg(), keyTypeAlgPrefix[key.Kty]) {
+		return nil, ErrUnsupportedAlgorithm
+	}
+	return key.Public()
+}
+
+func encode(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decode(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(s)
+}
@@ -0,0 +1,58 @@
+package jwk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	for _, alg := range []string{RS256, ES256} {
+		t.Run(alg, func(t *testing.T) {
+			key, err := GenerateKey(alg)
+			assert.NoError(t, err)
+
+			// 私钥落库后重新解析
+			encoded, err := MarshalPrivateKey(key)
+			assert.NoError(t, err)
+			key, err = ParsePrivateKey(encoded)
+			assert.NoError(t, err)
+
+			method, err := SigningMethod(alg)
+			assert.NoError(t, err)
+			token := jwt.NewWithClaims(method, jwt.RegisteredClaims{
+				Subject:   "user-1",
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			})
+			token.Header["kid"] = "key-1"
+			signed, err := token.SignedString(key)
+			assert.NoError(t, err)
+
+			public, err := PublicKey("key-1", alg, key.Public())
+			assert.NoError(t, err)
+			set := &Set{Keys: []*Key{public}}
+
+			claims := &jwt.RegisteredClaims{}
+			_, err = jwt.ParseWithClaims(signed, claims, set.Keyfunc, jwt.WithValidMethods([]string{alg}))
+			assert.NoError(t, err)
+			assert.Equal(t, "user-1", claims.Subject)
+
+			// 未知 kid 无法验签
+			token.Header["kid"] = "key-2"
+			signed, err = token.SignedString(key)
+			assert.NoError(t, err)
+			_, err = jwt.Parse(signed, set.Keyfunc)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestGenerateKey_Unsupported(t *testing.T) {
+	_, err := GenerateKey("HS256")
+	assert.ErrorIs(t, err, ErrUnsupportedAlgorithm)
+
+	_, err = SigningMethod("none")
+	assert.ErrorIs(t, err, ErrUnsupportedAlgorithm)
+}
@@ -0,0 +1,155 @@
+// Package ldapclient 对接 LDAP / Active Directory 的最小封装：服务账号绑定、分页搜索和用户密码校验。
+package ldapclient
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+)
+
+const (
+	defaultTimeout = 10 * time.Second
+	pageSize       = 500
+)
+
+var ErrInvalidCredentials = errors.New("ldapclient: invalid credentials")
+
+// Config 连接参数，URL 形如 ldap://host:389 或 ldaps://host:636
+type Config struct {
+	URL                string
+	StartTLS           bool
+	InsecureSkipVerify bool
+	BindDN             string
+	BindPassword       string
+	Timeout            time.Duration
+}
+
+// Entry 搜索结果条目，属性名不区分大小写
+type Entry struct {
+	DN         string
+	Attributes map[string][]string
+}
+
+// Get 返回属性的第一个值
+func (e *Entry) Get(name string) string {
+	if values := e.Values(name); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+func (e *Entry) Values(name string) []string {
+	return e.Attributes[strings.ToLower(name)]
+}
+
+type Conn struct {
+	conn *ldap.Conn
+}
+
+// Dial 建立连接并以服务账号绑定，BindDN 为空时匿名访问
+func Dial(ctx context.Context, cfg *Config) (*Conn, error) {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < timeout {
+		timeout = time.Until(deadline)
+	}
+	tlsConfig := &tls.Config{InsecureSkipVerify: cfg.InsecureSkipVerify}
+	conn, err := ldap.DialURL(cfg.URL,
+		ldap.DialWithDialer(&net.Dialer{Timeout: timeout}),
+		ldap.DialWithTLSConfig(tlsConfig),
+	)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetTimeout(timeout)
+	if cfg.StartTLS && strings.HasPrefix(strings.ToLower(cfg.URL), "ldap://") {
+		if err = conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	if cfg.BindDN != "" {
+		if err = conn.Bind(cfg.BindDN, cfg.BindPassword); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("ldapclient: bind %s: %w", cfg.BindDN, err)
+		}
+	}
+	return &Conn{conn: conn}, nil
+}
+
+func (c *Conn) Close() {
+	_ = c.conn.Close()
+}
+
+// Search 在 baseDN 子树下分页搜索
+func (c *Conn) Search(baseDN, filter string, attributes []string) ([]*Entry, error) {
+	req := ldap.NewSearchRequest(baseDN, ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+		filter, attributes, nil)
+	result, err := c.conn.SearchWithPaging(req, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]*Entry, 0, len(result.Entries))
+	for _, item := range result.Entries {
+		entry := &Entry{DN: item.DN, Attributes: make(map[string][]string, len(item.Attributes))}
+		for _, attr := range item.Attributes {
+			name := strings.ToLower(attr.Name)
+			entry.Attributes[name] = append(entry.Attributes[name], attr.Values...)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Authenticate 以用户 DN 和密码绑定校验密码，绑定后连接身份随之改变，校验完成后应关闭连接；
+// 空密码在 LDAP 中是匿名绑定，会被服务端视为成功，这里直接拒绝
+func (c *Conn) Authenticate(dn, password string) error {
+	if dn == "" || password == "" {
+		return ErrInvalidCredentials
+	}
+	err := c.conn.Bind(dn, password)
+	if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+		return ErrInvalidCredentials
+	}
+	return err
+}
+
+// EscapeFilter 转义搜索过滤器中的值
+func EscapeFilter(value string) string {
+	return ldap.EscapeFilter(value)
+}
+
+// NormalizeDN 返回用于比较的 DN，属性名和值均转为小写，无法解析时返回空字符串
+func NormalizeDN(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil || len(parsed.RDNs) == 0 {
+		return ""
+	}
+	return strings.ToLower(parsed.String())
+}
+
+// ParentDN 返回上级条目的规范化 DN，顶级条目返回空字符串
+func ParentDN(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil || len(parsed.RDNs) < 2 {
+		return ""
+	}
+	return strings.ToLower((&ldap.DN{RDNs: parsed.RDNs[1:]}).String())
+}
+
+// FirstRDNValue 返回 DN 最左侧 RDN 的值，如 ou=研发部,dc=example,dc=com 返回 研发部
+func FirstRDNValue(dn string) string {
+	parsed, err := ldap.ParseDN(dn)
+	if err != nil || len(parsed.RDNs) == 0 || len(parsed.RDNs[0].Attributes) == 0 {
+		return ""
+	}
+	return parsed.RDNs[0].Attributes[0].Value
+}
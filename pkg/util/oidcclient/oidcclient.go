@@ -0,0 +1,277 @@
+// Package oidcclient 对接上游 OpenID Connect 身份提供方的授权码流程（RP 端），
+// 只实现本系统需要的部分：发现文档、PKCE、授权码换取令牌、ID Token 校验和 userinfo。
+package oidcclient
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"quest-admin/pkg/util/jwk"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	discoveryPath = "/.well-known/openid-configuration"
+	// cacheTTL 发现文档和公钥的缓存时间，遇到未知 kid 时提前刷新公钥
+	cacheTTL     = time.Hour
+	maxBodyBytes = 1 << 20
+)
+
+var ErrIDTokenInvalid = errors.New("oidcclient: id token invalid")
+
+// Provider 上游的发现文档
+type Provider struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+// Token 令牌端点的响应
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	IDToken     string `json:"id_token"`
+}
+
+// AuthCodeRequest 授权请求参数
+type AuthCodeRequest struct {
+	ClientID      string
+	RedirectURI   string
+	Scopes        []string
+	State         string
+	Nonce         string
+	CodeChallenge string
+}
+
+// ExchangeRequest 授权码换取令牌的参数，客户端凭证以 client_secret_basic 方式提交
+type ExchangeRequest struct {
+	ClientID     string
+	ClientSecret string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+}
+
+type cacheEntry struct {
+	provider  *Provider
+	keys      *jwk.Set
+	fetchedAt time.Time
+}
+
+type Client struct {
+	httpClient *http.Client
+	mu         sync.Mutex
+	cache      map[string]*cacheEntry
+}
+
+func New(httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Client{
+		httpClient: httpClient,
+		cache:      make(map[string]*cacheEntry),
+	}
+}
+
+// Discover 获取上游的发现文档，issuer 必须与文档中声明的一致
+func (c *Client) Discover(ctx context.Context, issuer string) (*Provider, error) {
+	issuer = strings.TrimSuffix(issuer, "/")
+	c.mu.Lock()
+	entry, ok := c.cache[issuer]
+	c.mu.Unlock()
+	if ok && time.Since(entry.fetchedAt) < cacheTTL {
+		return entry.provider, nil
+	}
+
+	var provider Provider
+	if err := c.getJSON(ctx, issuer+discoveryPath, "", &provider); err != nil {
+		return nil, err
+	}
+	if strings.TrimSuffix(provider.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("oidcclient: issuer mismatch, want %s, got %s", issuer, provider.Issuer)
+	}
+	if provider.AuthorizationEndpoint == "" || provider.TokenEndpoint == "" || provider.JwksURI == "" {
+		return nil, errors.New("oidcclient: incomplete discovery document")
+	}
+	c.mu.Lock()
+	c.cache[issuer] = &cacheEntry{provider: &provider, fetchedAt: time.Now()}
+	c.mu.Unlock()
+	return &provider, nil
+}
+
+// AuthCodeURL 拼接浏览器跳转的授权地址，使用 PKCE S256
+func (p *Provider) AuthCodeURL(req *AuthCodeRequest) string {
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", req.ClientID)
+	query.Set("redirect_uri", req.RedirectURI)
+	query.Set("scope", strings.Join(req.Scopes, " "))
+	query.Set("state", req.State)
+	query.Set("nonce", req.Nonce)
+	query.Set("code_challenge", req.CodeChallenge)
+	query.Set("code_challenge_method", "S256")
+	sep := "?"
+	if strings.Contains(p.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return p.AuthorizationEndpoint + sep + query.Encode()
+}
+
+// Exchange 以授权码换取令牌
+func (c *Client) Exchange(ctx context.Context, p *Provider, req *ExchangeRequest) (*Token, error) {
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", req.Code)
+	form.Set("redirect_uri", req.RedirectURI)
+	form.Set("code_verifier", req.CodeVerifier)
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpReq.Header.Set("Accept", "application/json")
+	httpReq.SetBasicAuth(url.QueryEscape(req.ClientID), url.QueryEscape(req.ClientSecret))
+
+	var token Token
+	if err = c.doJSON(httpReq, &token); err != nil {
+		return nil, err
+	}
+	if token.IDToken == "" {
+		return nil, errors.New("oidcclient: token response has no id_token")
+	}
+	return &token, nil
+}
+
+// VerifyIDToken 校验 ID Token 的签名、签发者、受众、有效期和 nonce，返回全部声明
+func (c *Client) VerifyIDToken(ctx context.Context, p *Provider, rawIDToken, clientID, nonce string) (jwt.MapClaims, error) {
+	keys, err := c.keys(ctx, p, false)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := parseIDToken(rawIDToken, keys, p.Issuer, clientID)
+	if err != nil && errors.Is(err, jwt.ErrTokenUnverifiable) {
+		// 上游可能已轮换密钥，刷新后重试一次
+		if keys, err = c.keys(ctx, p, true); err != nil {
+			return nil, err
+		}
+		claims, err = parseIDToken(rawIDToken, keys, p.Issuer, clientID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrIDTokenInvalid, err)
+	}
+	if got, _ := claims["nonce"].(string); got != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrIDTokenInvalid)
+	}
+	if sub, _ := claims["sub"].(string); sub == "" {
+		return nil, fmt.Errorf("%w: missing sub", ErrIDTokenInvalid)
+	}
+	return claims, nil
+}
+
+// UserInfo 查询 userinfo 端点，上游未提供时返回 nil
+func (c *Client) UserInfo(ctx context.Context, p *Provider, accessToken string) (map[string]any, error) {
+	if p.UserinfoEndpoint == "" {
+		return nil, nil
+	}
+	claims := make(map[string]any)
+	if err := c.getJSON(ctx, p.UserinfoEndpoint, accessToken, &claims); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// NewCodeVerifier 生成 PKCE 校验值和对应的 S256 挑战值
+func NewCodeVerifier() (verifier, challenge string, err error) {
+	verifier, err = RandomString(32)
+	if err != nil {
+		return "", "", err
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	return verifier, base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// RandomString 生成 n 字节随机数的 base64url 编码，用于 state 和 nonce
+func RandomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func parseIDToken(rawIDToken string, keys *jwk.Set, issuer, clientID string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(rawIDToken, claims, keys.Keyfunc,
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(issuer),
+		jwt.WithAudience(clientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (c *Client) keys(ctx context.Context, p *Provider, refresh bool) (*jwk.Set, error) {
+	issuer := strings.TrimSuffix(p.Issuer, "/")
+	c.mu.Lock()
+	entry, ok := c.cache[issuer]
+	c.mu.Unlock()
+	if ok && entry.keys != nil && !refresh && time.Since(entry.fetchedAt) < cacheTTL {
+		return entry.keys, nil
+	}
+
+	var keys jwk.Set
+	if err := c.getJSON(ctx, p.JwksURI, "", &keys); err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.cache[issuer] = &cacheEntry{provider: p, keys: &keys, fetchedAt: time.Now()}
+	c.mu.Unlock()
+	return &keys, nil
+}
+
+func (c *Client) getJSON(ctx context.Context, endpoint, bearer string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if bearer != "" {
+		req.Header.Set("Authorization", "Bearer "+bearer)
+	}
+	return c.doJSON(req, v)
+}
+
+func (c *Client) doJSON(req *http.Request, v any) error {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBodyBytes))
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("oidcclient: %s %s returned %d: %s", req.Method, req.URL.Redacted(), resp.StatusCode, body)
+	}
+	return json.Unmarshal(body, v)
+}
@@ -8,6 +8,7 @@ import (
 	"crypto/subtle"
 	"encoding/base64"
 	"fmt"
+	"math/big"
 	"strings"
 
 	"golang.org/x/crypto/argon2"
@@ -102,3 +103,49 @@ func VerifyPassword(password, encodedHash string) (bool, error) {
 	// 比较计算得到的哈希值与存储的哈希值
 	return subtle.ConstantTimeCompare(decodedHash, computedHash) == 1, nil
 }
+
+const (
+	upperChars   = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+	lowerChars   = "abcdefghijkmnopqrstuvwxyz"
+	digitChars   = "23456789"
+	specialChars = "!@#$%^&*-_=+"
+)
+
+// GeneratePassword 生成包含大写字母、小写字母、数字和特殊字符的随机密码，长度不少于 4，
+// 去掉了容易混淆的字符
+func GeneratePassword(length int) (string, error) {
+	if length < 4 {
+		length = 4
+	}
+	classes := []string{upperChars, lowerChars, digitChars, specialChars}
+	all := strings.Join(classes, "")
+	password := make([]byte, length)
+	for i := range password {
+		chars := all
+		if i < len(classes) {
+			chars = classes[i]
+		}
+		c, err := randomChar(chars)
+		if err != nil {
+			return "", err
+		}
+		password[i] = c
+	}
+	// 打乱顺序，避免前几位的字符类别固定
+	for i := len(password) - 1; i > 0; i-- {
+		j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return "", err
+		}
+		password[i], password[j.Int64()] = password[j.Int64()], password[i]
+	}
+	return string(password), nil
+}
+
+func randomChar(chars string) (byte, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+	if err != nil {
+		return 0, err
+	}
+	return chars[n.Int64()], nil
+}
@@ -0,0 +1,21 @@
+package pswd
+
+import (
+	"quest-admin/pkg/util/validator"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratePassword(t *testing.T) {
+	for _, length := range []int{1, 4, 16, 32} {
+		password, err := GeneratePassword(length)
+		assert.NoError(t, err)
+		assert.Len(t, password, max(length, 4))
+		assert.Equal(t, 4, validator.CharClasses(password))
+	}
+
+	a, _ := GeneratePassword(16)
+	b, _ := GeneratePassword(16)
+	assert.NotEqual(t, a, b)
+}
@@ -0,0 +1,111 @@
+// Package scimfilter 解析 SCIM 2.0 (RFC 7644 3.4.2.2) 过滤表达式中身份提供方常用的子集：
+// 以 and 连接的等值比较，如 userName eq "alice" and active eq true。
+package scimfilter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrUnsupported = errors.New("scimfilter: unsupported filter")
+
+// Condition 一个等值比较，Attr 保留原始大小写，比较时应忽略大小写
+type Condition struct {
+	Attr string
+	// Value 字符串、布尔值和数字统一转为字符串，null 为空字符串
+	Value string
+}
+
+// Parse 解析过滤表达式，空表达式返回 nil
+func Parse(filter string) ([]*Condition, error) {
+	p := &parser{input: strings.TrimSpace(filter)}
+	if p.input == "" {
+		return nil, nil
+	}
+	var conditions []*Condition
+	for {
+		cond, err := p.condition()
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, cond)
+		p.skipSpace()
+		if p.eof() {
+			return conditions, nil
+		}
+		if !strings.EqualFold(p.word(), "and") {
+			return nil, fmt.Errorf("%w: only \"and\" is supported between conditions", ErrUnsupported)
+		}
+	}
+}
+
+type parser struct {
+	input string
+	pos   int
+}
+
+func (p *parser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *parser) skipSpace() {
+	for !p.eof() && p.input[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+// word 读取到下一个空格为止的内容
+func (p *parser) word() string {
+	p.skipSpace()
+	start := p.pos
+	for !p.eof() && p.input[p.pos] != ' ' {
+		p.pos++
+	}
+	return p.input[start:p.pos]
+}
+
+func (p *parser) condition() (*Condition, error) {
+	attr := p.word()
+	if attr == "" || strings.ContainsAny(attr, "()[]\"") {
+		return nil, fmt.Errorf("%w: invalid attribute %q", ErrUnsupported, attr)
+	}
+	if op := p.word(); !strings.EqualFold(op, "eq") {
+		return nil, fmt.Errorf("%w: operator %q", ErrUnsupported, op)
+	}
+	value, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	return &Condition{Attr: attr, Value: value}, nil
+}
+
+func (p *parser) value() (string, error) {
+	p.skipSpace()
+	if p.eof() {
+		return "", fmt.Errorf("%w: missing value", ErrUnsupported)
+	}
+	if p.input[p.pos] != '"' {
+		raw := p.word()
+		if raw == "null" {
+			return "", nil
+		}
+		return strings.ToLower(raw), nil
+	}
+	// 字符串按 JSON 字符串解码，支持 \" 等转义
+	for end := p.pos + 1; end < len(p.input); end++ {
+		switch p.input[end] {
+		case '\\':
+			end++
+		case '"':
+			var value string
+			if err := json.Unmarshal([]byte(p.input[p.pos:end+1]), &value); err != nil {
+				return "", fmt.Errorf("%w: invalid string %s", ErrUnsupported, p.input[p.pos:end+1])
+			}
+			p.pos = end + 1
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("%w: unterminated string", ErrUnsupported)
+}
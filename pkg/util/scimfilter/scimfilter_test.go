@@ -0,0 +1,40 @@
+package scimfilter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	conditions, err := Parse(`userName eq "alice"`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Condition{{Attr: "userName", Value: "alice"}}, conditions)
+
+	conditions, err = Parse(`userName Eq "a \"quoted\" name" AND active eq True and emails.value eq null`)
+	assert.NoError(t, err)
+	assert.Equal(t, []*Condition{
+		{Attr: "userName", Value: `a "quoted" name`},
+		{Attr: "active", Value: "true"},
+		{Attr: "emails.value", Value: ""},
+	}, conditions)
+
+	conditions, err = Parse("  ")
+	assert.NoError(t, err)
+	assert.Nil(t, conditions)
+}
+
+func TestParse_Unsupported(t *testing.T) {
+	for _, filter := range []string{
+		`userName sw "a"`,
+		`userName eq "a" or userName eq "b"`,
+		`emails[type eq "work"].value eq "a@example.com"`,
+		`userName eq "a`,
+		`userName eq`,
+		`(userName eq "a")`,
+	} {
+		_, err := Parse(filter)
+		assert.True(t, errors.Is(err, ErrUnsupported), filter)
+	}
+}
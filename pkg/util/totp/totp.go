@@ -0,0 +1,101 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RFC 6238 默认参数：HMAC-SHA1、30 秒步长、6 位数字
+const (
+	Period = 30
+	Digits = 6
+
+	secretSize = 20
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret 生成 160 位随机密钥，返回无填充的 base32 编码
+func GenerateSecret() (string, error) {
+	b := make([]byte, secretSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(b), nil
+}
+
+// Step 返回时间所在的时间步
+func Step(t time.Time) int64 {
+	return t.Unix() / Period
+}
+
+// GenerateCode 计算指定时间的验证码
+func GenerateCode(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return code(key, Step(t)), nil
+}
+
+// Validate 校验验证码，允许前后 skew 个时间步的时钟偏差，通过时返回匹配的时间步
+func Validate(secret, passcode string, t time.Time, skew int) (int64, bool) {
+	passcode = strings.TrimSpace(passcode)
+	if len(passcode) != Digits {
+		return 0, false
+	}
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+	current := Step(t)
+	for i := -skew; i <= skew; i++ {
+		step := current + int64(i)
+		if subtle.ConstantTimeCompare([]byte(code(key, step)), []byte(passcode)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// URI 生成认证器 App 可识别的 otpauth:// 地址
+func URI(issuer, account, secret string) string {
+	label := url.PathEscape(account)
+	if issuer != "" {
+		label = url.PathEscape(issuer) + ":" + label
+	}
+	params := url.Values{}
+	params.Set("secret", secret)
+	if issuer != "" {
+		params.Set("issuer", issuer)
+	}
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(Digits))
+	params.Set("period", fmt.Sprint(Period))
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+func code(key []byte, step int64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%1000000)
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(secret), " ", ""))
+	return encoding.DecodeString(strings.TrimRight(secret, "="))
+}
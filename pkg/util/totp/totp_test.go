@@ -0,0 +1,51 @@
+package totp
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rfcSecret RFC 6238 附录 B 中 SHA1 测试用例的密钥 "12345678901234567890"
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestGenerateCode(t *testing.T) {
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+	}
+	for _, tt := range tests {
+		got, err := GenerateCode(rfcSecret, time.Unix(tt.unix, 0))
+		assert.NoError(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	code, err := GenerateCode(rfcSecret, now.Add(-Period*time.Second))
+	assert.NoError(t, err)
+
+	step, ok := Validate(rfcSecret, code, now, 1)
+	assert.True(t, ok)
+	assert.Equal(t, Step(now)-1, step)
+
+	_, ok = Validate(rfcSecret, code, now, 0)
+	assert.False(t, ok)
+
+	_, ok = Validate(rfcSecret, "12345", now, 1)
+	assert.False(t, ok)
+}
+
+func TestURI(t *testing.T) {
+	uri := URI("Quest Admin", "admin", "JBSWY3DPEHPK3PXP")
+	assert.Equal(t, "otpauth://totp/Quest%20Admin:admin?algorithm=SHA1&digits=6&issuer=Quest+Admin&period=30&secret=JBSWY3DPEHPK3PXP", uri)
+}
@@ -0,0 +1,102 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// commonPasswords 常见弱密码，比较时忽略大小写
+var commonPasswords = []string{
+	"123456", "1234567", "12345678", "123456789", "1234567890", "111111", "000000", "888888",
+	"666666", "123123", "654321", "112233", "121212", "123321", "abc123", "abc123456",
+	"a123456", "a12345678", "qwe123", "qwerty", "qwerty123", "qwertyuiop", "1qaz2wsx", "1q2w3e4r",
+	"1q2w3e4r5t", "zxcvbnm", "asdfghjkl", "password", "password1", "password123", "passw0rd", "p@ssw0rd",
+	"p@ssword", "admin", "admin123", "admin@123", "admin888", "administrator", "root", "root123",
+	"iloveyou", "welcome", "welcome1", "letmein", "monkey", "dragon", "sunshine", "princess",
+	"football", "baseball", "superman", "trustno1", "woaini", "woaini1314", "aa123456", "test123",
+}
+
+// PasswordPolicy 密码策略，MinLength、MinClasses 为 0 时不校验，HistoryDepth、MaxAge 为 0 时不启用
+type PasswordPolicy struct {
+	MinLength  int
+	MaxLength  int
+	MinClasses int
+	// HistoryDepth 不允许与最近多少次使用过的密码相同
+	HistoryDepth int
+	// MaxAge 密码最长使用期限，超过后需在下次登录时修改
+	MaxAge   time.Duration
+	denylist map[string]struct{}
+}
+
+// NewPasswordPolicy 创建密码策略，内置常见弱密码列表，denylist 为额外禁用的密码
+func NewPasswordPolicy(minLength, minClasses, historyDepth int, maxAge time.Duration, denylist ...string) *PasswordPolicy {
+	p := &PasswordPolicy{
+		MinLength:    minLength,
+		MaxLength:    128,
+		MinClasses:   minClasses,
+		HistoryDepth: historyDepth,
+		MaxAge:       maxAge,
+		denylist:     make(map[string]struct{}, len(commonPasswords)+len(denylist)),
+	}
+	for _, item := range commonPasswords {
+		p.denylist[item] = struct{}{}
+	}
+	for _, item := range denylist {
+		if item = strings.ToLower(strings.TrimSpace(item)); item != "" {
+			p.denylist[item] = struct{}{}
+		}
+	}
+	return p
+}
+
+// Validate 校验密码是否满足策略，密码不能与用户名相同
+func (p *PasswordPolicy) Validate(password, username string) error {
+	if err := ValidatePassword(password); err != nil {
+		return err
+	}
+	if len(password) < p.MinLength {
+		return fmt.Errorf("密码长度不能少于%d个字符", p.MinLength)
+	}
+	if p.MaxLength > 0 && len(password) > p.MaxLength {
+		return fmt.Errorf("密码长度不能超过%d个字符", p.MaxLength)
+	}
+	if classes := CharClasses(password); classes < p.MinClasses {
+		return fmt.Errorf("密码需至少包含大写字母、小写字母、数字、特殊字符中的%d类", p.MinClasses)
+	}
+	lower := strings.ToLower(password)
+	if username != "" && strings.Contains(lower, strings.ToLower(username)) {
+		return fmt.Errorf("密码不能包含用户名")
+	}
+	if _, ok := p.denylist[lower]; ok {
+		return fmt.Errorf("密码过于常见，请更换")
+	}
+	return nil
+}
+
+// Expired 密码最后修改时间超过最长使用期限时返回 true，修改时间未知时不视为过期
+func (p *PasswordPolicy) Expired(updateAt, now time.Time) bool {
+	if p.MaxAge <= 0 || updateAt.IsZero() {
+		return false
+	}
+	return now.Sub(updateAt) > p.MaxAge
+}
+
+// CharClasses 统计密码包含的字符类别数：大写字母、小写字母、数字、其他字符
+func CharClasses(password string) int {
+	var upper, lower, digit, other int
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			other = 1
+		}
+	}
+	return upper + lower + digit + other
+}
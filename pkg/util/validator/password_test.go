@@ -0,0 +1,45 @@
+package validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	policy := NewPasswordPolicy(8, 3, 5, 0, "Quest@2024")
+	tests := []struct {
+		name     string
+		password string
+		username string
+		wantErr  bool
+	}{
+		{name: "valid", password: "Tr0ub4dor&3", username: "admin"},
+		{name: "too short", password: "Ab1!", wantErr: true},
+		{name: "not enough classes", password: "abcdefgh12", wantErr: true},
+		{name: "contains username", password: "Admin#2024x", username: "admin", wantErr: true},
+		{name: "common password", password: "P@ssw0rd", wantErr: true},
+		{name: "configured denylist", password: "quest@2024", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(tt.password, tt.username)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPasswordPolicy_Expired(t *testing.T) {
+	now := time.Now()
+	policy := NewPasswordPolicy(8, 3, 5, 90*24*time.Hour)
+	assert.False(t, policy.Expired(now.Add(-24*time.Hour), now))
+	assert.True(t, policy.Expired(now.Add(-91*24*time.Hour), now))
+	assert.False(t, policy.Expired(time.Time{}, now))
+
+	assert.False(t, NewPasswordPolicy(8, 3, 5, 0).Expired(now.Add(-365*24*time.Hour), now))
+}
@@ -2,24 +2,26 @@
 DROP TABLE IF EXISTS qa_user CASCADE;
 CREATE TABLE qa_user
 (
-    id         varchar(32) PRIMARY KEY,
-    username   varchar(32)                            NOT NULL,
-    password   varchar(128) DEFAULT ''                NOT NULL,
-    nickname   varchar(32)                            NOT NULL,
-    remark     varchar(512),
-    email      varchar(64)  DEFAULT '',
-    mobile     varchar(16)  DEFAULT '',
-    sex        smallint     DEFAULT 0,
-    avatar     varchar(512) DEFAULT '',
-    status     smallint     DEFAULT 0                 NOT NULL,
-    login_ip   varchar(64)  DEFAULT '',
-    login_date timestamp,
-    create_by  varchar(64)  DEFAULT '',
-    create_at  timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    update_by  varchar(64)  DEFAULT '',
-    update_at  timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
-    delete_at  timestamp,
-    tenant_id  varchar(32)  DEFAULT ''                NOT NULL
+    id                 varchar(32) PRIMARY KEY,
+    username           varchar(32)                            NOT NULL,
+    password           varchar(128) DEFAULT ''                NOT NULL,
+    nickname           varchar(32)                            NOT NULL,
+    remark             varchar(512),
+    email              varchar(64)  DEFAULT '',
+    mobile             varchar(16)  DEFAULT '',
+    sex                smallint     DEFAULT 0,
+    avatar             varchar(512) DEFAULT '',
+    status             smallint     DEFAULT 0                 NOT NULL,
+    login_ip           varchar(64)  DEFAULT '',
+    login_date         timestamp,
+    password_update_at timestamp    DEFAULT CURRENT_TIMESTAMP,
+    password_reset     smallint     DEFAULT 0                 NOT NULL,
+    create_by          varchar(64)  DEFAULT '',
+    create_at          timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    update_by          varchar(64)  DEFAULT '',
+    update_at          timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    delete_at          timestamp,
+    tenant_id          varchar(32)  DEFAULT ''                NOT NULL
 );
 
 COMMENT ON TABLE qa_user IS '用户信息表';
@@ -35,6 +37,8 @@ COMMENT ON COLUMN qa_user.avatar IS '头像地址';
 COMMENT ON COLUMN qa_user.status IS '帐号状态（0停用 1正常）';
 COMMENT ON COLUMN qa_user.login_ip IS '最后登录IP';
 COMMENT ON COLUMN qa_user.login_date IS '最后登录时间';
+COMMENT ON COLUMN qa_user.password_update_at IS '密码最后修改时间';
+COMMENT ON COLUMN qa_user.password_reset IS '是否需要修改密码（1管理员设置的密码，下次登录需修改）';
 COMMENT ON COLUMN qa_user.create_by IS '创建者';
 COMMENT ON COLUMN qa_user.create_at IS '创建时间';
 COMMENT ON COLUMN qa_user.update_by IS '更新者';
@@ -393,4 +397,521 @@ DROP INDEX IF EXISTS idx_config_key;
 CREATE UNIQUE INDEX idx_config_key ON qa_config (key, tenant_id);
 
 DROP INDEX IF EXISTS idx_config_name;
-CREATE INDEX idx_config_name ON qa_config (name, tenant_id);
\ No newline at end of file
+CREATE INDEX idx_config_name ON qa_config (name, tenant_id);
+DROP TABLE IF EXISTS qa_login_log CASCADE;
+CREATE TABLE qa_login_log
+(
+    id         varchar(32) PRIMARY KEY,
+    username   varchar(64)                            NOT NULL,
+    user_id    varchar(32)  DEFAULT '',
+    login_ip   varchar(64)  DEFAULT '',
+    user_agent varchar(512) DEFAULT '',
+    device     varchar(32)  DEFAULT '',
+    status     smallint                               NOT NULL,
+    reason     varchar(255) DEFAULT '',
+    login_at   timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    tenant_id  varchar(32)  DEFAULT ''                NOT NULL
+);
+
+COMMENT ON TABLE qa_login_log IS '登录日志表';
+COMMENT ON COLUMN qa_login_log.id IS '日志ID';
+COMMENT ON COLUMN qa_login_log.username IS '登录用户名';
+COMMENT ON COLUMN qa_login_log.user_id IS '用户ID（用户不存在时为空）';
+COMMENT ON COLUMN qa_login_log.login_ip IS '登录IP';
+COMMENT ON COLUMN qa_login_log.user_agent IS '浏览器UA';
+COMMENT ON COLUMN qa_login_log.device IS '登录设备';
+COMMENT ON COLUMN qa_login_log.status IS '登录结果（0失败 1成功）';
+COMMENT ON COLUMN qa_login_log.reason IS '失败原因';
+COMMENT ON COLUMN qa_login_log.login_at IS '登录时间';
+COMMENT ON COLUMN qa_login_log.tenant_id IS '租户编号';
+
+DROP INDEX IF EXISTS idx_login_log_login_at;
+CREATE INDEX idx_login_log_login_at ON qa_login_log (tenant_id, login_at);
+
+DROP INDEX IF EXISTS idx_login_log_user_id;
+CREATE INDEX idx_login_log_user_id ON qa_login_log (user_id);
+
+DROP TABLE IF EXISTS qa_operate_log CASCADE;
+CREATE TABLE qa_operate_log
+(
+    id         varchar(32) PRIMARY KEY,
+    operation  varchar(255)                           NOT NULL,
+    method     varchar(16)  DEFAULT '',
+    path       varchar(255) DEFAULT '',
+    login_id   varchar(32)  DEFAULT '',
+    trace_id   varchar(64)  DEFAULT '',
+    request    text,
+    code       integer                                NOT NULL,
+    reason     varchar(128) DEFAULT '',
+    latency_ms bigint       DEFAULT 0                 NOT NULL,
+    client_ip  varchar(64)  DEFAULT '',
+    user_agent varchar(512) DEFAULT '',
+    operate_at timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    tenant_id  varchar(32)  DEFAULT ''                NOT NULL,
+    switch_tenant_id varchar(32) DEFAULT ''
+);
+
+COMMENT ON TABLE qa_operate_log IS '操作日志表';
+COMMENT ON COLUMN qa_operate_log.id IS '日志ID';
+COMMENT ON COLUMN qa_operate_log.operation IS '操作名称（kratos operation）';
+COMMENT ON COLUMN qa_operate_log.method IS '请求方法';
+COMMENT ON COLUMN qa_operate_log.path IS '请求路径';
+COMMENT ON COLUMN qa_operate_log.login_id IS '操作人ID';
+COMMENT ON COLUMN qa_operate_log.trace_id IS '链路ID';
+COMMENT ON COLUMN qa_operate_log.request IS '请求参数（敏感字段已脱敏）';
+COMMENT ON COLUMN qa_operate_log.code IS '结果码（200成功）';
+COMMENT ON COLUMN qa_operate_log.reason IS '失败原因';
+COMMENT ON COLUMN qa_operate_log.latency_ms IS '耗时（毫秒）';
+COMMENT ON COLUMN qa_operate_log.client_ip IS '请求IP';
+COMMENT ON COLUMN qa_operate_log.user_agent IS '浏览器UA';
+COMMENT ON COLUMN qa_operate_log.operate_at IS '操作时间';
+COMMENT ON COLUMN qa_operate_log.tenant_id IS '租户编号';
+COMMENT ON COLUMN qa_operate_log.switch_tenant_id IS '切换访问的目标租户编号（超级管理员切换租户时记录）';
+
+DROP INDEX IF EXISTS idx_operate_log_operate_at;
+CREATE INDEX idx_operate_log_operate_at ON qa_operate_log (tenant_id, operate_at);
+
+DROP INDEX IF EXISTS idx_operate_log_trace_id;
+CREATE INDEX idx_operate_log_trace_id ON qa_operate_log (trace_id);
+
+DROP TABLE IF EXISTS qa_user_security CASCADE;
+CREATE TABLE qa_user_security
+(
+    user_id        varchar(32) PRIMARY KEY,
+    totp_secret    varchar(255) DEFAULT '',
+    totp_enabled   smallint     DEFAULT 0                 NOT NULL,
+    totp_last_step bigint       DEFAULT 0                 NOT NULL,
+    recovery_codes text,
+    create_at      timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    update_at      timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    tenant_id      varchar(32)  DEFAULT ''                NOT NULL
+);
+
+COMMENT ON TABLE qa_user_security IS '用户安全设置表';
+COMMENT ON COLUMN qa_user_security.user_id IS '用户ID';
+COMMENT ON COLUMN qa_user_security.totp_secret IS 'TOTP密钥（AES-GCM加密）';
+COMMENT ON COLUMN qa_user_security.totp_enabled IS 'TOTP是否启用（0未启用 1已启用）';
+COMMENT ON COLUMN qa_user_security.totp_last_step IS '最近一次通过校验的TOTP时间步，防止重放';
+COMMENT ON COLUMN qa_user_security.recovery_codes IS '恢复码哈希（JSON数组）';
+COMMENT ON COLUMN qa_user_security.create_at IS '创建时间';
+COMMENT ON COLUMN qa_user_security.update_at IS '更新时间';
+COMMENT ON COLUMN qa_user_security.tenant_id IS '租户编号';
+
+DROP TABLE IF EXISTS qa_password_history CASCADE;
+CREATE TABLE qa_password_history
+(
+    id        varchar(32) PRIMARY KEY,
+    user_id   varchar(32)                            NOT NULL,
+    password  varchar(128)                           NOT NULL,
+    create_at timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    tenant_id varchar(32)  DEFAULT ''                NOT NULL
+);
+
+COMMENT ON TABLE qa_password_history IS '历史密码表';
+COMMENT ON COLUMN qa_password_history.id IS '记录ID';
+COMMENT ON COLUMN qa_password_history.user_id IS '用户ID';
+COMMENT ON COLUMN qa_password_history.password IS '密码哈希';
+COMMENT ON COLUMN qa_password_history.create_at IS '设置时间';
+COMMENT ON COLUMN qa_password_history.tenant_id IS '租户编号';
+
+DROP INDEX IF EXISTS idx_password_history_user_id;
+CREATE INDEX idx_password_history_user_id ON qa_password_history (user_id, create_at);
+
+DROP TABLE IF EXISTS qa_api_key CASCADE;
+CREATE TABLE qa_api_key
+(
+    id           varchar(32) PRIMARY KEY,
+    user_id      varchar(32)                            NOT NULL,
+    name         varchar(64)                            NOT NULL,
+    key_prefix   varchar(16)                            NOT NULL,
+    key_hash     varchar(64)                            NOT NULL,
+    permissions  text,
+    expire_at    timestamp                              NOT NULL,
+    last_used_at timestamp,
+    create_by    varchar(64)  DEFAULT '',
+    create_at    timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    tenant_id    varchar(32)  DEFAULT ''                NOT NULL,
+    delete_at    timestamp
+);
+
+COMMENT ON TABLE qa_api_key IS 'API Key表';
+COMMENT ON COLUMN qa_api_key.id IS 'API Key编号';
+COMMENT ON COLUMN qa_api_key.user_id IS '所属用户ID';
+COMMENT ON COLUMN qa_api_key.name IS '名称';
+COMMENT ON COLUMN qa_api_key.key_prefix IS '密钥前缀，用于识别';
+COMMENT ON COLUMN qa_api_key.key_hash IS '密钥SHA-256哈希';
+COMMENT ON COLUMN qa_api_key.permissions IS '授权的权限码（JSON数组）';
+COMMENT ON COLUMN qa_api_key.expire_at IS '过期时间';
+COMMENT ON COLUMN qa_api_key.last_used_at IS '最近使用时间';
+COMMENT ON COLUMN qa_api_key.create_by IS '创建者';
+COMMENT ON COLUMN qa_api_key.create_at IS '创建时间';
+COMMENT ON COLUMN qa_api_key.tenant_id IS '租户编号';
+COMMENT ON COLUMN qa_api_key.delete_at IS '吊销时间';
+
+DROP INDEX IF EXISTS idx_api_key_hash;
+CREATE UNIQUE INDEX idx_api_key_hash ON qa_api_key (key_hash);
+DROP INDEX IF EXISTS idx_api_key_user_id;
+CREATE INDEX idx_api_key_user_id ON qa_api_key (user_id);
+
+DROP TABLE IF EXISTS qa_passkey CASCADE;
+CREATE TABLE qa_passkey
+(
+    id               varchar(32) PRIMARY KEY,
+    user_id          varchar(32)                            NOT NULL,
+    name             varchar(64)                            NOT NULL,
+    credential_id    varchar(1400)                          NOT NULL,
+    public_key       bytea                                  NOT NULL,
+    attestation_type varchar(32)  DEFAULT '',
+    aaguid           bytea,
+    sign_count       bigint       DEFAULT 0                 NOT NULL,
+    transports       varchar(256),
+    backup_eligible  boolean      DEFAULT false             NOT NULL,
+    backup_state     boolean      DEFAULT false             NOT NULL,
+    last_used_at     timestamp,
+    create_at        timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    tenant_id        varchar(32)  DEFAULT ''                NOT NULL,
+    delete_at        timestamp
+);
+
+COMMENT ON TABLE qa_passkey IS '通行密钥表';
+COMMENT ON COLUMN qa_passkey.id IS '通行密钥编号';
+COMMENT ON COLUMN qa_passkey.user_id IS '所属用户ID';
+COMMENT ON COLUMN qa_passkey.name IS '名称';
+COMMENT ON COLUMN qa_passkey.credential_id IS '凭证ID（base64url）';
+COMMENT ON COLUMN qa_passkey.public_key IS '凭证公钥（COSE）';
+COMMENT ON COLUMN qa_passkey.attestation_type IS '证明类型';
+COMMENT ON COLUMN qa_passkey.aaguid IS '认证器型号标识';
+COMMENT ON COLUMN qa_passkey.sign_count IS '签名计数';
+COMMENT ON COLUMN qa_passkey.transports IS '传输方式（JSON数组）';
+COMMENT ON COLUMN qa_passkey.backup_eligible IS '是否可同步备份';
+COMMENT ON COLUMN qa_passkey.backup_state IS '是否已同步备份';
+COMMENT ON COLUMN qa_passkey.last_used_at IS '最近使用时间';
+COMMENT ON COLUMN qa_passkey.create_at IS '创建时间';
+COMMENT ON COLUMN qa_passkey.tenant_id IS '租户编号';
+COMMENT ON COLUMN qa_passkey.delete_at IS '删除时间';
+
+DROP INDEX IF EXISTS idx_passkey_credential_id;
+CREATE UNIQUE INDEX idx_passkey_credential_id ON qa_passkey (credential_id) WHERE delete_at IS NULL;
+DROP INDEX IF EXISTS idx_passkey_user_id;
+CREATE INDEX idx_passkey_user_id ON qa_passkey (user_id);
+
+DROP TABLE IF EXISTS qa_ip_rule CASCADE;
+CREATE TABLE qa_ip_rule
+(
+    id        varchar(32) PRIMARY KEY,
+    scope     varchar(16)                            NOT NULL,
+    target_id varchar(32)  DEFAULT ''                NOT NULL,
+    cidr      varchar(64)                            NOT NULL,
+    remark    varchar(255) DEFAULT '',
+    status    smallint     DEFAULT 1                 NOT NULL,
+    create_at timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    update_at timestamp    DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    tenant_id varchar(32)  DEFAULT ''                NOT NULL,
+    delete_at timestamp
+);
+
+COMMENT ON TABLE qa_ip_rule IS '管理端访问IP白名单表';
+COMMENT ON COLUMN qa_ip_rule.id IS '规则编号';
+COMMENT ON COLUMN qa_ip_rule.scope IS '层级（tenant租户 role角色 user用户）';
+COMMENT ON COLUMN qa_ip_rule.target_id IS '角色或用户ID，租户级为空';
+COMMENT ON COLUMN qa_ip_rule.cidr IS '允许的网段（CIDR）';
+COMMENT ON COLUMN qa_ip_rule.remark IS '备注';
+COMMENT ON COLUMN qa_ip_rule.status IS '状态（0停用 1启用）';
+COMMENT ON COLUMN qa_ip_rule.create_at IS '创建时间';
+COMMENT ON COLUMN qa_ip_rule.update_at IS '更新时间';
+COMMENT ON COLUMN qa_ip_rule.tenant_id IS '租户编号';
+COMMENT ON COLUMN qa_ip_rule.delete_at IS '删除时间';
+
+DROP INDEX IF EXISTS idx_ip_rule_tenant_id;
+CREATE INDEX idx_ip_rule_tenant_id ON qa_ip_rule (tenant_id) WHERE delete_at IS NULL;
+
+DROP TABLE IF EXISTS qa_oauth2_client CASCADE;
+CREATE TABLE qa_oauth2_client
+(
+    id            varchar(32) PRIMARY KEY,
+    client_id     varchar(64)                           NOT NULL,
+    name          varchar(64)                           NOT NULL,
+    client_secret varchar(64) DEFAULT '',
+    client_type   varchar(16)                           NOT NULL,
+    redirect_uris text,
+    grant_types   varchar(256),
+    scopes        text,
+    trusted       boolean     DEFAULT false             NOT NULL,
+    status        smallint                              NOT NULL,
+    remark        varchar(512),
+    create_by     varchar(64) DEFAULT '',
+    create_at     timestamp   DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    update_by     varchar(64) DEFAULT '',
+    update_at     timestamp   DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    delete_at     timestamp,
+    tenant_id     varchar(32) DEFAULT ''                NOT NULL
+);
+
+COMMENT ON TABLE qa_oauth2_client IS 'OAuth2客户端表';
+COMMENT ON COLUMN qa_oauth2_client.id IS '客户端编号';
+COMMENT ON COLUMN qa_oauth2_client.client_id IS '客户端标识';
+COMMENT ON COLUMN qa_oauth2_client.name IS '客户端名称';
+COMMENT ON COLUMN qa_oauth2_client.client_secret IS '客户端密钥SHA-256哈希，公开客户端为空';
+COMMENT ON COLUMN qa_oauth2_client.client_type IS '客户端类型（confidential机密 public公开）';
+COMMENT ON COLUMN qa_oauth2_client.redirect_uris IS '回调地址（JSON数组）';
+COMMENT ON COLUMN qa_oauth2_client.grant_types IS '授权类型（JSON数组）';
+COMMENT ON COLUMN qa_oauth2_client.scopes IS '授权范围，对应菜单权限码（JSON数组）';
+COMMENT ON COLUMN qa_oauth2_client.trusted IS '是否第一方应用，授权时无需用户确认';
+COMMENT ON COLUMN qa_oauth2_client.status IS '状态（0停用 1正常）';
+COMMENT ON COLUMN qa_oauth2_client.remark IS '备注';
+COMMENT ON COLUMN qa_oauth2_client.create_by IS '创建者';
+COMMENT ON COLUMN qa_oauth2_client.create_at IS '创建时间';
+COMMENT ON COLUMN qa_oauth2_client.update_by IS '更新者';
+COMMENT ON COLUMN qa_oauth2_client.update_at IS '更新时间';
+COMMENT ON COLUMN qa_oauth2_client.delete_at IS '删除时间';
+COMMENT ON COLUMN qa_oauth2_client.tenant_id IS '租户编号';
+
+DROP INDEX IF EXISTS idx_oauth2_client_client_id;
+CREATE UNIQUE INDEX idx_oauth2_client_client_id ON qa_oauth2_client (client_id);
+
+DROP TABLE IF EXISTS qa_oidc_key CASCADE;
+CREATE TABLE qa_oidc_key
+(
+    id          varchar(32) PRIMARY KEY,
+    algorithm   varchar(16)                           NOT NULL,
+    private_key text                                  NOT NULL,
+    create_at   timestamp   DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    activate_at timestamp                             NOT NULL,
+    expire_at   timestamp                             NOT NULL,
+    retire_at   timestamp                             NOT NULL
+);
+
+COMMENT ON TABLE qa_oidc_key IS 'OIDC签名密钥表';
+COMMENT ON COLUMN qa_oidc_key.id IS '密钥编号，即JWT头部的kid';
+COMMENT ON COLUMN qa_oidc_key.algorithm IS '签名算法（RS256 ES256）';
+COMMENT ON COLUMN qa_oidc_key.private_key IS '加密后的PKCS#8私钥';
+COMMENT ON COLUMN qa_oidc_key.create_at IS '创建时间，即开始发布公钥时间';
+COMMENT ON COLUMN qa_oidc_key.activate_at IS '开始签名时间';
+COMMENT ON COLUMN qa_oidc_key.expire_at IS '停止签名时间';
+COMMENT ON COLUMN qa_oidc_key.retire_at IS '停止发布公钥时间';
+
+DROP TABLE IF EXISTS qa_social_provider CASCADE;
+CREATE TABLE qa_social_provider
+(
+    id              varchar(32) PRIMARY KEY,
+    code            varchar(32)                           NOT NULL,
+    name            varchar(64)                           NOT NULL,
+    icon            varchar(512) DEFAULT '',
+    issuer          varchar(512)                          NOT NULL,
+    client_id       varchar(256)                          NOT NULL,
+    client_secret   text                                  NOT NULL,
+    scopes          varchar(512),
+    claim_mapping   varchar(512),
+    auto_create     boolean     DEFAULT false             NOT NULL,
+    default_dept_id varchar(32) DEFAULT '',
+    default_role_id varchar(32) DEFAULT '',
+    sort            int         DEFAULT 0                 NOT NULL,
+    status          smallint                              NOT NULL,
+    remark          varchar(512),
+    create_by       varchar(64) DEFAULT '',
+    create_at       timestamp   DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    update_by       varchar(64) DEFAULT '',
+    update_at       timestamp   DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    delete_at       timestamp,
+    tenant_id       varchar(32) DEFAULT ''                NOT NULL
+);
+
+COMMENT ON TABLE qa_social_provider IS '第三方登录身份提供方表';
+COMMENT ON COLUMN qa_social_provider.id IS '身份提供方编号';
+COMMENT ON COLUMN qa_social_provider.code IS '身份提供方标识，租户内唯一';
+COMMENT ON COLUMN qa_social_provider.name IS '显示名称';
+COMMENT ON COLUMN qa_social_provider.icon IS '图标地址';
+COMMENT ON COLUMN qa_social_provider.issuer IS '上游OIDC签发者';
+COMMENT ON COLUMN qa_social_provider.client_id IS '上游客户端标识';
+COMMENT ON COLUMN qa_social_provider.client_secret IS '加密后的上游客户端密钥';
+COMMENT ON COLUMN qa_social_provider.scopes IS 'openid之外额外申请的范围（JSON数组）';
+COMMENT ON COLUMN qa_social_provider.claim_mapping IS '用户字段与上游声明的映射（JSON对象）';
+COMMENT ON COLUMN qa_social_provider.auto_create IS '未绑定时是否自动创建用户';
+COMMENT ON COLUMN qa_social_provider.default_dept_id IS '自动创建用户的默认部门';
+COMMENT ON COLUMN qa_social_provider.default_role_id IS '自动创建用户的默认角色';
+COMMENT ON COLUMN qa_social_provider.sort IS '显示顺序';
+COMMENT ON COLUMN qa_social_provider.status IS '状态（0停用 1正常）';
+COMMENT ON COLUMN qa_social_provider.remark IS '备注';
+COMMENT ON COLUMN qa_social_provider.create_by IS '创建者';
+COMMENT ON COLUMN qa_social_provider.create_at IS '创建时间';
+COMMENT ON COLUMN qa_social_provider.update_by IS '更新者';
+COMMENT ON COLUMN qa_social_provider.update_at IS '更新时间';
+COMMENT ON COLUMN qa_social_provider.delete_at IS '删除时间';
+COMMENT ON COLUMN qa_social_provider.tenant_id IS '租户编号';
+
+DROP INDEX IF EXISTS idx_social_provider_code;
+CREATE UNIQUE INDEX idx_social_provider_code ON qa_social_provider (tenant_id, code) WHERE delete_at IS NULL;
+
+DROP TABLE IF EXISTS qa_user_social CASCADE;
+CREATE TABLE qa_user_social
+(
+    id            varchar(32) PRIMARY KEY,
+    user_id       varchar(32)                           NOT NULL,
+    provider_id   varchar(32)                           NOT NULL,
+    subject       varchar(256)                          NOT NULL,
+    username      varchar(128) DEFAULT '',
+    nickname      varchar(128) DEFAULT '',
+    email         varchar(128) DEFAULT '',
+    avatar        varchar(512) DEFAULT '',
+    last_login_at timestamp,
+    create_by     varchar(64) DEFAULT '',
+    create_at     timestamp   DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    update_by     varchar(64) DEFAULT '',
+    update_at     timestamp   DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    delete_at     timestamp,
+    tenant_id     varchar(32) DEFAULT ''                NOT NULL
+);
+
+COMMENT ON TABLE qa_user_social IS '用户第三方身份绑定表';
+COMMENT ON COLUMN qa_user_social.id IS '绑定编号';
+COMMENT ON COLUMN qa_user_social.user_id IS '用户ID';
+COMMENT ON COLUMN qa_user_social.provider_id IS '身份提供方编号';
+COMMENT ON COLUMN qa_user_social.subject IS '上游身份标识（ID Token的sub）';
+COMMENT ON COLUMN qa_user_social.username IS '上游用户名快照';
+COMMENT ON COLUMN qa_user_social.nickname IS '上游昵称快照';
+COMMENT ON COLUMN qa_user_social.email IS '上游邮箱快照';
+COMMENT ON COLUMN qa_user_social.avatar IS '上游头像快照';
+COMMENT ON COLUMN qa_user_social.last_login_at IS '最后登录时间';
+COMMENT ON COLUMN qa_user_social.create_by IS '创建者';
+COMMENT ON COLUMN qa_user_social.create_at IS '创建时间';
+COMMENT ON COLUMN qa_user_social.update_by IS '更新者';
+COMMENT ON COLUMN qa_user_social.update_at IS '更新时间';
+COMMENT ON COLUMN qa_user_social.delete_at IS '删除时间';
+COMMENT ON COLUMN qa_user_social.tenant_id IS '租户编号';
+
+DROP INDEX IF EXISTS idx_user_social_subject;
+CREATE UNIQUE INDEX idx_user_social_subject ON qa_user_social (tenant_id, provider_id, subject);
+DROP INDEX IF EXISTS idx_user_social_user_id;
+CREATE INDEX idx_user_social_user_id ON qa_user_social (user_id);
+
+DROP TABLE IF EXISTS qa_ldap_config CASCADE;
+CREATE TABLE qa_ldap_config
+(
+    id                   varchar(32) PRIMARY KEY,
+    url                  varchar(512)                          NOT NULL,
+    start_tls            boolean     DEFAULT false             NOT NULL,
+    insecure_skip_verify boolean     DEFAULT false             NOT NULL,
+    bind_dn              varchar(512) DEFAULT '',
+    bind_password        text        DEFAULT '',
+    base_dn              varchar(512)                          NOT NULL,
+    user_filter          varchar(512)                          NOT NULL,
+    attributes           varchar(512),
+    group_base_dn        varchar(512) DEFAULT '',
+    group_filter         varchar(512)                          NOT NULL,
+    group_member_attr    varchar(64)                           NOT NULL,
+    group_roles          text,
+    dept_filter          varchar(512)                          NOT NULL,
+    root_dept_id         varchar(32) DEFAULT '',
+    auth_enabled         boolean     DEFAULT false             NOT NULL,
+    sync_enabled         boolean     DEFAULT false             NOT NULL,
+    sync_interval        int         DEFAULT 0                 NOT NULL,
+    status               smallint                              NOT NULL,
+    last_sync_at         timestamp,
+    last_sync_result     text,
+    create_by            varchar(64) DEFAULT '',
+    create_at            timestamp   DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    update_by            varchar(64) DEFAULT '',
+    update_at            timestamp   DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    delete_at            timestamp,
+    tenant_id            varchar(32) DEFAULT ''                NOT NULL
+);
+
+COMMENT ON TABLE qa_ldap_config IS 'LDAP配置表，每个租户一份';
+COMMENT ON COLUMN qa_ldap_config.id IS '配置编号';
+COMMENT ON COLUMN qa_ldap_config.url IS '目录服务地址（ldap://或ldaps://）';
+COMMENT ON COLUMN qa_ldap_config.start_tls IS 'ldap://连接是否升级为TLS';
+COMMENT ON COLUMN qa_ldap_config.insecure_skip_verify IS '是否跳过证书校验';
+COMMENT ON COLUMN qa_ldap_config.bind_dn IS '服务账号DN';
+COMMENT ON COLUMN qa_ldap_config.bind_password IS '加密后的服务账号密码';
+COMMENT ON COLUMN qa_ldap_config.base_dn IS '搜索根DN';
+COMMENT ON COLUMN qa_ldap_config.user_filter IS '用户搜索条件';
+COMMENT ON COLUMN qa_ldap_config.attributes IS '用户字段与目录属性的映射（JSON对象）';
+COMMENT ON COLUMN qa_ldap_config.group_base_dn IS '用户组搜索根DN';
+COMMENT ON COLUMN qa_ldap_config.group_filter IS '用户组搜索条件';
+COMMENT ON COLUMN qa_ldap_config.group_member_attr IS '用户组成员属性';
+COMMENT ON COLUMN qa_ldap_config.group_roles IS '用户组与角色的映射（JSON数组）';
+COMMENT ON COLUMN qa_ldap_config.dept_filter IS '导入为部门的OU搜索条件';
+COMMENT ON COLUMN qa_ldap_config.root_dept_id IS 'OU树挂载的上级部门';
+COMMENT ON COLUMN qa_ldap_config.auth_enabled IS '目录用户是否使用LDAP密码登录';
+COMMENT ON COLUMN qa_ldap_config.sync_enabled IS '是否开启自动同步';
+COMMENT ON COLUMN qa_ldap_config.sync_interval IS '自动同步间隔（分钟）';
+COMMENT ON COLUMN qa_ldap_config.status IS '状态（0停用 1正常）';
+COMMENT ON COLUMN qa_ldap_config.last_sync_at IS '最后同步时间';
+COMMENT ON COLUMN qa_ldap_config.last_sync_result IS '最后同步结果（JSON对象）';
+COMMENT ON COLUMN qa_ldap_config.create_by IS '创建者';
+COMMENT ON COLUMN qa_ldap_config.create_at IS '创建时间';
+COMMENT ON COLUMN qa_ldap_config.update_by IS '更新者';
+COMMENT ON COLUMN qa_ldap_config.update_at IS '更新时间';
+COMMENT ON COLUMN qa_ldap_config.delete_at IS '删除时间';
+COMMENT ON COLUMN qa_ldap_config.tenant_id IS '租户编号';
+
+DROP INDEX IF EXISTS idx_ldap_config_tenant;
+CREATE UNIQUE INDEX idx_ldap_config_tenant ON qa_ldap_config (tenant_id) WHERE delete_at IS NULL;
+
+DROP TABLE IF EXISTS qa_ldap_link CASCADE;
+CREATE TABLE qa_ldap_link
+(
+    id          varchar(32) PRIMARY KEY,
+    type        smallint                              NOT NULL,
+    external_id varchar(512)                          NOT NULL,
+    dn          varchar(1024)                         NOT NULL,
+    target_id   varchar(32)                           NOT NULL,
+    create_by   varchar(64) DEFAULT '',
+    create_at   timestamp   DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    update_by   varchar(64) DEFAULT '',
+    update_at   timestamp   DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    tenant_id   varchar(32) DEFAULT ''                NOT NULL
+);
+
+COMMENT ON TABLE qa_ldap_link IS 'LDAP目录条目与本地数据关联表';
+COMMENT ON COLUMN qa_ldap_link.id IS '关联编号';
+COMMENT ON COLUMN qa_ldap_link.type IS '关联类型（1用户 2部门）';
+COMMENT ON COLUMN qa_ldap_link.external_id IS '外部标识（用户为小写用户名，部门为规范化DN）';
+COMMENT ON COLUMN qa_ldap_link.dn IS '目录条目DN';
+COMMENT ON COLUMN qa_ldap_link.target_id IS '本地用户或部门编号';
+COMMENT ON COLUMN qa_ldap_link.create_by IS '创建者';
+COMMENT ON COLUMN qa_ldap_link.create_at IS '创建时间';
+COMMENT ON COLUMN qa_ldap_link.update_by IS '更新者';
+COMMENT ON COLUMN qa_ldap_link.update_at IS '更新时间';
+COMMENT ON COLUMN qa_ldap_link.tenant_id IS '租户编号';
+
+DROP INDEX IF EXISTS idx_ldap_link_external;
+CREATE UNIQUE INDEX idx_ldap_link_external ON qa_ldap_link (tenant_id, type, external_id);
+DROP INDEX IF EXISTS idx_ldap_link_target;
+CREATE INDEX idx_ldap_link_target ON qa_ldap_link (target_id);
+
+DROP TABLE IF EXISTS qa_scim_config CASCADE;
+CREATE TABLE qa_scim_config
+(
+    id           varchar(32) PRIMARY KEY,
+    token_prefix varchar(16)                           NOT NULL,
+    token_hash   varchar(64)                           NOT NULL,
+    group_target smallint    DEFAULT 1                 NOT NULL,
+    root_dept_id varchar(32) DEFAULT '',
+    status       smallint                              NOT NULL,
+    create_by    varchar(64) DEFAULT '',
+    create_at    timestamp   DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    update_by    varchar(64) DEFAULT '',
+    update_at    timestamp   DEFAULT CURRENT_TIMESTAMP NOT NULL,
+    delete_at    timestamp,
+    tenant_id    varchar(32) DEFAULT ''                NOT NULL
+);
+
+COMMENT ON TABLE qa_scim_config IS 'SCIM配置表，每个租户一份';
+COMMENT ON COLUMN qa_scim_config.id IS '配置编号';
+COMMENT ON COLUMN qa_scim_config.token_prefix IS '令牌前缀，用于展示';
+COMMENT ON COLUMN qa_scim_config.token_hash IS '令牌的SHA-256哈希';
+COMMENT ON COLUMN qa_scim_config.group_target IS '组映射到的本地数据（1部门 2角色）';
+COMMENT ON COLUMN qa_scim_config.root_dept_id IS '新建部门挂载的上级部门';
+COMMENT ON COLUMN qa_scim_config.status IS '状态（0停用 1正常）';
+COMMENT ON COLUMN qa_scim_config.create_by IS '创建者';
+COMMENT ON COLUMN qa_scim_config.create_at IS '创建时间';
+COMMENT ON COLUMN qa_scim_config.update_by IS '更新者';
+COMMENT ON COLUMN qa_scim_config.update_at IS '更新时间';
+COMMENT ON COLUMN qa_scim_config.delete_at IS '删除时间';
+COMMENT ON COLUMN qa_scim_config.tenant_id IS '租户编号';
+
+DROP INDEX IF EXISTS idx_scim_config_tenant;
+CREATE UNIQUE INDEX idx_scim_config_tenant ON qa_scim_config (tenant_id) WHERE delete_at IS NULL;
+DROP INDEX IF EXISTS idx_scim_config_token;
+CREATE UNIQUE INDEX idx_scim_config_token ON qa_scim_config (token_hash) WHERE delete_at IS NULL;
@@ -1,14 +1,27 @@
 package id
 
 const (
-	EMPTY          = ""
-	ADMIN_USER     = "AUID"
-	MENU           = "MENU"
-	ROLE           = "ROLE"
-	DEPT           = "DEPT"
-	POST           = "POST"
-	DICT           = "DICT"
-	CONFIG         = "CONF"
-	TENANT         = "TENA"
-	TENANT_PACKAGE = "TPAC"
+	EMPTY           = ""
+	ADMIN_USER      = "AUID"
+	MENU            = "MENU"
+	ROLE            = "ROLE"
+	DEPT            = "DEPT"
+	POST            = "POST"
+	DICT            = "DICT"
+	CONFIG          = "CONF"
+	TENANT          = "TENA"
+	TENANT_PACKAGE  = "TPAC"
+	LOGIN_LOG       = "LLOG"
+	OPERATE_LOG     = "OLOG"
+	PASSWORD_HIST   = "PWDH"
+	API_KEY         = "APIK"
+	OAUTH2_CLIENT   = "OACL"
+	OIDC_KEY        = "OIDK"
+	SOCIAL_PROVIDER = "SOCP"
+	USER_SOCIAL     = "USOC"
+	LDAP_CONFIG     = "LDAC"
+	LDAP_LINK       = "LDAL"
+	SCIM_CONFIG     = "SCIC"
+	PASSKEY         = "PKEY"
+	IP_RULE         = "IPRL"
 )
@@ -0,0 +1,11 @@
+package errkey
+
+import "quest-admin/pkg/errorx"
+
+var (
+	ErrOperateLogNotFound errorx.ErrorKey = "OPERATE_LOG_NOT_FOUND"
+)
+
+func init() {
+	errorx.Register(ErrOperateLogNotFound, 404, "OPERATE_LOG_NOT_FOUND", "操作日志不存在")
+}
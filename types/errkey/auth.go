@@ -3,13 +3,86 @@ package errkey
 import "quest-admin/pkg/errorx"
 
 var (
-	ErrTokenInvalid  errorx.ErrorKey = "TOKEN_INVALID"
-	ErrTokenExpired  errorx.ErrorKey = "TOKEN_EXPIRED"
-	ErrPasswordError errorx.ErrorKey = "PASSWORD_ERROR"
+	ErrTokenInvalid    errorx.ErrorKey = "TOKEN_INVALID"
+	ErrTokenExpired    errorx.ErrorKey = "TOKEN_EXPIRED"
+	ErrPasswordError   errorx.ErrorKey = "PASSWORD_ERROR"
+	ErrBadCredentials  errorx.ErrorKey = "BAD_CREDENTIALS"
+	ErrSessionNotFound errorx.ErrorKey = "SESSION_NOT_FOUND"
+
+	ErrRefreshTokenInvalid errorx.ErrorKey = "REFRESH_TOKEN_INVALID"
+	ErrRefreshTokenReused  errorx.ErrorKey = "REFRESH_TOKEN_REUSED"
+	ErrLoginLocked         errorx.ErrorKey = "LOGIN_LOCKED"
+	ErrCaptchaRequired     errorx.ErrorKey = "CAPTCHA_REQUIRED"
+	ErrCaptchaInvalid      errorx.ErrorKey = "CAPTCHA_INVALID"
+	ErrMfaTicketInvalid    errorx.ErrorKey = "MFA_TICKET_INVALID"
+	ErrMfaCodeInvalid      errorx.ErrorKey = "MFA_CODE_INVALID"
+	ErrMfaAlreadyEnabled   errorx.ErrorKey = "MFA_ALREADY_ENABLED"
+	ErrMfaNotEnabled       errorx.ErrorKey = "MFA_NOT_ENABLED"
+	ErrMfaNotEnrolling     errorx.ErrorKey = "MFA_NOT_ENROLLING"
+
+	ErrPasswordResetTokenInvalid errorx.ErrorKey = "PASSWORD_RESET_TOKEN_INVALID"
+
+	ErrApiKeyInvalid       errorx.ErrorKey = "API_KEY_INVALID"
+	ErrApiKeyNotFound      errorx.ErrorKey = "API_KEY_NOT_FOUND"
+	ErrApiKeyLimitExceeded errorx.ErrorKey = "API_KEY_LIMIT_EXCEEDED"
+	ErrApiKeyScopeDenied   errorx.ErrorKey = "API_KEY_SCOPE_DENIED"
+	ErrApiKeyNotAllowed    errorx.ErrorKey = "API_KEY_NOT_ALLOWED"
+
+	ErrPasskeyDisabled       errorx.ErrorKey = "PASSKEY_DISABLED"
+	ErrPasskeySessionInvalid errorx.ErrorKey = "PASSKEY_SESSION_INVALID"
+	ErrPasskeyInvalid        errorx.ErrorKey = "PASSKEY_INVALID"
+	ErrPasskeyNotFound       errorx.ErrorKey = "PASSKEY_NOT_FOUND"
+	ErrPasskeyExists         errorx.ErrorKey = "PASSKEY_EXISTS"
+	ErrPasskeyLimitExceeded  errorx.ErrorKey = "PASSKEY_LIMIT_EXCEEDED"
+
+	ErrLoginCodeChannelInvalid errorx.ErrorKey = "LOGIN_CODE_CHANNEL_INVALID"
+	ErrLoginCodeTooFrequent    errorx.ErrorKey = "LOGIN_CODE_TOO_FREQUENT"
+	ErrLoginCodeLimitExceeded  errorx.ErrorKey = "LOGIN_CODE_LIMIT_EXCEEDED"
+	ErrLoginCodeInvalid        errorx.ErrorKey = "LOGIN_CODE_INVALID"
+
+	ErrIpNotAllowed      errorx.ErrorKey = "IP_NOT_ALLOWED"
+	ErrIpRuleNotFound    errorx.ErrorKey = "IP_RULE_NOT_FOUND"
+	ErrIpRuleInvalid     errorx.ErrorKey = "IP_RULE_INVALID"
+	ErrIpRuleSelfLockout errorx.ErrorKey = "IP_RULE_SELF_LOCKOUT"
 )
 
 func init() {
 	errorx.Register(ErrTokenInvalid, 401, "TOKEN_INVALID", "token invalid")
+	errorx.Register(ErrSessionNotFound, 404, "SESSION_NOT_FOUND", "session not found")
 	errorx.Register(ErrTokenExpired, 401, "TOKEN_EXPIRED", "token expired")
 	errorx.Register(ErrPasswordError, 401, "PASSWORD_ERROR", "password error")
+	errorx.Register(ErrBadCredentials, 401, "BAD_CREDENTIALS", "username or password incorrect")
+	errorx.Register(ErrRefreshTokenInvalid, 401, "REFRESH_TOKEN_INVALID", "refresh token invalid")
+	errorx.Register(ErrRefreshTokenReused, 401, "REFRESH_TOKEN_REUSED", "refresh token reused, all sessions revoked")
+	errorx.Register(ErrLoginLocked, 429, "LOGIN_LOCKED", "too many failed login attempts, retry after %d seconds")
+	errorx.Register(ErrCaptchaRequired, 400, "CAPTCHA_REQUIRED", "captcha required")
+	errorx.Register(ErrCaptchaInvalid, 400, "CAPTCHA_INVALID", "captcha invalid or expired")
+	errorx.Register(ErrMfaTicketInvalid, 401, "MFA_TICKET_INVALID", "mfa ticket invalid or expired")
+	errorx.Register(ErrMfaCodeInvalid, 400, "MFA_CODE_INVALID", "mfa code invalid")
+	errorx.Register(ErrMfaAlreadyEnabled, 400, "MFA_ALREADY_ENABLED", "totp already enabled")
+	errorx.Register(ErrMfaNotEnabled, 400, "MFA_NOT_ENABLED", "totp not enabled")
+	errorx.Register(ErrMfaNotEnrolling, 400, "MFA_NOT_ENROLLING", "totp enrollment not started")
+	errorx.Register(ErrPasswordResetTokenInvalid, 400, "PASSWORD_RESET_TOKEN_INVALID", "password reset token invalid or expired")
+	errorx.Register(ErrApiKeyInvalid, 401, "API_KEY_INVALID", "api key invalid, expired or revoked")
+	errorx.Register(ErrApiKeyNotFound, 404, "API_KEY_NOT_FOUND", "api key not found")
+	errorx.Register(ErrApiKeyLimitExceeded, 400, "API_KEY_LIMIT_EXCEEDED", "at most %d api keys per user")
+	errorx.Register(ErrApiKeyScopeDenied, 403, "API_KEY_SCOPE_DENIED", "permission not granted to current user: %s")
+	errorx.Register(ErrApiKeyNotAllowed, 403, "API_KEY_NOT_ALLOWED", "operation not allowed with an api key or oauth2 token")
+
+	errorx.Register(ErrPasskeyDisabled, 400, "PASSKEY_DISABLED", "passkey is not configured")
+	errorx.Register(ErrPasskeySessionInvalid, 400, "PASSKEY_SESSION_INVALID", "passkey session invalid or expired")
+	errorx.Register(ErrPasskeyInvalid, 400, "PASSKEY_INVALID", "passkey verification failed")
+	errorx.Register(ErrPasskeyNotFound, 404, "PASSKEY_NOT_FOUND", "passkey not found")
+	errorx.Register(ErrPasskeyExists, 409, "PASSKEY_EXISTS", "passkey already registered")
+	errorx.Register(ErrPasskeyLimitExceeded, 400, "PASSKEY_LIMIT_EXCEEDED", "at most %d passkeys per user")
+
+	errorx.Register(ErrLoginCodeChannelInvalid, 400, "LOGIN_CODE_CHANNEL_INVALID", "unsupported login code channel: %s")
+	errorx.Register(ErrLoginCodeTooFrequent, 429, "LOGIN_CODE_TOO_FREQUENT", "login code sent too frequently, retry after %d seconds")
+	errorx.Register(ErrLoginCodeLimitExceeded, 429, "LOGIN_CODE_LIMIT_EXCEEDED", "daily login code limit exceeded")
+	errorx.Register(ErrLoginCodeInvalid, 400, "LOGIN_CODE_INVALID", "login code invalid or expired")
+
+	errorx.Register(ErrIpNotAllowed, 403, "IP_NOT_ALLOWED", "access from ip %s is not allowed")
+	errorx.Register(ErrIpRuleNotFound, 404, "IP_RULE_NOT_FOUND", "ip rule not found")
+	errorx.Register(ErrIpRuleInvalid, 400, "IP_RULE_INVALID", "invalid ip rule: %s")
+	errorx.Register(ErrIpRuleSelfLockout, 400, "IP_RULE_SELF_LOCKOUT", "change would block your current ip %s")
 }
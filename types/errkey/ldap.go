@@ -0,0 +1,19 @@
+package errkey
+
+import "quest-admin/pkg/errorx"
+
+var (
+	ErrLdapConfigNotFound errorx.ErrorKey = "LDAP_CONFIG_NOT_FOUND"
+	ErrLdapConfigInvalid  errorx.ErrorKey = "LDAP_CONFIG_INVALID"
+	ErrLdapUnavailable    errorx.ErrorKey = "LDAP_UNAVAILABLE"
+	ErrLdapSyncRunning    errorx.ErrorKey = "LDAP_SYNC_RUNNING"
+	ErrLdapSyncFailed     errorx.ErrorKey = "LDAP_SYNC_FAILED"
+)
+
+func init() {
+	errorx.Register(ErrLdapConfigNotFound, 404, "LDAP_CONFIG_NOT_FOUND", "ldap is not configured or disabled")
+	errorx.Register(ErrLdapConfigInvalid, 400, "LDAP_CONFIG_INVALID", "ldap config invalid: %s")
+	errorx.Register(ErrLdapUnavailable, 503, "LDAP_UNAVAILABLE", "directory service unavailable")
+	errorx.Register(ErrLdapSyncRunning, 409, "LDAP_SYNC_RUNNING", "directory sync is already running")
+	errorx.Register(ErrLdapSyncFailed, 500, "LDAP_SYNC_FAILED", "directory sync failed: %s")
+}
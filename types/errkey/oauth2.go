@@ -0,0 +1,31 @@
+package errkey
+
+import "quest-admin/pkg/errorx"
+
+// OAuth2 错误的 reason 去掉 OAUTH2_ 前缀并转为小写后即为 RFC 6749 定义的错误码
+var (
+	ErrOAuth2InvalidRequest          errorx.ErrorKey = "OAUTH2_INVALID_REQUEST"
+	ErrOAuth2InvalidClient           errorx.ErrorKey = "OAUTH2_INVALID_CLIENT"
+	ErrOAuth2InvalidGrant            errorx.ErrorKey = "OAUTH2_INVALID_GRANT"
+	ErrOAuth2UnauthorizedClient      errorx.ErrorKey = "OAUTH2_UNAUTHORIZED_CLIENT"
+	ErrOAuth2UnsupportedGrantType    errorx.ErrorKey = "OAUTH2_UNSUPPORTED_GRANT_TYPE"
+	ErrOAuth2UnsupportedResponseType errorx.ErrorKey = "OAUTH2_UNSUPPORTED_RESPONSE_TYPE"
+	ErrOAuth2InvalidScope            errorx.ErrorKey = "OAUTH2_INVALID_SCOPE"
+
+	ErrOAuth2ClientNotFound errorx.ErrorKey = "OAUTH2_CLIENT_NOT_FOUND"
+	ErrOAuth2ClientInvalid  errorx.ErrorKey = "OAUTH2_CLIENT_INVALID"
+	ErrOAuth2ScopeDenied    errorx.ErrorKey = "OAUTH2_SCOPE_DENIED"
+)
+
+func init() {
+	errorx.Register(ErrOAuth2InvalidRequest, 400, "OAUTH2_INVALID_REQUEST", "invalid request: %s")
+	errorx.Register(ErrOAuth2InvalidClient, 401, "OAUTH2_INVALID_CLIENT", "client authentication failed")
+	errorx.Register(ErrOAuth2InvalidGrant, 400, "OAUTH2_INVALID_GRANT", "authorization grant invalid, expired or revoked")
+	errorx.Register(ErrOAuth2UnauthorizedClient, 400, "OAUTH2_UNAUTHORIZED_CLIENT", "client not allowed to use grant type: %s")
+	errorx.Register(ErrOAuth2UnsupportedGrantType, 400, "OAUTH2_UNSUPPORTED_GRANT_TYPE", "unsupported grant type: %s")
+	errorx.Register(ErrOAuth2UnsupportedResponseType, 400, "OAUTH2_UNSUPPORTED_RESPONSE_TYPE", "unsupported response type: %s")
+	errorx.Register(ErrOAuth2InvalidScope, 400, "OAUTH2_INVALID_SCOPE", "invalid scope: %s")
+	errorx.Register(ErrOAuth2ClientNotFound, 404, "OAUTH2_CLIENT_NOT_FOUND", "oauth2 client not found")
+	errorx.Register(ErrOAuth2ClientInvalid, 400, "OAUTH2_CLIENT_INVALID", "oauth2 client invalid: %s")
+	errorx.Register(ErrOAuth2ScopeDenied, 403, "OAUTH2_SCOPE_DENIED", "scope not granted to current user: %s")
+}
@@ -11,6 +11,7 @@ var (
 	ErrInvalidMenuType   errorx.ErrorKey = "INVALID_MENU_TYPE"
 	ErrMenuLevelExceeded errorx.ErrorKey = "MENU_LEVEL_EXCEEDED"
 	ErrInvalidMenuPath   errorx.ErrorKey = "INVALID_MENU_PATH"
+	ErrMenuNotInPackage  errorx.ErrorKey = "MENU_NOT_IN_PACKAGE"
 )
 
 var (
@@ -36,4 +37,5 @@ func init() {
 	errorx.Register(ErrInvalidMenuType, 400, "INVALID_MENU_TYPE", "invalid menu type")
 	errorx.Register(ErrMenuLevelExceeded, 400, "MENU_LEVEL_EXCEEDED", "menu level exceeded")
 	errorx.Register(ErrInvalidMenuPath, 400, "INVALID_MENU_PATH", "invalid menu path")
+	errorx.Register(ErrMenuNotInPackage, 400, "MENU_NOT_IN_PACKAGE", "menu %s is not included in the tenant package")
 }
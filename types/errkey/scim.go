@@ -0,0 +1,21 @@
+package errkey
+
+import "quest-admin/pkg/errorx"
+
+var (
+	ErrScimConfigNotFound errorx.ErrorKey = "SCIM_CONFIG_NOT_FOUND"
+	ErrScimUnauthorized   errorx.ErrorKey = "SCIM_UNAUTHORIZED"
+	ErrScimInvalidFilter  errorx.ErrorKey = "SCIM_INVALID_FILTER"
+	ErrScimInvalidValue   errorx.ErrorKey = "SCIM_INVALID_VALUE"
+	ErrScimInvalidPath    errorx.ErrorKey = "SCIM_INVALID_PATH"
+	ErrScimMutability     errorx.ErrorKey = "SCIM_MUTABILITY"
+)
+
+func init() {
+	errorx.Register(ErrScimConfigNotFound, 404, "SCIM_CONFIG_NOT_FOUND", "scim is not configured")
+	errorx.Register(ErrScimUnauthorized, 401, "SCIM_UNAUTHORIZED", "scim token is invalid or disabled")
+	errorx.Register(ErrScimInvalidFilter, 400, "SCIM_INVALID_FILTER", "invalid filter: %s")
+	errorx.Register(ErrScimInvalidValue, 400, "SCIM_INVALID_VALUE", "invalid value: %s")
+	errorx.Register(ErrScimInvalidPath, 400, "SCIM_INVALID_PATH", "invalid path: %s")
+	errorx.Register(ErrScimMutability, 400, "SCIM_MUTABILITY", "attribute is immutable: %s")
+}
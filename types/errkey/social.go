@@ -0,0 +1,25 @@
+package errkey
+
+import "quest-admin/pkg/errorx"
+
+var (
+	ErrSocialProviderNotFound errorx.ErrorKey = "SOCIAL_PROVIDER_NOT_FOUND"
+	ErrSocialProviderExists   errorx.ErrorKey = "SOCIAL_PROVIDER_EXISTS"
+	ErrSocialProviderInvalid  errorx.ErrorKey = "SOCIAL_PROVIDER_INVALID"
+	ErrSocialStateInvalid     errorx.ErrorKey = "SOCIAL_STATE_INVALID"
+	ErrSocialLoginFailed      errorx.ErrorKey = "SOCIAL_LOGIN_FAILED"
+	ErrSocialNotBound         errorx.ErrorKey = "SOCIAL_NOT_BOUND"
+	ErrSocialAlreadyBound     errorx.ErrorKey = "SOCIAL_ALREADY_BOUND"
+	ErrSocialNotFound         errorx.ErrorKey = "SOCIAL_NOT_FOUND"
+)
+
+func init() {
+	errorx.Register(ErrSocialProviderNotFound, 404, "SOCIAL_PROVIDER_NOT_FOUND", "identity provider not found or disabled")
+	errorx.Register(ErrSocialProviderExists, 400, "SOCIAL_PROVIDER_EXISTS", "identity provider code already exists")
+	errorx.Register(ErrSocialProviderInvalid, 400, "SOCIAL_PROVIDER_INVALID", "identity provider invalid: %s")
+	errorx.Register(ErrSocialStateInvalid, 400, "SOCIAL_STATE_INVALID", "login state invalid or expired")
+	errorx.Register(ErrSocialLoginFailed, 401, "SOCIAL_LOGIN_FAILED", "third-party login failed")
+	errorx.Register(ErrSocialNotBound, 401, "SOCIAL_NOT_BOUND", "no local account is bound to this identity")
+	errorx.Register(ErrSocialAlreadyBound, 400, "SOCIAL_ALREADY_BOUND", "identity already bound to another account")
+	errorx.Register(ErrSocialNotFound, 404, "SOCIAL_NOT_FOUND", "social binding not found")
+}
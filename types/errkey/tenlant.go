@@ -9,6 +9,10 @@ var (
 	ErrInvalidTenantStatus errorx.ErrorKey = "INVALID_TENANT_STATUS"
 	ErrInvalidExpireTime   errorx.ErrorKey = "INVALID_TENANT_EXPIRE_TIME"
 	ErrInvalidAccountCount errorx.ErrorKey = "INVALID_TENANT_ACCOUNT_COUNT"
+	ErrTenantDisabled      errorx.ErrorKey = "TENANT_DISABLED"
+	ErrTenantExpired       errorx.ErrorKey = "TENANT_EXPIRED"
+	ErrTenantAccountLimit  errorx.ErrorKey = "TENANT_ACCOUNT_LIMIT"
+	ErrTenantSwitchDenied  errorx.ErrorKey = "TENANT_SWITCH_DENIED"
 )
 
 var (
@@ -16,6 +20,7 @@ var (
 	ErrTenantPackageNameExists    errorx.ErrorKey = "TENANT_PACKAGE_NAME_EXISTS"
 	ErrTenantPackageInUse         errorx.ErrorKey = "TENANT_PACKAGE_IN_USE"
 	ErrInvalidTenantPackageStatus errorx.ErrorKey = "INVALID_TENANT_PACKAGE_STATUS"
+	ErrTenantPackageRequired      errorx.ErrorKey = "TENANT_PACKAGE_REQUIRED"
 )
 
 func init() {
@@ -23,6 +28,7 @@ func init() {
 	errorx.Register(ErrTenantPackageNameExists, 409, "TENANT_PACKAGE_NAME_EXISTS", "tenant package name already exists")
 	errorx.Register(ErrTenantPackageInUse, 400, "TENANT_PACKAGE_IN_USE", "tenant package is in use")
 	errorx.Register(ErrInvalidTenantPackageStatus, 400, "INVALID_TENANT_PACKAGE_STATUS", "invalid tenant package status")
+	errorx.Register(ErrTenantPackageRequired, 400, "TENANT_PACKAGE_REQUIRED", "tenant package is required")
 
 	errorx.Register(ErrTenantNotFound, 404, "TENANT_NOT_FOUND", "tenant not found")
 	errorx.Register(ErrTenantNameExists, 409, "TENANT_NAME_EXISTS", "tenant name already exists")
@@ -30,4 +36,8 @@ func init() {
 	errorx.Register(ErrInvalidTenantStatus, 400, "INVALID_TENANT_STATUS", "invalid tenant status")
 	errorx.Register(ErrInvalidExpireTime, 400, "INVALID_TENANT_EXPIRE_TIME", "invalid tenant expire time")
 	errorx.Register(ErrInvalidAccountCount, 400, "INVALID_TENANT_ACCOUNT_COUNT", "invalid tenant account count")
+	errorx.Register(ErrTenantDisabled, 403, "TENANT_DISABLED", "tenant is disabled")
+	errorx.Register(ErrTenantExpired, 403, "TENANT_EXPIRED", "tenant has expired")
+	errorx.Register(ErrTenantAccountLimit, 400, "TENANT_ACCOUNT_LIMIT", "tenant account limit of %d reached")
+	errorx.Register(ErrTenantSwitchDenied, 403, "TENANT_SWITCH_DENIED", "only platform super admins can switch tenant")
 }
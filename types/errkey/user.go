@@ -10,6 +10,9 @@ var (
 	ErrInvalidOperationType    errorx.ErrorKey = "INVALID_OPERATION_TYPE"
 	ErrPasswordNotMatch        errorx.ErrorKey = "PASSWORD_NOT_MATCH"
 	ErrUserDisabled            errorx.ErrorKey = "USER_DISABLED"
+	ErrPasswordPolicy          errorx.ErrorKey = "PASSWORD_POLICY_VIOLATION"
+	ErrPasswordReused          errorx.ErrorKey = "PASSWORD_REUSED"
+	ErrPasswordChangeRequired  errorx.ErrorKey = "PASSWORD_CHANGE_REQUIRED"
 )
 
 func init() {
@@ -20,4 +23,7 @@ func init() {
 	errorx.Register(ErrInvalidOperationType, 400, "INVALID_OPERATION_TYPE", "invalid operation type")
 	errorx.Register(ErrPasswordNotMatch, 400, "PASSWORD_NOT_MATCH", "password not match")
 	errorx.Register(ErrUserDisabled, 403, "USER_DISABLED", "user disabled")
+	errorx.Register(ErrPasswordPolicy, 400, "PASSWORD_POLICY_VIOLATION", "password policy violation: %s")
+	errorx.Register(ErrPasswordReused, 400, "PASSWORD_REUSED", "password was used recently")
+	errorx.Register(ErrPasswordChangeRequired, 403, "PASSWORD_CHANGE_REQUIRED", "password must be changed first")
 }